@@ -0,0 +1,180 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/trofimovm/summvideo/models"
+	"github.com/trofimovm/summvideo/repositories"
+	"github.com/trofimovm/summvideo/utils"
+)
+
+// adminUsage описывает подкоманду управления администраторами
+const adminUsage = `Использование:
+  summvideo-app admin list
+  summvideo-app admin create -username NAME [-name FIRST_NAME] [-roles superadmin,support] [-force-change]
+  summvideo-app admin passwd -username NAME [-force-change]
+  summvideo-app admin disable -username NAME
+
+Пароль читается из переменной ADMIN_PASSWORD или из первой строки стандартного ввода.
+Администратор, созданный миграцией, называется superadmin.`
+
+// runAdminCommand выполняет подкоманду управления администраторами
+func runAdminCommand(args []string) error {
+	if len(args) == 0 {
+		return errors.New(adminUsage)
+	}
+
+	flags := flag.NewFlagSet("admin "+args[0], flag.ContinueOnError)
+	username := flags.String("username", "", "имя пользователя администратора")
+	firstName := flags.String("name", "", "имя администратора")
+	roles := flags.String("roles", models.RoleSuperadmin, "роли через запятую")
+	forceChange := flags.Bool("force-change", false, "потребовать смену пароля при входе")
+	if err := flags.Parse(args[1:]); err != nil {
+		return err
+	}
+
+	adminRepo := repositories.AdminRepository{}
+
+	if args[0] == "list" {
+		admins, err := adminRepo.FindAll()
+		if err != nil {
+			return err
+		}
+		for _, admin := range admins {
+			fmt.Printf("%d\t%s\tactive=%t\tmust_change_password=%t\troles=%s\n",
+				admin.ID, admin.Username, admin.IsActive, admin.MustChangePassword, strings.Join(admin.Roles, ","))
+		}
+		return nil
+	}
+
+	if *username == "" {
+		return errors.New("не указан -username\n" + adminUsage)
+	}
+
+	switch args[0] {
+	case "create":
+		passwordHash, err := readAdminPassword(*username)
+		if err != nil {
+			return err
+		}
+		admin, err := adminRepo.Create(*username, *firstName, passwordHash, strings.Split(*roles, ","), *forceChange, nil)
+		if err != nil {
+			return err
+		}
+		log.Printf("Администратор %s создан (ID %d)", admin.Username, admin.ID)
+
+	case "passwd":
+		admin, err := adminRepo.FindByUsername(*username)
+		if err != nil {
+			return fmt.Errorf("администратор %s не найден: %w", *username, err)
+		}
+		passwordHash, err := readAdminPassword(admin.Username)
+		if err != nil {
+			return err
+		}
+		if err := adminRepo.SetPassword(admin.ID, passwordHash, *forceChange); err != nil {
+			return err
+		}
+		sessionRepo := repositories.SessionRepository{}
+		if _, err := sessionRepo.RevokeAllByUserID(admin.ID); err != nil {
+			return err
+		}
+		log.Printf("Пароль администратора %s изменен", admin.Username)
+
+	case "disable":
+		admin, err := adminRepo.FindByUsername(*username)
+		if err != nil {
+			return fmt.Errorf("администратор %s не найден: %w", *username, err)
+		}
+		if err := adminRepo.Disable(admin.ID); err != nil {
+			return err
+		}
+		sessionRepo := repositories.SessionRepository{}
+		if _, err := sessionRepo.RevokeAllByUserID(admin.ID); err != nil {
+			return err
+		}
+		log.Printf("Администратор %s отключен", admin.Username)
+
+	default:
+		return fmt.Errorf("неизвестная команда %q\n%s", args[0], adminUsage)
+	}
+
+	return nil
+}
+
+// readAdminPassword читает пароль из ADMIN_PASSWORD или стандартного ввода,
+// проверяет его надежность и возвращает хэш
+func readAdminPassword(username string) (string, error) {
+	password, ok := os.LookupEnv("ADMIN_PASSWORD")
+	if !ok {
+		fmt.Fprint(os.Stderr, "Пароль: ")
+		line, err := bufio.NewReader(os.Stdin).ReadString('\n')
+		if err != nil && line == "" {
+			return "", fmt.Errorf("не удалось прочитать пароль: %w", err)
+		}
+		password = strings.TrimRight(line, "\r\n")
+	}
+
+	if err := utils.ValidatePassword(password, username); err != nil {
+		return "", err
+	}
+
+	return utils.HashPassword(password)
+}
+
+// bootstrapAdmin создает первого администратора из ADMIN_BOOTSTRAP_USERNAME и
+// ADMIN_BOOTSTRAP_PASSWORD, если других активных администраторов с паролем нет.
+// После этого администратор, созданный миграцией, отключается.
+func bootstrapAdmin() error {
+	username := os.Getenv("ADMIN_BOOTSTRAP_USERNAME")
+	password := os.Getenv("ADMIN_BOOTSTRAP_PASSWORD")
+	if username == "" || password == "" {
+		return nil
+	}
+
+	adminRepo := repositories.AdminRepository{}
+	count, err := adminRepo.CountActive()
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		return nil
+	}
+
+	if err := utils.ValidatePassword(password, username); err != nil {
+		return fmt.Errorf("ADMIN_BOOTSTRAP_PASSWORD: %w", err)
+	}
+	passwordHash, err := utils.HashPassword(password)
+	if err != nil {
+		return err
+	}
+
+	// Пароль из окружения остается в конфигурации, поэтому при первом входе его нужно сменить
+	admin, err := adminRepo.Create(username, "", passwordHash, []string{models.RoleSuperadmin}, true, nil)
+	if err != nil {
+		return err
+	}
+	log.Printf("Создан первый администратор %s", admin.Username)
+
+	userRepo := repositories.UserRepository{}
+	seeded, err := userRepo.FindByTelegramID(repositories.SeededAdminTelegramID)
+	if err != nil || !seeded.IsActive {
+		return nil
+	}
+	if err := adminRepo.Disable(seeded.ID); err != nil {
+		return fmt.Errorf("не удалось отключить администратора из миграции: %w", err)
+	}
+	sessionRepo := repositories.SessionRepository{}
+	if _, err := sessionRepo.RevokeAllByUserID(seeded.ID); err != nil {
+		return err
+	}
+	log.Printf("Администратор %s, созданный миграцией, отключен", seeded.Username)
+
+	return nil
+}
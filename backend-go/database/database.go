@@ -6,7 +6,6 @@ import (
 	"fmt"
 	"log"
 	"os"
-	"path/filepath"
 
 	"github.com/golang-migrate/migrate/v4"
 	_ "github.com/golang-migrate/migrate/v4/database/postgres"
@@ -57,20 +56,20 @@ func RunMigrations(migrationsDir string) error {
 
 	// Формируем путь к миграциям
 	migrationPath := fmt.Sprintf("file://%s", migrationsDir)
-	
+
 	// Создаем экземпляр мигратора
 	m, err := migrate.New(migrationPath, dbURL)
 	if err != nil {
 		return fmt.Errorf("ошибка при инициализации миграций: %v", err)
 	}
-	
+
 	// Запускаем миграции
 	if err := m.Up(); err != nil && err != migrate.ErrNoChange {
 		return fmt.Errorf("ошибка при выполнении миграций: %v", err)
 	}
-	
+
 	log.Println("Миграции успешно применены")
-	
+
 	return nil
 }
 
@@ -80,4 +79,4 @@ func Close() {
 		DB.Close()
 		log.Println("Соединение с базой данных закрыто")
 	}
-}
\ No newline at end of file
+}
@@ -1,15 +1,18 @@
 package handlers
 
 import (
+	"errors"
 	"net/http"
 	"strconv"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/trofimovm/summvideo/models"
 	"github.com/trofimovm/summvideo/repositories"
 )
 
-// AdminMiddleware проверяет, что пользователь является администратором
+// AdminMiddleware проверяет, что пользователю назначена хотя бы одна роль
+// администратора, и сохраняет его права в контексте для RequirePermission
 func AdminMiddleware(c *gin.Context) {
 	// Получаем ID пользователя из контекста (установлен в AuthMiddleware)
 	userID, exists := c.Get("userID")
@@ -21,19 +24,19 @@ func AdminMiddleware(c *gin.Context) {
 		return
 	}
 
-	// Получаем информацию о пользователе
-	userRepo := repositories.UserRepository{}
-	user, err := userRepo.FindByID(userID.(int64))
+	// Получаем права пользователя по его ролям
+	roleRepo := repositories.RoleRepository{}
+	permissions, err := roleRepo.FindPermissionsByUserID(userID.(int64))
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
-			Error: "Ошибка получения информации о пользователе: " + err.Error(),
+			Error: "Ошибка получения прав пользователя: " + err.Error(),
 		})
 		c.Abort()
 		return
 	}
 
 	// Проверяем, что пользователь - админ
-	if !user.IsAdmin {
+	if len(permissions) == 0 {
 		c.JSON(http.StatusForbidden, models.ErrorResponse{
 			Error: "Недостаточно прав для доступа",
 		})
@@ -41,6 +44,25 @@ func AdminMiddleware(c *gin.Context) {
 		return
 	}
 
+	// Администратор с временным или общеизвестным паролем должен сначала его сменить
+	userRepo := repositories.UserRepository{}
+	user, err := userRepo.FindByID(userID.(int64))
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, models.ErrorResponse{
+			Error: "Пользователь не найден",
+		})
+		c.Abort()
+		return
+	}
+	if user.MustChangePassword {
+		c.JSON(http.StatusForbidden, models.ErrorResponse{
+			Error: "Требуется сменить пароль",
+		})
+		c.Abort()
+		return
+	}
+
+	c.Set("permissions", permissions)
 	c.Next()
 }
 
@@ -59,14 +81,14 @@ func GetAllUsers(c *gin.Context) {
 	// Получаем параметры пагинации
 	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
 	pageSize, _ := strconv.Atoi(c.DefaultQuery("page_size", "10"))
-	
+
 	if page < 1 {
 		page = 1
 	}
 	if pageSize < 1 || pageSize > 100 {
 		pageSize = 10
 	}
-	
+
 	offset := (page - 1) * pageSize
 
 	// Получаем список пользователей
@@ -99,7 +121,7 @@ func GetAllUsers(c *gin.Context) {
 	})
 }
 
-// UpdateUserUsageLimit обновляет лимит использования для пользователя
+// UpdateUserUsageLimit устанавливает остаток квоты пользователя до конца текущего периода
 func UpdateUserUsageLimit(c *gin.Context) {
 	var update models.UsageLimitUpdate
 
@@ -112,7 +134,7 @@ func UpdateUserUsageLimit(c *gin.Context) {
 
 	// Проверяем, что пользователь существует
 	userRepo := repositories.UserRepository{}
-	user, err := userRepo.FindByID(update.UserID)
+	_, err := userRepo.FindByID(update.UserID)
 	if err != nil {
 		c.JSON(http.StatusNotFound, models.ErrorResponse{
 			Error: "Пользователь не найден",
@@ -120,8 +142,9 @@ func UpdateUserUsageLimit(c *gin.Context) {
 		return
 	}
 
-	// Обновляем лимит использования
-	err = userRepo.UpdateUsageLimit(update.UserID, update.LimitSeconds)
+	// Устанавливаем остаток квоты, изменение записывается в журнал от имени администратора
+	adminID, _ := c.Get("userID")
+	err = userRepo.UpdateUsageLimit(update.UserID, update.LimitSeconds, adminID.(int64))
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
 			Error: "Ошибка обновления лимита: " + err.Error(),
@@ -192,10 +215,316 @@ func GetUserUsage(c *gin.Context) {
 		return
 	}
 
+	// Получаем роли пользователя
+	roleRepo := repositories.RoleRepository{}
+	roles, err := roleRepo.FindByUserID(userID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error: "Ошибка получения ролей: " + err.Error(),
+		})
+		return
+	}
+
 	c.JSON(http.StatusOK, gin.H{
-		"user":                   user,
+		"user":                    user,
 		"remaining_usage_seconds": remainingSeconds,
 		"recent_activity":         history,
 		"total_activity_count":    totalCount,
+		"roles":                   roles,
+	})
+}
+
+// GetPlans возвращает список тарифных планов
+func GetPlans(c *gin.Context) {
+	planRepo := repositories.PlanRepository{}
+	plans, err := planRepo.FindAll()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error: "Ошибка получения тарифных планов: " + err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"plans": plans,
+	})
+}
+
+// UpdateUserPlan назначает пользователю тарифный план
+func UpdateUserPlan(c *gin.Context) {
+	var assignment models.PlanAssignment
+
+	if err := c.ShouldBindJSON(&assignment); err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error: "Неверные данные: " + err.Error(),
+		})
+		return
+	}
+
+	// Проверяем, что пользователь существует
+	userRepo := repositories.UserRepository{}
+	if _, err := userRepo.FindByID(assignment.UserID); err != nil {
+		c.JSON(http.StatusNotFound, models.ErrorResponse{
+			Error: "Пользователь не найден",
+		})
+		return
+	}
+
+	planRepo := repositories.PlanRepository{}
+	if _, err := planRepo.FindByCode(assignment.Plan); err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error: "Тарифный план не найден",
+		})
+		return
+	}
+
+	userPlan, err := planRepo.AssignPlan(assignment.UserID, assignment.Plan)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error: "Ошибка назначения тарифного плана: " + err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message":   "Тарифный план назначен",
+		"user_plan": userPlan,
+	})
+}
+
+// GetUserCredits возвращает журнал начислений и списаний пользователя вместе
+// с текущим состоянием квоты
+func GetUserCredits(c *gin.Context) {
+	userID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error: "Неверный ID пользователя",
+		})
+		return
+	}
+
+	// Получаем параметры пагинации
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	pageSize, _ := strconv.Atoi(c.DefaultQuery("page_size", "20"))
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 || pageSize > 100 {
+		pageSize = 20
+	}
+
+	// Проверяем, что пользователь существует
+	userRepo := repositories.UserRepository{}
+	if _, err := userRepo.FindByID(userID); err != nil {
+		c.JSON(http.StatusNotFound, models.ErrorResponse{
+			Error: "Пользователь не найден",
+		})
+		return
+	}
+
+	planRepo := repositories.PlanRepository{}
+	quota, err := planRepo.GetQuota(userID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error: "Ошибка получения квоты: " + err.Error(),
+		})
+		return
+	}
+
+	ledgerRepo := repositories.LedgerRepository{}
+	entries, err := ledgerRepo.FindByUserID(userID, pageSize, (page-1)*pageSize)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error: "Ошибка получения журнала начислений: " + err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"quota":     quota,
+		"entries":   entries,
+		"page":      page,
+		"page_size": pageSize,
+	})
+}
+
+// GrantUserCredits начисляет пользователю бонусные секунды с указанием причины
+// и срока действия, например, чтобы вернуть время за неудачную обработку
+func GrantUserCredits(c *gin.Context) {
+	adjustCredits(c, models.LedgerCredit)
+}
+
+// DeductUserCredits списывает у пользователя секунды с указанием причины
+func DeductUserCredits(c *gin.Context) {
+	adjustCredits(c, models.LedgerDebit)
+}
+
+// adjustCredits проверяет запрос и добавляет в журнал запись от имени администратора
+func adjustCredits(c *gin.Context, direction string) {
+	userID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error: "Неверный ID пользователя",
+		})
+		return
+	}
+
+	var adjustment models.CreditAdjustment
+	if err := c.ShouldBindJSON(&adjustment); err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error: "Неверные данные: " + err.Error(),
+		})
+		return
+	}
+
+	if adjustment.ExpiresAt != nil && !adjustment.ExpiresAt.After(time.Now()) {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error: "Срок действия начисления должен быть в будущем",
+		})
+		return
+	}
+
+	// Проверяем, что пользователь существует
+	userRepo := repositories.UserRepository{}
+	if _, err := userRepo.FindByID(userID); err != nil {
+		c.JSON(http.StatusNotFound, models.ErrorResponse{
+			Error: "Пользователь не найден",
+		})
+		return
+	}
+
+	adminID, _ := c.Get("userID")
+	ledgerRepo := repositories.LedgerRepository{}
+
+	var entry *models.LedgerEntry
+	if direction == models.LedgerCredit {
+		entry, err = ledgerRepo.Grant(userID, adjustment.Seconds, adjustment.Reason, adjustment.ExpiresAt, adminID.(int64))
+	} else {
+		entry, err = ledgerRepo.Deduct(userID, adjustment.Seconds, adjustment.Reason, adminID.(int64))
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error: "Ошибка изменения баланса: " + err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"message": "Баланс пользователя изменен",
+		"entry":   entry,
 	})
-}
\ No newline at end of file
+}
+
+// RevokeUserSessions отзывает все сессии пользователя: выданные токены
+// перестают действовать, и пользователю нужно войти заново
+func RevokeUserSessions(c *gin.Context) {
+	userID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error: "Неверный ID пользователя",
+		})
+		return
+	}
+
+	// Проверяем, что пользователь существует
+	userRepo := repositories.UserRepository{}
+	if _, err := userRepo.FindByID(userID); err != nil {
+		c.JSON(http.StatusNotFound, models.ErrorResponse{
+			Error: "Пользователь не найден",
+		})
+		return
+	}
+
+	sessionRepo := repositories.SessionRepository{}
+	revoked, err := sessionRepo.RevokeAllByUserID(userID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error: "Ошибка отзыва сессий: " + err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Сессии пользователя отозваны",
+		"revoked": revoked,
+	})
+}
+
+// GetRoles возвращает роли администраторов с их правами
+func GetRoles(c *gin.Context) {
+	roleRepo := repositories.RoleRepository{}
+	roles, err := roleRepo.FindAll()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error: "Ошибка получения ролей: " + err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"roles": roles,
+	})
+}
+
+// UpdateUserRoles заменяет роли пользователя
+func UpdateUserRoles(c *gin.Context) {
+	userID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error: "Неверный ID пользователя",
+		})
+		return
+	}
+
+	var assignment models.RoleAssignment
+	if err := c.ShouldBindJSON(&assignment); err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error: "Неверные данные: " + err.Error(),
+		})
+		return
+	}
+
+	// Проверяем, что пользователь существует
+	userRepo := repositories.UserRepository{}
+	if _, err := userRepo.FindByID(userID); err != nil {
+		c.JSON(http.StatusNotFound, models.ErrorResponse{
+			Error: "Пользователь не найден",
+		})
+		return
+	}
+
+	adminID, _ := c.Get("userID")
+	roleRepo := repositories.RoleRepository{}
+	err = roleRepo.SetUserRoles(userID, assignment.Roles, adminID.(int64))
+	if errors.Is(err, repositories.ErrLastSuperadmin) {
+		c.JSON(http.StatusConflict, models.ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+	if errors.Is(err, repositories.ErrRoleNotFound) {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error: "Ошибка назначения ролей: " + err.Error(),
+		})
+		return
+	}
+
+	roles, err := roleRepo.FindByUserID(userID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error: "Ошибка получения ролей: " + err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Роли пользователя обновлены",
+		"roles":   roles,
+	})
+}
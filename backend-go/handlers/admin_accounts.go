@@ -0,0 +1,196 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jackc/pgx/v4"
+	"github.com/trofimovm/summvideo/models"
+	"github.com/trofimovm/summvideo/repositories"
+	"github.com/trofimovm/summvideo/utils"
+)
+
+// GetAdmins возвращает администраторов, входящих по паролю
+func GetAdmins(c *gin.Context) {
+	adminRepo := repositories.AdminRepository{}
+	admins, err := adminRepo.FindAll()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error: "Ошибка получения администраторов: " + err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"admins": admins,
+	})
+}
+
+// CreateAdmin создает администратора с паролем. Выданный пароль временный:
+// администратор должен сменить его при первом входе.
+func CreateAdmin(c *gin.Context) {
+	var req models.AdminCreateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error: "Неверные данные: " + err.Error(),
+		})
+		return
+	}
+
+	if err := utils.ValidatePassword(req.Password, req.Username); err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error: "Ненадежный пароль: " + err.Error(),
+		})
+		return
+	}
+
+	passwordHash, err := utils.HashPassword(req.Password)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error: "Ошибка хеширования пароля: " + err.Error(),
+		})
+		return
+	}
+
+	adminID, _ := c.Get("userID")
+	createdBy := adminID.(int64)
+	adminRepo := repositories.AdminRepository{}
+	admin, err := adminRepo.Create(req.Username, req.FirstName, passwordHash, req.Roles, true, &createdBy)
+	if errors.Is(err, repositories.ErrUsernameTaken) {
+		c.JSON(http.StatusConflict, models.ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+	if errors.Is(err, repositories.ErrRoleNotFound) {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error: "Ошибка создания администратора: " + err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, admin)
+}
+
+// ResetAdminPassword выдает администратору временный пароль и завершает его сессии
+func ResetAdminPassword(c *gin.Context) {
+	userID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error: "Неверный ID пользователя",
+		})
+		return
+	}
+
+	var req models.AdminPasswordReset
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error: "Неверные данные: " + err.Error(),
+		})
+		return
+	}
+
+	adminRepo := repositories.AdminRepository{}
+	admin, err := adminRepo.FindByID(userID)
+	if err != nil {
+		c.JSON(http.StatusNotFound, models.ErrorResponse{
+			Error: "Администратор не найден",
+		})
+		return
+	}
+
+	if err := utils.ValidatePassword(req.Password, admin.Username); err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error: "Ненадежный пароль: " + err.Error(),
+		})
+		return
+	}
+
+	passwordHash, err := utils.HashPassword(req.Password)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error: "Ошибка хеширования пароля: " + err.Error(),
+		})
+		return
+	}
+
+	if err := adminRepo.SetPassword(userID, passwordHash, true); err != nil {
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error: "Ошибка смены пароля: " + err.Error(),
+		})
+		return
+	}
+
+	sessionRepo := repositories.SessionRepository{}
+	if _, err := sessionRepo.RevokeAllByUserID(userID); err != nil {
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error: "Ошибка отзыва сессий: " + err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Пароль сброшен, администратор должен сменить его при входе",
+	})
+}
+
+// DisableAdmin отключает администратора с паролем, например созданного
+// миграцией, и завершает его сессии
+func DisableAdmin(c *gin.Context) {
+	userID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error: "Неверный ID пользователя",
+		})
+		return
+	}
+
+	adminID, _ := c.Get("userID")
+	if userID == adminID.(int64) {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error: "Нельзя отключить собственную учетную запись",
+		})
+		return
+	}
+
+	adminRepo := repositories.AdminRepository{}
+	err = adminRepo.Disable(userID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		c.JSON(http.StatusNotFound, models.ErrorResponse{
+			Error: "Активный администратор не найден",
+		})
+		return
+	}
+	if errors.Is(err, repositories.ErrLastActiveSuperadmin) {
+		c.JSON(http.StatusConflict, models.ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error: "Ошибка отключения администратора: " + err.Error(),
+		})
+		return
+	}
+
+	sessionRepo := repositories.SessionRepository{}
+	if _, err := sessionRepo.RevokeAllByUserID(userID); err != nil {
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error: "Ошибка отзыва сессий: " + err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Администратор отключен",
+	})
+}
@@ -0,0 +1,403 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/trofimovm/summvideo/models"
+	"github.com/trofimovm/summvideo/repositories"
+	"github.com/trofimovm/summvideo/utils"
+)
+
+// recoveryCodeCount — сколько кодов восстановления выдается при включении 2FA
+const recoveryCodeCount = 10
+
+// AdminTwoFactorHandler завершает вход администратора с включенной 2FA: принимает
+// токен, выданный POST /auth/admin, и код TOTP или код восстановления
+func AdminTwoFactorHandler(c *gin.Context) {
+	var req models.TwoFactorLoginRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error: "Неверные данные: " + err.Error(),
+		})
+		return
+	}
+
+	totpRepo := repositories.TOTPRepository{}
+	challengeID, userID, err := totpRepo.AttemptChallenge(utils.HashToken(req.ChallengeToken))
+	if errors.Is(err, repositories.ErrChallengeInvalid) {
+		c.JSON(http.StatusUnauthorized, models.ErrorResponse{
+			Error: "Ошибка авторизации: " + err.Error(),
+		})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error: "Ошибка проверки входа: " + err.Error(),
+		})
+		return
+	}
+
+	userRepo := repositories.UserRepository{}
+	user, err := userRepo.FindByID(userID)
+	if err != nil || !user.IsActive || !user.IsAdmin {
+		c.JSON(http.StatusUnauthorized, models.ErrorResponse{
+			Error: "Ошибка авторизации: учетная запись недоступна",
+		})
+		return
+	}
+
+	ok, err := checkSecondFactor(user.ID, req.Code)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error: "Ошибка проверки кода: " + err.Error(),
+		})
+		return
+	}
+	if !ok {
+		lockout := time.Duration(utils.GetEnvInt("ADMIN_2FA_LOCKOUT_MINUTES", 15)) * time.Minute
+		locked, err := totpRepo.RecordFailure(user.ID, utils.GetEnvInt("ADMIN_2FA_MAX_FAILURES", 10), lockout)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+				Error: "Ошибка проверки кода: " + err.Error(),
+			})
+			return
+		}
+		if locked {
+			c.JSON(http.StatusTooManyRequests, models.ErrorResponse{
+				Error: "Ошибка авторизации: " + repositories.ErrSecondFactorLocked.Error(),
+			})
+			return
+		}
+		c.JSON(http.StatusUnauthorized, models.ErrorResponse{
+			Error: "Неверный или уже использованный код",
+		})
+		return
+	}
+
+	if err := totpRepo.ResetFailures(user.ID); err != nil {
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error: "Ошибка проверки кода: " + err.Error(),
+		})
+		return
+	}
+
+	if err := totpRepo.CompleteChallenge(challengeID); err != nil {
+		c.JSON(http.StatusUnauthorized, models.ErrorResponse{
+			Error: "Ошибка авторизации: " + err.Error(),
+		})
+		return
+	}
+
+	response, err := startSession(c, user)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error: "Ошибка генерации токена: " + err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// startAdminChallenge создает незавершенный вход для администратора с включенной 2FA
+func startAdminChallenge(userID int64) (*models.AdminLoginChallenge, error) {
+	token, tokenHash, err := utils.GenerateChallengeToken()
+	if err != nil {
+		return nil, err
+	}
+
+	expiresAt := time.Now().Add(time.Duration(utils.GetEnvInt("ADMIN_2FA_CHALLENGE_TTL_MINUTES", 5)) * time.Minute)
+
+	totpRepo := repositories.TOTPRepository{}
+	if err := totpRepo.CreateChallenge(userID, tokenHash, expiresAt); err != nil {
+		return nil, err
+	}
+
+	return &models.AdminLoginChallenge{
+		TwoFactorRequired: true,
+		ChallengeToken:    token,
+		ExpiresAt:         expiresAt,
+	}, nil
+}
+
+// checkSecondFactor проверяет код TOTP или, если код не из шести цифр,
+// код восстановления. Принятый код нельзя использовать повторно.
+func checkSecondFactor(userID int64, code string) (bool, error) {
+	totpRepo := repositories.TOTPRepository{}
+	state, err := totpRepo.FindByUserID(userID)
+	if err != nil {
+		return false, err
+	}
+	if !state.Enabled {
+		return false, nil
+	}
+
+	code = strings.TrimSpace(code)
+	if _, err := strconv.Atoi(code); err == nil && len(code) == 6 {
+		step, ok := utils.ValidateTOTP(state.Secret, code, time.Now())
+		if !ok {
+			return false, nil
+		}
+		return totpRepo.UseStep(userID, step)
+	}
+
+	return totpRepo.UseRecoveryCode(userID, utils.HashRecoveryCode(code))
+}
+
+// passwordAdmin возвращает текущего пользователя, если он входит по паролю.
+// Иначе отвечает 403 и возвращает false.
+func passwordAdmin(c *gin.Context) (*models.User, bool) {
+	userID, _ := c.Get("userID")
+
+	userRepo := repositories.UserRepository{}
+	user, err := userRepo.FindByID(userID.(int64))
+	if err != nil || user.PasswordHash == "" {
+		c.JSON(http.StatusForbidden, models.ErrorResponse{
+			Error: "Доступно только администраторам с паролем",
+		})
+		return nil, false
+	}
+
+	return user, true
+}
+
+// SetupTOTP создает новый секрет TOTP и возвращает otpauth URI для QR-кода.
+// 2FA включается только после подтверждения первым кодом.
+func SetupTOTP(c *gin.Context) {
+	user, ok := passwordAdmin(c)
+	if !ok {
+		return
+	}
+
+	secret, err := utils.GenerateTOTPSecret()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error: "Ошибка генерации секрета: " + err.Error(),
+		})
+		return
+	}
+
+	totpRepo := repositories.TOTPRepository{}
+	if err := totpRepo.SetPendingSecret(user.ID, secret); err != nil {
+		c.JSON(http.StatusConflict, models.ErrorResponse{
+			Error: "Двухфакторная аутентификация уже включена",
+		})
+		return
+	}
+
+	issuer := utils.GetEnv("TOTP_ISSUER", "SummVideo")
+	c.JSON(http.StatusOK, models.TOTPSetup{
+		Secret:     secret,
+		OTPAuthURI: utils.TOTPURI(issuer, user.Username, secret),
+	})
+}
+
+// EnableTOTP включает 2FA после проверки первого кода и возвращает коды
+// восстановления. Коды показываются только в этом ответе.
+func EnableTOTP(c *gin.Context) {
+	user, ok := passwordAdmin(c)
+	if !ok {
+		return
+	}
+
+	var req models.TOTPCodeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error: "Неверные данные: " + err.Error(),
+		})
+		return
+	}
+
+	totpRepo := repositories.TOTPRepository{}
+	state, err := totpRepo.FindByUserID(user.ID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error: "Ошибка получения настроек 2FA: " + err.Error(),
+		})
+		return
+	}
+	if state.Enabled {
+		c.JSON(http.StatusConflict, models.ErrorResponse{
+			Error: "Двухфакторная аутентификация уже включена",
+		})
+		return
+	}
+	if state.Secret == "" {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error: "Сначала получите секрет через POST /auth/admin/totp/setup",
+		})
+		return
+	}
+
+	step, valid := utils.ValidateTOTP(state.Secret, req.Code, time.Now())
+	if !valid {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error: "Неверный код",
+		})
+		return
+	}
+
+	codes, hashes, err := utils.GenerateRecoveryCodes(recoveryCodeCount)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error: "Ошибка генерации кодов восстановления: " + err.Error(),
+		})
+		return
+	}
+
+	if err := totpRepo.Enable(user.ID, step, hashes); err != nil {
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error: "Ошибка включения 2FA: " + err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message":        "Двухфакторная аутентификация включена",
+		"recovery_codes": codes,
+	})
+}
+
+// DisableTOTP выключает 2FA по паролю и действующему коду
+func DisableTOTP(c *gin.Context) {
+	user, ok := passwordAdmin(c)
+	if !ok {
+		return
+	}
+
+	var req models.TOTPDisableRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error: "Неверные данные: " + err.Error(),
+		})
+		return
+	}
+
+	if !utils.CheckPassword(user.PasswordHash, req.Password) {
+		c.JSON(http.StatusUnauthorized, models.ErrorResponse{
+			Error: "Неверный пароль",
+		})
+		return
+	}
+
+	valid, err := checkSecondFactor(user.ID, req.Code)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error: "Ошибка проверки кода: " + err.Error(),
+		})
+		return
+	}
+	if !valid {
+		c.JSON(http.StatusUnauthorized, models.ErrorResponse{
+			Error: "Неверный или уже использованный код",
+		})
+		return
+	}
+
+	totpRepo := repositories.TOTPRepository{}
+	if err := totpRepo.Disable(user.ID); err != nil {
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error: "Ошибка отключения 2FA: " + err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Двухфакторная аутентификация отключена",
+	})
+}
+
+// RegenerateRecoveryCodes заменяет коды восстановления новыми по действующему коду
+func RegenerateRecoveryCodes(c *gin.Context) {
+	user, ok := passwordAdmin(c)
+	if !ok {
+		return
+	}
+
+	var req models.TOTPCodeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error: "Неверные данные: " + err.Error(),
+		})
+		return
+	}
+
+	valid, err := checkSecondFactor(user.ID, req.Code)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error: "Ошибка проверки кода: " + err.Error(),
+		})
+		return
+	}
+	if !valid {
+		c.JSON(http.StatusUnauthorized, models.ErrorResponse{
+			Error: "Неверный или уже использованный код",
+		})
+		return
+	}
+
+	codes, hashes, err := utils.GenerateRecoveryCodes(recoveryCodeCount)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error: "Ошибка генерации кодов восстановления: " + err.Error(),
+		})
+		return
+	}
+
+	totpRepo := repositories.TOTPRepository{}
+	if err := totpRepo.ReplaceRecoveryCodes(user.ID, hashes); err != nil {
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error: "Ошибка сохранения кодов восстановления: " + err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"recovery_codes": codes,
+	})
+}
+
+// ResetAdminTOTP выключает 2FA другого администратора, например при потере
+// телефона, и завершает его сессии
+func ResetAdminTOTP(c *gin.Context) {
+	userID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error: "Неверный ID пользователя",
+		})
+		return
+	}
+
+	adminRepo := repositories.AdminRepository{}
+	if _, err := adminRepo.FindByID(userID); err != nil {
+		c.JSON(http.StatusNotFound, models.ErrorResponse{
+			Error: "Администратор не найден",
+		})
+		return
+	}
+
+	totpRepo := repositories.TOTPRepository{}
+	if err := totpRepo.Disable(userID); err != nil {
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error: "Ошибка отключения 2FA: " + err.Error(),
+		})
+		return
+	}
+
+	sessionRepo := repositories.SessionRepository{}
+	if _, err := sessionRepo.RevokeAllByUserID(userID); err != nil {
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error: "Ошибка отзыва сессий: " + err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Двухфакторная аутентификация администратора отключена",
+	})
+}
@@ -0,0 +1,132 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jackc/pgx/v4"
+	"github.com/trofimovm/summvideo/models"
+	"github.com/trofimovm/summvideo/repositories"
+	"github.com/trofimovm/summvideo/utils"
+)
+
+// GetAPIKeys возвращает персональные API-ключи текущего пользователя
+func GetAPIKeys(c *gin.Context) {
+	userID, _ := c.Get("userID")
+
+	apiKeyRepo := repositories.APIKeyRepository{}
+	keys, err := apiKeyRepo.FindByUserID(userID.(int64))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error: "Ошибка получения API-ключей: " + err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"api_keys": keys,
+	})
+}
+
+// CreateAPIKey создает персональный API-ключ. Ключ возвращается только
+// в этом ответе, в БД сохраняется его хэш.
+func CreateAPIKey(c *gin.Context) {
+	userID, _ := c.Get("userID")
+
+	var req models.APIKeyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error: "Неверные данные: " + err.Error(),
+		})
+		return
+	}
+
+	for _, scope := range req.Scopes {
+		if !validAPIKeyScope(scope) {
+			c.JSON(http.StatusBadRequest, models.ErrorResponse{
+				Error: "Неизвестная область действия ключа: " + scope,
+			})
+			return
+		}
+	}
+
+	if req.ExpiresAt != nil && !req.ExpiresAt.After(time.Now()) {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error: "Срок действия ключа должен быть в будущем",
+		})
+		return
+	}
+
+	key, prefix, keyHash, err := utils.GenerateAPIKey()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error: "Ошибка генерации API-ключа: " + err.Error(),
+		})
+		return
+	}
+
+	apiKeyRepo := repositories.APIKeyRepository{}
+	created, err := apiKeyRepo.Create(&models.APIKey{
+		UserID:    userID.(int64),
+		Name:      req.Name,
+		Prefix:    prefix,
+		Scopes:    req.Scopes,
+		ExpiresAt: req.ExpiresAt,
+	}, keyHash)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error: "Ошибка сохранения API-ключа: " + err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, models.CreatedAPIKey{
+		APIKey: *created,
+		Key:    key,
+	})
+}
+
+// RevokeAPIKey отзывает персональный API-ключ текущего пользователя
+func RevokeAPIKey(c *gin.Context) {
+	userID, _ := c.Get("userID")
+
+	keyID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error: "Неверный ID ключа",
+		})
+		return
+	}
+
+	apiKeyRepo := repositories.APIKeyRepository{}
+	err = apiKeyRepo.Revoke(keyID, userID.(int64))
+	if errors.Is(err, pgx.ErrNoRows) {
+		c.JSON(http.StatusNotFound, models.ErrorResponse{
+			Error: "Ключ не найден или уже отозван",
+		})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error: "Ошибка отзыва API-ключа: " + err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Ключ отозван",
+	})
+}
+
+// validAPIKeyScope проверяет, что область действия ключа известна
+func validAPIKeyScope(scope string) bool {
+	for _, known := range models.APIKeyScopes {
+		if scope == known {
+			return true
+		}
+	}
+	return false
+}
@@ -1,8 +1,12 @@
 package handlers
 
 import (
+	"errors"
+	"io"
 	"net/http"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/trofimovm/summvideo/models"
@@ -10,21 +14,16 @@ import (
 	"github.com/trofimovm/summvideo/utils"
 )
 
-// TelegramAuthHandler обрабатывает авторизацию через Telegram
-func TelegramAuthHandler(c *gin.Context) {
-	var authData models.TelegramAuthData
+// maxInitDataSize ограничивает размер initData, принимаемых от Telegram Mini App
+const maxInitDataSize = 16 << 10
 
-	// Получаем параметры из запроса
-	authData.ID, _ = strconv.ParseInt(c.Query("id"), 10, 64)
-	authData.FirstName = c.Query("first_name")
-	authData.LastName = c.Query("last_name")
-	authData.Username = c.Query("username")
-	authData.PhotoURL = c.Query("photo_url")
-	authData.AuthDate, _ = strconv.ParseInt(c.Query("auth_date"), 10, 64)
-	authData.Hash = c.Query("hash")
+// TelegramAuthHandler обрабатывает авторизацию через Telegram Login Widget.
+// Параметры виджета передаются в строке запроса.
+func TelegramAuthHandler(c *gin.Context) {
+	params := c.Request.URL.Query()
 
 	// Валидация данных
-	if authData.ID == 0 || authData.Hash == "" || authData.AuthDate == 0 {
+	if params.Get("id") == "" || params.Get("hash") == "" || params.Get("auth_date") == "" {
 		c.JSON(http.StatusBadRequest, models.ErrorResponse{
 			Error: "Invalid auth data",
 		})
@@ -32,16 +31,66 @@ func TelegramAuthHandler(c *gin.Context) {
 	}
 
 	// Проверяем подпись данных
-	if err := utils.ValidateTelegramAuth(&authData); err != nil {
+	authData, err := utils.ValidateTelegramLogin(params)
+	if err != nil {
 		c.JSON(http.StatusUnauthorized, models.ErrorResponse{
 			Error: "Invalid auth signature: " + err.Error(),
 		})
 		return
 	}
 
+	loginTelegramUser(c, authData)
+}
+
+// TelegramWebAppAuthHandler обрабатывает авторизацию из Telegram Mini App.
+// initData передается как есть: в поле init_data JSON или телом запроса.
+func TelegramWebAppAuthHandler(c *gin.Context) {
+	var initData string
+	if c.ContentType() == "application/json" {
+		var req models.TelegramWebAppAuthRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, models.ErrorResponse{
+				Error: "Invalid auth data",
+			})
+			return
+		}
+		initData = req.InitData
+	} else {
+		body, err := io.ReadAll(io.LimitReader(c.Request.Body, maxInitDataSize))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, models.ErrorResponse{
+				Error: "Invalid auth data",
+			})
+			return
+		}
+		initData = strings.TrimSpace(string(body))
+	}
+
+	if initData == "" {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error: "Invalid auth data",
+		})
+		return
+	}
+
+	// Проверяем подпись данных
+	authData, err := utils.ValidateTelegramWebAppData(initData)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, models.ErrorResponse{
+			Error: "Invalid auth signature: " + err.Error(),
+		})
+		return
+	}
+
+	loginTelegramUser(c, authData)
+}
+
+// loginTelegramUser создает или обновляет пользователя по проверенным данным
+// Telegram и начинает для него сессию
+func loginTelegramUser(c *gin.Context, authData *models.TelegramAuthData) {
 	// Создаем или обновляем пользователя
 	userRepo := repositories.UserRepository{}
-	user, err := userRepo.CreateOrUpdate(&authData)
+	user, err := userRepo.CreateOrUpdate(authData)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
 			Error: "Failed to create/update user: " + err.Error(),
@@ -49,8 +98,8 @@ func TelegramAuthHandler(c *gin.Context) {
 		return
 	}
 
-	// Генерируем JWT токен
-	token, err := utils.GenerateJWT(user.ID)
+	// Создаем сессию и выдаем пару токенов
+	response, err := startSession(c, user)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
 			Error: "Failed to generate token: " + err.Error(),
@@ -58,11 +107,8 @@ func TelegramAuthHandler(c *gin.Context) {
 		return
 	}
 
-	// Возвращаем токен и информацию о пользователе
-	c.JSON(http.StatusOK, models.AuthResponse{
-		Token: token,
-		User:  *user,
-	})
+	// Возвращаем токены и информацию о пользователе
+	c.JSON(http.StatusOK, response)
 }
 
 // AdminLoginHandler обрабатывает вход администратора
@@ -86,8 +132,35 @@ func AdminLoginHandler(c *gin.Context) {
 		return
 	}
 
-	// Генерируем JWT токен
-	token, err := utils.GenerateJWT(user.ID)
+	// При включенной 2FA токены выдаются только после проверки кода
+	totpRepo := repositories.TOTPRepository{}
+	totp, err := totpRepo.FindByUserID(user.ID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error: "Ошибка получения настроек 2FA: " + err.Error(),
+		})
+		return
+	}
+	if totp.Enabled {
+		challenge, err := startAdminChallenge(user.ID)
+		if errors.Is(err, repositories.ErrSecondFactorLocked) {
+			c.JSON(http.StatusTooManyRequests, models.ErrorResponse{
+				Error: "Ошибка авторизации: " + err.Error(),
+			})
+			return
+		}
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+				Error: "Ошибка начала входа: " + err.Error(),
+			})
+			return
+		}
+		c.JSON(http.StatusOK, challenge)
+		return
+	}
+
+	// Создаем сессию и выдаем пару токенов
+	response, err := startSession(c, user)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
 			Error: "Ошибка генерации токена: " + err.Error(),
@@ -95,13 +168,188 @@ func AdminLoginHandler(c *gin.Context) {
 		return
 	}
 
-	// Возвращаем токен и информацию о пользователе
+	// Возвращаем токены и информацию о пользователе
+	c.JSON(http.StatusOK, response)
+}
+
+// ChangeAdminPasswordHandler меняет пароль текущего администратора и снимает
+// требование сменить пароль
+func ChangeAdminPasswordHandler(c *gin.Context) {
+	user, ok := passwordAdmin(c)
+	if !ok {
+		return
+	}
+
+	var req models.PasswordChangeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error: "Неверные данные: " + err.Error(),
+		})
+		return
+	}
+
+	if !utils.CheckPassword(user.PasswordHash, req.CurrentPassword) {
+		c.JSON(http.StatusUnauthorized, models.ErrorResponse{
+			Error: "Неверный текущий пароль",
+		})
+		return
+	}
+
+	if req.NewPassword == req.CurrentPassword {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error: "Новый пароль должен отличаться от текущего",
+		})
+		return
+	}
+
+	if err := utils.ValidatePassword(req.NewPassword, user.Username); err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error: "Ненадежный пароль: " + err.Error(),
+		})
+		return
+	}
+
+	passwordHash, err := utils.HashPassword(req.NewPassword)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error: "Ошибка хеширования пароля: " + err.Error(),
+		})
+		return
+	}
+
+	adminRepo := repositories.AdminRepository{}
+	if err := adminRepo.SetPassword(user.ID, passwordHash, false); err != nil {
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error: "Ошибка смены пароля: " + err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Пароль изменен",
+	})
+}
+
+// RefreshTokenHandler обменивает обновляющий токен на новую пару токенов.
+// Предъявленный токен становится недействительным.
+func RefreshTokenHandler(c *gin.Context) {
+	var req models.RefreshRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error: "Refresh token is required",
+		})
+		return
+	}
+
+	refreshToken, refreshHash, err := utils.GenerateRefreshToken()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error: "Failed to generate token: " + err.Error(),
+		})
+		return
+	}
+
+	sessionRepo := repositories.SessionRepository{}
+	session, err := sessionRepo.Rotate(utils.HashToken(req.RefreshToken), refreshHash, refreshExpiresAt())
+	if errors.Is(err, repositories.ErrSessionInvalid) || errors.Is(err, repositories.ErrRefreshTokenReused) {
+		c.JSON(http.StatusUnauthorized, models.ErrorResponse{
+			Error: "Invalid refresh token: " + err.Error(),
+		})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error: "Failed to refresh session: " + err.Error(),
+		})
+		return
+	}
+
+	// Деактивированный пользователь не может продлить сессию
+	userRepo := repositories.UserRepository{}
+	user, err := userRepo.FindByID(session.UserID)
+	if err != nil || !user.IsActive {
+		sessionRepo.RevokeByToken(refreshHash)
+		c.JSON(http.StatusForbidden, models.ErrorResponse{
+			Error: "User is deactivated",
+		})
+		return
+	}
+
+	token, expiresAt, err := utils.GenerateJWT(user.ID, session.ID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error: "Failed to generate token: " + err.Error(),
+		})
+		return
+	}
+
 	c.JSON(http.StatusOK, models.AuthResponse{
-		Token: token,
-		User:  *user,
+		Token:            token,
+		ExpiresAt:        expiresAt,
+		RefreshToken:     refreshToken,
+		RefreshExpiresAt: session.ExpiresAt,
+		User:             *user,
+	})
+}
+
+// LogoutHandler завершает сессию, к которой привязан обновляющий токен
+func LogoutHandler(c *gin.Context) {
+	var req models.RefreshRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error: "Refresh token is required",
+		})
+		return
+	}
+
+	sessionRepo := repositories.SessionRepository{}
+	err := sessionRepo.RevokeByToken(utils.HashToken(req.RefreshToken))
+	if err != nil && !errors.Is(err, repositories.ErrSessionInvalid) {
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error: "Failed to revoke session: " + err.Error(),
+		})
+		return
+	}
+
+	// Повторный выход из уже завершенной сессии не считается ошибкой
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Logged out",
 	})
 }
 
+// startSession создает сессию пользователя и выдает токен доступа вместе с обновляющим токеном
+func startSession(c *gin.Context, user *models.User) (*models.AuthResponse, error) {
+	refreshToken, refreshHash, err := utils.GenerateRefreshToken()
+	if err != nil {
+		return nil, err
+	}
+
+	sessionRepo := repositories.SessionRepository{}
+	session, err := sessionRepo.Create(user.ID, refreshHash, c.Request.UserAgent(), c.ClientIP(), refreshExpiresAt())
+	if err != nil {
+		return nil, err
+	}
+
+	token, expiresAt, err := utils.GenerateJWT(user.ID, session.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.AuthResponse{
+		Token:            token,
+		ExpiresAt:        expiresAt,
+		RefreshToken:     refreshToken,
+		RefreshExpiresAt: session.ExpiresAt,
+		User:             *user,
+	}, nil
+}
+
+// refreshExpiresAt возвращает срок действия нового обновляющего токена
+// (REFRESH_TOKEN_TTL_DAYS, по умолчанию 30 дней)
+func refreshExpiresAt() time.Time {
+	return time.Now().Add(time.Duration(utils.GetEnvInt("REFRESH_TOKEN_TTL_DAYS", 30)) * 24 * time.Hour)
+}
+
 // GetUserProfile возвращает профиль текущего пользователя
 func GetUserProfile(c *gin.Context) {
 	// Получаем ID пользователя из контекста (установлен в AuthMiddleware)
@@ -123,8 +371,9 @@ func GetUserProfile(c *gin.Context) {
 		return
 	}
 
-	// Получаем оставшееся время использования
-	remainingSeconds, err := userRepo.GetRemainingUsageSeconds(userID.(int64))
+	// Получаем тарифный план и оставшееся время использования в текущем периоде
+	planRepo := repositories.PlanRepository{}
+	quota, err := planRepo.GetQuota(userID.(int64))
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
 			Error: "Failed to get remaining usage: " + err.Error(),
@@ -132,9 +381,26 @@ func GetUserProfile(c *gin.Context) {
 		return
 	}
 
+	// Получаем действующие сессии пользователя
+	sessionRepo := repositories.SessionRepository{}
+	sessions, err := sessionRepo.FindActiveByUserID(userID.(int64))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error: "Failed to get sessions: " + err.Error(),
+		})
+		return
+	}
+	currentSessionID, _ := c.Get("sessionID")
+	for i := range sessions {
+		sessions[i].Current = sessions[i].ID == currentSessionID
+	}
+
 	c.JSON(http.StatusOK, gin.H{
-		"user": user,
-		"remaining_usage_seconds": remainingSeconds,
+		"user":                    user,
+		"remaining_usage_seconds": quota.RemainingSeconds,
+		"usage_reset_at":          quota.ResetAt,
+		"quota":                   quota,
+		"sessions":                sessions,
 	})
 }
 
@@ -152,14 +418,14 @@ func GetUserHistory(c *gin.Context) {
 	// Получаем параметры пагинации
 	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
 	pageSize, _ := strconv.Atoi(c.DefaultQuery("page_size", "10"))
-	
+
 	if page < 1 {
 		page = 1
 	}
 	if pageSize < 1 || pageSize > 100 {
 		pageSize = 10
 	}
-	
+
 	offset := (page - 1) * pageSize
 
 	// Получаем историю использования
@@ -190,4 +456,4 @@ func GetUserHistory(c *gin.Context) {
 			"pages":     (total + pageSize - 1) / pageSize,
 		},
 	})
-}
\ No newline at end of file
+}
@@ -0,0 +1,97 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/trofimovm/summvideo/models"
+	"github.com/trofimovm/summvideo/repositories"
+)
+
+// GetTranscriptionCache возвращает настройки и состояние кэша транскрипций
+func GetTranscriptionCache(c *gin.Context) {
+	cacheRepo := repositories.TranscriptionCacheRepository{}
+	ttl, err := cacheRepo.TTL()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error: "Ошибка получения настроек кэша: " + err.Error(),
+		})
+		return
+	}
+
+	entries, expired, hits, err := cacheRepo.GetStats(ttl)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error: "Ошибка получения состояния кэша: " + err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, models.TranscriptionCacheStats{
+		TTLHours: int(ttl.Hours()),
+		Entries:  entries,
+		Expired:  expired,
+		Hits:     hits,
+	})
+}
+
+// UpdateTranscriptionCache изменяет срок жизни записей кэша транскрипций.
+// Нулевой срок отключает кэш.
+func UpdateTranscriptionCache(c *gin.Context) {
+	var update models.TranscriptionCacheSettings
+
+	if err := c.ShouldBindJSON(&update); err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error: "Неверные данные: " + err.Error(),
+		})
+		return
+	}
+
+	settingsRepo := repositories.SettingsRepository{}
+	if err := settingsRepo.SetInt(repositories.SettingTranscriptionCacheTTL, *update.TTLHours); err != nil {
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error: "Ошибка сохранения настроек кэша: " + err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message":   "Настройки кэша обновлены",
+		"ttl_hours": *update.TTLHours,
+	})
+}
+
+// PurgeTranscriptionCache удаляет записи кэша транскрипций. По умолчанию
+// очищается весь кэш; с параметром hash — записи одного файла,
+// с expired=true — только устаревшие записи.
+func PurgeTranscriptionCache(c *gin.Context) {
+	cacheRepo := repositories.TranscriptionCacheRepository{}
+
+	var deleted int64
+	var err error
+	switch {
+	case c.Query("hash") != "":
+		deleted, err = cacheRepo.PurgeByHash(c.Query("hash"))
+	case c.Query("expired") == "true":
+		ttl, ttlErr := cacheRepo.TTL()
+		if ttlErr != nil {
+			err = ttlErr
+			break
+		}
+		deleted, err = cacheRepo.PurgeExpired(ttl)
+	default:
+		deleted, err = cacheRepo.PurgeAll()
+	}
+
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error: "Ошибка очистки кэша: " + err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Кэш очищен",
+		"deleted": deleted,
+	})
+}
@@ -1,17 +1,22 @@
 package handlers
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
 	"log"
+	"mime/multipart"
 	"net/http"
 	"os"
 	"path/filepath"
-	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/trofimovm/summvideo/jobs"
 	"github.com/trofimovm/summvideo/models"
 	"github.com/trofimovm/summvideo/repositories"
 	"github.com/trofimovm/summvideo/services"
-	"github.com/trofimovm/summvideo/utils"
 )
 
 // Константы приложения
@@ -29,7 +34,7 @@ func RedirectToHome(c *gin.Context) {
 	c.Redirect(http.StatusFound, "/")
 }
 
-// UploadVideo обрабатывает загрузку и обработку видео
+// UploadVideo принимает видео и ставит задачу на его обработку в очередь
 func UploadVideo(c *gin.Context) {
 	// Проверка аутентификации
 	userIDValue, exists := c.Get("userID")
@@ -42,28 +47,24 @@ func UploadVideo(c *gin.Context) {
 	userID := userIDValue.(int64)
 
 	// Проверяем, не превышен ли лимит использования
-	userRepo := repositories.UserRepository{}
-	remainingSeconds, err := userRepo.GetRemainingUsageSeconds(userID)
-	if err != nil {
-		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
-			Error: "Ошибка проверки лимита использования: " + err.Error(),
-		})
+	if !ensureUsageLeft(c, userID) {
 		return
 	}
 
-	if remainingSeconds <= 0 {
-		c.JSON(http.StatusForbidden, models.ErrorResponse{
-			Error: "Превышен лимит бесплатного использования. Пожалуйста, свяжитесь с администратором для увеличения лимита.",
+	// Проверка провайдеров транскрибации и суммаризации
+	if !providersConfigured() {
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error: "Провайдеры транскрибации и суммаризации не настроены",
 		})
 		return
 	}
 
-	// Проверка OpenAI API ключа
-	apiKey := os.Getenv("OPENAI_API_KEY")
-	if apiKey == "" {
-		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
-			Error: "API ключ OpenAI не найден",
-		})
+	// Проверяем место на диске до чтения тела запроса
+	uploadSize := c.Request.ContentLength
+	if uploadSize < 0 || uploadSize > MaxFileSize {
+		uploadSize = MaxFileSize
+	}
+	if !ensureUploadSpace(c, uploadSize) {
 		return
 	}
 
@@ -76,6 +77,15 @@ func UploadVideo(c *gin.Context) {
 		return
 	}
 
+	// Стратегия суммаризации длинных транскрипций, по умолчанию выбирается автоматически
+	summaryStrategy, err := services.ParseSummaryStrategy(c.PostForm("summary_strategy"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
 	// Получаем файл видео
 	file, err := c.FormFile("file")
 	if err != nil {
@@ -93,101 +103,252 @@ func UploadVideo(c *gin.Context) {
 		return
 	}
 
-	// Создаем временный файл для сохранения видео
-	tempDir := os.TempDir()
-	videoPath := filepath.Join(tempDir, file.Filename)
+	// Формат определяется по сигнатуре файла, а не по расширению или Content-Type,
+	// до сохранения файла на диск
+	settingsRepo := repositories.SettingsRepository{}
+	allowedContainers, err := settingsRepo.AllowedContainers()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error: "Ошибка получения допустимых форматов: " + err.Error(),
+		})
+		return
+	}
+	container, err := checkUploadContainer(file, allowedContainers)
+	if err != nil {
+		respondMediaError(c, err)
+		return
+	}
 
-	// Сохраняем загруженный файл
-	if err := c.SaveUploadedFile(file, videoPath); err != nil {
+	// Каждая задача получает собственный рабочий каталог, который живет до конца
+	// обработки и переживает перезапуск сервера. Имя файла клиента сохраняется
+	// только в задаче и в путях не используется.
+	workspace, err := services.NewWorkspace()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+	videoPath := services.SourcePath(workspace, file.Filename)
+
+	tempFile, err := os.OpenFile(videoPath, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o600)
+	if err != nil {
+		services.RemoveWorkspace(workspace)
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error: "Ошибка создания файла: " + err.Error(),
+		})
+		return
+	}
+
+	// Сохраняем загруженный файл, одновременно вычисляя хеш содержимого
+	// для поиска готовой транскрипции в кэше
+	contentHash, err := saveUploadWithHash(file, tempFile)
+	tempFile.Close()
+	if err != nil {
+		services.RemoveWorkspace(workspace)
 		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
 			Error: "Ошибка сохранения файла: " + err.Error(),
 		})
 		return
 	}
-	defer os.Remove(videoPath) // Удаляем временный файл после обработки
 
-	// Фиксируем время начала обработки
-	startTime := time.Now()
+	job, ok := enqueueUpload(c, &models.Job{
+		UserID:          userID,
+		VideoName:       filepath.Base(file.Filename),
+		FilePath:        videoPath,
+		PromptText:      prompt,
+		SummaryStrategy: summaryStrategy,
+		ContentHash:     contentHash,
+	}, container, nil)
+	if !ok {
+		return
+	}
 
-	// Обработка видео в отдельной горутине с использованием каналов для результатов
-	resultCh := make(chan *models.VideoResponse)
-	errorCh := make(chan error)
+	// Возвращаем ID задачи, результат доступен через GET /jobs/:id
+	c.JSON(http.StatusAccepted, gin.H{
+		"job_id": job.ID,
+		"status": job.Status,
+	})
+}
 
-	go func() {
-		// Извлечение аудио из видео
-		audioFile, err := services.ExtractAudio(videoPath)
-		if err != nil {
-			errorCh <- err
-			return
-		}
-		defer os.Remove(audioFile) // Удаляем временный аудиофайл
+// ensureUsageLeft проверяет, что у пользователя остался лимит использования.
+// Если лимит исчерпан, отвечает 403 и возвращает false.
+func ensureUsageLeft(c *gin.Context, userID int64) bool {
+	userRepo := repositories.UserRepository{}
+	remainingSeconds, err := userRepo.GetRemainingUsageSeconds(userID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error: "Ошибка проверки лимита использования: " + err.Error(),
+		})
+		return false
+	}
 
-		// Конвертация аудио в mp3 для OpenAI API
-		mp3File, err := services.ConvertToMP3(audioFile)
-		if err != nil {
-			errorCh <- err
-			return
-		}
-		defer os.Remove(mp3File) // Удаляем временный mp3 файл
+	if remainingSeconds <= 0 {
+		c.JSON(http.StatusForbidden, models.ErrorResponse{
+			Error: "Превышен лимит бесплатного использования. Пожалуйста, свяжитесь с администратором для увеличения лимита.",
+		})
+		return false
+	}
 
-		// Транскрибация аудио через OpenAI API
-		transcription, err := services.TranscribeAudio(apiKey, mp3File)
-		if err != nil {
-			errorCh <- err
-			return
-		}
+	return true
+}
 
-		// Генерация саммари на основе транскрипции и промта
-		summary, err := services.GenerateSummary(apiKey, transcription, prompt)
-		if err != nil {
-			errorCh <- err
-			return
-		}
+// ensureUploadSpace проверяет, что на диске хватит места для загрузки размером
+// size. Кроме самой загрузки место нужно под извлеченное аудио, поэтому
+// требуется двойной размер. Если места нет, отвечает 507 и возвращает false.
+func ensureUploadSpace(c *gin.Context, size int64) bool {
+	err := services.EnsureDiskSpace(2 * size)
+	if err == nil {
+		return true
+	}
 
-		// Логирование результатов
-		if err := utils.LogData(file.Filename, prompt, transcription, summary); err != nil {
-			// Лог ошибки, но продолжаем обработку
-			// Ошибка логирования не должна прервать обработку запроса
-			// TODO: добавить логирование ошибки логирования
-		}
+	status := http.StatusInternalServerError
+	if errors.Is(err, services.ErrInsufficientDiskSpace) {
+		status = http.StatusInsufficientStorage
+	}
+	c.JSON(status, models.ErrorResponse{
+		Error: "Сервер временно не может принять файл: " + err.Error(),
+	})
+	return false
+}
 
-		// Отправляем результат в канал
-		resultCh <- &models.VideoResponse{
-			Summary:       summary,
-			Transcription: transcription,
+// enqueueUpload проверяет сохраненный файл задачи, резервирует длительность
+// записи в лимите пользователя и ставит задачу в очередь. При ошибке отвечает
+// клиенту и возвращает false. Файл, загруженный одним запросом, при ошибке
+// удаляется. Файл возобновляемой загрузки upload удаляется вместе с ней, только
+// если он не прошел проверку: после отказа по лимиту или ошибки сервера
+// загрузку можно завершить повторно, не передавая файл заново.
+func enqueueUpload(c *gin.Context, job *models.Job, container string, upload *models.Upload) (*models.Job, bool) {
+	uploadRepo := repositories.UploadRepository{}
+	discard := func() {
+		services.RemoveJobFiles(job.FilePath)
+		if upload == nil {
+			return
 		}
-	}()
-
-	// Ожидаем результат или ошибку
-	select {
-	case result := <-resultCh:
-		// Вычисляем время обработки
-		processingTime := int(time.Since(startTime).Seconds())
-		summaryLength := len(result.Summary)
-
-		// Обновляем использованное время пользователя
-		if err := userRepo.UpdateUsage(userID, processingTime); err != nil {
-			log.Printf("Ошибка обновления использованного времени: %v", err)
+		if err := uploadRepo.Delete(upload.ID); err != nil {
+			log.Printf("Загрузка %d: ошибка удаления: %v", upload.ID, err)
 		}
-
-		// Сохраняем запись об использовании
-		usageRepo := repositories.UsageRepository{}
-		_, err := usageRepo.Create(userID, file.Filename, prompt, summaryLength, processingTime)
-		if err != nil {
-			log.Printf("Ошибка сохранения записи об использовании: %v", err)
+	}
+	release := func() {
+		if upload == nil {
+			services.RemoveJobFiles(job.FilePath)
 		}
+	}
+
+	// Проверяем звуковую дорожку сразу, чтобы не ставить в очередь файлы,
+	// на которых ffmpeg всё равно завершится ошибкой. Использование списывается
+	// по длительности записи, поэтому записи длиннее остатка лимита отклоняются здесь же.
+	media, err := services.InspectMedia(c.Request.Context(), job.FilePath, container)
+	if err != nil {
+		discard()
+		respondMediaError(c, err)
+		return nil, false
+	}
+	job.MediaDuration = media.Duration
+
+	// Резервируем длительность записи в лимите пользователя, чтобы параллельные
+	// загрузки не могли вместе превысить лимит. Резерв погашается по завершении задачи.
+	userRepo := repositories.UserRepository{}
+	mediaSeconds := services.BillableSeconds(job.MediaDuration)
+	reservationID, err := userRepo.ReserveForProcessing(job.UserID, mediaSeconds)
+	if errors.Is(err, repositories.ErrQuotaExceeded) {
+		release()
+		remainingSeconds, _ := userRepo.GetRemainingUsageSeconds(job.UserID)
+		c.JSON(http.StatusForbidden, models.ErrorResponse{
+			Error: fmt.Sprintf(
+				"Длительность записи (%d сек.) превышает оставшийся лимит использования (%d сек.)",
+				mediaSeconds, remainingSeconds,
+			),
+		})
+		return nil, false
+	}
+	if err != nil {
+		release()
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error: "Ошибка резервирования лимита использования: " + err.Error(),
+		})
+		return nil, false
+	}
+	job.ReservationID = &reservationID
 
-		// Возвращаем успешный результат
-		c.JSON(http.StatusOK, gin.H{
-			"summary":        result.Summary,
-			"transcription":  result.Transcription,
-			"processing_time": processingTime,
+	// Ставим задачу в очередь обработки. Задача по возобновляемой загрузке
+	// связывается с ней в той же транзакции.
+	jobRepo := repositories.JobRepository{}
+	var created *models.Job
+	if upload != nil {
+		created, err = jobRepo.CreateForUpload(job, upload.ID)
+	} else {
+		created, err = jobRepo.Create(job)
+	}
+	if errors.Is(err, repositories.ErrUploadCompleted) {
+		// Загрузку завершил параллельный запрос, файл принадлежит его задаче
+		userRepo.ReleaseReservation(reservationID)
+		c.JSON(http.StatusConflict, models.ErrorResponse{
+			Error: "Загрузка уже завершена",
+		})
+		return nil, false
+	}
+	if err != nil {
+		release()
+		userRepo.ReleaseReservation(reservationID)
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error: "Ошибка постановки задачи в очередь: " + err.Error(),
 		})
+		return nil, false
+	}
+	jobs.Notify()
+
+	return created, true
+}
 
-	case err := <-errorCh:
-		// Возвращаем ошибку
+// checkUploadContainer определяет контейнер загруженного файла по сигнатуре
+// и проверяет, что он разрешен
+func checkUploadContainer(file *multipart.FileHeader, allowed []string) (string, error) {
+	src, err := file.Open()
+	if err != nil {
+		return "", err
+	}
+	defer src.Close()
+
+	return services.CheckContainer(src, allowed)
+}
+
+// respondMediaError отвечает на ошибку проверки загруженного файла: 415, если
+// формат не распознан или не разрешен, 422, если файл нельзя обработать,
+// и 500 при прочих ошибках
+func respondMediaError(c *gin.Context, err error) {
+	var mediaErr *services.MediaError
+	if !errors.As(err, &mediaErr) {
 		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
-			Error: "Ошибка обработки видео: " + err.Error(),
+			Error: "Ошибка проверки файла: " + err.Error(),
 		})
+		return
+	}
+
+	status := http.StatusUnprocessableEntity
+	if mediaErr == services.ErrUnknownContainer || mediaErr == services.ErrContainerNotAllowed {
+		status = http.StatusUnsupportedMediaType
 	}
-}
\ No newline at end of file
+
+	c.JSON(status, models.ErrorResponse{
+		Error: "Файл не может быть обработан: " + err.Error(),
+		Code:  mediaErr.Code,
+	})
+}
+
+// saveUploadWithHash копирует загруженный файл в dst и возвращает SHA-256
+// его содержимого в виде hex-строки
+func saveUploadWithHash(file *multipart.FileHeader, dst io.Writer) (string, error) {
+	src, err := file.Open()
+	if err != nil {
+		return "", err
+	}
+	defer src.Close()
+
+	hash := sha256.New()
+	if _, err := io.Copy(io.MultiWriter(dst, hash), src); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
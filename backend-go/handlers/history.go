@@ -0,0 +1,201 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jackc/pgx/v4"
+	"github.com/trofimovm/summvideo/models"
+	"github.com/trofimovm/summvideo/repositories"
+	"github.com/trofimovm/summvideo/services"
+	"github.com/trofimovm/summvideo/utils"
+)
+
+// GetHistoryItem возвращает запись истории пользователя вместе с сохраненными
+// транскрипцией и саммари
+func GetHistoryItem(c *gin.Context) {
+	usage, ok := findUserUsage(c)
+	if !ok {
+		return
+	}
+
+	resultRepo := repositories.ResultRepository{}
+	result, err := resultRepo.FindByUsageID(usage.ID)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error: "Ошибка получения результата: " + err.Error(),
+		})
+		return
+	}
+
+	// Для записей, созданных до появления таблицы результатов, result будет пустым
+	var summaries []models.Summary
+	if result != nil {
+		summaryRepo := repositories.SummaryRepository{}
+		summaries, err = summaryRepo.FindByResultID(result.ID)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+				Error: "Ошибка получения саммари: " + err.Error(),
+			})
+			return
+		}
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"usage":     usage,
+		"result":    result,
+		"summaries": summaries,
+	})
+}
+
+// CreateHistorySummary строит новое саммари по сохраненной транскрипции записи
+// истории с другим промтом. Видео повторно не обрабатывается, поэтому в лимит
+// засчитывается RESUMMARY_CHARGE_PERCENT процентов длительности записи
+// (10 по умолчанию, не меньше секунды), см. services.ResummarySeconds.
+func CreateHistorySummary(c *gin.Context) {
+	usage, ok := findUserUsage(c)
+	if !ok {
+		return
+	}
+
+	var req models.SummaryRequest
+	if err := c.ShouldBindJSON(&req); err != nil || strings.TrimSpace(req.Prompt) == "" {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error: "Промт не может быть пустым",
+		})
+		return
+	}
+
+	strategy, err := services.ParseSummaryStrategy(req.Strategy)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	if !ensureUsageLeft(c, usage.UserID) {
+		return
+	}
+
+	if !providersConfigured() {
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error: "Провайдеры транскрибации и суммаризации не настроены",
+		})
+		return
+	}
+
+	resultRepo := repositories.ResultRepository{}
+	result, err := resultRepo.FindByUsageID(usage.ID)
+	if errors.Is(err, pgx.ErrNoRows) || (err == nil && strings.TrimSpace(result.Transcription) == "") {
+		c.JSON(http.StatusConflict, models.ErrorResponse{
+			Error: "Для этой записи не сохранена транскрипция",
+		})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error: "Ошибка получения результата: " + err.Error(),
+		})
+		return
+	}
+
+	// Резервируем стоимость саммари до запроса к провайдеру, чтобы параллельные
+	// запросы не могли вместе превысить лимит. Резерв погашается после генерации.
+	userRepo := repositories.UserRepository{}
+	chargeSeconds := services.ResummarySeconds(result.MediaDuration, utils.GetEnvInt("RESUMMARY_CHARGE_PERCENT", 10))
+	var reservationID *int64
+	if chargeSeconds > 0 {
+		id, err := userRepo.ReserveForProcessing(usage.UserID, chargeSeconds)
+		if errors.Is(err, repositories.ErrQuotaExceeded) {
+			remainingSeconds, _ := userRepo.GetRemainingUsageSeconds(usage.UserID)
+			c.JSON(http.StatusForbidden, models.ErrorResponse{
+				Error: fmt.Sprintf(
+					"Стоимость саммари (%d сек.) превышает оставшийся лимит использования (%d сек.)",
+					chargeSeconds, remainingSeconds,
+				),
+			})
+			return
+		}
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+				Error: "Ошибка резервирования лимита использования: " + err.Error(),
+			})
+			return
+		}
+		reservationID = &id
+	}
+
+	startTime := time.Now()
+	summary, strategy, err := services.SummarizeTranscript(
+		c.Request.Context(), summarizer, result.Transcription, req.Prompt, strategy,
+		services.SummarizationConfigFromEnv(), nil,
+	)
+	if err != nil {
+		if reservationID != nil {
+			userRepo.ReleaseReservation(*reservationID)
+		}
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error: "Ошибка генерации саммари: " + err.Error(),
+		})
+		return
+	}
+	summaryTime := int(time.Since(startTime).Seconds())
+
+	// Списываем долю длительности записи: стоимость не зависит от задержек провайдера
+	if reservationID != nil {
+		if err := userRepo.SettleReservation(*reservationID, chargeSeconds); err != nil {
+			c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+				Error: "Ошибка обновления использования: " + err.Error(),
+			})
+			return
+		}
+	}
+
+	summaryRepo := repositories.SummaryRepository{}
+	created, err := summaryRepo.Create(result.ID, req.Prompt, summary, summarizer.SummaryModel(), strategy, summaryTime)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error: "Ошибка сохранения саммари: " + err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, created)
+}
+
+// findUserUsage загружает запись истории из параметра пути и проверяет, что она
+// принадлежит текущему пользователю. При ошибке отправляет ответ сам.
+func findUserUsage(c *gin.Context) (*models.UsageHistory, bool) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, models.ErrorResponse{
+			Error: "Требуется авторизация",
+		})
+		return nil, false
+	}
+
+	usageID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error: "Неверный ID записи",
+		})
+		return nil, false
+	}
+
+	usageRepo := repositories.UsageRepository{}
+	usage, err := usageRepo.FindByID(usageID)
+	if err != nil || usage.UserID != userID.(int64) {
+		c.JSON(http.StatusNotFound, models.ErrorResponse{
+			Error: "Запись не найдена",
+		})
+		return nil, false
+	}
+
+	return usage, true
+}
@@ -0,0 +1,231 @@
+package handlers
+
+import (
+	"errors"
+	"io"
+	"mime"
+	"net/http"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/trofimovm/summvideo/jobs"
+	"github.com/trofimovm/summvideo/models"
+	"github.com/trofimovm/summvideo/repositories"
+	"github.com/trofimovm/summvideo/services"
+)
+
+// sseHeartbeatInterval задает период отправки комментариев, не дающих
+// прокси-серверам закрыть простаивающее SSE соединение
+const sseHeartbeatInterval = 15 * time.Second
+
+// GetJob возвращает статус задачи обработки видео и её результат, если он готов
+func GetJob(c *gin.Context) {
+	job, ok := findUserJob(c)
+	if !ok {
+		return
+	}
+
+	c.JSON(http.StatusOK, job)
+}
+
+// CancelJob отменяет задачу обработки видео. Если транскрипция уже получена,
+// время записи списывается по политике возвратов, иначе возвращается.
+func CancelJob(c *gin.Context) {
+	job, ok := findUserJob(c)
+	if !ok {
+		return
+	}
+
+	if job.IsFinished() {
+		c.JSON(http.StatusConflict, models.ErrorResponse{
+			Error: "Задача уже завершена",
+		})
+		return
+	}
+
+	if err := jobs.Cancel(job.ID); err != nil {
+		status := http.StatusInternalServerError
+		if errors.Is(err, jobs.ErrNotCancellable) {
+			status = http.StatusConflict
+		}
+		c.JSON(status, models.ErrorResponse{
+			Error: "Ошибка отмены задачи: " + err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusAccepted, gin.H{
+		"message": "Задача отменяется",
+	})
+}
+
+// GetJobEvents отправляет события о ходе обработки задачи через Server-Sent Events.
+// Первым событием отправляется текущее состояние задачи, последним — done или failed.
+func GetJobEvents(c *gin.Context) {
+	job, ok := findUserJob(c)
+	if !ok {
+		return
+	}
+
+	// Подписываемся до повторного чтения задачи, чтобы не пропустить завершение
+	last, eventsCh, unsubscribe := jobs.Subscribe(job.ID)
+	defer unsubscribe()
+
+	jobRepo := repositories.JobRepository{}
+	if current, err := jobRepo.FindByID(job.ID); err == nil {
+		job = current
+	}
+
+	c.Header("Content-Type", "text/event-stream")
+	c.Header("Cache-Control", "no-cache")
+	c.Header("Connection", "keep-alive")
+	c.Header("X-Accel-Buffering", "no")
+
+	initial := jobEventFromJob(job)
+	if last != nil && !job.IsFinished() {
+		initial = *last
+	}
+	c.SSEvent("progress", initial)
+	c.Writer.Flush()
+	if initial.IsFinal() {
+		return
+	}
+
+	heartbeat := time.NewTicker(sseHeartbeatInterval)
+	defer heartbeat.Stop()
+
+	c.Stream(func(w io.Writer) bool {
+		select {
+		case <-c.Request.Context().Done():
+			return false
+		case <-heartbeat.C:
+			io.WriteString(w, ": ping\n\n")
+			return true
+		case event, open := <-eventsCh:
+			if !open {
+				// Финальное событие могло быть пропущено, берем итог из БД
+				if finished, err := jobRepo.FindByID(job.ID); err == nil {
+					c.SSEvent("progress", jobEventFromJob(finished))
+				}
+				return false
+			}
+			c.SSEvent("progress", event)
+			return !event.IsFinal()
+		}
+	})
+}
+
+// GetJobTranscript отдает транскрипцию задачи для скачивания в формате
+// SRT, WebVTT или простого текста с временными метками
+func GetJobTranscript(c *gin.Context) {
+	job, ok := findUserJob(c)
+	if !ok {
+		return
+	}
+
+	if job.Status != models.JobStatusDone {
+		c.JSON(http.StatusConflict, models.ErrorResponse{
+			Error: "Транскрипция ещё не готова",
+		})
+		return
+	}
+
+	format := c.Param("format")
+	contentType, ok := transcriptContentTypes[format]
+	if !ok {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error: "Неподдерживаемый формат, доступны srt, vtt и txt",
+		})
+		return
+	}
+
+	if len(job.Segments) == 0 {
+		c.JSON(http.StatusUnprocessableEntity, models.ErrorResponse{
+			Error: "Для этой транскрипции нет временных меток",
+		})
+		return
+	}
+
+	content, err := services.FormatTranscript(job.Segments, format)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.Header("Content-Disposition", transcriptDisposition(job.VideoName, format))
+	c.Data(http.StatusOK, contentType, []byte(content))
+}
+
+// transcriptContentTypes задает MIME-типы форматов экспорта транскрипции
+var transcriptContentTypes = map[string]string{
+	services.TranscriptFormatSRT:  "application/x-subrip; charset=utf-8",
+	services.TranscriptFormatVTT:  "text/vtt; charset=utf-8",
+	services.TranscriptFormatText: "text/plain; charset=utf-8",
+}
+
+// transcriptDisposition формирует заголовок Content-Disposition с именем файла,
+// построенным из имени исходного видео
+func transcriptDisposition(videoName, format string) string {
+	base := strings.TrimSuffix(filepath.Base(videoName), filepath.Ext(videoName))
+	if base == "" || base == "." {
+		base = "transcript"
+	}
+	return mime.FormatMediaType("attachment", map[string]string{
+		"filename": base + "." + format,
+	})
+}
+
+// findUserJob загружает задачу из параметра пути и проверяет, что она
+// принадлежит текущему пользователю. При ошибке отправляет ответ сам.
+func findUserJob(c *gin.Context) (*models.Job, bool) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, models.ErrorResponse{
+			Error: "Требуется авторизация",
+		})
+		return nil, false
+	}
+
+	jobID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error: "Неверный ID задачи",
+		})
+		return nil, false
+	}
+
+	// Пользователь видит только свои задачи
+	jobRepo := repositories.JobRepository{}
+	job, err := jobRepo.FindByID(jobID)
+	if err != nil || job.UserID != userID.(int64) {
+		c.JSON(http.StatusNotFound, models.ErrorResponse{
+			Error: "Задача не найдена",
+		})
+		return nil, false
+	}
+
+	return job, true
+}
+
+// jobEventFromJob строит событие, описывающее сохраненное состояние задачи
+func jobEventFromJob(job *models.Job) models.JobEvent {
+	event := models.JobEvent{
+		JobID:  job.ID,
+		Stage:  job.Status,
+		Status: job.Status,
+	}
+
+	switch job.Status {
+	case models.JobStatusDone:
+		event.Percent = 100
+	case models.JobStatusFailed:
+		event.Message = job.Error
+	}
+
+	return event
+}
@@ -0,0 +1,69 @@
+package handlers
+
+import (
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/trofimovm/summvideo/models"
+	"github.com/trofimovm/summvideo/repositories"
+)
+
+// GetMediaFormats возвращает контейнеры, которые разрешено загружать, и все
+// контейнеры, которые сервис умеет распознавать
+func GetMediaFormats(c *gin.Context) {
+	settingsRepo := repositories.SettingsRepository{}
+	allowed, err := settingsRepo.AllowedContainers()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error: "Ошибка получения допустимых форматов: " + err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, models.MediaFormats{
+		AllowedContainers:   allowed,
+		SupportedContainers: models.SupportedContainers,
+	})
+}
+
+// UpdateMediaFormats заменяет список контейнеров, которые разрешено загружать.
+// Каждый контейнер должен быть из списка поддерживаемых.
+func UpdateMediaFormats(c *gin.Context) {
+	var update models.MediaFormatSettings
+
+	if err := c.ShouldBindJSON(&update); err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error: "Неверные данные: " + err.Error(),
+		})
+		return
+	}
+
+	var allowed []string
+	for _, container := range update.AllowedContainers {
+		container = strings.ToLower(strings.TrimSpace(container))
+		if !slices.Contains(models.SupportedContainers, container) {
+			c.JSON(http.StatusBadRequest, models.ErrorResponse{
+				Error: "Неизвестный контейнер: " + container,
+			})
+			return
+		}
+		if !slices.Contains(allowed, container) {
+			allowed = append(allowed, container)
+		}
+	}
+
+	settingsRepo := repositories.SettingsRepository{}
+	if err := settingsRepo.SetAllowedContainers(allowed); err != nil {
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error: "Ошибка сохранения допустимых форматов: " + err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message":            "Допустимые форматы обновлены",
+		"allowed_containers": allowed,
+	})
+}
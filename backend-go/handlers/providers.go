@@ -0,0 +1,20 @@
+package handlers
+
+import "github.com/trofimovm/summvideo/services"
+
+// Провайдеры транскрибации и суммаризации, внедряемые при старте через SetProviders
+var (
+	transcriber services.Transcriber
+	summarizer  services.Summarizer
+)
+
+// SetProviders задает провайдеров, используемых обработчиками запросов
+func SetProviders(t services.Transcriber, s services.Summarizer) {
+	transcriber = t
+	summarizer = s
+}
+
+// providersConfigured сообщает, заданы ли провайдеры
+func providersConfigured() bool {
+	return transcriber != nil && summarizer != nil
+}
@@ -0,0 +1,492 @@
+package handlers
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jackc/pgx/v4"
+	"github.com/trofimovm/summvideo/models"
+	"github.com/trofimovm/summvideo/repositories"
+	"github.com/trofimovm/summvideo/services"
+	"github.com/trofimovm/summvideo/utils"
+)
+
+// uploadChunkContentType — тип содержимого частей загрузки, как в протоколе tus
+const uploadChunkContentType = "application/offset+octet-stream"
+
+// Заголовки с числом полученных байт и размером файла, как в протоколе tus
+const (
+	headerUploadOffset = "Upload-Offset"
+	headerUploadLength = "Upload-Length"
+)
+
+// uploadLockLease — на сколько блокируется загрузка при записи части. Пока данные
+// поступают, блокировка продлевается; если клиент пропал, она истекает сама.
+const uploadLockLease = 2 * time.Minute
+
+// uploadExpiry возвращает срок, до которого незавершенная загрузка ждет
+// следующей части (UPLOAD_EXPIRY_HOURS, по умолчанию 24 часа)
+func uploadExpiry() time.Time {
+	return time.Now().Add(time.Duration(utils.GetEnvInt("UPLOAD_EXPIRY_HOURS", 24)) * time.Hour)
+}
+
+// CreateUpload начинает возобновляемую загрузку: проверяет размер файла,
+// место на диске и параметры обработки и создает пустой файл в рабочем каталоге.
+// Файл передается частями через PATCH /uploads/:id.
+func CreateUpload(c *gin.Context) {
+	userID, _ := c.Get("userID")
+
+	var req models.UploadCreateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error: "Неверные данные: " + err.Error(),
+		})
+		return
+	}
+
+	// Проверяем, не превышен ли лимит использования
+	if !ensureUsageLeft(c, userID.(int64)) {
+		return
+	}
+
+	if !providersConfigured() {
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error: "Провайдеры транскрибации и суммаризации не настроены",
+		})
+		return
+	}
+
+	if req.Size > MaxFileSize {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error: "Размер файла превышает 500 МБ",
+		})
+		return
+	}
+
+	summaryStrategy, err := services.ParseSummaryStrategy(req.SummaryStrategy)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	if !ensureUploadSpace(c, req.Size) {
+		return
+	}
+
+	workspace, err := services.NewWorkspace()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+	filePath := services.SourcePath(workspace, req.FileName)
+
+	file, err := os.OpenFile(filePath, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o600)
+	if err != nil {
+		services.RemoveWorkspace(workspace)
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error: "Ошибка создания файла: " + err.Error(),
+		})
+		return
+	}
+	file.Close()
+
+	uploadRepo := repositories.UploadRepository{}
+	upload, err := uploadRepo.Create(&models.Upload{
+		UserID:          userID.(int64),
+		FileName:        filepath.Base(req.FileName),
+		FilePath:        filePath,
+		Size:            req.Size,
+		Checksum:        strings.ToLower(req.Checksum),
+		PromptText:      req.Prompt,
+		SummaryStrategy: summaryStrategy,
+		ExpiresAt:       uploadExpiry(),
+	})
+	if err != nil {
+		services.RemoveWorkspace(workspace)
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error: "Ошибка создания загрузки: " + err.Error(),
+		})
+		return
+	}
+
+	c.Header("Location", fmt.Sprintf("/uploads/%d", upload.ID))
+	setUploadHeaders(c, upload)
+	c.JSON(http.StatusCreated, upload)
+}
+
+// HeadUpload сообщает в заголовках, сколько байт загрузки уже получено,
+// чтобы клиент мог продолжить передачу после обрыва соединения
+func HeadUpload(c *gin.Context) {
+	upload, ok := findUpload(c)
+	if !ok {
+		return
+	}
+
+	setUploadHeaders(c, upload)
+	c.Header("Cache-Control", "no-store")
+	c.Status(http.StatusOK)
+}
+
+// GetUpload возвращает состояние загрузки, в том числе ID задачи,
+// если загрузка завершена
+func GetUpload(c *gin.Context) {
+	upload, ok := findUpload(c)
+	if !ok {
+		return
+	}
+
+	setUploadHeaders(c, upload)
+	c.Header("Cache-Control", "no-store")
+	c.JSON(http.StatusOK, upload)
+}
+
+// PatchUpload принимает очередную часть файла. Заголовок Upload-Offset должен
+// совпадать с числом уже полученных байт. Тело запроса записывается на диск
+// по мере получения; при обрыве соединения сохраняется всё, что успело прийти.
+// На время записи загрузка блокируется в БД, поэтому параллельные запросы,
+// в том числе к другим экземплярам сервиса, не пишут в один файл.
+// После последней части проверяется контрольная сумма и создается задача.
+func PatchUpload(c *gin.Context) {
+	if c.ContentType() != uploadChunkContentType {
+		c.JSON(http.StatusUnsupportedMediaType, models.ErrorResponse{
+			Error: "Часть загрузки должна передаваться с Content-Type " + uploadChunkContentType,
+		})
+		return
+	}
+
+	offset, err := strconv.ParseInt(c.GetHeader(headerUploadOffset), 10, 64)
+	if err != nil || offset < 0 {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error: "Неверный заголовок " + headerUploadOffset,
+		})
+		return
+	}
+
+	upload, ok := findUpload(c)
+	if !ok {
+		return
+	}
+
+	token, err := newLockToken()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error: "Ошибка блокировки загрузки: " + err.Error(),
+		})
+		return
+	}
+
+	// Блокировка возвращает актуальное состояние: пока запрос ждал,
+	// загрузку могли продолжить или завершить
+	uploadRepo := repositories.UploadRepository{}
+	upload, err = uploadRepo.Lock(upload.ID, token, uploadLockLease)
+	if err != nil {
+		respondUploadLockError(c, err)
+		return
+	}
+	locked := true
+	defer func() {
+		if locked {
+			uploadRepo.Unlock(upload.ID, token)
+		}
+	}()
+
+	if status, message := checkChunk(upload, offset, c.Request.ContentLength); status != 0 {
+		setUploadHeaders(c, upload)
+		c.JSON(status, models.ErrorResponse{Error: message})
+		return
+	}
+
+	file, err := os.OpenFile(upload.FilePath, os.O_WRONLY, 0)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error: "Ошибка открытия файла: " + err.Error(),
+		})
+		return
+	}
+	dst := &leaseWriter{
+		w:       io.NewOffsetWriter(file, upload.Offset),
+		renewed: time.Now(),
+		renew: func() error {
+			return uploadRepo.ExtendLock(upload.ID, token, uploadLockLease)
+		},
+	}
+	written, copyErr := io.Copy(dst, io.LimitReader(c.Request.Body, upload.Size-upload.Offset))
+	closeErr := file.Close()
+
+	if errors.Is(copyErr, repositories.ErrUploadLocked) {
+		// Блокировка истекла и перехвачена: полученное этим запросом не учитывается
+		locked = false
+		respondUploadLockError(c, copyErr)
+		return
+	}
+
+	upload.Offset += written
+	err = uploadRepo.SetOffset(upload.ID, token, upload.Offset, uploadExpiry())
+	locked = false
+	if err != nil {
+		respondUploadLockError(c, err)
+		return
+	}
+	setUploadHeaders(c, upload)
+
+	if copyErr != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error: "Передача части прервана: " + copyErr.Error(),
+		})
+		return
+	}
+	if closeErr != nil {
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error: "Ошибка сохранения файла: " + closeErr.Error(),
+		})
+		return
+	}
+
+	if upload.Offset < upload.Size {
+		c.JSON(http.StatusOK, upload)
+		return
+	}
+
+	completeUpload(c, upload)
+}
+
+// checkChunk проверяет, что часть длиной length (-1, если неизвестна) с
+// заголовком Upload-Offset offset продолжает загрузку. Возвращает код ответа
+// и сообщение об ошибке или 0, если часть можно принять.
+func checkChunk(upload *models.Upload, offset, length int64) (int, string) {
+	if upload.JobID != nil {
+		return http.StatusConflict, "Загрузка уже завершена"
+	}
+	if offset != upload.Offset {
+		return http.StatusConflict, fmt.Sprintf("Неверное смещение: получено %d байт", upload.Offset)
+	}
+	if remaining := upload.Size - upload.Offset; length > remaining {
+		return http.StatusRequestEntityTooLarge, fmt.Sprintf("Часть выходит за размер файла: осталось %d байт", remaining)
+	}
+
+	return 0, ""
+}
+
+// leaseWriter продлевает блокировку загрузки, пока в файл записываются данные.
+// Перед записью после долгой паузы блокировка проверяется, и если её
+// перехватил другой запрос, запись прекращается с ошибкой renew.
+type leaseWriter struct {
+	w       io.Writer
+	renew   func() error
+	renewed time.Time
+}
+
+func (lw *leaseWriter) Write(p []byte) (int, error) {
+	if time.Since(lw.renewed) > uploadLockLease/3 {
+		if err := lw.renew(); err != nil {
+			return 0, err
+		}
+		lw.renewed = time.Now()
+	}
+
+	return lw.w.Write(p)
+}
+
+// respondUploadLockError отвечает клиенту, если загрузку не удалось заблокировать
+func respondUploadLockError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, repositories.ErrUploadLocked):
+		c.JSON(http.StatusConflict, models.ErrorResponse{
+			Error: "Часть этой загрузки уже передается",
+		})
+	case errors.Is(err, repositories.ErrUploadCompleted):
+		c.JSON(http.StatusConflict, models.ErrorResponse{
+			Error: "Загрузка уже завершена",
+		})
+	case errors.Is(err, pgx.ErrNoRows):
+		c.JSON(http.StatusNotFound, models.ErrorResponse{
+			Error: "Загрузка не найдена",
+		})
+	default:
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error: "Ошибка обработки загрузки: " + err.Error(),
+		})
+	}
+}
+
+// newLockToken создает случайный идентификатор блокировки загрузки
+func newLockToken() (string, error) {
+	buf := make([]byte, 16)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(buf), nil
+}
+
+// completeUpload проверяет контрольную сумму полностью полученного файла
+// и ставит задачу на его обработку в очередь. Если файл не прошел проверку,
+// загрузка удаляется.
+func completeUpload(c *gin.Context, upload *models.Upload) {
+	uploadRepo := repositories.UploadRepository{}
+	discard := func() {
+		services.RemoveJobFiles(upload.FilePath)
+		if err := uploadRepo.Delete(upload.ID); err != nil {
+			log.Printf("Загрузка %d: ошибка удаления: %v", upload.ID, err)
+		}
+	}
+
+	// Хеш содержимого нужен и для проверки, и для кэша транскрипций
+	contentHash, err := verifyUploadChecksum(upload)
+	if errors.Is(err, errChecksumMismatch) {
+		discard()
+		c.JSON(http.StatusUnprocessableEntity, models.ErrorResponse{
+			Error: "Контрольная сумма не совпадает, загрузите файл заново",
+			Code:  "checksum_mismatch",
+		})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error: "Ошибка проверки контрольной суммы: " + err.Error(),
+		})
+		return
+	}
+
+	settingsRepo := repositories.SettingsRepository{}
+	allowedContainers, err := settingsRepo.AllowedContainers()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error: "Ошибка получения допустимых форматов: " + err.Error(),
+		})
+		return
+	}
+	container, err := services.CheckFileContainer(upload.FilePath, allowedContainers)
+	if err != nil {
+		discard()
+		respondMediaError(c, err)
+		return
+	}
+
+	job, ok := enqueueUpload(c, &models.Job{
+		UserID:          upload.UserID,
+		VideoName:       upload.FileName,
+		FilePath:        upload.FilePath,
+		PromptText:      upload.PromptText,
+		SummaryStrategy: upload.SummaryStrategy,
+		ContentHash:     contentHash,
+	}, container, upload)
+	if !ok {
+		return
+	}
+
+	// Возвращаем ID задачи, результат доступен через GET /jobs/:id
+	c.JSON(http.StatusAccepted, gin.H{
+		"job_id": job.ID,
+		"status": job.Status,
+	})
+}
+
+// DeleteUpload прерывает незавершенную загрузку и удаляет полученные данные
+func DeleteUpload(c *gin.Context) {
+	upload, ok := findUpload(c)
+	if !ok {
+		return
+	}
+
+	// Загрузка, часть которой сейчас передается, не удаляется
+	uploadRepo := repositories.UploadRepository{}
+	if err := uploadRepo.Delete(upload.ID); err != nil {
+		respondUploadLockError(c, err)
+		return
+	}
+
+	// Файл завершенной загрузки принадлежит задаче
+	if upload.JobID == nil {
+		services.RemoveJobFiles(upload.FilePath)
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Загрузка удалена",
+	})
+}
+
+// findUpload возвращает загрузку текущего пользователя по ID из пути.
+// Если загрузки нет, отвечает клиенту и возвращает false.
+func findUpload(c *gin.Context) (*models.Upload, bool) {
+	uploadID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error: "Неверный ID загрузки",
+		})
+		return nil, false
+	}
+
+	userID, _ := c.Get("userID")
+
+	uploadRepo := repositories.UploadRepository{}
+	upload, err := uploadRepo.FindByID(uploadID, userID.(int64))
+	if errors.Is(err, pgx.ErrNoRows) {
+		c.JSON(http.StatusNotFound, models.ErrorResponse{
+			Error: "Загрузка не найдена",
+		})
+		return nil, false
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error: "Ошибка получения загрузки: " + err.Error(),
+		})
+		return nil, false
+	}
+
+	return upload, true
+}
+
+// setUploadHeaders передает число полученных байт и размер файла в заголовках
+func setUploadHeaders(c *gin.Context, upload *models.Upload) {
+	c.Header(headerUploadOffset, strconv.FormatInt(upload.Offset, 10))
+	c.Header(headerUploadLength, strconv.FormatInt(upload.Size, 10))
+}
+
+// errChecksumMismatch возвращается, если полученный файл не совпадает с заявленной контрольной суммой
+var errChecksumMismatch = errors.New("контрольная сумма не совпадает")
+
+// verifyUploadChecksum сверяет SHA-256 полученного файла с контрольной суммой
+// загрузки и возвращает его. При несовпадении возвращает errChecksumMismatch.
+func verifyUploadChecksum(upload *models.Upload) (string, error) {
+	contentHash, err := fileSHA256(upload.FilePath)
+	if err != nil {
+		return "", err
+	}
+	if contentHash != upload.Checksum {
+		return "", errChecksumMismatch
+	}
+
+	return contentHash, nil
+}
+
+// fileSHA256 возвращает SHA-256 содержимого файла в виде hex-строки
+func fileSHA256(path string) (string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	hash := sha256.New()
+	if _, err := io.Copy(hash, file); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
@@ -0,0 +1,110 @@
+package handlers
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/trofimovm/summvideo/models"
+	"github.com/trofimovm/summvideo/repositories"
+)
+
+func TestCheckChunk(t *testing.T) {
+	jobID := int64(7)
+
+	tests := []struct {
+		name   string
+		upload models.Upload
+		offset int64
+		length int64
+		status int
+	}{
+		{"first part", models.Upload{Size: 100}, 0, 40, 0},
+		{"next part", models.Upload{Size: 100, Offset: 40}, 40, 60, 0},
+		{"unknown length", models.Upload{Size: 100, Offset: 40}, 40, -1, 0},
+		{"empty part completes upload", models.Upload{Size: 100, Offset: 100}, 100, 0, 0},
+		{"offset behind", models.Upload{Size: 100, Offset: 40}, 0, 40, http.StatusConflict},
+		{"offset ahead", models.Upload{Size: 100, Offset: 40}, 80, 20, http.StatusConflict},
+		{"part past end", models.Upload{Size: 100, Offset: 40}, 40, 61, http.StatusRequestEntityTooLarge},
+		{"completed", models.Upload{Size: 100, Offset: 100, JobID: &jobID}, 100, 0, http.StatusConflict},
+	}
+	for _, tt := range tests {
+		status, message := checkChunk(&tt.upload, tt.offset, tt.length)
+		if status != tt.status {
+			t.Errorf("%s: got status %d (%s), want %d", tt.name, status, message, tt.status)
+		}
+	}
+}
+
+func TestLeaseWriter(t *testing.T) {
+	var buf bytes.Buffer
+	renewals := 0
+	lw := &leaseWriter{
+		w:       &buf,
+		renewed: time.Now(),
+		renew: func() error {
+			renewals++
+			return nil
+		},
+	}
+
+	// Свежая блокировка не продлевается на каждой записи
+	lw.Write([]byte("ab"))
+	lw.Write([]byte("cd"))
+	if renewals != 0 {
+		t.Fatalf("got %d renewals for a fresh lock, want 0", renewals)
+	}
+
+	lw.renewed = time.Now().Add(-uploadLockLease)
+	if _, err := lw.Write([]byte("ef")); err != nil {
+		t.Fatalf("write after renewal: %v", err)
+	}
+	if renewals != 1 || buf.String() != "abcdef" {
+		t.Fatalf("got %d renewals and %q, want 1 and abcdef", renewals, buf.String())
+	}
+
+	// Перехваченная блокировка останавливает запись до изменения файла
+	lw.renewed = time.Now().Add(-uploadLockLease)
+	lw.renew = func() error { return repositories.ErrUploadLocked }
+	n, err := lw.Write([]byte("gh"))
+	if !errors.Is(err, repositories.ErrUploadLocked) || n != 0 {
+		t.Fatalf("got n=%d, err=%v, want 0 and ErrUploadLocked", n, err)
+	}
+	if buf.String() != "abcdef" {
+		t.Fatalf("got %q after lost lock, want abcdef", buf.String())
+	}
+}
+
+func TestVerifyUploadChecksum(t *testing.T) {
+	content := []byte("recording")
+	sum := sha256.Sum256(content)
+	checksum := hex.EncodeToString(sum[:])
+
+	path := filepath.Join(t.TempDir(), "source.mp4")
+	if err := os.WriteFile(path, content, 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	hash, err := verifyUploadChecksum(&models.Upload{FilePath: path, Checksum: checksum})
+	if err != nil || hash != checksum {
+		t.Fatalf("matching checksum: got %q, %v", hash, err)
+	}
+
+	// Файл, часть которого перезаписана, не проходит проверку
+	if err := os.WriteFile(path, []byte("recordinG"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := verifyUploadChecksum(&models.Upload{FilePath: path, Checksum: checksum}); !errors.Is(err, errChecksumMismatch) {
+		t.Fatalf("changed file: got %v, want errChecksumMismatch", err)
+	}
+
+	if _, err := verifyUploadChecksum(&models.Upload{FilePath: path + ".missing", Checksum: checksum}); err == nil || errors.Is(err, errChecksumMismatch) {
+		t.Fatalf("missing file: got %v, want a read error", err)
+	}
+}
@@ -0,0 +1,113 @@
+package handlers
+
+import (
+	"net/http"
+	"os"
+	"path"
+
+	"github.com/gin-gonic/gin"
+	"github.com/trofimovm/summvideo/jobs"
+	"github.com/trofimovm/summvideo/models"
+	"github.com/trofimovm/summvideo/repositories"
+	"github.com/trofimovm/summvideo/services"
+)
+
+// UploadFromURL ставит в очередь задачу обработки записи по адресу, указанному
+// пользователем. Файл загружает обработчик очереди, чтобы запрос клиента не
+// ждал загрузки; затем файл проходит те же проверки, что и загруженный напрямую.
+// Адрес источника сохраняется в задаче и истории.
+func UploadFromURL(c *gin.Context) {
+	userID, _ := c.Get("userID")
+
+	var req models.URLUploadRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error: "Неверные данные: " + err.Error(),
+		})
+		return
+	}
+
+	// Проверяем, не превышен ли лимит использования
+	if !ensureUsageLeft(c, userID.(int64)) {
+		return
+	}
+
+	if !providersConfigured() {
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error: "Провайдеры транскрибации и суммаризации не настроены",
+		})
+		return
+	}
+
+	summaryStrategy, err := services.ParseSummaryStrategy(req.SummaryStrategy)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	sourceURL, err := services.ParseFetchURL(req.URL)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error: "Адрес должен начинаться с http:// или https:// и не содержать учетных данных",
+			Code:  services.ErrInvalidURL.Code,
+		})
+		return
+	}
+
+	// Размер файла заранее неизвестен, поэтому место проверяется по максимальному
+	if !ensureUploadSpace(c, jobs.MaxFetchSize) {
+		return
+	}
+
+	workspace, err := services.NewWorkspace()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+	filePath := services.SourcePath(workspace, path.Base(sourceURL.Path))
+
+	// Пустой файл отмечает рабочий каталог как занятый задачей
+	file, err := os.OpenFile(filePath, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o600)
+	if err != nil {
+		services.RemoveWorkspace(workspace)
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error: "Ошибка создания файла: " + err.Error(),
+		})
+		return
+	}
+	file.Close()
+
+	// Имя файла уточняется после загрузки по Content-Disposition
+	videoName := path.Base(sourceURL.Path)
+	if videoName == "/" || videoName == "." {
+		videoName = sourceURL.Hostname()
+	}
+
+	jobRepo := repositories.JobRepository{}
+	job, err := jobRepo.Create(&models.Job{
+		UserID:          userID.(int64),
+		VideoName:       videoName,
+		FilePath:        filePath,
+		PromptText:      req.Prompt,
+		SummaryStrategy: summaryStrategy,
+		SourceURL:       sourceURL.String(),
+	})
+	if err != nil {
+		services.RemoveWorkspace(workspace)
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error: "Ошибка постановки задачи в очередь: " + err.Error(),
+		})
+		return
+	}
+	jobs.Notify()
+
+	// Возвращаем ID задачи, ход загрузки и результат доступны через GET /jobs/:id
+	c.JSON(http.StatusAccepted, gin.H{
+		"job_id": job.ID,
+		"status": job.Status,
+	})
+}
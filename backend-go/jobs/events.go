@@ -0,0 +1,147 @@
+package jobs
+
+import (
+	"sync"
+
+	"github.com/trofimovm/summvideo/models"
+	"github.com/trofimovm/summvideo/services"
+)
+
+// subscriberBuffer задает размер буфера событий одного подписчика.
+// Если подписчик не успевает читать, промежуточные события отбрасываются.
+const subscriberBuffer = 32
+
+// Диапазоны общего прогресса задачи (в процентах), отводимые этапам обработки
+const (
+	extractingFrom   = 0
+	extractingTo     = 35
+	convertingTo     = 50
+	transcribingTo   = 90
+	summarizingStart = 92
+)
+
+// broker рассылает события о ходе обработки подписчикам в пределах процесса
+type broker struct {
+	mu   sync.Mutex
+	subs map[int64]map[chan models.JobEvent]struct{}
+	last map[int64]models.JobEvent
+}
+
+var events = &broker{
+	subs: make(map[int64]map[chan models.JobEvent]struct{}),
+	last: make(map[int64]models.JobEvent),
+}
+
+// Subscribe подписывает на события задачи. Возвращает последнее известное
+// событие (если задача уже обрабатывается), канал новых событий и функцию отписки.
+// Канал закрывается после финального события задачи.
+func Subscribe(jobID int64) (*models.JobEvent, <-chan models.JobEvent, func()) {
+	events.mu.Lock()
+	defer events.mu.Unlock()
+
+	ch := make(chan models.JobEvent, subscriberBuffer)
+	if events.subs[jobID] == nil {
+		events.subs[jobID] = make(map[chan models.JobEvent]struct{})
+	}
+	events.subs[jobID][ch] = struct{}{}
+
+	var last *models.JobEvent
+	if event, ok := events.last[jobID]; ok {
+		last = &event
+	}
+
+	unsubscribe := func() {
+		events.mu.Lock()
+		defer events.mu.Unlock()
+
+		if _, ok := events.subs[jobID][ch]; ok {
+			delete(events.subs[jobID], ch)
+			close(ch)
+			if len(events.subs[jobID]) == 0 {
+				delete(events.subs, jobID)
+			}
+		}
+	}
+
+	return last, ch, unsubscribe
+}
+
+// publish рассылает событие всем подписчикам задачи
+func publish(event models.JobEvent) {
+	events.mu.Lock()
+	defer events.mu.Unlock()
+
+	for ch := range events.subs[event.JobID] {
+		select {
+		case ch <- event:
+		default:
+			// Подписчик не успевает читать, пропускаем промежуточное событие
+		}
+	}
+
+	if !event.IsFinal() {
+		events.last[event.JobID] = event
+		return
+	}
+
+	// После финального события подписчики больше не нужны
+	for ch := range events.subs[event.JobID] {
+		close(ch)
+	}
+	delete(events.subs, event.JobID)
+	delete(events.last, event.JobID)
+}
+
+// tracker публикует события о ходе обработки одной задачи
+type tracker struct {
+	job     *models.Job
+	status  string
+	percent float64
+}
+
+func newTracker(job *models.Job) *tracker {
+	return &tracker{job: job, status: job.Status}
+}
+
+// report публикует событие с заданным этапом и общим прогрессом
+func (t *tracker) report(stage string, percent float64, message string) {
+	t.reportChunk(stage, percent, 0, 0, message)
+}
+
+// reportChunk публикует событие с информацией об обработанном фрагменте
+func (t *tracker) reportChunk(stage string, percent float64, chunk, chunks int, message string) {
+	switch stage {
+	case models.JobStageTranscribing:
+		t.status = models.JobStatusTranscribing
+	case models.JobStageSummarizing:
+		t.status = models.JobStatusSummarizing
+	case models.JobStageDone:
+		t.status = models.JobStatusDone
+	case models.JobStageFailed:
+		t.status = models.JobStatusFailed
+	}
+	t.percent = percent
+
+	publish(models.JobEvent{
+		JobID:   t.job.ID,
+		Stage:   stage,
+		Status:  t.status,
+		Percent: percent,
+		Chunk:   chunk,
+		Chunks:  chunks,
+		Message: message,
+	})
+}
+
+// within возвращает обработчик прогресса операции, отображающий её долю
+// выполнения на диапазон [from, to] общего прогресса задачи.
+// События публикуются только при изменении прогресса на целый процент.
+func (t *tracker) within(stage string, from, to float64) services.ProgressFunc {
+	return func(fraction float64) {
+		percent := float64(int(from + (to-from)*fraction))
+		if percent <= t.percent {
+			return
+		}
+		t.report(stage, percent, "")
+	}
+}
@@ -0,0 +1,104 @@
+package jobs
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/trofimovm/summvideo/models"
+	"github.com/trofimovm/summvideo/repositories"
+	"github.com/trofimovm/summvideo/services"
+)
+
+// MaxFetchSize — максимальный размер файла, загружаемого по адресу, как и для загрузки напрямую
+const MaxFetchSize = 500 * 1024 * 1024
+
+// needsFetch проверяет, что исходный файл задачи ещё нужно загрузить по адресу.
+// После загрузки у задачи появляется резерв лимита, поэтому задача,
+// прерванная перезапуском до резервирования, загружает файл заново.
+func needsFetch(job *models.Job) bool {
+	return job.SourceURL != "" && job.ReservationID == nil
+}
+
+// fetchSource загружает исходный файл задачи по адресу и проверяет его так же,
+// как файл, загруженный напрямую: контейнер по сигнатуре, звуковую дорожку
+// и оставшийся лимит, под который резервируется длительность записи.
+func (q *Queue) fetchSource(ctx context.Context, job *models.Job, progress *tracker) error {
+	fail := func(class string, err error) error {
+		if ctx.Err() != nil {
+			class = services.FailureCancelled
+		}
+		return services.NewProcessingError(class, models.JobStageFetching, false, err)
+	}
+
+	if err := q.jobRepo.UpdateStatus(job.ID, models.JobStatusFetching); err != nil {
+		return fail(services.FailureInternal, err)
+	}
+	progress.report(models.JobStageFetching, extractingFrom, "Загрузка файла по адресу")
+
+	sourceURL, err := services.ParseFetchURL(job.SourceURL)
+	if err != nil {
+		return fail(services.FailureSourceFetch, err)
+	}
+
+	// Файл перезаписывается, если прошлая попытка была прервана перезапуском
+	file, err := os.OpenFile(job.FilePath, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o600)
+	if err != nil {
+		return fail(services.FailureInternal, fmt.Errorf("ошибка создания файла: %v", err))
+	}
+
+	// Хеш содержимого считается при загрузке, он нужен для кэша транскрипций
+	hash := sha256.New()
+	result, err := services.FetchURL(ctx, sourceURL, services.FetchConfigFromEnv(MaxFetchSize), io.MultiWriter(file, hash))
+	closeErr := file.Close()
+	if err != nil {
+		return fail(services.FailureSourceFetch, err)
+	}
+	if closeErr != nil {
+		return fail(services.FailureInternal, fmt.Errorf("ошибка сохранения файла: %v", closeErr))
+	}
+
+	settingsRepo := repositories.SettingsRepository{}
+	allowedContainers, err := settingsRepo.AllowedContainers()
+	if err != nil {
+		return fail(services.FailureInternal, err)
+	}
+	container, err := services.CheckFileContainer(job.FilePath, allowedContainers)
+	if err != nil {
+		return fail(services.FailureInvalidMedia, err)
+	}
+	media, err := services.InspectMedia(ctx, job.FilePath, container)
+	if err != nil {
+		return fail(services.FailureInvalidMedia, err)
+	}
+
+	// Резервируем длительность записи в лимите пользователя, как при загрузке файла
+	userRepo := repositories.UserRepository{}
+	mediaSeconds := services.BillableSeconds(media.Duration)
+	reservationID, err := userRepo.ReserveForProcessing(job.UserID, mediaSeconds)
+	if errors.Is(err, repositories.ErrQuotaExceeded) {
+		return fail(services.FailureQuotaExceeded, fmt.Errorf(
+			"длительность записи (%d сек.) превышает оставшийся лимит использования", mediaSeconds,
+		))
+	}
+	if err != nil {
+		return fail(services.FailureInternal, err)
+	}
+
+	job.VideoName = result.FileName
+	job.ContentHash = hex.EncodeToString(hash.Sum(nil))
+	job.MediaDuration = media.Duration
+	job.ReservationID = &reservationID
+
+	if err := q.jobRepo.SetFetchedSource(job); err != nil {
+		userRepo.ReleaseReservation(reservationID)
+		job.ReservationID = nil
+		return fail(services.FailureInternal, err)
+	}
+
+	return nil
+}
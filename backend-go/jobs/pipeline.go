@@ -0,0 +1,389 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"sync/atomic"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/trofimovm/summvideo/models"
+	"github.com/trofimovm/summvideo/repositories"
+	"github.com/trofimovm/summvideo/services"
+	"github.com/trofimovm/summvideo/utils"
+)
+
+// pipelineResult содержит результат обработки видео
+type pipelineResult struct {
+	Transcription     *models.Transcription
+	Summary           string
+	SummaryStrategy   string        // фактически примененная стратегия суммаризации
+	AudioPath         string        // способ подготовки аудио, services.AudioPath*
+	MediaDuration     float64       // длительность записи в секундах
+	TranscriptionTime time.Duration // время транскрибации
+	SummaryTime       time.Duration // время генерации саммари
+}
+
+// process выполняет обработку видео для задачи и сохраняет результат
+func (q *Queue) process(job *models.Job) {
+	jobRepo := repositories.JobRepository{}
+	progress := newTracker(job)
+
+	// Обработку можно прервать через Cancel, в том числе с другого экземпляра сервера
+	ctx, cancel := context.WithCancel(context.Background())
+	q.track(job.ID, cancel)
+	defer q.untrack(job.ID)
+	go watchCancel(ctx, cancelCheckInterval, func() (bool, error) {
+		return jobRepo.CancelRequested(job.ID)
+	}, cancel)
+
+	// Аренда продлевается, пока задача выполняется. Если аренда истекла и задачу
+	// уже забрал другой экземпляр, обработка прерывается, а её результат
+	// отбрасывается: задачей и её файлами распоряжается новый обработчик.
+	var leaseLost atomic.Bool
+	go renewLease(ctx, leaseRenewInterval, func() (bool, error) {
+		return jobRepo.RenewLease(job.ID, job.Attempts, jobLeaseDuration)
+	}, func() {
+		leaseLost.Store(true)
+		cancel()
+	})
+
+	// Фиксируем время начала обработки
+	startTime := time.Now()
+
+	result, err := q.runPipeline(ctx, job, progress)
+	if leaseLost.Load() {
+		log.Printf("Задача %d: аренда истекла, задача передана другому обработчику", job.ID)
+		return
+	}
+
+	// Рабочий каталог с исходным и промежуточными файлами больше не нужен
+	// ни при успехе, ни при ошибке
+	services.RemoveJobFiles(job.FilePath)
+
+	if err != nil {
+		log.Printf("Задача %d: ошибка обработки видео: %v", job.ID, err)
+		message := "Ошибка обработки видео: " + err.Error()
+		if services.ClassifyError(err) == services.FailureCancelled {
+			message = cancelledMessage
+		}
+		if err := jobRepo.Fail(job.ID, message); err != nil {
+			log.Printf("Задача %d: ошибка сохранения статуса: %v", job.ID, err)
+		}
+		progress.report(models.JobStageFailed, progress.percent, message)
+		q.settleFailure(job, err, int(time.Since(startTime).Seconds()))
+		return
+	}
+
+	// Вычисляем время обработки
+	processingTime := int(time.Since(startTime).Seconds())
+	summaryLength := len(result.Summary)
+
+	if err := jobRepo.Complete(job.ID, result.Transcription, result.Summary, processingTime); err != nil {
+		log.Printf("Задача %d: ошибка сохранения результата: %v", job.ID, err)
+	}
+	progress.report(models.JobStageDone, 100, "Обработка завершена")
+
+	// Списываем длительность записи: стоимость не зависит от задержек
+	// очереди и провайдеров
+	mediaSeconds := services.BillableSeconds(result.MediaDuration)
+	userRepo := repositories.UserRepository{}
+	if job.ReservationID != nil {
+		err = userRepo.SettleReservation(*job.ReservationID, mediaSeconds)
+	} else {
+		err = userRepo.UpdateUsage(job.UserID, mediaSeconds)
+	}
+	if err != nil {
+		log.Printf("Ошибка обновления использованного времени: %v", err)
+	}
+
+	// Сохраняем запись об использовании
+	usageRepo := repositories.UsageRepository{}
+	usage, err := usageRepo.Create(&models.UsageHistory{
+		UserID:         job.UserID,
+		VideoName:      job.VideoName,
+		PromptText:     job.PromptText,
+		SummaryLength:  summaryLength,
+		MediaSeconds:   mediaSeconds,
+		ProcessingTime: processingTime,
+		SourceURL:      job.SourceURL,
+	})
+	if err != nil {
+		log.Printf("Ошибка сохранения записи об использовании: %v", err)
+		return
+	}
+
+	// Сохраняем полный результат, чтобы пользователь мог открыть его из истории
+	resultRepo := repositories.ResultRepository{}
+	_, err = resultRepo.Create(&models.Result{
+		UsageID:            usage.ID,
+		JobID:              &job.ID,
+		Transcription:      result.Transcription.Text,
+		Segments:           result.Transcription.Segments,
+		Summary:            result.Summary,
+		TranscriptionModel: q.transcriber.TranscriptionModel(),
+		SummaryModel:       q.summarizer.SummaryModel(),
+		SummaryStrategy:    result.SummaryStrategy,
+		AudioPath:          result.AudioPath,
+		Language:           result.Transcription.Language,
+		MediaDuration:      result.MediaDuration,
+		TranscriptionTime:  int(result.TranscriptionTime.Seconds()),
+		SummaryTime:        int(result.SummaryTime.Seconds()),
+		ProcessingTime:     processingTime,
+	})
+	if err != nil {
+		log.Printf("Задача %d: ошибка сохранения результата в историю: %v", job.ID, err)
+	}
+}
+
+// settleFailure применяет политику возвратов к неудачной задаче: возвращает
+// пользователю зарезервированное время или списывает его и сохраняет
+// неудачную обработку в истории использования
+func (q *Queue) settleFailure(job *models.Job, failure error, processingTime int) {
+	mediaSeconds := services.BillableSeconds(job.MediaDuration)
+	decision := services.DefaultRefundPolicy.Decide(failure, mediaSeconds)
+	log.Printf("Задача %d: класс ошибки %s, правило %s, списано %d с, возвращено %d с",
+		job.ID, decision.Class, decision.Rule, decision.ChargedSeconds, decision.RefundedSeconds)
+
+	userRepo := repositories.UserRepository{}
+	var billingErr error
+	switch {
+	case job.ReservationID == nil:
+		// Задачи без резерва оплачиваются только по факту
+		if decision.ChargedSeconds > 0 {
+			billingErr = userRepo.UpdateUsage(job.UserID, decision.ChargedSeconds)
+		}
+	case decision.ChargedSeconds > 0:
+		billingErr = userRepo.SettleReservation(*job.ReservationID, decision.ChargedSeconds)
+	default:
+		billingErr = userRepo.ReleaseReservation(*job.ReservationID)
+	}
+	if billingErr != nil {
+		log.Printf("Задача %d: ошибка возврата или списания времени: %v", job.ID, billingErr)
+	}
+
+	status := models.UsageStatusFailed
+	if decision.Class == services.FailureCancelled {
+		status = models.UsageStatusCancelled
+	}
+
+	usageRepo := repositories.UsageRepository{}
+	_, err := usageRepo.Create(&models.UsageHistory{
+		UserID:          job.UserID,
+		VideoName:       job.VideoName,
+		PromptText:      job.PromptText,
+		MediaSeconds:    decision.ChargedSeconds,
+		ProcessingTime:  processingTime,
+		Status:          status,
+		ErrorClass:      decision.Class,
+		ErrorMessage:    failure.Error(),
+		RefundedSeconds: decision.RefundedSeconds,
+		RefundRule:      decision.Rule,
+		SourceURL:       job.SourceURL,
+	})
+	if err != nil {
+		log.Printf("Задача %d: ошибка сохранения неудачной обработки в историю: %v", job.ID, err)
+	}
+}
+
+// runPipeline извлекает аудио, транскрибирует его и генерирует саммари,
+// обновляя статус задачи и публикуя события на каждом этапе. Ошибки
+// возвращаются как services.ProcessingError с классом для политики возвратов.
+func (q *Queue) runPipeline(ctx context.Context, job *models.Job, progress *tracker) (*pipelineResult, error) {
+	jobRepo := repositories.JobRepository{}
+
+	transcribed := false
+	fail := func(class, stage string, err error) error {
+		failure := services.NewProcessingError(class, stage, transcribed, err)
+		if ctx.Err() != nil {
+			failure.Class = services.FailureCancelled
+		}
+		return failure
+	}
+
+	// Файл, указанный адресом, загружается и проверяется обработчиком,
+	// чтобы не держать запрос клиента открытым на время загрузки
+	if needsFetch(job) {
+		if err := q.fetchSource(ctx, job, progress); err != nil {
+			return nil, err
+		}
+	}
+
+	// Длительность определяется при загрузке; задачи, поставленные в очередь
+	// до появления этой проверки, измеряем здесь
+	if job.MediaDuration <= 0 {
+		mediaDuration, err := services.ProbeDuration(ctx, job.FilePath)
+		if err != nil {
+			return nil, fail(services.FailureInvalidMedia, models.JobStageExtracting, err)
+		}
+		job.MediaDuration = mediaDuration
+	}
+
+	// Одинаковые файлы повторно не транскрибируем, если транскрипция есть в кэше
+	transcriptionStart := time.Now()
+	transcription, cached := q.cachedTranscription(job)
+	audioPath := services.AudioPathCached
+	if cached {
+		progress.report(models.JobStageTranscribing, transcribingTo, "Транскрипция взята из кэша")
+	} else {
+		var err error
+		transcription, audioPath, err = q.transcribe(ctx, job, progress)
+		if err != nil {
+			return nil, fail(services.FailureProviderError, models.JobStageTranscribing, err)
+		}
+		q.cacheTranscription(job, transcription)
+	}
+	transcribed = true
+	transcriptionTime := time.Since(transcriptionStart)
+
+	if err := ctx.Err(); err != nil {
+		return nil, fail(services.FailureCancelled, models.JobStageSummarizing, err)
+	}
+
+	// Генерация саммари на основе транскрипции и промта
+	if err := jobRepo.UpdateStatus(job.ID, models.JobStatusSummarizing); err != nil {
+		return nil, fail(services.FailureInternal, models.JobStageSummarizing, err)
+	}
+	progress.report(models.JobStageSummarizing, summarizingStart, "Генерация саммари")
+	summaryStart := time.Now()
+	summary, strategy, err := services.SummarizeTranscript(
+		ctx, q.summarizer, transcription.Text, job.PromptText, job.SummaryStrategy,
+		services.SummarizationConfigFromEnv(),
+		func(done, total int) {
+			// Для длинных транскрипций сообщаем о каждом суммаризированном фрагменте
+			if total > 1 {
+				percent := float64(int(summarizingStart + (100-summarizingStart-1)*float64(done)/float64(total)))
+				progress.reportChunk(models.JobStageSummarizing, percent, done, total,
+					fmt.Sprintf("Саммари: выполнено %d из %d запросов", done, total))
+			}
+		},
+	)
+	if err != nil {
+		return nil, fail(services.FailureProviderError, models.JobStageSummarizing, err)
+	}
+	summaryTime := time.Since(summaryStart)
+
+	// Логирование результатов
+	if err := utils.LogData(job.VideoName, job.PromptText, transcription.Text, summary); err != nil {
+		log.Printf("Ошибка записи в лог: %v", err)
+	}
+
+	return &pipelineResult{
+		Transcription:     transcription,
+		Summary:           summary,
+		SummaryStrategy:   strategy,
+		AudioPath:         audioPath,
+		MediaDuration:     job.MediaDuration,
+		TranscriptionTime: transcriptionTime,
+		SummaryTime:       summaryTime,
+	}, nil
+}
+
+// transcribe готовит аудио и транскрибирует его, возвращая также способ
+// подготовки аудио. Ошибки ffmpeg и сервера классифицируются здесь,
+// остальные относятся к провайдеру.
+func (q *Queue) transcribe(ctx context.Context, job *models.Job, progress *tracker) (*models.Transcription, string, error) {
+	jobRepo := repositories.JobRepository{}
+
+	// Промежуточные файлы создаются в рабочем каталоге задачи. Задачи,
+	// поставленные в очередь до появления рабочих каталогов, получают отдельный каталог
+	workDir := services.JobWorkspace(job.FilePath)
+	if workDir == "" {
+		dir, err := services.NewWorkspace()
+		if err != nil {
+			return nil, "", services.NewProcessingError(services.FailureInternal, models.JobStageExtracting, false, err)
+		}
+		defer services.RemoveWorkspace(dir)
+		workDir = dir
+	}
+
+	// Видео и несовместимое аудио перекодируются за один проход ffmpeg,
+	// совместимое аудио передается провайдеру как есть
+	progress.report(models.JobStageExtracting, extractingFrom, "Подготовка аудио")
+	audio, err := services.PrepareAudio(ctx, job.FilePath, workDir, progress.within(models.JobStageExtracting, extractingFrom, convertingTo))
+	if err != nil {
+		return nil, "", services.NewProcessingError(services.FailureMediaProcessing, models.JobStageExtracting, false, err)
+	}
+	if audio.Path != job.FilePath {
+		defer os.Remove(audio.Path) // Удаляем временный аудиофайл
+	}
+	log.Printf("Задача %d: аудио подготовлено (%s)", job.ID, audio.Mode)
+	progress.report(models.JobStageAudioExtracted, convertingTo, "Аудио подготовлено")
+
+	if err := ctx.Err(); err != nil {
+		return nil, "", err
+	}
+
+	// Транскрибация аудио
+	if err := jobRepo.UpdateStatus(job.ID, models.JobStatusTranscribing); err != nil {
+		return nil, "", services.NewProcessingError(services.FailureInternal, models.JobStageTranscribing, false, err)
+	}
+	progress.report(models.JobStageTranscribing, convertingTo, "Транскрибация аудио")
+	transcription, err := services.TranscribeChunked(
+		ctx,
+		audio.Path,
+		services.ChunkingConfigFromEnv(),
+		func(path string) (*models.Transcription, error) {
+			return q.transcriber.Transcribe(ctx, path)
+		},
+		func(done, total int) {
+			percent := float64(int(convertingTo + (transcribingTo-convertingTo)*float64(done)/float64(total)))
+			progress.reportChunk(models.JobStageTranscribedChunk, percent, done, total,
+				fmt.Sprintf("Фрагмент %d из %d транскрибирован", done, total))
+		},
+	)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return transcription, audio.Mode, nil
+}
+
+// cachedTranscription ищет в кэше транскрипцию файла задачи, сделанную той же
+// моделью с тем же языком. Ошибки кэша не прерывают обработку.
+func (q *Queue) cachedTranscription(job *models.Job) (*models.Transcription, bool) {
+	if job.ContentHash == "" {
+		return nil, false
+	}
+
+	cacheRepo := repositories.TranscriptionCacheRepository{}
+	ttl, err := cacheRepo.TTL()
+	if err != nil {
+		log.Printf("Задача %d: ошибка получения настроек кэша: %v", job.ID, err)
+		return nil, false
+	}
+	if ttl <= 0 {
+		return nil, false
+	}
+
+	transcription, err := cacheRepo.Find(job.ContentHash, q.transcriber.TranscriptionModel(), q.transcriber.TranscriptionLanguage(), ttl)
+	if err != nil {
+		if !errors.Is(err, pgx.ErrNoRows) {
+			log.Printf("Задача %d: ошибка чтения кэша транскрипций: %v", job.ID, err)
+		}
+		return nil, false
+	}
+
+	log.Printf("Задача %d: транскрипция взята из кэша", job.ID)
+	return transcription, true
+}
+
+// cacheTranscription сохраняет транскрипцию файла задачи в кэш, если он включен
+func (q *Queue) cacheTranscription(job *models.Job, transcription *models.Transcription) {
+	if job.ContentHash == "" {
+		return
+	}
+
+	cacheRepo := repositories.TranscriptionCacheRepository{}
+	ttl, err := cacheRepo.TTL()
+	if err != nil || ttl <= 0 {
+		return
+	}
+
+	if err := cacheRepo.Save(job.ContentHash, q.transcriber.TranscriptionModel(), q.transcriber.TranscriptionLanguage(), transcription); err != nil {
+		log.Printf("Задача %d: ошибка сохранения транскрипции в кэш: %v", job.ID, err)
+	}
+}
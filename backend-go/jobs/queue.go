@@ -0,0 +1,468 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"log"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/trofimovm/summvideo/models"
+	"github.com/trofimovm/summvideo/repositories"
+	"github.com/trofimovm/summvideo/services"
+	"github.com/trofimovm/summvideo/utils"
+)
+
+// pollInterval задает, как часто обработчики проверяют очередь без уведомлений
+const pollInterval = 5 * time.Second
+
+// workspaceSweepInterval задает, как часто удаляются брошенные рабочие каталоги задач
+const workspaceSweepInterval = 30 * time.Minute
+
+// sessionSweepInterval задает, как часто удаляются хэши замененных токенов закончившихся сессий
+const sessionSweepInterval = time.Hour
+
+// cancelCheckInterval задает, как часто выполняемая задача проверяет запрос отмены,
+// сохраненный другим экземпляром сервера
+const cancelCheckInterval = 2 * time.Second
+
+// jobLeaseDuration задает срок аренды выполняемой задачи. Если обработчик не
+// продлил аренду за это время (экземпляр сервера остановлен или потерял связь
+// с базой), задачу возвращает в очередь любой экземпляр.
+const jobLeaseDuration = time.Minute
+
+// leaseRenewInterval задает, как часто обработчик продлевает аренду выполняемой задачи
+const leaseRenewInterval = 15 * time.Second
+
+// quotaCheckInterval задает, как часто снимаются просроченные резервы лимита
+// и обновляются квоты пользователей, у которых закончился период
+const quotaCheckInterval = time.Minute
+
+// cancelledMessage сохраняется как ошибка задачи, отмененной пользователем
+const cancelledMessage = "Обработка отменена пользователем"
+
+// ErrNotCancellable возвращается, если задача уже завершена и не может быть отменена
+var ErrNotCancellable = errors.New("задача уже завершена")
+
+// Config содержит настройки пула обработчиков
+type Config struct {
+	Workers     int                  // число одновременно обрабатываемых задач
+	MaxAttempts int                  // число попыток обработки задачи с учетом перезапусков
+	Transcriber services.Transcriber // провайдер транскрибации
+	Summarizer  services.Summarizer  // провайдер суммаризации
+}
+
+// Queue представляет пул обработчиков, забирающих задачи из таблицы jobs
+type Queue struct {
+	workers     int
+	maxAttempts int
+	transcriber services.Transcriber
+	summarizer  services.Summarizer
+	wake        chan struct{}
+	jobRepo     repositories.JobRepository
+
+	mu      sync.Mutex
+	running map[int64]context.CancelFunc // прерывание выполняемых задач по ID
+}
+
+// queue глобальный пул обработчиков, создается в Start
+var queue *Queue
+
+// Start восстанавливает прерванные задачи и запускает пул обработчиков.
+// Задачи, обработчик которых остановился (аренда задачи истекла), возвращаются
+// в очередь, пока не исчерпано cfg.MaxAttempts попыток, иначе помечаются как
+// неудачные. Проверка повторяется каждые jobLeaseDuration, поэтому задачи
+// остановленного экземпляра подхватывают остальные экземпляры.
+func Start(cfg Config) error {
+	if cfg.Transcriber == nil || cfg.Summarizer == nil {
+		return errors.New("не заданы провайдеры транскрибации и суммаризации")
+	}
+
+	workers := cfg.Workers
+	if workers < 1 {
+		workers = 1
+	}
+	maxAttempts := cfg.MaxAttempts
+	if maxAttempts < 1 {
+		maxAttempts = 1
+	}
+
+	q := &Queue{
+		workers:     workers,
+		maxAttempts: maxAttempts,
+		transcriber: cfg.Transcriber,
+		summarizer:  cfg.Summarizer,
+		wake:        make(chan struct{}, workers),
+		running:     make(map[int64]context.CancelFunc),
+	}
+
+	if err := q.recoverInterrupted(); err != nil {
+		return err
+	}
+
+	for i := 0; i < workers; i++ {
+		go q.worker()
+	}
+	go q.watchLeases()
+	go maintainQuotas()
+	go sweepSessions()
+	go q.sweepWorkspaces()
+
+	queue = q
+	log.Printf("Очередь обработки запущена, обработчиков: %d", workers)
+	return nil
+}
+
+// Notify будит обработчики после постановки новой задачи в очередь
+func Notify() {
+	if queue == nil {
+		return
+	}
+
+	select {
+	case queue.wake <- struct{}{}:
+	default:
+		// Все обработчики уже разбужены
+	}
+}
+
+// Cancel отменяет задачу обработки. Задача из очереди снимается сразу,
+// у выполняемой задачи прерывается обработка. Запрос отмены сохраняется
+// в задаче, поэтому задачу можно отменить через любой экземпляр сервера:
+// обработчик, который её выполняет, проверяет запрос каждые cancelCheckInterval.
+// Время возвращается или списывается по политике возвратов.
+func Cancel(jobID int64) error {
+	if queue == nil {
+		return errors.New("очередь обработки не запущена")
+	}
+
+	return queue.cancel(jobID)
+}
+
+// cancel снимает задачу с очереди или прерывает её обработку
+func (q *Queue) cancel(jobID int64) error {
+	job, err := q.jobRepo.CancelQueued(jobID, cancelledMessage)
+	if err == nil {
+		// Задача ещё не начала выполняться
+		services.RemoveJobFiles(job.FilePath)
+		newTracker(job).report(models.JobStageFailed, 0, cancelledMessage)
+		q.settleFailure(job, services.NewProcessingError(
+			services.FailureCancelled, models.JobStageQueued, false, errors.New(cancelledMessage),
+		), 0)
+		return nil
+	}
+	if !errors.Is(err, pgx.ErrNoRows) {
+		return err
+	}
+
+	err = q.jobRepo.RequestCancel(jobID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return ErrNotCancellable
+	}
+	if err != nil {
+		return err
+	}
+
+	// Задачу, которая выполняется на этом экземпляре, прерываем сразу
+	q.mu.Lock()
+	cancel, ok := q.running[jobID]
+	q.mu.Unlock()
+	if ok {
+		cancel()
+	}
+	return nil
+}
+
+// watchCancel прерывает обработку задачи, когда requested сообщает о запросе
+// отмены. Проверка повторяется каждые interval, пока не завершится ctx.
+// Ошибки проверки не прерывают обработку.
+func watchCancel(ctx context.Context, interval time.Duration, requested func() (bool, error), cancel context.CancelFunc) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		ok, err := requested()
+		if err != nil {
+			log.Printf("Ошибка проверки запроса отмены: %v", err)
+			continue
+		}
+		if ok {
+			cancel()
+			return
+		}
+	}
+}
+
+// renewLease продлевает аренду выполняемой задачи каждые interval, пока не
+// завершится ctx. Если renew сообщает, что задача больше не принадлежит
+// обработчику (аренда истекла и задачу вернул в очередь другой экземпляр),
+// вызывается lost. Ошибки продления не прерывают обработку.
+func renewLease(ctx context.Context, interval time.Duration, renew func() (bool, error), lost func()) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		ok, err := renew()
+		if err != nil {
+			log.Printf("Ошибка продления аренды задачи: %v", err)
+			continue
+		}
+		if !ok {
+			lost()
+			return
+		}
+	}
+}
+
+// track запоминает функцию прерывания выполняемой задачи
+func (q *Queue) track(jobID int64, cancel context.CancelFunc) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	q.running[jobID] = cancel
+}
+
+// untrack забывает завершенную задачу и освобождает её контекст
+func (q *Queue) untrack(jobID int64) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if cancel, ok := q.running[jobID]; ok {
+		cancel()
+		delete(q.running, jobID)
+	}
+}
+
+// recoverInterrupted возвращает в очередь или помечает неудачными задачи,
+// аренда которых истекла: их обработчик остановился вместе со своим
+// экземпляром сервера. Задачи с действующей арендой выполняются, возможно,
+// другим экземпляром и не трогаются. Каждая задача переводится условным
+// обновлением, поэтому задачу восстанавливает только один экземпляр.
+func (q *Queue) recoverInterrupted() error {
+	expired, err := q.jobRepo.FindLeaseExpired()
+	if err != nil {
+		return err
+	}
+
+	for _, job := range expired {
+		job := job
+
+		// Без исходного файла задачу продолжить невозможно, если его не загрузить
+		// заново по адресу. UPLOAD_DIR общий для всех экземпляров, поэтому файл
+		// проверяется и для задач, начатых другим экземпляром.
+		if _, err := os.Stat(job.FilePath); err != nil && !needsFetch(&job) {
+			message := "Исходный файл утерян после остановки обработчика"
+			ok, err := q.jobRepo.FailExpired(job.ID, job.Attempts, message)
+			if err != nil {
+				return err
+			}
+			if ok {
+				log.Printf("Задача %d: исходный файл недоступен, задача отменена", job.ID)
+				q.settleFailure(&job, interruptedError(&job, message), 0)
+			}
+			continue
+		}
+
+		if job.Attempts >= q.maxAttempts {
+			message := "Обработка прервана остановкой обработчика"
+			ok, err := q.jobRepo.FailExpired(job.ID, job.Attempts, message)
+			if err != nil {
+				return err
+			}
+			if ok {
+				log.Printf("Задача %d: исчерпаны попытки обработки", job.ID)
+				q.settleFailure(&job, interruptedError(&job, message), 0)
+				services.RemoveJobFiles(job.FilePath)
+			}
+			continue
+		}
+
+		ok, err := q.jobRepo.RequeueExpired(job.ID, job.Attempts)
+		if err != nil {
+			return err
+		}
+		if ok {
+			log.Printf("Задача %d: возвращена в очередь после остановки обработчика", job.ID)
+			Notify()
+		}
+	}
+
+	return nil
+}
+
+// watchLeases периодически восстанавливает задачи с истекшей арендой
+func (q *Queue) watchLeases() {
+	ticker := time.NewTicker(jobLeaseDuration)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		if err := q.recoverInterrupted(); err != nil {
+			log.Printf("Ошибка восстановления прерванных задач: %v", err)
+		}
+	}
+}
+
+// interruptedError описывает задачу, которую не удалось продолжить после перезапуска
+func interruptedError(job *models.Job, message string) error {
+	return services.NewProcessingError(
+		services.FailureInternal, job.Status, job.Status == models.JobStatusSummarizing, errors.New(message),
+	)
+}
+
+// worker забирает задачи из очереди, пока они есть, затем ждет уведомления
+func (q *Queue) worker() {
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	for {
+		q.drain()
+
+		select {
+		case <-q.wake:
+		case <-ticker.C:
+		}
+	}
+}
+
+// drain обрабатывает задачи одну за другой, пока очередь не опустеет
+func (q *Queue) drain() {
+	for {
+		job, err := q.jobRepo.ClaimNext(jobLeaseDuration)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return
+		}
+		if err != nil {
+			log.Printf("Ошибка получения задачи из очереди: %v", err)
+			return
+		}
+
+		q.process(job)
+	}
+}
+
+// maintainQuotas периодически снимает резервы лимита, которые не были погашены
+// вовремя (например, из-за аварийного завершения обработчика), списывает
+// истекшие бонусные начисления и обновляет квоты тарифных планов по окончании периода
+func maintainQuotas() {
+	userRepo := repositories.UserRepository{}
+	planRepo := repositories.PlanRepository{}
+	ledgerRepo := repositories.LedgerRepository{}
+	ticker := time.NewTicker(quotaCheckInterval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		expired, err := userRepo.ExpireStaleReservations()
+		if err != nil {
+			log.Printf("Ошибка снятия просроченных резервов лимита: %v", err)
+		} else if expired > 0 {
+			log.Printf("Снято просроченных резервов лимита: %d", expired)
+		}
+
+		granted, err := ledgerRepo.ExpireGrants()
+		if err != nil {
+			log.Printf("Ошибка списания истекших бонусных начислений: %v", err)
+		} else if granted > 0 {
+			log.Printf("Списаны истекшие бонусные начисления пользователей: %d", granted)
+		}
+
+		reset, err := planRepo.ResetDuePeriods()
+		if err != nil {
+			log.Printf("Ошибка обновления квот: %v", err)
+		} else if reset > 0 {
+			log.Printf("Обновлено квот по окончании периода: %d", reset)
+		}
+	}
+}
+
+// sweepSessions периодически удаляет хэши замененных обновляющих токенов
+// отозванных и истекших сессий, чтобы таблица не росла с каждой ротацией
+func sweepSessions() {
+	sessionRepo := repositories.SessionRepository{}
+	ticker := time.NewTicker(sessionSweepInterval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		deleted, err := sessionRepo.DeleteStaleRotatedTokens()
+		if err != nil {
+			log.Printf("Ошибка удаления токенов закончившихся сессий: %v", err)
+		} else if deleted > 0 {
+			log.Printf("Удалено токенов закончившихся сессий: %d", deleted)
+		}
+	}
+}
+
+// sweepWorkspaces при старте и затем периодически удаляет истекшие
+// возобновляемые загрузки и рабочие каталоги, которые не принадлежат
+// незавершенным задачам и загрузкам, например оставшиеся после аварийного
+// завершения сервера во время загрузки или обработки. Каталоги моложе
+// WORKSPACE_ORPHAN_GRACE_MINUTES (60 по умолчанию) не трогаются,
+// чтобы не удалить загрузку, для которой задача ещё не создана.
+func (q *Queue) sweepWorkspaces() {
+	grace := time.Duration(utils.GetEnvInt("WORKSPACE_ORPHAN_GRACE_MINUTES", 60)) * time.Minute
+	ticker := time.NewTicker(workspaceSweepInterval)
+	defer ticker.Stop()
+
+	uploadRepo := repositories.UploadRepository{}
+	for {
+		expired, err := uploadRepo.DeleteExpired()
+		if err != nil {
+			log.Printf("Ошибка удаления истекших загрузок: %v", err)
+		}
+		for _, filePath := range expired {
+			services.RemoveJobFiles(filePath)
+		}
+		if len(expired) > 0 {
+			log.Printf("Удалено незавершенных загрузок: %d", len(expired))
+		}
+
+		active, err := q.activeFiles()
+		if err != nil {
+			log.Printf("Ошибка получения незавершенных задач и загрузок: %v", err)
+		} else {
+			removed, err := services.SweepWorkspaces(active, grace)
+			if err != nil {
+				log.Printf("Ошибка удаления брошенных рабочих каталогов: %v", err)
+			} else if removed > 0 {
+				log.Printf("Удалено брошенных рабочих каталогов: %d", removed)
+			}
+		}
+
+		<-ticker.C
+	}
+}
+
+// activeFiles возвращает пути к файлам незавершенных задач и загрузок.
+// Загрузки читаются раньше задач: загрузка, завершенная между запросами,
+// попадет в список как незавершенная или как задача.
+func (q *Queue) activeFiles() ([]string, error) {
+	uploadRepo := repositories.UploadRepository{}
+	active, err := uploadRepo.FindIncompletePaths()
+	if err != nil {
+		return nil, err
+	}
+
+	unfinished, err := q.jobRepo.FindUnfinished()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, job := range unfinished {
+		active = append(active, job.FilePath)
+	}
+
+	return active, nil
+}
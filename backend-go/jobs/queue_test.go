@@ -0,0 +1,96 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestWatchCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// Ошибки проверки пропускаются, отмена срабатывает по первому запросу
+	var checks atomic.Int32
+	done := make(chan struct{})
+	go func() {
+		watchCancel(ctx, time.Millisecond, func() (bool, error) {
+			switch checks.Add(1) {
+			case 1:
+				return false, errors.New("database is unavailable")
+			case 2:
+				return false, nil
+			default:
+				return true, nil
+			}
+		}, cancel)
+		close(done)
+	}()
+
+	select {
+	case <-ctx.Done():
+	case <-time.After(5 * time.Second):
+		t.Fatal("job context was not cancelled")
+	}
+	<-done
+	if n := checks.Load(); n != 3 {
+		t.Errorf("got %d checks, want 3", n)
+	}
+}
+
+func TestWatchCancelStopsWithJob(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	done := make(chan struct{})
+	go func() {
+		watchCancel(ctx, time.Millisecond, func() (bool, error) { return false, nil }, func() {
+			t.Error("finished job was cancelled")
+		})
+		close(done)
+	}()
+
+	// Завершение обработки останавливает проверку
+	time.Sleep(5 * time.Millisecond)
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("watcher did not stop after the job finished")
+	}
+}
+
+func TestRenewLease(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// Ошибки продления пропускаются, потеря аренды сообщается один раз
+	var renewals, losses atomic.Int32
+	done := make(chan struct{})
+	go func() {
+		renewLease(ctx, time.Millisecond, func() (bool, error) {
+			switch renewals.Add(1) {
+			case 1:
+				return false, errors.New("database is unavailable")
+			case 2:
+				return true, nil
+			default:
+				return false, nil
+			}
+		}, func() { losses.Add(1) })
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("lease renewal did not stop after the lease was lost")
+	}
+	if n := renewals.Load(); n != 3 {
+		t.Errorf("got %d renewals, want 3", n)
+	}
+	if n := losses.Load(); n != 1 {
+		t.Errorf("got %d lost leases, want 1", n)
+	}
+}
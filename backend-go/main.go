@@ -9,7 +9,10 @@ import (
 	"github.com/joho/godotenv"
 	"github.com/trofimovm/summvideo/database"
 	"github.com/trofimovm/summvideo/handlers"
+	"github.com/trofimovm/summvideo/jobs"
 	"github.com/trofimovm/summvideo/middleware"
+	"github.com/trofimovm/summvideo/models"
+	"github.com/trofimovm/summvideo/services"
 	"github.com/trofimovm/summvideo/utils"
 )
 
@@ -36,8 +39,9 @@ func main() {
 	// Настройка CORS
 	router.Use(cors.New(cors.Config{
 		AllowAllOrigins:  true,
-		AllowMethods:     []string{"GET", "POST", "PUT", "PATCH", "DELETE", "OPTIONS"},
-		AllowHeaders:     []string{"Origin", "Content-Type", "Accept", "Authorization"},
+		AllowMethods:     []string{"GET", "HEAD", "POST", "PUT", "PATCH", "DELETE", "OPTIONS"},
+		AllowHeaders:     []string{"Origin", "Content-Type", "Accept", "Authorization", "X-API-Key", "Upload-Offset"},
+		ExposeHeaders:    []string{"Location", "Upload-Offset", "Upload-Length"},
 		AllowCredentials: true,
 	}))
 
@@ -64,6 +68,37 @@ func main() {
 		log.Fatalf("Failed to run migrations: %v", err)
 	}
 
+	// Подкоманда управления администраторами выполняется вместо запуска сервера
+	if len(os.Args) > 1 && os.Args[1] == "admin" {
+		if err := runAdminCommand(os.Args[2:]); err != nil {
+			log.Fatalf("admin: %v", err)
+		}
+		return
+	}
+
+	// Создание первого администратора из переменных окружения
+	if err := bootstrapAdmin(); err != nil {
+		log.Fatalf("Failed to bootstrap admin: %v", err)
+	}
+
+	// Инициализация провайдеров транскрибации и суммаризации
+	transcriber, summarizer, err := services.NewProvidersFromEnv()
+	if err != nil {
+		log.Fatalf("Failed to configure AI providers: %v", err)
+	}
+	handlers.SetProviders(transcriber, summarizer)
+
+	// Запуск пула обработчиков очереди задач
+	err = jobs.Start(jobs.Config{
+		Workers:     utils.GetEnvInt("JOB_WORKERS", 2),
+		MaxAttempts: utils.GetEnvInt("JOB_MAX_ATTEMPTS", 3),
+		Transcriber: transcriber,
+		Summarizer:  summarizer,
+	})
+	if err != nil {
+		log.Fatalf("Failed to start job queue: %v", err)
+	}
+
 	// Статические файлы
 	router.Static("/static", staticDir)
 
@@ -75,32 +110,83 @@ func main() {
 	router.GET("/", handlers.HomePage)
 	router.GET("/index.html", handlers.RedirectToHome)
 	router.GET("/auth/telegram", handlers.TelegramAuthHandler)
+	router.POST("/auth/telegram/webapp", handlers.TelegramWebAppAuthHandler)
 	router.POST("/auth/admin", handlers.AdminLoginHandler)
-	
+	router.POST("/auth/refresh", handlers.RefreshTokenHandler)
+	router.POST("/auth/logout", handlers.LogoutHandler)
+	router.POST("/auth/admin/2fa", handlers.AdminTwoFactorHandler)
+
+	// Учетная запись администратора с паролем
+	adminAccount := router.Group("/auth/admin")
+	adminAccount.Use(middleware.AuthMiddleware(), middleware.SessionOnly())
+	{
+		adminAccount.POST("/password", handlers.ChangeAdminPasswordHandler)
+		adminAccount.POST("/totp/setup", handlers.SetupTOTP)
+		adminAccount.POST("/totp/enable", handlers.EnableTOTP)
+		adminAccount.POST("/totp/disable", handlers.DisableTOTP)
+		adminAccount.POST("/totp/recovery-codes", handlers.RegenerateRecoveryCodes)
+	}
+
 	// Страницы администратора
 	router.GET("/admin", handlers.AdminLoginPage)
-	router.GET("/admin/dashboard", middleware.AuthMiddleware(), handlers.AdminMiddleware, handlers.AdminDashboardPage)
-	
-	// Защищенные маршруты (требуют авторизации)
+	router.GET("/admin/dashboard", middleware.AuthMiddleware(), middleware.SessionOnly(), handlers.AdminMiddleware, handlers.AdminDashboardPage)
+
+	// Защищенные маршруты (требуют авторизации). При доступе по API-ключу
+	// маршрут должен быть разрешен областями действия ключа
+	upload := middleware.RequireScope(models.APIKeyScopeUpload)
+	historyRead := middleware.RequireScope(models.APIKeyScopeHistoryRead)
 	protected := router.Group("/")
 	protected.Use(middleware.AuthMiddleware())
 	{
-		protected.POST("/upload_video/", handlers.UploadVideo)
+		protected.POST("/upload_video/", upload, handlers.UploadVideo)
+		protected.POST("/upload_url/", upload, handlers.UploadFromURL)
+		protected.POST("/uploads", upload, handlers.CreateUpload)
+		protected.HEAD("/uploads/:id", upload, handlers.HeadUpload)
+		protected.GET("/uploads/:id", upload, handlers.GetUpload)
+		protected.PATCH("/uploads/:id", upload, handlers.PatchUpload)
+		protected.DELETE("/uploads/:id", upload, handlers.DeleteUpload)
+		protected.GET("/jobs/:id", upload, handlers.GetJob)
+		protected.GET("/jobs/:id/events", upload, handlers.GetJobEvents)
+		protected.POST("/jobs/:id/cancel", upload, handlers.CancelJob)
+		protected.GET("/jobs/:id/transcript/:format", upload, handlers.GetJobTranscript)
 		protected.GET("/profile", handlers.GetUserProfile)
-		protected.GET("/history", handlers.GetUserHistory)
+		protected.GET("/history", historyRead, handlers.GetUserHistory)
+		protected.GET("/history/:id", historyRead, handlers.GetHistoryItem)
+		protected.POST("/history/:id/summaries", historyRead, upload, handlers.CreateHistorySummary)
+		protected.GET("/api-keys", middleware.SessionOnly(), handlers.GetAPIKeys)
+		protected.POST("/api-keys", middleware.SessionOnly(), handlers.CreateAPIKey)
+		protected.DELETE("/api-keys/:id", middleware.SessionOnly(), handlers.RevokeAPIKey)
 	}
 
 	// Маршруты API для администратора
 	adminAPI := router.Group("/api/admin")
-	adminAPI.Use(middleware.AuthMiddleware(), handlers.AdminMiddleware)
+	adminAPI.Use(middleware.AuthMiddleware(), middleware.SessionOnly(), handlers.AdminMiddleware)
 	{
-		adminAPI.GET("/users", handlers.GetAllUsers)
-		adminAPI.GET("/users/:id/usage", handlers.GetUserUsage)
-		adminAPI.PUT("/users/limit", handlers.UpdateUserUsageLimit)
+		adminAPI.GET("/users", middleware.RequirePermission(models.PermissionUsersRead), handlers.GetAllUsers)
+		adminAPI.GET("/users/:id/usage", middleware.RequirePermission(models.PermissionUsersRead), handlers.GetUserUsage)
+		adminAPI.PUT("/users/limit", middleware.RequirePermission(models.PermissionUsersLimitWrite), handlers.UpdateUserUsageLimit)
+		adminAPI.PUT("/users/plan", middleware.RequirePermission(models.PermissionUsersPlanWrite), handlers.UpdateUserPlan)
+		adminAPI.PUT("/users/:id/roles", middleware.RequirePermission(models.PermissionUsersRolesWrite), handlers.UpdateUserRoles)
+		adminAPI.GET("/users/:id/credits", middleware.RequirePermission(models.PermissionUsersCreditsRead), handlers.GetUserCredits)
+		adminAPI.POST("/users/:id/credits/grant", middleware.RequirePermission(models.PermissionUsersCreditsWrite), handlers.GrantUserCredits)
+		adminAPI.POST("/users/:id/credits/deduct", middleware.RequirePermission(models.PermissionUsersCreditsWrite), handlers.DeductUserCredits)
+		adminAPI.DELETE("/users/:id/sessions", middleware.RequirePermission(models.PermissionUsersSessionsRevoke), handlers.RevokeUserSessions)
+		adminAPI.GET("/admins", middleware.RequirePermission(models.PermissionAdminsRead), handlers.GetAdmins)
+		adminAPI.POST("/admins", middleware.RequirePermission(models.PermissionAdminsWrite), handlers.CreateAdmin)
+		adminAPI.PUT("/admins/:id/password", middleware.RequirePermission(models.PermissionAdminsWrite), handlers.ResetAdminPassword)
+		adminAPI.POST("/admins/:id/disable", middleware.RequirePermission(models.PermissionAdminsWrite), handlers.DisableAdmin)
+		adminAPI.DELETE("/admins/:id/totp", middleware.RequirePermission(models.PermissionAdminsWrite), handlers.ResetAdminTOTP)
+		adminAPI.GET("/roles", middleware.RequirePermission(models.PermissionRolesRead), handlers.GetRoles)
+		adminAPI.GET("/plans", middleware.RequirePermission(models.PermissionPlansRead), handlers.GetPlans)
+		adminAPI.GET("/transcription-cache", middleware.RequirePermission(models.PermissionCacheRead), handlers.GetTranscriptionCache)
+		adminAPI.PUT("/transcription-cache", middleware.RequirePermission(models.PermissionCacheWrite), handlers.UpdateTranscriptionCache)
+		adminAPI.DELETE("/transcription-cache", middleware.RequirePermission(models.PermissionCacheWrite), handlers.PurgeTranscriptionCache)
+		adminAPI.GET("/media-formats", middleware.RequirePermission(models.PermissionMediaRead), handlers.GetMediaFormats)
+		adminAPI.PUT("/media-formats", middleware.RequirePermission(models.PermissionMediaWrite), handlers.UpdateMediaFormats)
 	}
 
 	// Проверка OPENAI_API_KEY
-	if os.Getenv("OPENAI_API_KEY") == "" {
+	if os.Getenv("OPENAI_API_KEY") == "" && utils.GetEnv("AI_PROVIDER", services.ProviderOpenAI) == services.ProviderOpenAI {
 		log.Println("ВНИМАНИЕ: OPENAI_API_KEY не найден. Установите переменную окружения для работы с OpenAI API.")
 	}
 
@@ -110,4 +196,4 @@ func main() {
 	if err := router.Run(":" + port); err != nil {
 		log.Fatalf("Ошибка запуска сервера: %v", err)
 	}
-}
\ No newline at end of file
+}
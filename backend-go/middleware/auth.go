@@ -24,38 +24,61 @@ func AuthMiddleware() gin.HandlerFunc {
 			return
 		}
 
-		// Получаем токен из заголовка Authorization
-		authHeader := c.GetHeader("Authorization")
-		if authHeader == "" {
-			c.AbortWithStatusJSON(http.StatusUnauthorized, models.ErrorResponse{
-				Error: "Authorization header is required",
-			})
-			return
-		}
+		// Персональный API-ключ передается в X-API-Key или вместо токена в Authorization
+		apiKey := c.GetHeader("X-API-Key")
 
-		// Проверяем формат (Bearer token)
-		parts := strings.Split(authHeader, " ")
-		if len(parts) != 2 || parts[0] != "Bearer" {
-			c.AbortWithStatusJSON(http.StatusUnauthorized, models.ErrorResponse{
-				Error: "Authorization header format must be Bearer {token}",
-			})
-			return
-		}
+		var (
+			userID int64
+			key    *models.APIKey
+		)
+		if apiKey == "" {
+			// Получаем токен из заголовка Authorization
+			authHeader := c.GetHeader("Authorization")
+			if authHeader == "" {
+				c.AbortWithStatusJSON(http.StatusUnauthorized, models.ErrorResponse{
+					Error: "Authorization header is required",
+				})
+				return
+			}
 
-		tokenString := parts[1]
+			// Проверяем формат (Bearer token)
+			parts := strings.Split(authHeader, " ")
+			if len(parts) != 2 || parts[0] != "Bearer" {
+				c.AbortWithStatusJSON(http.StatusUnauthorized, models.ErrorResponse{
+					Error: "Authorization header format must be Bearer {token}",
+				})
+				return
+			}
 
-		// Валидируем JWT токен
-		claims, err := utils.ValidateJWT(tokenString)
-		if err != nil {
-			c.AbortWithStatusJSON(http.StatusUnauthorized, models.ErrorResponse{
-				Error: "Invalid or expired token",
-			})
-			return
+			if strings.HasPrefix(parts[1], utils.APIKeyPrefix) {
+				apiKey = parts[1]
+			} else {
+				claims, ok := authenticateJWT(c, parts[1])
+				if !ok {
+					return
+				}
+				userID = claims.UserID
+				c.Set("sessionID", claims.SessionID)
+			}
+		}
+
+		if apiKey != "" {
+			// Ищем действующий ключ по хэшу
+			apiKeyRepo := repositories.APIKeyRepository{}
+			found, err := apiKeyRepo.FindActiveByHash(utils.HashToken(apiKey))
+			if err != nil {
+				c.AbortWithStatusJSON(http.StatusUnauthorized, models.ErrorResponse{
+					Error: "Invalid, expired or revoked API key",
+				})
+				return
+			}
+			key = found
+			userID = key.UserID
 		}
 
 		// Проверяем существование пользователя
 		userRepo := repositories.UserRepository{}
-		user, err := userRepo.FindByID(claims.UserID)
+		user, err := userRepo.FindByID(userID)
 		if err != nil {
 			c.AbortWithStatusJSON(http.StatusUnauthorized, models.ErrorResponse{
 				Error: "User not found",
@@ -73,7 +96,74 @@ func AuthMiddleware() gin.HandlerFunc {
 
 		// Сохраняем ID пользователя в контексте для дальнейшего использования
 		c.Set("userID", user.ID)
+		if key != nil {
+			c.Set("apiKey", key)
+		}
+
+		c.Next()
+	}
+}
+
+// authenticateJWT проверяет токен доступа и сессию, которая его выдала.
+// При ошибке прерывает запрос сам.
+func authenticateJWT(c *gin.Context, tokenString string) (*utils.Claims, bool) {
+	// Валидируем JWT токен
+	claims, err := utils.ValidateJWT(tokenString)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusUnauthorized, models.ErrorResponse{
+			Error: "Invalid or expired token",
+		})
+		return nil, false
+	}
+
+	// Проверяем, что сессия, выдавшая токен, не отозвана
+	sessionRepo := repositories.SessionRepository{}
+	active, err := sessionRepo.IsActive(claims.SessionID)
+	if err != nil || !active {
+		c.AbortWithStatusJSON(http.StatusUnauthorized, models.ErrorResponse{
+			Error: "Session is revoked or expired",
+		})
+		return nil, false
+	}
+
+	return claims, true
+}
+
+// RequireScope пропускает запросы с API-ключом, только если ключу разрешены все
+// области действия scopes. Запросы с токеном сессии проходят без ограничений.
+func RequireScope(scopes ...string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		value, exists := c.Get("apiKey")
+		if !exists {
+			c.Next()
+			return
+		}
+
+		key := value.(*models.APIKey)
+		for _, scope := range scopes {
+			if !key.HasScope(scope) {
+				c.AbortWithStatusJSON(http.StatusForbidden, models.ErrorResponse{
+					Error: "API key lacks scope: " + scope,
+				})
+				return
+			}
+		}
+
+		c.Next()
+	}
+}
+
+// SessionOnly запрещает доступ по API-ключу: управление ключами, сессиями
+// и администрирование доступны только после входа
+func SessionOnly() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if _, exists := c.Get("apiKey"); exists {
+			c.AbortWithStatusJSON(http.StatusForbidden, models.ErrorResponse{
+				Error: "This endpoint is not available with an API key",
+			})
+			return
+		}
 
 		c.Next()
 	}
-}
\ No newline at end of file
+}
@@ -0,0 +1,51 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/trofimovm/summvideo/models"
+	"github.com/trofimovm/summvideo/repositories"
+)
+
+// RequirePermission пропускает запрос, только если роли пользователя дают право
+// permission. Права берутся из контекста (их сохраняет AdminMiddleware)
+// или загружаются из БД.
+func RequirePermission(permission string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, exists := c.Get("userID")
+		if !exists {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, models.ErrorResponse{
+				Error: "Требуется авторизация",
+			})
+			return
+		}
+
+		var permissions []string
+		if value, ok := c.Get("permissions"); ok {
+			permissions = value.([]string)
+		} else {
+			roleRepo := repositories.RoleRepository{}
+			loaded, err := roleRepo.FindPermissionsByUserID(userID.(int64))
+			if err != nil {
+				c.AbortWithStatusJSON(http.StatusInternalServerError, models.ErrorResponse{
+					Error: "Ошибка получения прав пользователя: " + err.Error(),
+				})
+				return
+			}
+			permissions = loaded
+			c.Set("permissions", permissions)
+		}
+
+		for _, granted := range permissions {
+			if granted == permission {
+				c.Next()
+				return
+			}
+		}
+
+		c.AbortWithStatusJSON(http.StatusForbidden, models.ErrorResponse{
+			Error: "Недостаточно прав: требуется " + permission,
+		})
+	}
+}
@@ -0,0 +1,21 @@
+CREATE TABLE jobs (
+    id SERIAL PRIMARY KEY,
+    user_id INTEGER REFERENCES users(id),
+    status VARCHAR(32) NOT NULL DEFAULT 'queued', -- queued, extracting, transcribing, summarizing, done, failed
+    video_name TEXT,
+    file_path TEXT,
+    prompt_text TEXT,
+    transcription TEXT,
+    summary TEXT,
+    error TEXT,
+    processing_time INTEGER DEFAULT 0,
+    attempts INTEGER DEFAULT 0,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    started_at TIMESTAMP,
+    finished_at TIMESTAMP
+);
+
+-- Индекс для быстрого выбора задач из очереди
+CREATE INDEX idx_jobs_status_created_at ON jobs (status, created_at);
+CREATE INDEX idx_jobs_user_id ON jobs (user_id);
@@ -0,0 +1,2 @@
+ALTER TABLE jobs
+DROP COLUMN segments;
\ No newline at end of file
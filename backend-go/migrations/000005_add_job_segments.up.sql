@@ -0,0 +1,3 @@
+-- Сегменты транскрипции с временными метками: [{"start": 0.0, "end": 4.2, "text": "..."}]
+ALTER TABLE jobs
+ADD COLUMN segments JSONB;
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS results;
\ No newline at end of file
@@ -0,0 +1,16 @@
+CREATE TABLE results (
+    id SERIAL PRIMARY KEY,
+    usage_id INTEGER UNIQUE NOT NULL REFERENCES usage_history(id) ON DELETE CASCADE,
+    job_id INTEGER REFERENCES jobs(id) ON DELETE SET NULL,
+    transcription TEXT,
+    segments JSONB,
+    summary TEXT,
+    transcription_model VARCHAR(255),
+    summary_model VARCHAR(255),
+    language VARCHAR(32),
+    media_duration REAL DEFAULT 0, -- длительность записи в секундах
+    transcription_time INTEGER DEFAULT 0,
+    summary_time INTEGER DEFAULT 0,
+    processing_time INTEGER DEFAULT 0,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
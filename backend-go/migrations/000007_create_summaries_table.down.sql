@@ -0,0 +1 @@
+DROP TABLE IF EXISTS summaries;
\ No newline at end of file
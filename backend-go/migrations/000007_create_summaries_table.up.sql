@@ -0,0 +1,12 @@
+-- Дополнительные саммари, построенные по сохраненной транскрипции с другим промтом
+CREATE TABLE summaries (
+    id SERIAL PRIMARY KEY,
+    result_id INTEGER NOT NULL REFERENCES results(id) ON DELETE CASCADE,
+    prompt_text TEXT,
+    summary TEXT,
+    summary_model VARCHAR(255),
+    processing_time INTEGER DEFAULT 0,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_summaries_result_id ON summaries (result_id);
@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS settings;
+DROP TABLE IF EXISTS transcription_cache;
+
+ALTER TABLE jobs
+DROP COLUMN content_hash;
\ No newline at end of file
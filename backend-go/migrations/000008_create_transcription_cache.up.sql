@@ -0,0 +1,25 @@
+-- Хеш содержимого загруженного файла (SHA-256)
+ALTER TABLE jobs
+ADD COLUMN content_hash VARCHAR(64);
+
+-- Кэш транскрипций: одинаковые файлы повторно не транскрибируются
+CREATE TABLE transcription_cache (
+    id SERIAL PRIMARY KEY,
+    content_hash VARCHAR(64) NOT NULL,
+    transcription_model VARCHAR(255) NOT NULL,
+    language VARCHAR(32) NOT NULL DEFAULT '',
+    transcription TEXT,
+    segments JSONB,
+    detected_language VARCHAR(32),
+    hits INTEGER DEFAULT 0,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    last_used_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (content_hash, transcription_model, language)
+);
+
+-- Настройки сервиса, изменяемые администратором
+CREATE TABLE settings (
+    key VARCHAR(100) PRIMARY KEY,
+    value TEXT NOT NULL,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
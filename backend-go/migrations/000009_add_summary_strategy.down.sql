@@ -0,0 +1,8 @@
+ALTER TABLE summaries
+DROP COLUMN summary_strategy;
+
+ALTER TABLE results
+DROP COLUMN summary_strategy;
+
+ALTER TABLE jobs
+DROP COLUMN summary_strategy;
\ No newline at end of file
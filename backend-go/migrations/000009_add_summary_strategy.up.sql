@@ -0,0 +1,9 @@
+-- Стратегия суммаризации: запрошенная для задачи и фактически примененная для результатов
+ALTER TABLE jobs
+ADD COLUMN summary_strategy VARCHAR(32);
+
+ALTER TABLE results
+ADD COLUMN summary_strategy VARCHAR(32);
+
+ALTER TABLE summaries
+ADD COLUMN summary_strategy VARCHAR(32);
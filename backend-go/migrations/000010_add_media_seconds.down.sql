@@ -0,0 +1,5 @@
+ALTER TABLE jobs
+DROP COLUMN media_duration;
+
+ALTER TABLE usage_history
+DROP COLUMN media_seconds;
\ No newline at end of file
@@ -0,0 +1,7 @@
+-- Использование списывается по длительности записи, а не по времени обработки
+ALTER TABLE usage_history
+ADD COLUMN media_seconds INTEGER DEFAULT 0;
+
+-- Длительность записи, определенная при загрузке
+ALTER TABLE jobs
+ADD COLUMN media_duration REAL;
@@ -0,0 +1,4 @@
+ALTER TABLE jobs
+DROP COLUMN reservation_id;
+
+DROP TABLE IF EXISTS usage_reservations;
\ No newline at end of file
@@ -0,0 +1,16 @@
+-- Резервирование лимита использования на время обработки задачи
+CREATE TABLE usage_reservations (
+    id SERIAL PRIMARY KEY,
+    user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    seconds INTEGER NOT NULL,
+    status VARCHAR(16) NOT NULL DEFAULT 'active', -- active, settled, released, expired
+    settled_seconds INTEGER,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    expires_at TIMESTAMP NOT NULL,
+    finished_at TIMESTAMP
+);
+
+CREATE INDEX idx_usage_reservations_user_status ON usage_reservations (user_id, status);
+
+ALTER TABLE jobs
+ADD COLUMN reservation_id INTEGER REFERENCES usage_reservations(id);
@@ -0,0 +1,6 @@
+DROP TRIGGER IF EXISTS usage_ledger_append_only ON usage_ledger;
+DROP FUNCTION IF EXISTS usage_ledger_append_only();
+
+DROP TABLE IF EXISTS usage_ledger;
+DROP TABLE IF EXISTS user_plans;
+DROP TABLE IF EXISTS plans;
\ No newline at end of file
@@ -0,0 +1,64 @@
+-- Тарифные планы с квотой секунд обработки на период
+CREATE TABLE plans (
+    id SERIAL PRIMARY KEY,
+    code VARCHAR(32) NOT NULL UNIQUE,
+    name VARCHAR(255) NOT NULL,
+    quota_seconds INTEGER NOT NULL,
+    reset_period VARCHAR(16) NOT NULL DEFAULT 'monthly', -- monthly, weekly
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+INSERT INTO plans (code, name, quota_seconds, reset_period) VALUES
+    ('free', 'Free', 3600, 'monthly'),     -- 1 час в месяц
+    ('pro', 'Pro', 36000, 'monthly'),      -- 10 часов в месяц
+    ('team', 'Team', 180000, 'monthly');   -- 50 часов в месяц
+
+-- Тарифный план пользователя и текущий период квоты
+CREATE TABLE user_plans (
+    user_id INTEGER PRIMARY KEY REFERENCES users(id),
+    plan_id INTEGER NOT NULL REFERENCES plans(id),
+    period_start TIMESTAMP NOT NULL,
+    period_end TIMESTAMP NOT NULL,
+    assigned_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_user_plans_period_end ON user_plans (period_end);
+
+-- Журнал начислений и списаний секунд, остаток вычисляется по нему
+CREATE TABLE usage_ledger (
+    id SERIAL PRIMARY KEY,
+    user_id INTEGER NOT NULL REFERENCES users(id),
+    direction VARCHAR(8) NOT NULL, -- credit, debit
+    seconds INTEGER NOT NULL CHECK (seconds >= 0),
+    reason VARCHAR(32) NOT NULL,
+    reservation_id INTEGER REFERENCES usage_reservations(id),
+    comment TEXT,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_usage_ledger_user_created_at ON usage_ledger (user_id, created_at);
+
+-- Журнал только дополняется: исправления вносятся новыми записями
+CREATE FUNCTION usage_ledger_append_only() RETURNS trigger AS $$
+BEGIN
+    RAISE EXCEPTION 'usage_ledger допускает только добавление записей';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER usage_ledger_append_only
+BEFORE UPDATE OR DELETE ON usage_ledger
+FOR EACH ROW EXECUTE FUNCTION usage_ledger_append_only();
+
+-- Существующие пользователи получают бесплатный план, а прежние лимит
+-- и использованное время переносятся в журнал, чтобы остаток не изменился
+INSERT INTO user_plans (user_id, plan_id, period_start, period_end)
+SELECT id, (SELECT id FROM plans WHERE code = 'free'), CURRENT_TIMESTAMP, CURRENT_TIMESTAMP + INTERVAL '1 month'
+FROM users;
+
+INSERT INTO usage_ledger (user_id, direction, seconds, reason)
+SELECT id, 'credit', usage_limit_secs, 'opening_balance'
+FROM users WHERE usage_limit_secs > 0;
+
+INSERT INTO usage_ledger (user_id, direction, seconds, reason)
+SELECT id, 'debit', usage_total_secs, 'opening_usage'
+FROM users WHERE usage_total_secs > 0;
@@ -0,0 +1,4 @@
+ALTER TABLE usage_ledger
+DROP COLUMN related_entry_id,
+DROP COLUMN expires_at,
+DROP COLUMN admin_id;
\ No newline at end of file
@@ -0,0 +1,8 @@
+-- Начисления и списания администратором: кто внес запись и до какого момента действует начисление
+ALTER TABLE usage_ledger
+ADD COLUMN admin_id INTEGER REFERENCES users(id),
+ADD COLUMN expires_at TIMESTAMP,
+ADD COLUMN related_entry_id INTEGER REFERENCES usage_ledger(id);
+
+CREATE INDEX idx_usage_ledger_expires_at ON usage_ledger (expires_at) WHERE expires_at IS NOT NULL;
+CREATE INDEX idx_usage_ledger_related_entry_id ON usage_ledger (related_entry_id);
@@ -0,0 +1,6 @@
+ALTER TABLE usage_history
+DROP COLUMN refund_rule,
+DROP COLUMN refunded_seconds,
+DROP COLUMN error_message,
+DROP COLUMN error_class,
+DROP COLUMN status;
\ No newline at end of file
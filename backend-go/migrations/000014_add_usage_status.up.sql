@@ -0,0 +1,8 @@
+-- Неудачные обработки тоже попадают в историю: со статусом, классом ошибки
+-- и временем, возвращенным пользователю по политике возвратов
+ALTER TABLE usage_history
+ADD COLUMN status VARCHAR(16) NOT NULL DEFAULT 'completed', -- completed, failed, cancelled
+ADD COLUMN error_class VARCHAR(32),
+ADD COLUMN error_message TEXT,
+ADD COLUMN refunded_seconds INTEGER NOT NULL DEFAULT 0,
+ADD COLUMN refund_rule VARCHAR(64);
@@ -0,0 +1,24 @@
+-- Сессии пользователей: обновляющий токен хранится только в виде SHA-256
+CREATE TABLE sessions (
+    id SERIAL PRIMARY KEY,
+    user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    refresh_token_hash VARCHAR(64) UNIQUE NOT NULL,
+    user_agent TEXT,
+    ip_address VARCHAR(64),
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    last_used_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    expires_at TIMESTAMP NOT NULL,
+    revoked_at TIMESTAMP
+);
+
+CREATE INDEX idx_sessions_user_id ON sessions (user_id);
+
+-- Хэши всех замененных обновляющих токенов сессии, чтобы распознать повторное
+-- использование любого из них после ротации
+CREATE TABLE session_rotated_tokens (
+    token_hash VARCHAR(64) PRIMARY KEY,
+    session_id INTEGER NOT NULL REFERENCES sessions(id) ON DELETE CASCADE,
+    rotated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_session_rotated_tokens_session_id ON session_rotated_tokens (session_id);
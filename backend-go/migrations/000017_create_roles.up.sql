@@ -0,0 +1,61 @@
+-- Роли и права администраторов вместо единственного флага is_admin
+CREATE TABLE roles (
+    id SERIAL PRIMARY KEY,
+    code VARCHAR(32) UNIQUE NOT NULL,
+    name VARCHAR(100) NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE permissions (
+    code VARCHAR(64) PRIMARY KEY,
+    description TEXT NOT NULL
+);
+
+CREATE TABLE role_permissions (
+    role_id INTEGER NOT NULL REFERENCES roles(id) ON DELETE CASCADE,
+    permission_code VARCHAR(64) NOT NULL REFERENCES permissions(code) ON DELETE CASCADE,
+    PRIMARY KEY (role_id, permission_code)
+);
+
+CREATE TABLE user_roles (
+    user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    role_id INTEGER NOT NULL REFERENCES roles(id) ON DELETE CASCADE,
+    assigned_by INTEGER REFERENCES users(id) ON DELETE SET NULL,
+    assigned_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (user_id, role_id)
+);
+
+INSERT INTO permissions (code, description) VALUES
+    ('users.read', 'Просмотр пользователей и их использования'),
+    ('users.limit.write', 'Изменение лимита использования'),
+    ('users.plan.write', 'Назначение тарифного плана'),
+    ('users.credits.read', 'Просмотр журнала начислений'),
+    ('users.credits.write', 'Начисление и списание секунд'),
+    ('users.sessions.revoke', 'Отзыв сессий пользователя'),
+    ('users.roles.write', 'Назначение ролей'),
+    ('roles.read', 'Просмотр ролей и прав'),
+    ('plans.read', 'Просмотр тарифных планов'),
+    ('cache.read', 'Просмотр кэша транскрипций'),
+    ('cache.write', 'Настройка и очистка кэша транскрипций');
+
+INSERT INTO roles (code, name) VALUES
+    ('viewer', 'Наблюдатель'),
+    ('support', 'Поддержка'),
+    ('billing', 'Биллинг'),
+    ('superadmin', 'Суперадминистратор');
+
+INSERT INTO role_permissions (role_id, permission_code)
+SELECT r.id, p.code
+FROM roles r
+JOIN permissions p ON
+    (r.code = 'viewer' AND p.code IN ('users.read', 'users.credits.read', 'plans.read', 'cache.read'))
+    OR (r.code = 'support' AND p.code IN ('users.read', 'users.credits.read', 'plans.read', 'cache.read',
+        'users.credits.write', 'users.sessions.revoke'))
+    OR (r.code = 'billing' AND p.code IN ('users.read', 'users.credits.read', 'plans.read', 'cache.read',
+        'users.credits.write', 'users.limit.write', 'users.plan.write'))
+    OR r.code = 'superadmin';
+
+-- Существующие администраторы получают все права
+INSERT INTO user_roles (user_id, role_id)
+SELECT u.id, r.id FROM users u, roles r
+WHERE u.is_admin = TRUE AND r.code = 'superadmin';
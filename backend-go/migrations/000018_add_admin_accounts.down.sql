@@ -0,0 +1,8 @@
+DELETE FROM permissions WHERE code IN ('admins.read', 'admins.write');
+
+UPDATE users SET telegram_id = -id WHERE telegram_id IS NULL;
+ALTER TABLE users ALTER COLUMN telegram_id SET NOT NULL;
+
+ALTER TABLE users
+DROP COLUMN IF EXISTS password_changed_at,
+DROP COLUMN IF EXISTS must_change_password;
\ No newline at end of file
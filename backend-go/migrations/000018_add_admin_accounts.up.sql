@@ -0,0 +1,20 @@
+-- Администраторы с паролем: обязательная смена пароля и вход без Telegram
+ALTER TABLE users
+ADD COLUMN must_change_password BOOLEAN NOT NULL DEFAULT FALSE,
+ADD COLUMN password_changed_at TIMESTAMP;
+
+ALTER TABLE users ALTER COLUMN telegram_id DROP NOT NULL;
+
+-- Пароль администратора из миграции 000003 общеизвестен,
+-- поэтому при следующем входе его нужно сменить
+UPDATE users SET must_change_password = TRUE
+WHERE telegram_id = 0
+  AND password_hash = '$2a$10$HcuzvB7hFXB.IUvNmXZQyeyyPpBbhFh3/rC5F6eZeEKqm.g9kUjdK';
+
+INSERT INTO permissions (code, description) VALUES
+    ('admins.read', 'Просмотр администраторов'),
+    ('admins.write', 'Создание и отключение администраторов, сброс паролей');
+
+INSERT INTO role_permissions (role_id, permission_code)
+SELECT r.id, p.code FROM roles r, permissions p
+WHERE r.code = 'superadmin' AND p.code IN ('admins.read', 'admins.write');
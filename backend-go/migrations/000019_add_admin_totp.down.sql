@@ -0,0 +1,9 @@
+DROP TABLE IF EXISTS admin_login_challenges;
+DROP TABLE IF EXISTS admin_recovery_codes;
+
+ALTER TABLE users
+DROP COLUMN IF EXISTS totp_locked_until,
+DROP COLUMN IF EXISTS totp_failed_attempts,
+DROP COLUMN IF EXISTS totp_last_step,
+DROP COLUMN IF EXISTS totp_enabled,
+DROP COLUMN IF EXISTS totp_secret;
\ No newline at end of file
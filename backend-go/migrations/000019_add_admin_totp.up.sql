@@ -0,0 +1,32 @@
+-- Двухфакторная аутентификация администраторов по TOTP.
+-- totp_last_step хранит интервал последнего принятого кода, чтобы код нельзя было повторить.
+-- totp_failed_attempts считает неверные коды подряд по всем входам пользователя,
+-- после превышения лимита вход со вторым фактором блокируется до totp_locked_until
+ALTER TABLE users
+ADD COLUMN totp_secret VARCHAR(64),
+ADD COLUMN totp_enabled BOOLEAN NOT NULL DEFAULT FALSE,
+ADD COLUMN totp_last_step BIGINT,
+ADD COLUMN totp_failed_attempts INTEGER NOT NULL DEFAULT 0,
+ADD COLUMN totp_locked_until TIMESTAMP;
+
+-- Одноразовые коды восстановления, хранятся только в виде SHA-256
+CREATE TABLE admin_recovery_codes (
+    id SERIAL PRIMARY KEY,
+    user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    code_hash VARCHAR(64) NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    used_at TIMESTAMP
+);
+
+CREATE INDEX idx_admin_recovery_codes_user_id ON admin_recovery_codes (user_id);
+
+-- Незавершенные входы: пароль проверен, ожидается код второго фактора
+CREATE TABLE admin_login_challenges (
+    id SERIAL PRIMARY KEY,
+    user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    token_hash VARCHAR(64) UNIQUE NOT NULL,
+    attempts INTEGER NOT NULL DEFAULT 0,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    expires_at TIMESTAMP NOT NULL,
+    completed_at TIMESTAMP
+);
@@ -0,0 +1,3 @@
+DELETE FROM settings WHERE key = 'allowed_containers';
+
+DELETE FROM permissions WHERE code IN ('media.read', 'media.write');
\ No newline at end of file
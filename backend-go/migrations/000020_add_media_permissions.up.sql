@@ -0,0 +1,9 @@
+-- Настройка контейнеров, которые разрешено загружать
+INSERT INTO permissions (code, description) VALUES
+    ('media.read', 'Просмотр допустимых форматов загрузки'),
+    ('media.write', 'Изменение допустимых форматов загрузки');
+
+INSERT INTO role_permissions (role_id, permission_code)
+SELECT r.id, p.code FROM roles r, permissions p
+WHERE (r.code IN ('viewer', 'support', 'billing') AND p.code = 'media.read')
+   OR (r.code = 'superadmin' AND p.code IN ('media.read', 'media.write'));
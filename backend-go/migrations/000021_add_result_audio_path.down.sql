@@ -0,0 +1,2 @@
+ALTER TABLE results
+DROP COLUMN IF EXISTS audio_path;
\ No newline at end of file
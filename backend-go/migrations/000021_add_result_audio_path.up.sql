@@ -0,0 +1,4 @@
+-- Способ подготовки аудио: без перекодирования, перекодирование аудиофайла,
+-- извлечение звука из видео или транскрипция из кэша
+ALTER TABLE results
+ADD COLUMN audio_path VARCHAR(32);
@@ -0,0 +1,20 @@
+-- Возобновляемые загрузки: файл принимается частями, задача создается
+-- после получения последней части и проверки контрольной суммы
+CREATE TABLE uploads (
+    id SERIAL PRIMARY KEY,
+    user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    file_name VARCHAR(255) NOT NULL,
+    file_path TEXT NOT NULL,
+    size BIGINT NOT NULL,
+    received BIGINT NOT NULL DEFAULT 0,
+    checksum VARCHAR(64) NOT NULL,
+    prompt_text TEXT NOT NULL,
+    summary_strategy VARCHAR(32),
+    job_id INTEGER REFERENCES jobs(id) ON DELETE SET NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    expires_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX idx_uploads_user_id ON uploads (user_id);
+CREATE INDEX idx_uploads_expires_at ON uploads (expires_at);
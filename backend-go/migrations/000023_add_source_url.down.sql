@@ -0,0 +1,5 @@
+ALTER TABLE usage_history
+DROP COLUMN IF EXISTS source_url;
+
+ALTER TABLE jobs
+DROP COLUMN IF EXISTS source_url;
\ No newline at end of file
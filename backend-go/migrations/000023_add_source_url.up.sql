@@ -0,0 +1,6 @@
+-- Адрес, с которого сервер загрузил запись вместо загрузки файла пользователем
+ALTER TABLE jobs
+ADD COLUMN source_url TEXT;
+
+ALTER TABLE usage_history
+ADD COLUMN source_url TEXT;
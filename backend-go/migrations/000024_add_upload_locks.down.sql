@@ -0,0 +1,3 @@
+ALTER TABLE uploads
+DROP COLUMN IF EXISTS locked_until,
+DROP COLUMN IF EXISTS lock_token;
\ No newline at end of file
@@ -0,0 +1,5 @@
+-- Блокировка загрузки на время записи части: запросы к разным экземплярам
+-- сервиса не должны писать в один файл одновременно
+ALTER TABLE uploads
+ADD COLUMN lock_token VARCHAR(64),
+ADD COLUMN locked_until TIMESTAMP;
@@ -0,0 +1,2 @@
+ALTER TABLE jobs
+DROP COLUMN IF EXISTS cancel_requested_at;
\ No newline at end of file
@@ -0,0 +1,3 @@
+-- Запрос отмены задачи, которую выполняет обработчик, возможно, на другом экземпляре сервера
+ALTER TABLE jobs
+ADD COLUMN cancel_requested_at TIMESTAMP;
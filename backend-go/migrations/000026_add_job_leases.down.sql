@@ -0,0 +1,2 @@
+ALTER TABLE jobs
+DROP COLUMN IF EXISTS lease_expires_at;
\ No newline at end of file
@@ -0,0 +1,5 @@
+-- Аренда выполняемой задачи: обработчик продлевает её, пока обрабатывает задачу.
+-- Задачи с истекшей арендой остались без обработчика (экземпляр сервера остановлен)
+-- и возвращаются в очередь любым экземпляром
+ALTER TABLE jobs
+ADD COLUMN lease_expires_at TIMESTAMP;
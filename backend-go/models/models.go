@@ -11,6 +11,7 @@ type VideoResponse struct {
 // ErrorResponse представляет ответ API при ошибке
 type ErrorResponse struct {
 	Error string `json:"error"`
+	Code  string `json:"code,omitempty"` // машинно-читаемая причина, если клиенту нужно её различать
 }
 
 // Config содержит основные настройки приложения
@@ -22,22 +23,23 @@ type Config struct {
 
 // User представляет пользователя Telegram
 type User struct {
-	ID              int64     `json:"id"`
-	TelegramID      int64     `json:"telegram_id"`
-	Username        string    `json:"username"`
-	FirstName       string    `json:"first_name"`
-	LastName        string    `json:"last_name"`
-	PhotoURL        string    `json:"photo_url"`
-	AuthDate        int64     `json:"auth_date"`
-	Hash            string    `json:"hash"`
-	CreatedAt       time.Time `json:"created_at"`
-	UpdatedAt       time.Time `json:"updated_at"`
-	LastLogin       time.Time `json:"last_login"`
-	IsActive        bool      `json:"is_active"`
-	IsAdmin         bool      `json:"is_admin"`         // Флаг админа
-	UsageLimitSecs  int       `json:"usage_limit_secs"` // Лимит использования в секундах
-	UsageTotalSecs  int       `json:"usage_total_secs"` // Общее использованное время в секундах
-	PasswordHash    string    `json:"-"`                // Хеш пароля (для админов)
+	ID                 int64     `json:"id"`
+	TelegramID         int64     `json:"telegram_id"`
+	Username           string    `json:"username"`
+	FirstName          string    `json:"first_name"`
+	LastName           string    `json:"last_name"`
+	PhotoURL           string    `json:"photo_url"`
+	AuthDate           int64     `json:"auth_date"`
+	Hash               string    `json:"hash"`
+	CreatedAt          time.Time `json:"created_at"`
+	UpdatedAt          time.Time `json:"updated_at"`
+	LastLogin          time.Time `json:"last_login"`
+	IsActive           bool      `json:"is_active"`
+	IsAdmin            bool      `json:"is_admin"`             // Флаг админа
+	UsageLimitSecs     int       `json:"usage_limit_secs"`     // Лимит использования в секундах
+	UsageTotalSecs     int       `json:"usage_total_secs"`     // Общее использованное время в секундах
+	PasswordHash       string    `json:"-"`                    // Хеш пароля (для админов)
+	MustChangePassword bool      `json:"must_change_password"` // Админ должен сменить пароль перед работой
 }
 
 // AdminCredentials представляет данные для входа администратора
@@ -63,19 +65,514 @@ type TelegramAuthData struct {
 	Hash      string `json:"hash"`
 }
 
-// AuthResponse представляет ответ на успешную авторизацию
+// Области действия API-ключей
+const (
+	APIKeyScopeUpload      = "upload"       // загрузка записей и отслеживание своих задач
+	APIKeyScopeHistoryRead = "history:read" // чтение истории и сохраненных результатов
+)
+
+// APIKeyScopes перечисляет допустимые области действия API-ключей
+var APIKeyScopes = []string{APIKeyScopeUpload, APIKeyScopeHistoryRead}
+
+// APIKey представляет персональный API-ключ пользователя. Сам ключ не хранится
+// и показывается только при создании.
+type APIKey struct {
+	ID         int64      `json:"id"`
+	UserID     int64      `json:"user_id"`
+	Name       string     `json:"name"`
+	Prefix     string     `json:"prefix"` // начало ключа, по которому его можно узнать
+	Scopes     []string   `json:"scopes"`
+	CreatedAt  time.Time  `json:"created_at"`
+	ExpiresAt  *time.Time `json:"expires_at,omitempty"`
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+	RevokedAt  *time.Time `json:"revoked_at,omitempty"`
+}
+
+// HasScope сообщает, разрешена ли ключу область действия scope
+func (k *APIKey) HasScope(scope string) bool {
+	for _, s := range k.Scopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
+
+// APIKeyRequest представляет запрос на создание API-ключа
+type APIKeyRequest struct {
+	Name      string     `json:"name" binding:"required,max=100"`
+	Scopes    []string   `json:"scopes" binding:"required,min=1"`
+	ExpiresAt *time.Time `json:"expires_at"`
+}
+
+// CreatedAPIKey представляет только что созданный API-ключ вместе с самим ключом
+type CreatedAPIKey struct {
+	APIKey
+	Key string `json:"key"`
+}
+
+// TelegramWebAppAuthRequest представляет запрос авторизации из Telegram Mini App
+type TelegramWebAppAuthRequest struct {
+	InitData string `json:"init_data" binding:"required"` // строка Telegram.WebApp.initData без изменений
+}
+
+// AuthResponse представляет ответ на успешную авторизацию. Token — короткоживущий
+// токен доступа, RefreshToken обменивается на новую пару токенов через /auth/refresh.
 type AuthResponse struct {
-	Token string `json:"token"`
-	User  User   `json:"user"`
+	Token            string    `json:"token"`
+	ExpiresAt        time.Time `json:"expires_at"`
+	RefreshToken     string    `json:"refresh_token"`
+	RefreshExpiresAt time.Time `json:"refresh_expires_at"`
+	User             User      `json:"user"`
+}
+
+// RefreshRequest представляет запрос на обновление токенов или выход
+type RefreshRequest struct {
+	RefreshToken string `json:"refresh_token" binding:"required"`
+}
+
+// Session представляет сессию пользователя, к которой привязан обновляющий токен
+type Session struct {
+	ID         int64      `json:"id"`
+	UserID     int64      `json:"user_id"`
+	UserAgent  string     `json:"user_agent"`
+	IPAddress  string     `json:"ip_address"`
+	CreatedAt  time.Time  `json:"created_at"`
+	LastUsedAt time.Time  `json:"last_used_at"`
+	ExpiresAt  time.Time  `json:"expires_at"`
+	RevokedAt  *time.Time `json:"revoked_at,omitempty"`
+	Current    bool       `json:"current"` // сессия, из которой сделан запрос
 }
 
 // UsageHistory представляет запись об использовании сервиса
 type UsageHistory struct {
-	ID             int64     `json:"id"`
-	UserID         int64     `json:"user_id"`
-	VideoName      string    `json:"video_name"`
-	PromptText     string    `json:"prompt_text"`
-	SummaryLength  int       `json:"summary_length"`
-	ProcessingTime int       `json:"processing_time"` // в секундах
-	CreatedAt      time.Time `json:"created_at"`
-}
\ No newline at end of file
+	ID              int64     `json:"id"`
+	UserID          int64     `json:"user_id"`
+	VideoName       string    `json:"video_name"`
+	PromptText      string    `json:"prompt_text"`
+	SummaryLength   int       `json:"summary_length"`
+	MediaSeconds    int       `json:"media_seconds"`   // списанная длительность записи
+	ProcessingTime  int       `json:"processing_time"` // в секундах
+	Status          string    `json:"status"`
+	ErrorClass      string    `json:"error_class,omitempty"`
+	ErrorMessage    string    `json:"error_message,omitempty"`
+	RefundedSeconds int       `json:"refunded_seconds"`      // время, возвращенное после неудачной обработки
+	RefundRule      string    `json:"refund_rule,omitempty"` // правило политики возвратов, по которому принято решение
+	SourceURL       string    `json:"source_url,omitempty"`  // адрес, с которого загружена запись
+	CreatedAt       time.Time `json:"created_at"`
+}
+
+// Статусы записей истории использования
+const (
+	UsageStatusCompleted = "completed"
+	UsageStatusFailed    = "failed"
+	UsageStatusCancelled = "cancelled"
+)
+
+// TranscriptSegment представляет фрагмент транскрипции с временными метками
+type TranscriptSegment struct {
+	Start float64 `json:"start"` // начало фрагмента, секунды от начала записи
+	End   float64 `json:"end"`   // конец фрагмента, секунды от начала записи
+	Text  string  `json:"text"`
+}
+
+// Transcription представляет результат распознавания речи
+type Transcription struct {
+	Text     string              `json:"text"`
+	Language string              `json:"language,omitempty"`
+	Segments []TranscriptSegment `json:"segments,omitempty"`
+}
+
+// Статусы задач обработки видео
+const (
+	JobStatusQueued       = "queued"
+	JobStatusFetching     = "fetching"
+	JobStatusExtracting   = "extracting"
+	JobStatusTranscribing = "transcribing"
+	JobStatusSummarizing  = "summarizing"
+	JobStatusDone         = "done"
+	JobStatusFailed       = "failed"
+)
+
+// Job представляет задачу обработки видео в очереди
+type Job struct {
+	ID              int64               `json:"id"`
+	UserID          int64               `json:"user_id"`
+	Status          string              `json:"status"`
+	VideoName       string              `json:"video_name"`
+	FilePath        string              `json:"-"`
+	PromptText      string              `json:"prompt_text"`
+	SummaryStrategy string              `json:"summary_strategy"`       // запрошенная стратегия суммаризации
+	ContentHash     string              `json:"content_hash,omitempty"` // SHA-256 загруженного файла
+	MediaDuration   float64             `json:"media_duration"`         // длительность записи в секундах
+	ReservationID   *int64              `json:"-"`                      // резерв лимита пользователя на время обработки
+	SourceURL       string              `json:"source_url,omitempty"`   // адрес, с которого загружена запись
+	Transcription   string              `json:"transcription,omitempty"`
+	Segments        []TranscriptSegment `json:"segments,omitempty"`
+	Summary         string              `json:"summary,omitempty"`
+	Error           string              `json:"error,omitempty"`
+	ProcessingTime  int                 `json:"processing_time"` // в секундах
+	Attempts        int                 `json:"attempts"`
+	CreatedAt       time.Time           `json:"created_at"`
+	UpdatedAt       time.Time           `json:"updated_at"`
+	StartedAt       *time.Time          `json:"started_at,omitempty"`
+	FinishedAt      *time.Time          `json:"finished_at,omitempty"`
+}
+
+// IsFinished сообщает, завершена ли задача (успешно или с ошибкой)
+func (j *Job) IsFinished() bool {
+	return j.Status == JobStatusDone || j.Status == JobStatusFailed
+}
+
+// Upload представляет возобновляемую загрузку файла частями. Задача обработки
+// создается, когда получены все байты и совпала контрольная сумма.
+type Upload struct {
+	ID              int64     `json:"id"`
+	UserID          int64     `json:"user_id"`
+	FileName        string    `json:"file_name"`
+	FilePath        string    `json:"-"`
+	Size            int64     `json:"size"`
+	Offset          int64     `json:"offset"`   // сколько байт уже получено
+	Checksum        string    `json:"checksum"` // SHA-256 всего файла в hex
+	PromptText      string    `json:"prompt_text"`
+	SummaryStrategy string    `json:"summary_strategy"`
+	JobID           *int64    `json:"job_id,omitempty"` // задача, созданная после завершения загрузки
+	CreatedAt       time.Time `json:"created_at"`
+	UpdatedAt       time.Time `json:"updated_at"`
+	ExpiresAt       time.Time `json:"expires_at"` // незавершенная загрузка удаляется после этого времени
+}
+
+// UploadCreateRequest представляет запрос на создание возобновляемой загрузки
+type UploadCreateRequest struct {
+	FileName        string `json:"file_name" binding:"required,max=255"`
+	Size            int64  `json:"size" binding:"required,min=1"`
+	Checksum        string `json:"checksum" binding:"required,len=64,hexadecimal"`
+	Prompt          string `json:"prompt" binding:"required"`
+	SummaryStrategy string `json:"summary_strategy"`
+}
+
+// URLUploadRequest представляет запрос на обработку записи, загружаемой по адресу
+type URLUploadRequest struct {
+	URL             string `json:"url" binding:"required,max=2048"`
+	Prompt          string `json:"prompt" binding:"required"`
+	SummaryStrategy string `json:"summary_strategy"`
+}
+
+// Этапы обработки, о которых сообщают события задачи
+const (
+	JobStageQueued           = "queued"
+	JobStageFetching         = "fetching"
+	JobStageExtracting       = "extracting"
+	JobStageAudioExtracted   = "audio_extracted"
+	JobStageConverting       = "converting"
+	JobStageMP3Converted     = "mp3_converted"
+	JobStageTranscribing     = "transcribing"
+	JobStageTranscribedChunk = "transcription_chunk"
+	JobStageSummarizing      = "summarizing"
+	JobStageDone             = "done"
+	JobStageFailed           = "failed"
+)
+
+// JobEvent представляет событие о ходе обработки задачи
+type JobEvent struct {
+	JobID   int64   `json:"job_id"`
+	Stage   string  `json:"stage"`
+	Status  string  `json:"status"`
+	Percent float64 `json:"percent"`          // общий прогресс задачи от 0 до 100
+	Chunk   int     `json:"chunk,omitempty"`  // номер обработанного фрагмента транскрипции
+	Chunks  int     `json:"chunks,omitempty"` // общее число фрагментов транскрипции
+	Message string  `json:"message,omitempty"`
+}
+
+// IsFinal сообщает, является ли событие последним для задачи
+func (e *JobEvent) IsFinal() bool {
+	return e.Stage == JobStageDone || e.Stage == JobStageFailed
+}
+
+// Result представляет сохраненный результат обработки видео,
+// связанный с записью об использовании сервиса
+type Result struct {
+	ID                 int64               `json:"id"`
+	UsageID            int64               `json:"usage_id"`
+	JobID              *int64              `json:"job_id,omitempty"`
+	Transcription      string              `json:"transcription"`
+	Segments           []TranscriptSegment `json:"segments,omitempty"`
+	Summary            string              `json:"summary"`
+	TranscriptionModel string              `json:"transcription_model"`
+	SummaryModel       string              `json:"summary_model"`
+	SummaryStrategy    string              `json:"summary_strategy"`
+	AudioPath          string              `json:"audio_path"` // способ подготовки аудио: passthrough, transcoded, extracted или cached
+	Language           string              `json:"language"`
+	MediaDuration      float64             `json:"media_duration"`     // длительность записи в секундах
+	TranscriptionTime  int                 `json:"transcription_time"` // в секундах
+	SummaryTime        int                 `json:"summary_time"`       // в секундах
+	ProcessingTime     int                 `json:"processing_time"`    // в секундах
+	CreatedAt          time.Time           `json:"created_at"`
+}
+
+// Summary представляет дополнительное саммари, построенное по сохраненной транскрипции
+type Summary struct {
+	ID              int64     `json:"id"`
+	ResultID        int64     `json:"result_id"`
+	PromptText      string    `json:"prompt_text"`
+	Summary         string    `json:"summary"`
+	SummaryModel    string    `json:"summary_model"`
+	SummaryStrategy string    `json:"summary_strategy"`
+	ProcessingTime  int       `json:"processing_time"` // в секундах
+	CreatedAt       time.Time `json:"created_at"`
+}
+
+// SummaryRequest представляет запрос на новое саммари для записи истории
+type SummaryRequest struct {
+	Prompt   string `json:"prompt" binding:"required"`
+	Strategy string `json:"strategy"` // auto, single, map_reduce или refine
+}
+
+// TranscriptionCacheStats представляет состояние кэша транскрипций для администратора
+type TranscriptionCacheStats struct {
+	TTLHours int   `json:"ttl_hours"` // 0 — кэш отключен
+	Entries  int   `json:"entries"`
+	Expired  int   `json:"expired"`
+	Hits     int64 `json:"hits"`
+}
+
+// TranscriptionCacheSettings представляет запрос на изменение настроек кэша транскрипций
+type TranscriptionCacheSettings struct {
+	TTLHours *int `json:"ttl_hours" binding:"required,min=0"`
+}
+
+// Контейнеры медиафайлов, которые распознаются по сигнатуре при загрузке
+const (
+	ContainerMP4      = "mp4"      // MP4, MOV, M4A, 3GP
+	ContainerMatroska = "matroska" // MKV, WebM
+	ContainerAVI      = "avi"
+	ContainerMPEGTS   = "mpegts"
+	ContainerMPEGPS   = "mpeg"
+	ContainerFLV      = "flv"
+	ContainerASF      = "asf" // WMV, WMA
+	ContainerOgg      = "ogg"
+	ContainerWAV      = "wav"
+	ContainerMP3      = "mp3"
+	ContainerFLAC     = "flac"
+	ContainerAAC      = "aac" // поток ADTS
+)
+
+// SupportedContainers перечисляет все распознаваемые контейнеры
+var SupportedContainers = []string{
+	ContainerMP4, ContainerMatroska, ContainerAVI, ContainerMPEGTS, ContainerMPEGPS, ContainerFLV,
+	ContainerASF, ContainerOgg, ContainerWAV, ContainerMP3, ContainerFLAC, ContainerAAC,
+}
+
+// MediaFormats представляет настройки допустимых форматов загрузки для администратора
+type MediaFormats struct {
+	AllowedContainers   []string `json:"allowed_containers"`
+	SupportedContainers []string `json:"supported_containers"`
+}
+
+// MediaFormatSettings представляет запрос на изменение списка разрешенных контейнеров
+type MediaFormatSettings struct {
+	AllowedContainers []string `json:"allowed_containers" binding:"required,min=1"`
+}
+
+// Периоды обновления квоты тарифного плана
+const (
+	ResetPeriodMonthly = "monthly"
+	ResetPeriodWeekly  = "weekly"
+)
+
+// Plan представляет тарифный план с квотой на период
+type Plan struct {
+	ID           int64     `json:"id"`
+	Code         string    `json:"code"` // free, pro, team
+	Name         string    `json:"name"`
+	QuotaSeconds int       `json:"quota_seconds"` // секунд обработки на период
+	ResetPeriod  string    `json:"reset_period"`  // monthly или weekly
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+// NextPeriodEnd возвращает конец периода, начинающегося в start
+func (p *Plan) NextPeriodEnd(start time.Time) time.Time {
+	if p.ResetPeriod == ResetPeriodWeekly {
+		return start.AddDate(0, 0, 7)
+	}
+	return start.AddDate(0, 1, 0)
+}
+
+// UserPlan представляет назначение тарифного плана пользователю и текущий период квоты
+type UserPlan struct {
+	UserID      int64     `json:"user_id"`
+	Plan        Plan      `json:"plan"`
+	PeriodStart time.Time `json:"period_start"`
+	PeriodEnd   time.Time `json:"period_end"` // дата следующего обновления квоты
+	AssignedAt  time.Time `json:"assigned_at"`
+}
+
+// Направления записей журнала использования
+const (
+	LedgerCredit = "credit" // начисление секунд
+	LedgerDebit  = "debit"  // списание секунд
+)
+
+// Основания записей журнала использования
+const (
+	LedgerReasonPlanGrant       = "plan_grant"       // квота тарифного плана на новый период
+	LedgerReasonPeriodExpiry    = "period_expiry"    // сгорание неиспользованного остатка периода
+	LedgerReasonUsage           = "usage"            // обработка видео или генерация саммари
+	LedgerReasonOpeningBalance  = "opening_balance"  // лимит, перенесенный из users.usage_limit_secs
+	LedgerReasonOpeningUsage    = "opening_usage"    // использование, перенесенное из users.usage_total_secs
+	LedgerReasonAdminAdjustment = "admin_adjustment" // изменение лимита администратором
+	LedgerReasonAdminGrant      = "admin_grant"      // бонусное начисление администратором
+	LedgerReasonAdminDeduct     = "admin_deduct"     // ручное списание администратором
+	LedgerReasonGrantExpiry     = "grant_expiry"     // сгорание неиспользованного бонусного начисления
+)
+
+// LedgerEntry представляет запись журнала начислений и списаний секунд.
+// Журнал только дополняется, остаток вычисляется по его записям.
+type LedgerEntry struct {
+	ID             int64      `json:"id"`
+	UserID         int64      `json:"user_id"`
+	Direction      string     `json:"direction"` // credit или debit
+	Seconds        int        `json:"seconds"`
+	Reason         string     `json:"reason"`
+	ReservationID  *int64     `json:"reservation_id,omitempty"`
+	Comment        string     `json:"comment,omitempty"`
+	AdminID        *int64     `json:"admin_id,omitempty"`         // администратор, внесший запись
+	ExpiresAt      *time.Time `json:"expires_at,omitempty"`       // срок действия бонусного начисления
+	RelatedEntryID *int64     `json:"related_entry_id,omitempty"` // начисление, к которому относится сгорание
+	CreatedAt      time.Time  `json:"created_at"`
+}
+
+// Quota представляет состояние квоты пользователя в текущем периоде
+type Quota struct {
+	Plan             string    `json:"plan"`
+	PlanName         string    `json:"plan_name"`
+	QuotaSeconds     int       `json:"quota_seconds"`
+	ResetPeriod      string    `json:"reset_period"`
+	PeriodStart      time.Time `json:"period_start"`
+	ResetAt          time.Time `json:"reset_at"`
+	UsedSeconds      int       `json:"used_seconds"`     // списано в текущем периоде
+	ReservedSeconds  int       `json:"reserved_seconds"` // зарезервировано под обрабатываемые задачи
+	RemainingSeconds int       `json:"remaining_seconds"`
+}
+
+// PlanAssignment представляет назначение тарифного плана пользователю
+type PlanAssignment struct {
+	UserID int64  `json:"user_id" binding:"required"`
+	Plan   string `json:"plan" binding:"required"`
+}
+
+// CreditAdjustment представляет ручное начисление или списание секунд администратором
+type CreditAdjustment struct {
+	Seconds   int        `json:"seconds" binding:"required,min=1"`
+	Reason    string     `json:"reason" binding:"required"`
+	ExpiresAt *time.Time `json:"expires_at"` // только для начислений; по умолчанию — конец текущего периода
+}
+
+// Права администраторов, проверяемые на маршрутах /api/admin
+const (
+	PermissionUsersRead           = "users.read"
+	PermissionUsersLimitWrite     = "users.limit.write"
+	PermissionUsersPlanWrite      = "users.plan.write"
+	PermissionUsersCreditsRead    = "users.credits.read"
+	PermissionUsersCreditsWrite   = "users.credits.write"
+	PermissionUsersSessionsRevoke = "users.sessions.revoke"
+	PermissionUsersRolesWrite     = "users.roles.write"
+	PermissionRolesRead           = "roles.read"
+	PermissionPlansRead           = "plans.read"
+	PermissionCacheRead           = "cache.read"
+	PermissionCacheWrite          = "cache.write"
+	PermissionAdminsRead          = "admins.read"
+	PermissionAdminsWrite         = "admins.write"
+	PermissionMediaRead           = "media.read"
+	PermissionMediaWrite          = "media.write"
+)
+
+// RoleSuperadmin — роль со всеми правами
+const RoleSuperadmin = "superadmin"
+
+// Role представляет роль администратора с её правами
+type Role struct {
+	ID          int64    `json:"id"`
+	Code        string   `json:"code"`
+	Name        string   `json:"name"`
+	Permissions []string `json:"permissions"`
+}
+
+// RoleAssignment представляет набор ролей, назначаемых пользователю.
+// Пустой список снимает все роли.
+type RoleAssignment struct {
+	Roles []string `json:"roles" binding:"required"`
+}
+
+// AdminAccount представляет администратора, входящего по имени пользователя и паролю
+type AdminAccount struct {
+	ID                 int64      `json:"id"`
+	Username           string     `json:"username"`
+	FirstName          string     `json:"first_name"`
+	IsActive           bool       `json:"is_active"`
+	MustChangePassword bool       `json:"must_change_password"`
+	TOTPEnabled        bool       `json:"totp_enabled"`
+	PasswordChangedAt  *time.Time `json:"password_changed_at"`
+	LastLogin          time.Time  `json:"last_login"`
+	Roles              []string   `json:"roles"`
+}
+
+// AdminCreateRequest представляет запрос на создание администратора.
+// Созданный администратор должен сменить пароль при первом входе.
+type AdminCreateRequest struct {
+	Username  string   `json:"username" binding:"required"`
+	Password  string   `json:"password" binding:"required"`
+	FirstName string   `json:"first_name"`
+	Roles     []string `json:"roles" binding:"required,min=1"`
+}
+
+// AdminPasswordReset представляет новый пароль, выданный другим администратором
+type AdminPasswordReset struct {
+	Password string `json:"password" binding:"required"`
+}
+
+// PasswordChangeRequest представляет смену пароля самим администратором
+type PasswordChangeRequest struct {
+	CurrentPassword string `json:"current_password" binding:"required"`
+	NewPassword     string `json:"new_password" binding:"required"`
+}
+
+// TOTPState представляет состояние двухфакторной аутентификации администратора
+type TOTPState struct {
+	Secret            string
+	Enabled           bool
+	LastStep          *int64 // интервал последнего принятого кода
+	RecoveryCodesLeft int
+}
+
+// AdminLoginChallenge возвращается вместо токенов, если у администратора
+// включена 2FA. Вход завершается кодом через POST /auth/admin/2fa.
+type AdminLoginChallenge struct {
+	TwoFactorRequired bool      `json:"two_factor_required"`
+	ChallengeToken    string    `json:"challenge_token"`
+	ExpiresAt         time.Time `json:"expires_at"`
+}
+
+// TwoFactorLoginRequest завершает вход администратора кодом TOTP
+// или одноразовым кодом восстановления
+type TwoFactorLoginRequest struct {
+	ChallengeToken string `json:"challenge_token" binding:"required"`
+	Code           string `json:"code" binding:"required"`
+}
+
+// TOTPSetup содержит секрет для подключения приложения-аутентификатора
+type TOTPSetup struct {
+	Secret     string `json:"secret"`
+	OTPAuthURI string `json:"otpauth_uri"`
+}
+
+// TOTPCodeRequest представляет код из приложения-аутентификатора или код восстановления
+type TOTPCodeRequest struct {
+	Code string `json:"code" binding:"required"`
+}
+
+// TOTPDisableRequest представляет отключение 2FA самим администратором
+type TOTPDisableRequest struct {
+	Password string `json:"password" binding:"required"`
+	Code     string `json:"code" binding:"required"`
+}
@@ -0,0 +1,261 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/trofimovm/summvideo/database"
+	"github.com/trofimovm/summvideo/models"
+)
+
+// SeededAdminTelegramID — специальный telegram_id администратора, созданного миграцией 000003
+const SeededAdminTelegramID = 0
+
+// ErrUsernameTaken возвращается при создании администратора с занятым именем
+var ErrUsernameTaken = errors.New("имя пользователя уже занято")
+
+// ErrLastActiveSuperadmin возвращается при попытке отключить последнего активного суперадминистратора
+var ErrLastActiveSuperadmin = errors.New("нельзя отключить последнего активного суперадминистратора")
+
+// AdminRepository предоставляет методы для работы с администраторами, входящими по паролю
+type AdminRepository struct{}
+
+// adminAccountQuery выбирает администраторов с паролем вместе с их ролями
+const adminAccountQuery = `SELECT u.id, u.username, COALESCE(u.first_name, ''), u.is_active,
+         u.must_change_password, u.totp_enabled, u.password_changed_at, u.last_login,
+         COALESCE(ARRAY_AGG(r.code ORDER BY r.id) FILTER (WHERE r.code IS NOT NULL), '{}')
+         FROM users u
+         LEFT JOIN user_roles ur ON ur.user_id = u.id
+         LEFT JOIN roles r ON r.id = ur.role_id
+         WHERE u.password_hash IS NOT NULL`
+
+// scanAdminAccount считывает администратора из строки результата запроса
+func scanAdminAccount(row pgx.Row) (*models.AdminAccount, error) {
+	var account models.AdminAccount
+
+	err := row.Scan(
+		&account.ID, &account.Username, &account.FirstName, &account.IsActive,
+		&account.MustChangePassword, &account.TOTPEnabled, &account.PasswordChangedAt, &account.LastLogin,
+		&account.Roles,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &account, nil
+}
+
+// FindAll возвращает всех администраторов с паролем
+func (r *AdminRepository) FindAll() ([]models.AdminAccount, error) {
+	rows, err := database.DB.Query(
+		context.Background(),
+		adminAccountQuery+`
+         GROUP BY u.id
+         ORDER BY u.id`,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var accounts []models.AdminAccount
+	for rows.Next() {
+		account, err := scanAdminAccount(rows)
+		if err != nil {
+			return nil, err
+		}
+		accounts = append(accounts, *account)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return accounts, nil
+}
+
+// FindByID ищет администратора с паролем по ID
+func (r *AdminRepository) FindByID(id int64) (*models.AdminAccount, error) {
+	return scanAdminAccount(database.DB.QueryRow(
+		context.Background(),
+		adminAccountQuery+` AND u.id = $1
+         GROUP BY u.id`,
+		id,
+	))
+}
+
+// FindByUsername ищет администратора с паролем по имени пользователя
+func (r *AdminRepository) FindByUsername(username string) (*models.AdminAccount, error) {
+	return scanAdminAccount(database.DB.QueryRow(
+		context.Background(),
+		adminAccountQuery+` AND u.username = $1
+         GROUP BY u.id`,
+		username,
+	))
+}
+
+// CountActive возвращает число активных администраторов с паролем, не считая
+// администратора из миграции 000003
+func (r *AdminRepository) CountActive() (int, error) {
+	var count int
+
+	err := database.DB.QueryRow(
+		context.Background(),
+		`SELECT COUNT(*) FROM users
+         WHERE password_hash IS NOT NULL AND is_admin = TRUE AND is_active = TRUE
+         AND telegram_id IS DISTINCT FROM $1`,
+		SeededAdminTelegramID,
+	).Scan(&count)
+
+	return count, err
+}
+
+// Create создает администратора с паролем и назначает ему роли.
+// createdBy пуст, если администратор создан из командной строки или при первом запуске.
+func (r *AdminRepository) Create(username, firstName, passwordHash string, roles []string, mustChangePassword bool, createdBy *int64) (*models.AdminAccount, error) {
+	ctx := context.Background()
+
+	tx, err := database.DB.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	// Имя пользователя используется для входа, поэтому оно должно быть уникальным
+	// среди всех пользователей, включая вошедших через Telegram
+	var taken bool
+	err = tx.QueryRow(ctx, `SELECT EXISTS (SELECT 1 FROM users WHERE username = $1)`, username).Scan(&taken)
+	if err != nil {
+		return nil, err
+	}
+	if taken {
+		return nil, ErrUsernameTaken
+	}
+
+	var roleIDs []int64
+	for _, code := range roles {
+		var roleID int64
+		err := tx.QueryRow(ctx, `SELECT id FROM roles WHERE code = $1`, code).Scan(&roleID)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("%w: %s", ErrRoleNotFound, code)
+		}
+		if err != nil {
+			return nil, err
+		}
+		roleIDs = append(roleIDs, roleID)
+	}
+
+	var userID int64
+	err = tx.QueryRow(
+		ctx,
+		`INSERT INTO users (username, first_name, last_name, photo_url, auth_date, hash,
+         is_admin, is_active, password_hash, must_change_password, password_changed_at)
+         VALUES ($1, $2, '', '', 0, '', $3, TRUE, $4, $5, $6)
+         RETURNING id`,
+		username, firstName, len(roleIDs) > 0, passwordHash, mustChangePassword, time.Now(),
+	).Scan(&userID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, roleID := range roleIDs {
+		_, err := tx.Exec(
+			ctx,
+			`INSERT INTO user_roles (user_id, role_id, assigned_by) VALUES ($1, $2, $3)
+             ON CONFLICT DO NOTHING`,
+			userID, roleID, createdBy,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+
+	return r.FindByID(userID)
+}
+
+// SetPassword заменяет пароль администратора. Если mustChangePassword
+// установлен, администратор должен сменить пароль при следующем входе.
+// Возвращает pgx.ErrNoRows, если администратор с паролем не найден.
+func (r *AdminRepository) SetPassword(userID int64, passwordHash string, mustChangePassword bool) error {
+	now := time.Now()
+
+	tag, err := database.DB.Exec(
+		context.Background(),
+		`UPDATE users SET password_hash = $1, must_change_password = $2,
+         password_changed_at = $3, updated_at = $3
+         WHERE id = $4 AND password_hash IS NOT NULL`,
+		passwordHash, mustChangePassword, now, userID,
+	)
+	if err != nil {
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
+
+	return nil
+}
+
+// Disable отключает администратора с паролем. Последнего активного
+// суперадминистратора отключить нельзя. Возвращает pgx.ErrNoRows,
+// если активный администратор с паролем не найден.
+func (r *AdminRepository) Disable(userID int64) error {
+	ctx := context.Background()
+
+	tx, err := database.DB.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	// Блокируем назначения суперадминистраторов, как и при смене ролей,
+	// чтобы два запроса не отключили последних суперадминистраторов одновременно
+	_, err = tx.Exec(
+		ctx,
+		`SELECT 1 FROM user_roles ur JOIN roles r ON r.id = ur.role_id
+         WHERE r.code = $1 FOR UPDATE OF ur`,
+		models.RoleSuperadmin,
+	)
+	if err != nil {
+		return err
+	}
+
+	tag, err := tx.Exec(
+		ctx,
+		`UPDATE users SET is_active = FALSE, updated_at = $1
+         WHERE id = $2 AND password_hash IS NOT NULL AND is_active = TRUE`,
+		time.Now(), userID,
+	)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
+
+	var superadmins int
+	err = tx.QueryRow(
+		ctx,
+		`SELECT COUNT(*) FROM user_roles ur
+         JOIN roles r ON r.id = ur.role_id
+         JOIN users u ON u.id = ur.user_id
+         WHERE r.code = $1 AND u.is_active = TRUE`,
+		models.RoleSuperadmin,
+	).Scan(&superadmins)
+	if err != nil {
+		return err
+	}
+	if superadmins == 0 {
+		return ErrLastActiveSuperadmin
+	}
+
+	return tx.Commit(ctx)
+}
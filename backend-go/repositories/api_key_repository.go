@@ -0,0 +1,119 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/trofimovm/summvideo/database"
+	"github.com/trofimovm/summvideo/models"
+)
+
+// apiKeyTouchInterval задает, как часто обновляется время последнего использования ключа
+const apiKeyTouchInterval = time.Minute
+
+// APIKeyRepository предоставляет методы для работы с персональными API-ключами
+type APIKeyRepository struct{}
+
+// apiKeyColumns перечисляет поля ключа в порядке, ожидаемом scanAPIKey
+const apiKeyColumns = `id, user_id, name, prefix, scopes, created_at, expires_at, last_used_at, revoked_at`
+
+// scanAPIKey считывает API-ключ из строки результата запроса
+func scanAPIKey(row pgx.Row) (*models.APIKey, error) {
+	var key models.APIKey
+
+	err := row.Scan(
+		&key.ID, &key.UserID, &key.Name, &key.Prefix, &key.Scopes,
+		&key.CreatedAt, &key.ExpiresAt, &key.LastUsedAt, &key.RevokedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &key, nil
+}
+
+// Create сохраняет API-ключ по его хэшу
+func (r *APIKeyRepository) Create(key *models.APIKey, keyHash string) (*models.APIKey, error) {
+	return scanAPIKey(database.DB.QueryRow(
+		context.Background(),
+		`INSERT INTO api_keys (user_id, name, prefix, key_hash, scopes, expires_at)
+         VALUES ($1, $2, $3, $4, $5, $6)
+         RETURNING `+apiKeyColumns,
+		key.UserID, key.Name, key.Prefix, keyHash, key.Scopes, key.ExpiresAt,
+	))
+}
+
+// FindByUserID возвращает API-ключи пользователя, включая отозванные, начиная с новых
+func (r *APIKeyRepository) FindByUserID(userID int64) ([]models.APIKey, error) {
+	rows, err := database.DB.Query(
+		context.Background(),
+		`SELECT `+apiKeyColumns+` FROM api_keys WHERE user_id = $1 ORDER BY created_at DESC`,
+		userID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var keys []models.APIKey
+	for rows.Next() {
+		key, err := scanAPIKey(rows)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, *key)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return keys, nil
+}
+
+// FindActiveByHash ищет неотозванный и неистекший ключ по хэшу и отмечает его
+// использование. Время использования обновляется не чаще apiKeyTouchInterval.
+func (r *APIKeyRepository) FindActiveByHash(keyHash string) (*models.APIKey, error) {
+	ctx := context.Background()
+	now := time.Now()
+
+	key, err := scanAPIKey(database.DB.QueryRow(
+		ctx,
+		`SELECT `+apiKeyColumns+` FROM api_keys
+         WHERE key_hash = $1 AND revoked_at IS NULL AND (expires_at IS NULL OR expires_at > $2)`,
+		keyHash, now,
+	))
+	if err != nil {
+		return nil, err
+	}
+
+	if key.LastUsedAt == nil || now.Sub(*key.LastUsedAt) >= apiKeyTouchInterval {
+		_, err = database.DB.Exec(ctx, `UPDATE api_keys SET last_used_at = $1 WHERE id = $2`, now, key.ID)
+		if err != nil {
+			return nil, err
+		}
+		key.LastUsedAt = &now
+	}
+
+	return key, nil
+}
+
+// Revoke отзывает API-ключ пользователя. Если ключ не найден или уже
+// отозван, возвращает pgx.ErrNoRows.
+func (r *APIKeyRepository) Revoke(id, userID int64) error {
+	tag, err := database.DB.Exec(
+		context.Background(),
+		`UPDATE api_keys SET revoked_at = $1 WHERE id = $2 AND user_id = $3 AND revoked_at IS NULL`,
+		time.Now(), id, userID,
+	)
+	if err != nil {
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
+
+	return nil
+}
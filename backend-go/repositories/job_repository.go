@@ -0,0 +1,344 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/trofimovm/summvideo/database"
+	"github.com/trofimovm/summvideo/models"
+)
+
+// JobRepository предоставляет методы для работы с очередью задач обработки видео
+type JobRepository struct{}
+
+// jobColumns перечисляет поля задачи в порядке, ожидаемом scanJob
+const jobColumns = `id, user_id, status, COALESCE(video_name, ''), COALESCE(file_path, ''),
+         COALESCE(prompt_text, ''), COALESCE(summary_strategy, ''), COALESCE(content_hash, ''),
+         COALESCE(media_duration, 0), reservation_id, COALESCE(source_url, ''),
+         COALESCE(transcription, ''), COALESCE(segments, '[]'::jsonb), COALESCE(summary, ''),
+         COALESCE(error, ''), COALESCE(processing_time, 0), COALESCE(attempts, 0),
+         created_at, updated_at, started_at, finished_at`
+
+// scanJob считывает задачу из строки результата запроса
+func scanJob(row pgx.Row) (*models.Job, error) {
+	var job models.Job
+
+	err := row.Scan(
+		&job.ID, &job.UserID, &job.Status, &job.VideoName, &job.FilePath,
+		&job.PromptText, &job.SummaryStrategy, &job.ContentHash,
+		&job.MediaDuration, &job.ReservationID, &job.SourceURL,
+		&job.Transcription, &job.Segments, &job.Summary,
+		&job.Error, &job.ProcessingTime, &job.Attempts,
+		&job.CreatedAt, &job.UpdatedAt, &job.StartedAt, &job.FinishedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &job, nil
+}
+
+// jobInsertQuery добавляет задачу в очередь и возвращает её поля
+const jobInsertQuery = `INSERT INTO jobs (user_id, status, video_name, file_path, prompt_text, summary_strategy,
+         content_hash, media_duration, reservation_id, source_url)
+         VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, NULLIF($10, ''))
+         RETURNING ` + jobColumns
+
+// jobInsertArgs возвращает параметры jobInsertQuery
+func jobInsertArgs(job *models.Job) []interface{} {
+	return []interface{}{
+		job.UserID, models.JobStatusQueued, job.VideoName, job.FilePath, job.PromptText, job.SummaryStrategy,
+		job.ContentHash, job.MediaDuration, job.ReservationID, job.SourceURL,
+	}
+}
+
+// Create ставит новую задачу в очередь. ContentHash — SHA-256 загруженного файла,
+// по которому ищется готовая транскрипция в кэше, MediaDuration — длительность
+// записи в секундах, по которой списывается использование, ReservationID —
+// резерв лимита пользователя, который будет погашен по завершении задачи.
+func (r *JobRepository) Create(job *models.Job) (*models.Job, error) {
+	return scanJob(database.DB.QueryRow(context.Background(), jobInsertQuery, jobInsertArgs(job)...))
+}
+
+// CreateForUpload ставит в очередь задачу по возобновляемой загрузке и в той же
+// транзакции связывает загрузку с задачей, чтобы файл загрузки ни в какой момент
+// не считался брошенным. Если по загрузке уже создана задача или загрузка
+// удалена, возвращает ErrUploadCompleted.
+func (r *JobRepository) CreateForUpload(job *models.Job, uploadID int64) (*models.Job, error) {
+	ctx := context.Background()
+
+	tx, err := database.DB.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	created, err := scanJob(tx.QueryRow(ctx, jobInsertQuery, jobInsertArgs(job)...))
+	if err != nil {
+		return nil, err
+	}
+
+	tag, err := tx.Exec(
+		ctx,
+		`UPDATE uploads SET job_id = $1, updated_at = $2 WHERE id = $3 AND job_id IS NULL`,
+		created.ID, time.Now(), uploadID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if tag.RowsAffected() == 0 {
+		return nil, ErrUploadCompleted
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
+
+// SetFetchedSource сохраняет сведения о файле, загруженном обработчиком по адресу:
+// имя файла, хеш содержимого, длительность записи и резерв лимита
+func (r *JobRepository) SetFetchedSource(job *models.Job) error {
+	_, err := database.DB.Exec(
+		context.Background(),
+		`UPDATE jobs
+         SET video_name = $1, content_hash = $2, media_duration = $3, reservation_id = $4, updated_at = $5
+         WHERE id = $6`,
+		job.VideoName, job.ContentHash, job.MediaDuration, job.ReservationID, time.Now(), job.ID,
+	)
+
+	return err
+}
+
+// FindByID ищет задачу по ID
+func (r *JobRepository) FindByID(id int64) (*models.Job, error) {
+	return scanJob(database.DB.QueryRow(
+		context.Background(),
+		`SELECT `+jobColumns+` FROM jobs WHERE id = $1`,
+		id,
+	))
+}
+
+// ClaimNext атомарно забирает самую старую задачу из очереди, переводит её
+// в статус извлечения аудио и выдает аренду на lease. Если очередь пуста,
+// возвращает pgx.ErrNoRows. SKIP LOCKED позволяет нескольким обработчикам
+// не мешать друг другу.
+func (r *JobRepository) ClaimNext(lease time.Duration) (*models.Job, error) {
+	now := time.Now()
+
+	return scanJob(database.DB.QueryRow(
+		context.Background(),
+		`UPDATE jobs
+         SET status = $1, attempts = attempts + 1, started_at = $2, updated_at = $2, lease_expires_at = $3
+         WHERE id = (
+             SELECT id FROM jobs
+             WHERE status = $4
+             ORDER BY created_at
+             FOR UPDATE SKIP LOCKED
+             LIMIT 1
+         )
+         RETURNING `+jobColumns,
+		models.JobStatusExtracting, now, now.Add(lease), models.JobStatusQueued,
+	))
+}
+
+// jobLeaseExpired отбирает выполняемые задачи, аренда которых истекла
+// или не выдавалась. Параметры: $1 — текущее время, $2..$4 — статусы
+// queued, done и failed.
+const jobLeaseExpired = `status NOT IN ($2, $3, $4) AND (lease_expires_at IS NULL OR lease_expires_at < $1)`
+
+// RenewLease продлевает аренду задачи на lease. attempt — номер попытки,
+// с которой обработчик забрал задачу: если задача с тех пор возвращена
+// в очередь, завершена или забрана заново, аренда не продлевается
+// и возвращается false.
+func (r *JobRepository) RenewLease(id int64, attempt int, lease time.Duration) (bool, error) {
+	now := time.Now()
+
+	tag, err := database.DB.Exec(
+		context.Background(),
+		`UPDATE jobs SET lease_expires_at = $1
+         WHERE id = $2 AND attempts = $3 AND status NOT IN ($4, $5, $6)`,
+		now.Add(lease), id, attempt, models.JobStatusQueued, models.JobStatusDone, models.JobStatusFailed,
+	)
+	if err != nil {
+		return false, err
+	}
+
+	return tag.RowsAffected() > 0, nil
+}
+
+// FindLeaseExpired возвращает выполняемые задачи, аренда которых истекла:
+// обработчик, который их выполнял, остановился
+func (r *JobRepository) FindLeaseExpired() ([]models.Job, error) {
+	return r.findJobs(
+		`SELECT `+jobColumns+` FROM jobs
+         WHERE `+jobLeaseExpired+`
+         ORDER BY created_at`,
+		time.Now(), models.JobStatusQueued, models.JobStatusDone, models.JobStatusFailed,
+	)
+}
+
+// RequeueExpired возвращает в очередь задачу с истекшей арендой. attempt — номер
+// попытки, полученный из FindLeaseExpired. Возвращает false, если задачу уже
+// вернул в очередь другой экземпляр или её обработчик успел продлить аренду.
+func (r *JobRepository) RequeueExpired(id int64, attempt int) (bool, error) {
+	now := time.Now()
+
+	tag, err := database.DB.Exec(
+		context.Background(),
+		`UPDATE jobs SET status = $5, lease_expires_at = NULL, updated_at = $1
+         WHERE id = $6 AND attempts = $7 AND `+jobLeaseExpired,
+		now, models.JobStatusQueued, models.JobStatusDone, models.JobStatusFailed,
+		models.JobStatusQueued, id, attempt,
+	)
+	if err != nil {
+		return false, err
+	}
+
+	return tag.RowsAffected() > 0, nil
+}
+
+// FailExpired помечает неудачной задачу с истекшей арендой. Возвращает false
+// на тех же условиях, что и RequeueExpired.
+func (r *JobRepository) FailExpired(id int64, attempt int, errMsg string) (bool, error) {
+	now := time.Now()
+
+	tag, err := database.DB.Exec(
+		context.Background(),
+		`UPDATE jobs SET status = $5, error = $6, lease_expires_at = NULL, updated_at = $1, finished_at = $1
+         WHERE id = $7 AND attempts = $8 AND `+jobLeaseExpired,
+		now, models.JobStatusQueued, models.JobStatusDone, models.JobStatusFailed,
+		models.JobStatusFailed, errMsg, id, attempt,
+	)
+	if err != nil {
+		return false, err
+	}
+
+	return tag.RowsAffected() > 0, nil
+}
+
+// UpdateStatus переводит задачу в новый статус
+func (r *JobRepository) UpdateStatus(id int64, status string) error {
+	_, err := database.DB.Exec(
+		context.Background(),
+		`UPDATE jobs SET status = $1, updated_at = $2 WHERE id = $3`,
+		status, time.Now(), id,
+	)
+
+	return err
+}
+
+// Complete сохраняет результат обработки и помечает задачу выполненной
+func (r *JobRepository) Complete(id int64, transcription *models.Transcription, summary string, processingTime int) error {
+	now := time.Now()
+
+	_, err := database.DB.Exec(
+		context.Background(),
+		`UPDATE jobs
+         SET status = $1, transcription = $2, segments = $3, summary = $4, processing_time = $5,
+         error = NULL, updated_at = $6, finished_at = $6
+         WHERE id = $7`,
+		models.JobStatusDone, transcription.Text, transcription.Segments, summary, processingTime, now, id,
+	)
+
+	return err
+}
+
+// Fail помечает задачу как завершившуюся с ошибкой
+func (r *JobRepository) Fail(id int64, errMsg string) error {
+	now := time.Now()
+
+	_, err := database.DB.Exec(
+		context.Background(),
+		`UPDATE jobs SET status = $1, error = $2, updated_at = $3, finished_at = $3 WHERE id = $4`,
+		models.JobStatusFailed, errMsg, now, id,
+	)
+
+	return err
+}
+
+// CancelQueued помечает неудачной задачу, которая ещё ждет в очереди.
+// Если задача уже взята в обработку или завершена, возвращает pgx.ErrNoRows.
+func (r *JobRepository) CancelQueued(id int64, errMsg string) (*models.Job, error) {
+	now := time.Now()
+
+	return scanJob(database.DB.QueryRow(
+		context.Background(),
+		`UPDATE jobs SET status = $1, error = $2, updated_at = $3, finished_at = $3
+         WHERE id = $4 AND status = $5
+         RETURNING `+jobColumns,
+		models.JobStatusFailed, errMsg, now, id, models.JobStatusQueued,
+	))
+}
+
+// RequestCancel сохраняет запрос отмены выполняемой задачи. Обработчик, который
+// выполняет задачу, проверяет запрос через CancelRequested и прерывает обработку.
+// Если задача уже завершена, возвращает pgx.ErrNoRows.
+func (r *JobRepository) RequestCancel(id int64) error {
+	now := time.Now()
+
+	tag, err := database.DB.Exec(
+		context.Background(),
+		`UPDATE jobs SET cancel_requested_at = COALESCE(cancel_requested_at, $1), updated_at = $1
+         WHERE id = $2 AND status NOT IN ($3, $4)`,
+		now, id, models.JobStatusDone, models.JobStatusFailed,
+	)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
+
+	return nil
+}
+
+// CancelRequested проверяет, запрошена ли отмена задачи
+func (r *JobRepository) CancelRequested(id int64) (bool, error) {
+	var requested bool
+
+	err := database.DB.QueryRow(
+		context.Background(),
+		`SELECT cancel_requested_at IS NOT NULL FROM jobs WHERE id = $1`,
+		id,
+	).Scan(&requested)
+
+	return requested, err
+}
+
+// FindUnfinished возвращает все задачи, которые ещё не завершены
+// (ожидают в очереди или выполняются)
+func (r *JobRepository) FindUnfinished() ([]models.Job, error) {
+	return r.findJobs(
+		`SELECT `+jobColumns+` FROM jobs
+         WHERE status NOT IN ($1, $2)
+         ORDER BY created_at`,
+		models.JobStatusDone, models.JobStatusFailed,
+	)
+}
+
+// findJobs выполняет запрос, выбирающий jobColumns, и возвращает найденные задачи
+func (r *JobRepository) findJobs(query string, args ...interface{}) ([]models.Job, error) {
+	rows, err := database.DB.Query(context.Background(), query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var jobs []models.Job
+	for rows.Next() {
+		job, err := scanJob(rows)
+		if err != nil {
+			return nil, err
+		}
+		jobs = append(jobs, *job)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return jobs, nil
+}
@@ -0,0 +1,299 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+	"github.com/trofimovm/summvideo/database"
+	"github.com/trofimovm/summvideo/models"
+)
+
+// dbtx объединяет методы пула соединений и транзакции, чтобы вспомогательные
+// функции можно было вызывать как отдельно, так и внутри транзакции
+type dbtx interface {
+	Exec(ctx context.Context, sql string, args ...interface{}) (pgconn.CommandTag, error)
+	QueryRow(ctx context.Context, sql string, args ...interface{}) pgx.Row
+}
+
+// LedgerRepository предоставляет методы для работы с журналом начислений и списаний секунд
+type LedgerRepository struct{}
+
+// FindByUserID возвращает записи журнала пользователя, начиная с последних
+func (r *LedgerRepository) FindByUserID(userID int64, limit, offset int) ([]models.LedgerEntry, error) {
+	rows, err := database.DB.Query(
+		context.Background(),
+		`SELECT id, user_id, direction, seconds, reason, reservation_id, COALESCE(comment, ''),
+         admin_id, expires_at, related_entry_id, created_at
+         FROM usage_ledger
+         WHERE user_id = $1
+         ORDER BY created_at DESC, id DESC
+         LIMIT $2 OFFSET $3`,
+		userID, limit, offset,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var entries []models.LedgerEntry
+	for rows.Next() {
+		var entry models.LedgerEntry
+		if err := rows.Scan(
+			&entry.ID, &entry.UserID, &entry.Direction, &entry.Seconds, &entry.Reason,
+			&entry.ReservationID, &entry.Comment, &entry.AdminID, &entry.ExpiresAt,
+			&entry.RelatedEntryID, &entry.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		entries = append(entries, entry)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return entries, nil
+}
+
+// Grant начисляет пользователю бонусные секунды от имени администратора.
+// Неиспользованный остаток начисления сгорает в expiresAt; если срок не указан,
+// начисление действует до конца текущего периода тарифного плана.
+func (r *LedgerRepository) Grant(userID int64, seconds int, reason string, expiresAt *time.Time, adminID int64) (*models.LedgerEntry, error) {
+	ctx := context.Background()
+
+	tx, err := database.DB.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	userPlan, err := ensureCurrentPeriod(ctx, tx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if expiresAt == nil {
+		expiresAt = &userPlan.PeriodEnd
+	}
+
+	entry := &models.LedgerEntry{
+		UserID:    userID,
+		Direction: models.LedgerCredit,
+		Seconds:   seconds,
+		Reason:    models.LedgerReasonAdminGrant,
+		Comment:   reason,
+		AdminID:   &adminID,
+		ExpiresAt: expiresAt,
+	}
+	if err := insertLedgerEntry(ctx, tx, entry); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+
+	return entry, nil
+}
+
+// Deduct списывает у пользователя секунды от имени администратора
+func (r *LedgerRepository) Deduct(userID int64, seconds int, reason string, adminID int64) (*models.LedgerEntry, error) {
+	ctx := context.Background()
+
+	entry := &models.LedgerEntry{
+		UserID:    userID,
+		Direction: models.LedgerDebit,
+		Seconds:   seconds,
+		Reason:    models.LedgerReasonAdminDeduct,
+		Comment:   reason,
+		AdminID:   &adminID,
+	}
+	if err := insertLedgerEntry(ctx, database.DB, entry); err != nil {
+		return nil, err
+	}
+
+	return entry, nil
+}
+
+// ExpireGrants списывает неиспользованный остаток бонусных начислений, срок которых
+// истек. Возвращает число пользователей, у которых сгорели начисления.
+func (r *LedgerRepository) ExpireGrants() (int, error) {
+	ctx := context.Background()
+
+	rows, err := database.DB.Query(
+		ctx,
+		`SELECT DISTINCT g.user_id
+         FROM usage_ledger g
+         WHERE g.reason = $1 AND g.expires_at <= $2
+         AND NOT EXISTS (SELECT 1 FROM usage_ledger e WHERE e.related_entry_id = g.id)`,
+		models.LedgerReasonAdminGrant, time.Now(),
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	var userIDs []int64
+	for rows.Next() {
+		var userID int64
+		if err := rows.Scan(&userID); err != nil {
+			rows.Close()
+			return 0, err
+		}
+		userIDs = append(userIDs, userID)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return 0, err
+	}
+
+	for _, userID := range userIDs {
+		tx, err := database.DB.Begin(ctx)
+		if err != nil {
+			return 0, err
+		}
+
+		// Блокировки берутся в том же порядке, что и при резервировании лимита
+		_, err = tx.Exec(ctx, `SELECT 1 FROM users WHERE id = $1 FOR UPDATE`, userID)
+		if err == nil {
+			_, err = ensureCurrentPeriod(ctx, tx, userID)
+		}
+		if err == nil {
+			err = expireDueGrants(ctx, tx, userID)
+		}
+		if err != nil {
+			tx.Rollback(ctx)
+			return 0, err
+		}
+
+		if err := tx.Commit(ctx); err != nil {
+			return 0, err
+		}
+	}
+
+	return len(userIDs), nil
+}
+
+// expireDueGrants списывает остаток истекших бонусных начислений пользователя.
+// Бонусные секунды расходуются в последнюю очередь, поэтому у каждого начисления
+// сгорает не больше текущего свободного остатка. Вызывается в транзакции,
+// заблокировавшей назначение плана пользователя.
+func expireDueGrants(ctx context.Context, tx pgx.Tx, userID int64) error {
+	rows, err := tx.Query(
+		ctx,
+		`SELECT g.id, g.seconds
+         FROM usage_ledger g
+         WHERE g.user_id = $1 AND g.reason = $2 AND g.expires_at <= $3
+         AND NOT EXISTS (SELECT 1 FROM usage_ledger e WHERE e.related_entry_id = g.id)
+         ORDER BY g.expires_at, g.id`,
+		userID, models.LedgerReasonAdminGrant, time.Now(),
+	)
+	if err != nil {
+		return err
+	}
+
+	var grants []models.LedgerEntry
+	for rows.Next() {
+		var grant models.LedgerEntry
+		if err := rows.Scan(&grant.ID, &grant.Seconds); err != nil {
+			rows.Close()
+			return err
+		}
+		grants = append(grants, grant)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	for _, grant := range grants {
+		balance, err := ledgerBalance(ctx, tx, userID)
+		if err != nil {
+			return err
+		}
+
+		reserved, err := reservedSeconds(ctx, tx, userID)
+		if err != nil {
+			return err
+		}
+
+		err = appendLedgerEntry(ctx, tx, &models.LedgerEntry{
+			UserID:         userID,
+			Direction:      models.LedgerDebit,
+			Seconds:        max(min(grant.Seconds, balance-reserved), 0),
+			Reason:         models.LedgerReasonGrantExpiry,
+			RelatedEntryID: &grant.ID,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// insertLedgerEntry добавляет запись в журнал и заполняет её ID и время создания
+func insertLedgerEntry(ctx context.Context, db dbtx, entry *models.LedgerEntry) error {
+	return db.QueryRow(
+		ctx,
+		`INSERT INTO usage_ledger (user_id, direction, seconds, reason, reservation_id, comment,
+         admin_id, expires_at, related_entry_id)
+         VALUES ($1, $2, $3, $4, $5, NULLIF($6, ''), $7, $8, $9)
+         RETURNING id, created_at`,
+		entry.UserID, entry.Direction, entry.Seconds, entry.Reason, entry.ReservationID, entry.Comment,
+		entry.AdminID, entry.ExpiresAt, entry.RelatedEntryID,
+	).Scan(&entry.ID, &entry.CreatedAt)
+}
+
+// appendLedgerEntry добавляет запись в журнал. Записи с нулем секунд не сохраняются,
+// кроме отметок о сгорании начислений: по ним видно, что начисление уже обработано.
+func appendLedgerEntry(ctx context.Context, db dbtx, entry *models.LedgerEntry) error {
+	if entry.Seconds == 0 && entry.RelatedEntryID == nil {
+		return nil
+	}
+
+	return insertLedgerEntry(ctx, db, entry)
+}
+
+// ledgerBalance возвращает остаток секунд пользователя по журналу
+// без учета зарезервированного времени
+func ledgerBalance(ctx context.Context, db dbtx, userID int64) (int, error) {
+	var balance int
+
+	err := db.QueryRow(
+		ctx,
+		`SELECT COALESCE(SUM(CASE WHEN direction = $2 THEN seconds ELSE -seconds END), 0)
+         FROM usage_ledger WHERE user_id = $1`,
+		userID, models.LedgerCredit,
+	).Scan(&balance)
+
+	return balance, err
+}
+
+// reservedSeconds возвращает время, зарезервированное под обрабатываемые задачи пользователя
+func reservedSeconds(ctx context.Context, db dbtx, userID int64) (int, error) {
+	var reserved int
+
+	err := db.QueryRow(
+		ctx,
+		`SELECT COALESCE(SUM(seconds), 0) FROM usage_reservations WHERE user_id = $1 AND status = $2`,
+		userID, ReservationActive,
+	).Scan(&reserved)
+
+	return reserved, err
+}
+
+// outstandingGrants возвращает сумму действующих бонусных начислений пользователя.
+// Они не сгорают вместе с остатком периода тарифного плана.
+func outstandingGrants(ctx context.Context, db dbtx, userID int64) (int, error) {
+	var outstanding int
+
+	err := db.QueryRow(
+		ctx,
+		`SELECT COALESCE(SUM(seconds), 0) FROM usage_ledger
+         WHERE user_id = $1 AND reason = $2 AND expires_at > $3`,
+		userID, models.LedgerReasonAdminGrant, time.Now(),
+	).Scan(&outstanding)
+
+	return outstanding, err
+}
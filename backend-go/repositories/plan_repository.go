@@ -0,0 +1,393 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/trofimovm/summvideo/database"
+	"github.com/trofimovm/summvideo/models"
+	"github.com/trofimovm/summvideo/utils"
+)
+
+// PlanRepository предоставляет методы для работы с тарифными планами и периодами квоты
+type PlanRepository struct{}
+
+// planColumns перечисляет поля плана в порядке, ожидаемом Scan
+const planColumns = `p.id, p.code, p.name, p.quota_seconds, p.reset_period, p.created_at`
+
+// FindAll возвращает все тарифные планы
+func (r *PlanRepository) FindAll() ([]models.Plan, error) {
+	rows, err := database.DB.Query(
+		context.Background(),
+		`SELECT `+planColumns+` FROM plans p ORDER BY p.quota_seconds`,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var plans []models.Plan
+	for rows.Next() {
+		var plan models.Plan
+		if err := rows.Scan(
+			&plan.ID, &plan.Code, &plan.Name, &plan.QuotaSeconds, &plan.ResetPeriod, &plan.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		plans = append(plans, plan)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return plans, nil
+}
+
+// FindByCode ищет тарифный план по коду
+func (r *PlanRepository) FindByCode(code string) (*models.Plan, error) {
+	var plan models.Plan
+
+	err := database.DB.QueryRow(
+		context.Background(),
+		`SELECT `+planColumns+` FROM plans p WHERE p.code = $1`,
+		code,
+	).Scan(&plan.ID, &plan.Code, &plan.Name, &plan.QuotaSeconds, &plan.ResetPeriod, &plan.CreatedAt)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &plan, nil
+}
+
+// AssignPlan назначает пользователю тарифный план. С момента назначения
+// начинается новый период: неиспользованный остаток сгорает, начисляется
+// квота нового плана.
+func (r *PlanRepository) AssignPlan(userID int64, planCode string) (*models.UserPlan, error) {
+	ctx := context.Background()
+
+	plan, err := r.FindByCode(planCode)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := database.DB.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	// Создаем назначение по умолчанию, если его ещё нет, и блокируем его
+	if _, err := ensureCurrentPeriod(ctx, tx, userID); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	if err := expireBalance(ctx, tx, userID); err != nil {
+		return nil, err
+	}
+
+	_, err = tx.Exec(
+		ctx,
+		`UPDATE user_plans SET plan_id = $1, period_start = $2, period_end = $3, assigned_at = $2
+         WHERE user_id = $4`,
+		plan.ID, now, plan.NextPeriodEnd(now), userID,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	err = appendLedgerEntry(ctx, tx, &models.LedgerEntry{
+		UserID:    userID,
+		Direction: models.LedgerCredit,
+		Seconds:   plan.QuotaSeconds,
+		Reason:    models.LedgerReasonPlanGrant,
+		Comment:   plan.Code,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+
+	return &models.UserPlan{
+		UserID:      userID,
+		Plan:        *plan,
+		PeriodStart: now,
+		PeriodEnd:   plan.NextPeriodEnd(now),
+		AssignedAt:  now,
+	}, nil
+}
+
+// GetQuota возвращает план пользователя и состояние квоты в текущем периоде.
+// Если период закончился, квота обновляется перед расчетом.
+func (r *PlanRepository) GetQuota(userID int64) (*models.Quota, error) {
+	ctx := context.Background()
+
+	tx, err := database.DB.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	userPlan, err := ensureCurrentPeriod(ctx, tx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	balance, err := ledgerBalance(ctx, tx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	reserved, err := reservedSeconds(ctx, tx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	var used int
+	err = tx.QueryRow(
+		ctx,
+		`SELECT COALESCE(SUM(seconds), 0) FROM usage_ledger
+         WHERE user_id = $1 AND direction = $2 AND reason = $3 AND created_at >= $4`,
+		userID, models.LedgerDebit, models.LedgerReasonUsage, userPlan.PeriodStart,
+	).Scan(&used)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+
+	return &models.Quota{
+		Plan:             userPlan.Plan.Code,
+		PlanName:         userPlan.Plan.Name,
+		QuotaSeconds:     userPlan.Plan.QuotaSeconds,
+		ResetPeriod:      userPlan.Plan.ResetPeriod,
+		PeriodStart:      userPlan.PeriodStart,
+		ResetAt:          userPlan.PeriodEnd,
+		UsedSeconds:      used,
+		ReservedSeconds:  reserved,
+		RemainingSeconds: max(balance-reserved, 0),
+	}, nil
+}
+
+// ResetDuePeriods обновляет квоту всем пользователям, у которых закончился период.
+// Возвращает число обновленных пользователей.
+func (r *PlanRepository) ResetDuePeriods() (int, error) {
+	ctx := context.Background()
+
+	rows, err := database.DB.Query(
+		ctx,
+		`SELECT user_id FROM user_plans WHERE period_end <= $1`,
+		time.Now(),
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	var userIDs []int64
+	for rows.Next() {
+		var userID int64
+		if err := rows.Scan(&userID); err != nil {
+			rows.Close()
+			return 0, err
+		}
+		userIDs = append(userIDs, userID)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return 0, err
+	}
+
+	for _, userID := range userIDs {
+		tx, err := database.DB.Begin(ctx)
+		if err != nil {
+			return 0, err
+		}
+		if _, err := ensureCurrentPeriod(ctx, tx, userID); err != nil {
+			tx.Rollback(ctx)
+			return 0, err
+		}
+		if err := tx.Commit(ctx); err != nil {
+			return 0, err
+		}
+	}
+
+	return len(userIDs), nil
+}
+
+// ensureCurrentPeriod возвращает план пользователя, блокируя назначение до конца
+// транзакции. Пользователю без плана назначается план по умолчанию (DEFAULT_PLAN).
+// Если период закончился, остаток сгорает, начинается новый период
+// и начисляется квота плана.
+func ensureCurrentPeriod(ctx context.Context, tx pgx.Tx, userID int64) (*models.UserPlan, error) {
+	userPlan, err := lockUserPlan(ctx, tx, userID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		userPlan, err = assignDefaultPlan(ctx, tx, userID)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	if now.Before(userPlan.PeriodEnd) {
+		return userPlan, nil
+	}
+
+	// Пропущенные периоды не начисляются: квота дается только на текущий
+	start := userPlan.PeriodEnd
+	end := userPlan.Plan.NextPeriodEnd(start)
+	for !now.Before(end) {
+		start, end = end, userPlan.Plan.NextPeriodEnd(end)
+	}
+
+	if err := expireBalance(ctx, tx, userID); err != nil {
+		return nil, err
+	}
+
+	_, err = tx.Exec(
+		ctx,
+		`UPDATE user_plans SET period_start = $1, period_end = $2 WHERE user_id = $3`,
+		start, end, userID,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	err = appendLedgerEntry(ctx, tx, &models.LedgerEntry{
+		UserID:    userID,
+		Direction: models.LedgerCredit,
+		Seconds:   userPlan.Plan.QuotaSeconds,
+		Reason:    models.LedgerReasonPlanGrant,
+		Comment:   userPlan.Plan.Code,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	userPlan.PeriodStart, userPlan.PeriodEnd = start, end
+	return userPlan, nil
+}
+
+// periodBalance возвращает остаток квоты текущего периода — ту часть баланса,
+// которая сгорает в конце периода: без зарезервированного времени и действующих
+// бонусных начислений. Истекшие бонусные начисления предварительно сгорают.
+func periodBalance(ctx context.Context, tx pgx.Tx, userID int64) (int, error) {
+	// Сначала сгорают истекшие бонусные начисления, чтобы не списать их дважды
+	if err := expireDueGrants(ctx, tx, userID); err != nil {
+		return 0, err
+	}
+
+	balance, err := ledgerBalance(ctx, tx, userID)
+	if err != nil {
+		return 0, err
+	}
+
+	reserved, err := reservedSeconds(ctx, tx, userID)
+	if err != nil {
+		return 0, err
+	}
+
+	grants, err := outstandingGrants(ctx, tx, userID)
+	if err != nil {
+		return 0, err
+	}
+
+	return balance - reserved - grants, nil
+}
+
+// lockUserPlan загружает назначение плана пользователя с блокировкой строки
+func lockUserPlan(ctx context.Context, tx pgx.Tx, userID int64) (*models.UserPlan, error) {
+	var userPlan models.UserPlan
+	plan := &userPlan.Plan
+
+	err := tx.QueryRow(
+		ctx,
+		`SELECT up.user_id, up.period_start, up.period_end, up.assigned_at, `+planColumns+`
+         FROM user_plans up
+         JOIN plans p ON p.id = up.plan_id
+         WHERE up.user_id = $1
+         FOR UPDATE OF up`,
+		userID,
+	).Scan(
+		&userPlan.UserID, &userPlan.PeriodStart, &userPlan.PeriodEnd, &userPlan.AssignedAt,
+		&plan.ID, &plan.Code, &plan.Name, &plan.QuotaSeconds, &plan.ResetPeriod, &plan.CreatedAt,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &userPlan, nil
+}
+
+// assignDefaultPlan назначает новому пользователю план по умолчанию и начисляет его квоту
+func assignDefaultPlan(ctx context.Context, tx pgx.Tx, userID int64) (*models.UserPlan, error) {
+	var plan models.Plan
+
+	err := tx.QueryRow(
+		ctx,
+		`SELECT `+planColumns+` FROM plans p WHERE p.code = $1`,
+		utils.GetEnv("DEFAULT_PLAN", "free"),
+	).Scan(&plan.ID, &plan.Code, &plan.Name, &plan.QuotaSeconds, &plan.ResetPeriod, &plan.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	tag, err := tx.Exec(
+		ctx,
+		`INSERT INTO user_plans (user_id, plan_id, period_start, period_end, assigned_at)
+         VALUES ($1, $2, $3, $4, $3)
+         ON CONFLICT (user_id) DO NOTHING`,
+		userID, plan.ID, now, plan.NextPeriodEnd(now),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// План уже назначен параллельным запросом
+	if tag.RowsAffected() == 0 {
+		return lockUserPlan(ctx, tx, userID)
+	}
+
+	err = appendLedgerEntry(ctx, tx, &models.LedgerEntry{
+		UserID:    userID,
+		Direction: models.LedgerCredit,
+		Seconds:   plan.QuotaSeconds,
+		Reason:    models.LedgerReasonPlanGrant,
+		Comment:   plan.Code,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return lockUserPlan(ctx, tx, userID)
+}
+
+// expireBalance списывает неиспользованный остаток закончившегося периода.
+// Время, зарезервированное под обрабатываемые задачи, не сгорает: оно будет
+// списано при их завершении. Действующие бонусные начисления сгорают по своему сроку.
+func expireBalance(ctx context.Context, tx pgx.Tx, userID int64) error {
+	expired, err := periodBalance(ctx, tx, userID)
+	if err != nil {
+		return err
+	}
+	if expired <= 0 {
+		return nil
+	}
+
+	return appendLedgerEntry(ctx, tx, &models.LedgerEntry{
+		UserID:    userID,
+		Direction: models.LedgerDebit,
+		Seconds:   expired,
+		Reason:    models.LedgerReasonPeriodExpiry,
+	})
+}
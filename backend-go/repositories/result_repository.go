@@ -0,0 +1,67 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/trofimovm/summvideo/database"
+	"github.com/trofimovm/summvideo/models"
+)
+
+// ResultRepository предоставляет методы для работы с результатами обработки видео
+type ResultRepository struct{}
+
+// Create сохраняет результат обработки для записи об использовании
+func (r *ResultRepository) Create(result *models.Result) (*models.Result, error) {
+	var created models.Result
+
+	err := database.DB.QueryRow(
+		context.Background(),
+		`INSERT INTO results (usage_id, job_id, transcription, segments, summary,
+         transcription_model, summary_model, summary_strategy, audio_path, language, media_duration,
+         transcription_time, summary_time, processing_time)
+         VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)
+         RETURNING id, usage_id, job_id, transcription, COALESCE(segments, '[]'::jsonb), summary,
+         transcription_model, summary_model, summary_strategy, COALESCE(audio_path, ''), language, media_duration,
+         transcription_time, summary_time, processing_time, created_at`,
+		result.UsageID, result.JobID, result.Transcription, result.Segments, result.Summary,
+		result.TranscriptionModel, result.SummaryModel, result.SummaryStrategy, result.AudioPath, result.Language, result.MediaDuration,
+		result.TranscriptionTime, result.SummaryTime, result.ProcessingTime,
+	).Scan(
+		&created.ID, &created.UsageID, &created.JobID, &created.Transcription, &created.Segments,
+		&created.Summary, &created.TranscriptionModel, &created.SummaryModel, &created.SummaryStrategy, &created.AudioPath, &created.Language,
+		&created.MediaDuration, &created.TranscriptionTime, &created.SummaryTime,
+		&created.ProcessingTime, &created.CreatedAt,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &created, nil
+}
+
+// FindByUsageID возвращает результат обработки для записи об использовании
+func (r *ResultRepository) FindByUsageID(usageID int64) (*models.Result, error) {
+	var result models.Result
+
+	err := database.DB.QueryRow(
+		context.Background(),
+		`SELECT id, usage_id, job_id, COALESCE(transcription, ''), COALESCE(segments, '[]'::jsonb),
+         COALESCE(summary, ''), COALESCE(transcription_model, ''), COALESCE(summary_model, ''),
+         COALESCE(summary_strategy, ''), COALESCE(audio_path, ''), COALESCE(language, ''), COALESCE(media_duration, 0), COALESCE(transcription_time, 0),
+         COALESCE(summary_time, 0), COALESCE(processing_time, 0), created_at
+         FROM results WHERE usage_id = $1`,
+		usageID,
+	).Scan(
+		&result.ID, &result.UsageID, &result.JobID, &result.Transcription, &result.Segments,
+		&result.Summary, &result.TranscriptionModel, &result.SummaryModel, &result.SummaryStrategy, &result.AudioPath, &result.Language,
+		&result.MediaDuration, &result.TranscriptionTime, &result.SummaryTime,
+		&result.ProcessingTime, &result.CreatedAt,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
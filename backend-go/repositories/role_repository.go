@@ -0,0 +1,188 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/trofimovm/summvideo/database"
+	"github.com/trofimovm/summvideo/models"
+)
+
+// ErrRoleNotFound возвращается при назначении несуществующей роли
+var ErrRoleNotFound = errors.New("роль не найдена")
+
+// ErrLastSuperadmin возвращается при попытке снять роль с последнего активного суперадминистратора
+var ErrLastSuperadmin = errors.New("нельзя снять роль с последнего активного суперадминистратора")
+
+// RoleRepository предоставляет методы для работы с ролями и правами администраторов
+type RoleRepository struct{}
+
+// FindAll возвращает все роли с их правами
+func (r *RoleRepository) FindAll() ([]models.Role, error) {
+	rows, err := database.DB.Query(
+		context.Background(),
+		`SELECT r.id, r.code, r.name,
+         COALESCE(ARRAY_AGG(rp.permission_code ORDER BY rp.permission_code)
+             FILTER (WHERE rp.permission_code IS NOT NULL), '{}')
+         FROM roles r
+         LEFT JOIN role_permissions rp ON rp.role_id = r.id
+         GROUP BY r.id
+         ORDER BY r.id`,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var roles []models.Role
+	for rows.Next() {
+		var role models.Role
+		if err := rows.Scan(&role.ID, &role.Code, &role.Name, &role.Permissions); err != nil {
+			return nil, err
+		}
+		roles = append(roles, role)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return roles, nil
+}
+
+// FindByUserID возвращает коды ролей пользователя
+func (r *RoleRepository) FindByUserID(userID int64) ([]string, error) {
+	rows, err := database.DB.Query(
+		context.Background(),
+		`SELECT r.code FROM user_roles ur
+         JOIN roles r ON r.id = ur.role_id
+         WHERE ur.user_id = $1
+         ORDER BY r.id`,
+		userID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var codes []string
+	for rows.Next() {
+		var code string
+		if err := rows.Scan(&code); err != nil {
+			return nil, err
+		}
+		codes = append(codes, code)
+	}
+
+	return codes, rows.Err()
+}
+
+// FindPermissionsByUserID возвращает права, полученные пользователем через все его роли
+func (r *RoleRepository) FindPermissionsByUserID(userID int64) ([]string, error) {
+	rows, err := database.DB.Query(
+		context.Background(),
+		`SELECT DISTINCT rp.permission_code FROM user_roles ur
+         JOIN role_permissions rp ON rp.role_id = ur.role_id
+         WHERE ur.user_id = $1`,
+		userID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var permissions []string
+	for rows.Next() {
+		var permission string
+		if err := rows.Scan(&permission); err != nil {
+			return nil, err
+		}
+		permissions = append(permissions, permission)
+	}
+
+	return permissions, rows.Err()
+}
+
+// SetUserRoles заменяет роли пользователя. Флаг is_admin, по которому разрешен
+// вход в панель администратора, выставляется при наличии хотя бы одной роли.
+// Последнего активного суперадминистратора лишить этой роли нельзя.
+func (r *RoleRepository) SetUserRoles(userID int64, codes []string, assignedBy int64) error {
+	ctx := context.Background()
+
+	tx, err := database.DB.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	// Блокируем назначения суперадминистраторов, чтобы параллельные запросы
+	// не сняли роль с двух последних одновременно
+	_, err = tx.Exec(
+		ctx,
+		`SELECT 1 FROM user_roles ur JOIN roles r ON r.id = ur.role_id
+         WHERE r.code = $1 FOR UPDATE OF ur`,
+		models.RoleSuperadmin,
+	)
+	if err != nil {
+		return err
+	}
+
+	var roleIDs []int64
+	for _, code := range codes {
+		var roleID int64
+		err := tx.QueryRow(ctx, `SELECT id FROM roles WHERE code = $1`, code).Scan(&roleID)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return fmt.Errorf("%w: %s", ErrRoleNotFound, code)
+		}
+		if err != nil {
+			return err
+		}
+		roleIDs = append(roleIDs, roleID)
+	}
+
+	if _, err := tx.Exec(ctx, `DELETE FROM user_roles WHERE user_id = $1`, userID); err != nil {
+		return err
+	}
+
+	for _, roleID := range roleIDs {
+		_, err := tx.Exec(
+			ctx,
+			`INSERT INTO user_roles (user_id, role_id, assigned_by) VALUES ($1, $2, $3)
+             ON CONFLICT DO NOTHING`,
+			userID, roleID, assignedBy,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	var superadmins int
+	err = tx.QueryRow(
+		ctx,
+		`SELECT COUNT(*) FROM user_roles ur
+         JOIN roles r ON r.id = ur.role_id
+         JOIN users u ON u.id = ur.user_id
+         WHERE r.code = $1 AND u.is_active = TRUE`,
+		models.RoleSuperadmin,
+	).Scan(&superadmins)
+	if err != nil {
+		return err
+	}
+	if superadmins == 0 {
+		return ErrLastSuperadmin
+	}
+
+	_, err = tx.Exec(
+		ctx,
+		`UPDATE users SET is_admin = $1, updated_at = $2 WHERE id = $3`,
+		len(roleIDs) > 0, time.Now(), userID,
+	)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
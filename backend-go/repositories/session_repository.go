@@ -0,0 +1,238 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/trofimovm/summvideo/database"
+	"github.com/trofimovm/summvideo/models"
+)
+
+// ErrSessionInvalid возвращается, если обновляющий токен не найден, истек или отозван
+var ErrSessionInvalid = errors.New("сессия недействительна")
+
+// ErrRefreshTokenReused возвращается при повторном предъявлении уже замененного
+// обновляющего токена. Это признак утечки токена, поэтому сессия отзывается.
+var ErrRefreshTokenReused = errors.New("обновляющий токен уже использован, сессия отозвана")
+
+// SessionRepository предоставляет методы для работы с сессиями пользователей
+type SessionRepository struct{}
+
+// sessionColumns перечисляет поля сессии в порядке, ожидаемом scanSession
+const sessionColumns = `id, user_id, COALESCE(user_agent, ''), COALESCE(ip_address, ''),
+         created_at, last_used_at, expires_at, revoked_at`
+
+// scanSession считывает сессию из строки результата запроса
+func scanSession(row pgx.Row) (*models.Session, error) {
+	var session models.Session
+
+	err := row.Scan(
+		&session.ID, &session.UserID, &session.UserAgent, &session.IPAddress,
+		&session.CreatedAt, &session.LastUsedAt, &session.ExpiresAt, &session.RevokedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &session, nil
+}
+
+// Create создает сессию с хэшем обновляющего токена
+func (r *SessionRepository) Create(userID int64, refreshTokenHash, userAgent, ipAddress string, expiresAt time.Time) (*models.Session, error) {
+	return scanSession(database.DB.QueryRow(
+		context.Background(),
+		`INSERT INTO sessions (user_id, refresh_token_hash, user_agent, ip_address, expires_at)
+         VALUES ($1, $2, NULLIF($3, ''), NULLIF($4, ''), $5)
+         RETURNING `+sessionColumns,
+		userID, refreshTokenHash, userAgent, ipAddress, expiresAt,
+	))
+}
+
+// Rotate заменяет обновляющий токен сессии новым и продлевает её. Замененные
+// токены сохраняются в session_rotated_tokens: предъявление любого из них
+// отзывает сессию целиком вместе со всеми выданными по ней токенами.
+func (r *SessionRepository) Rotate(refreshTokenHash, newTokenHash string, expiresAt time.Time) (*models.Session, error) {
+	ctx := context.Background()
+
+	tx, err := database.DB.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	var (
+		sessionID int64
+		revokedAt *time.Time
+		expires   time.Time
+	)
+	err = tx.QueryRow(
+		ctx,
+		`SELECT id, revoked_at, expires_at FROM sessions
+         WHERE refresh_token_hash = $1
+         FOR UPDATE`,
+		refreshTokenHash,
+	).Scan(&sessionID, &revokedAt, &expires)
+	if errors.Is(err, pgx.ErrNoRows) {
+		// Параллельная ротация того же токена ждет блокировки строки
+		// и попадает сюда, когда токен уже заменен
+		return nil, r.revokeReused(ctx, tx, refreshTokenHash)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	if revokedAt != nil || !now.Before(expires) {
+		return nil, ErrSessionInvalid
+	}
+
+	_, err = tx.Exec(
+		ctx,
+		`INSERT INTO session_rotated_tokens (token_hash, session_id, rotated_at) VALUES ($1, $2, $3)`,
+		refreshTokenHash, sessionID, now,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	session, err := scanSession(tx.QueryRow(
+		ctx,
+		`UPDATE sessions
+         SET refresh_token_hash = $1, last_used_at = $2, expires_at = $3
+         WHERE id = $4
+         RETURNING `+sessionColumns,
+		newTokenHash, now, expiresAt, sessionID,
+	))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+
+	return session, nil
+}
+
+// revokeReused отзывает сессию, которой принадлежал уже замененный токен.
+// Возвращает ErrRefreshTokenReused, если сессия была действующей,
+// и ErrSessionInvalid, если токен неизвестен или сессия уже закончилась.
+func (r *SessionRepository) revokeReused(ctx context.Context, tx pgx.Tx, refreshTokenHash string) error {
+	now := time.Now()
+
+	tag, err := tx.Exec(
+		ctx,
+		`UPDATE sessions SET revoked_at = $1
+         WHERE id = (SELECT session_id FROM session_rotated_tokens WHERE token_hash = $2)
+         AND revoked_at IS NULL AND expires_at > $1`,
+		now, refreshTokenHash,
+	)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrSessionInvalid
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return err
+	}
+
+	return ErrRefreshTokenReused
+}
+
+// IsActive сообщает, что сессия не отозвана и не истекла
+func (r *SessionRepository) IsActive(sessionID int64) (bool, error) {
+	var active bool
+
+	err := database.DB.QueryRow(
+		context.Background(),
+		`SELECT EXISTS (SELECT 1 FROM sessions WHERE id = $1 AND revoked_at IS NULL AND expires_at > $2)`,
+		sessionID, time.Now(),
+	).Scan(&active)
+
+	return active, err
+}
+
+// FindActiveByUserID возвращает действующие сессии пользователя, начиная с последних использованных
+func (r *SessionRepository) FindActiveByUserID(userID int64) ([]models.Session, error) {
+	rows, err := database.DB.Query(
+		context.Background(),
+		`SELECT `+sessionColumns+` FROM sessions
+         WHERE user_id = $1 AND revoked_at IS NULL AND expires_at > $2
+         ORDER BY last_used_at DESC`,
+		userID, time.Now(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var sessions []models.Session
+	for rows.Next() {
+		session, err := scanSession(rows)
+		if err != nil {
+			return nil, err
+		}
+		sessions = append(sessions, *session)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return sessions, nil
+}
+
+// RevokeByToken отзывает сессию по обновляющему токену
+func (r *SessionRepository) RevokeByToken(refreshTokenHash string) error {
+	tag, err := database.DB.Exec(
+		context.Background(),
+		`UPDATE sessions SET revoked_at = $1 WHERE refresh_token_hash = $2 AND revoked_at IS NULL`,
+		time.Now(), refreshTokenHash,
+	)
+	if err != nil {
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return ErrSessionInvalid
+	}
+
+	return nil
+}
+
+// RevokeAllByUserID отзывает все действующие сессии пользователя.
+// Возвращает число отозванных сессий.
+func (r *SessionRepository) RevokeAllByUserID(userID int64) (int, error) {
+	tag, err := database.DB.Exec(
+		context.Background(),
+		`UPDATE sessions SET revoked_at = $1 WHERE user_id = $2 AND revoked_at IS NULL`,
+		time.Now(), userID,
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	return int(tag.RowsAffected()), nil
+}
+
+// DeleteStaleRotatedTokens удаляет хэши замененных токенов закончившихся сессий:
+// повторное предъявление такого токена отклоняется и без них, потому что
+// отозванную или истекшую сессию продлить нельзя. Возвращает число удаленных хэшей.
+func (r *SessionRepository) DeleteStaleRotatedTokens() (int, error) {
+	tag, err := database.DB.Exec(
+		context.Background(),
+		`DELETE FROM session_rotated_tokens t
+         USING sessions s
+         WHERE s.id = t.session_id AND (s.revoked_at IS NOT NULL OR s.expires_at <= $1)`,
+		time.Now(),
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	return int(tag.RowsAffected()), nil
+}
@@ -0,0 +1,119 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/trofimovm/summvideo/database"
+	"github.com/trofimovm/summvideo/models"
+	"github.com/trofimovm/summvideo/utils"
+)
+
+// Ключи настроек, изменяемых администратором
+const (
+	SettingTranscriptionCacheTTL = "transcription_cache_ttl_hours"
+	SettingAllowedContainers     = "allowed_containers"
+)
+
+// SettingsRepository предоставляет методы для работы с настройками сервиса
+type SettingsRepository struct{}
+
+// GetInt возвращает целочисленное значение настройки или defaultValue,
+// если настройка не задана
+func (r *SettingsRepository) GetInt(key string, defaultValue int) (int, error) {
+	var value string
+
+	err := database.DB.QueryRow(
+		context.Background(),
+		`SELECT value FROM settings WHERE key = $1`,
+		key,
+	).Scan(&value)
+
+	if errors.Is(err, pgx.ErrNoRows) {
+		return defaultValue, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	return strconv.Atoi(value)
+}
+
+// SetInt сохраняет целочисленное значение настройки
+func (r *SettingsRepository) SetInt(key string, value int) error {
+	_, err := database.DB.Exec(
+		context.Background(),
+		`INSERT INTO settings (key, value, updated_at)
+         VALUES ($1, $2, $3)
+         ON CONFLICT (key) DO UPDATE SET value = EXCLUDED.value, updated_at = EXCLUDED.updated_at`,
+		key, strconv.Itoa(value), time.Now(),
+	)
+
+	return err
+}
+
+// GetString возвращает строковое значение настройки или defaultValue,
+// если настройка не задана
+func (r *SettingsRepository) GetString(key string, defaultValue string) (string, error) {
+	var value string
+
+	err := database.DB.QueryRow(
+		context.Background(),
+		`SELECT value FROM settings WHERE key = $1`,
+		key,
+	).Scan(&value)
+
+	if errors.Is(err, pgx.ErrNoRows) {
+		return defaultValue, nil
+	}
+	if err != nil {
+		return "", err
+	}
+
+	return value, nil
+}
+
+// SetString сохраняет строковое значение настройки
+func (r *SettingsRepository) SetString(key string, value string) error {
+	_, err := database.DB.Exec(
+		context.Background(),
+		`INSERT INTO settings (key, value, updated_at)
+         VALUES ($1, $2, $3)
+         ON CONFLICT (key) DO UPDATE SET value = EXCLUDED.value, updated_at = EXCLUDED.updated_at`,
+		key, value, time.Now(),
+	)
+
+	return err
+}
+
+// AllowedContainers возвращает контейнеры, которые можно загружать: список,
+// заданный администратором, или ALLOWED_CONTAINERS через запятую. Если ни то,
+// ни другое не задано, разрешены все поддерживаемые контейнеры.
+func (r *SettingsRepository) AllowedContainers() ([]string, error) {
+	value, err := r.GetString(SettingAllowedContainers, utils.GetEnv("ALLOWED_CONTAINERS", ""))
+	if err != nil {
+		return nil, err
+	}
+
+	var containers []string
+	for _, container := range strings.Split(value, ",") {
+		if container = strings.TrimSpace(container); container != "" {
+			containers = append(containers, container)
+		}
+	}
+
+	if len(containers) == 0 {
+		return models.SupportedContainers, nil
+	}
+
+	return containers, nil
+}
+
+// SetAllowedContainers сохраняет список контейнеров, которые можно загружать
+func (r *SettingsRepository) SetAllowedContainers(containers []string) error {
+	return r.SetString(SettingAllowedContainers, strings.Join(containers, ","))
+}
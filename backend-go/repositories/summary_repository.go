@@ -0,0 +1,68 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/trofimovm/summvideo/database"
+	"github.com/trofimovm/summvideo/models"
+)
+
+// SummaryRepository предоставляет методы для работы с дополнительными саммари
+type SummaryRepository struct{}
+
+// Create сохраняет новое саммари для результата обработки
+func (r *SummaryRepository) Create(resultID int64, promptText, summary, summaryModel, summaryStrategy string, processingTime int) (*models.Summary, error) {
+	var created models.Summary
+
+	err := database.DB.QueryRow(
+		context.Background(),
+		`INSERT INTO summaries (result_id, prompt_text, summary, summary_model, summary_strategy, processing_time)
+         VALUES ($1, $2, $3, $4, $5, $6)
+         RETURNING id, result_id, prompt_text, summary, summary_model, summary_strategy, processing_time, created_at`,
+		resultID, promptText, summary, summaryModel, summaryStrategy, processingTime,
+	).Scan(
+		&created.ID, &created.ResultID, &created.PromptText, &created.Summary,
+		&created.SummaryModel, &created.SummaryStrategy, &created.ProcessingTime, &created.CreatedAt,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &created, nil
+}
+
+// FindByResultID возвращает все дополнительные саммари результата в порядке создания
+func (r *SummaryRepository) FindByResultID(resultID int64) ([]models.Summary, error) {
+	rows, err := database.DB.Query(
+		context.Background(),
+		`SELECT id, result_id, COALESCE(prompt_text, ''), COALESCE(summary, ''),
+         COALESCE(summary_model, ''), COALESCE(summary_strategy, ''), COALESCE(processing_time, 0), created_at
+         FROM summaries
+         WHERE result_id = $1
+         ORDER BY created_at`,
+		resultID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var summaries []models.Summary
+	for rows.Next() {
+		var summary models.Summary
+		if err := rows.Scan(
+			&summary.ID, &summary.ResultID, &summary.PromptText, &summary.Summary,
+			&summary.SummaryModel, &summary.SummaryStrategy, &summary.ProcessingTime, &summary.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		summaries = append(summaries, summary)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return summaries, nil
+}
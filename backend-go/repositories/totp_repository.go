@@ -0,0 +1,315 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/trofimovm/summvideo/database"
+	"github.com/trofimovm/summvideo/models"
+)
+
+// maxChallengeAttempts ограничивает число попыток ввести код второго фактора
+const maxChallengeAttempts = 5
+
+// ErrSecondFactorLocked возвращается, если вход со вторым фактором временно
+// заблокирован после серии неверных кодов
+var ErrSecondFactorLocked = errors.New("слишком много неверных кодов, вход временно заблокирован")
+
+// ErrChallengeInvalid возвращается, если токен входа не найден, истек,
+// уже использован или исчерпал попытки
+var ErrChallengeInvalid = errors.New("вход не начат или истек, войдите заново")
+
+// TOTPRepository предоставляет методы для двухфакторной аутентификации администраторов
+type TOTPRepository struct{}
+
+// FindByUserID возвращает состояние TOTP пользователя
+func (r *TOTPRepository) FindByUserID(userID int64) (*models.TOTPState, error) {
+	var state models.TOTPState
+
+	err := database.DB.QueryRow(
+		context.Background(),
+		`SELECT COALESCE(totp_secret, ''), totp_enabled, totp_last_step,
+         (SELECT COUNT(*) FROM admin_recovery_codes WHERE user_id = $1 AND used_at IS NULL)
+         FROM users WHERE id = $1`,
+		userID,
+	).Scan(&state.Secret, &state.Enabled, &state.LastStep, &state.RecoveryCodesLeft)
+	if err != nil {
+		return nil, err
+	}
+
+	return &state, nil
+}
+
+// SetPendingSecret сохраняет новый секрет, который вступит в силу после
+// подтверждения первым кодом. Для включенной 2FA секрет не меняется.
+func (r *TOTPRepository) SetPendingSecret(userID int64, secret string) error {
+	tag, err := database.DB.Exec(
+		context.Background(),
+		`UPDATE users SET totp_secret = $1, totp_last_step = NULL, updated_at = $2
+         WHERE id = $3 AND totp_enabled = FALSE`,
+		secret, time.Now(), userID,
+	)
+	if err != nil {
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
+
+	return nil
+}
+
+// Enable включает 2FA после проверки первого кода и сохраняет коды восстановления
+func (r *TOTPRepository) Enable(userID, step int64, recoveryCodeHashes []string) error {
+	ctx := context.Background()
+
+	tx, err := database.DB.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	tag, err := tx.Exec(
+		ctx,
+		`UPDATE users SET totp_enabled = TRUE, totp_last_step = $1, updated_at = $2
+         WHERE id = $3 AND totp_secret IS NOT NULL AND totp_enabled = FALSE`,
+		step, time.Now(), userID,
+	)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
+
+	if err := replaceRecoveryCodes(ctx, tx, userID, recoveryCodeHashes); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+// Disable выключает 2FA, удаляя секрет и коды восстановления, и снимает блокировку входа
+func (r *TOTPRepository) Disable(userID int64) error {
+	ctx := context.Background()
+
+	tx, err := database.DB.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	_, err = tx.Exec(
+		ctx,
+		`UPDATE users SET totp_secret = NULL, totp_enabled = FALSE, totp_last_step = NULL,
+         totp_failed_attempts = 0, totp_locked_until = NULL, updated_at = $1
+         WHERE id = $2`,
+		time.Now(), userID,
+	)
+	if err != nil {
+		return err
+	}
+
+	if err := replaceRecoveryCodes(ctx, tx, userID, nil); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+// ReplaceRecoveryCodes заменяет все коды восстановления пользователя новыми
+func (r *TOTPRepository) ReplaceRecoveryCodes(userID int64, recoveryCodeHashes []string) error {
+	ctx := context.Background()
+
+	tx, err := database.DB.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	if err := replaceRecoveryCodes(ctx, tx, userID, recoveryCodeHashes); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+// replaceRecoveryCodes удаляет коды восстановления пользователя и сохраняет новые в транзакции tx
+func replaceRecoveryCodes(ctx context.Context, tx pgx.Tx, userID int64, recoveryCodeHashes []string) error {
+	if _, err := tx.Exec(ctx, `DELETE FROM admin_recovery_codes WHERE user_id = $1`, userID); err != nil {
+		return err
+	}
+
+	for _, codeHash := range recoveryCodeHashes {
+		_, err := tx.Exec(
+			ctx,
+			`INSERT INTO admin_recovery_codes (user_id, code_hash) VALUES ($1, $2)`,
+			userID, codeHash,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// UseStep отмечает интервал TOTP использованным. Возвращает false, если код
+// из этого или более позднего интервала уже был принят.
+func (r *TOTPRepository) UseStep(userID, step int64) (bool, error) {
+	tag, err := database.DB.Exec(
+		context.Background(),
+		`UPDATE users SET totp_last_step = $1
+         WHERE id = $2 AND (totp_last_step IS NULL OR totp_last_step < $1)`,
+		step, userID,
+	)
+	if err != nil {
+		return false, err
+	}
+
+	return tag.RowsAffected() > 0, nil
+}
+
+// UseRecoveryCode погашает код восстановления. Возвращает false, если код
+// не найден или уже использован.
+func (r *TOTPRepository) UseRecoveryCode(userID int64, codeHash string) (bool, error) {
+	tag, err := database.DB.Exec(
+		context.Background(),
+		`UPDATE admin_recovery_codes SET used_at = $1
+         WHERE user_id = $2 AND code_hash = $3 AND used_at IS NULL`,
+		time.Now(), userID, codeHash,
+	)
+	if err != nil {
+		return false, err
+	}
+
+	return tag.RowsAffected() > 0, nil
+}
+
+// CreateChallenge сохраняет токен незавершенного входа и удаляет истекшие.
+// Пока вход пользователя заблокирован, возвращает ErrSecondFactorLocked.
+func (r *TOTPRepository) CreateChallenge(userID int64, tokenHash string, expiresAt time.Time) error {
+	ctx := context.Background()
+	now := time.Now()
+
+	_, err := database.DB.Exec(ctx, `DELETE FROM admin_login_challenges WHERE expires_at < $1`, now)
+	if err != nil {
+		return err
+	}
+
+	tag, err := database.DB.Exec(
+		ctx,
+		`INSERT INTO admin_login_challenges (user_id, token_hash, expires_at)
+         SELECT $1, $2, $3
+         WHERE NOT EXISTS (SELECT 1 FROM users WHERE id = $1 AND totp_locked_until > $4)`,
+		userID, tokenHash, expiresAt, now,
+	)
+	if err != nil {
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return ErrSecondFactorLocked
+	}
+
+	return nil
+}
+
+// AttemptChallenge учитывает попытку завершить вход и возвращает ID
+// пользователя и ID входа. После maxChallengeAttempts попыток, а также
+// пока вход пользователя заблокирован, вход недействителен.
+func (r *TOTPRepository) AttemptChallenge(tokenHash string) (int64, int64, error) {
+	var challengeID, userID int64
+
+	err := database.DB.QueryRow(
+		context.Background(),
+		`UPDATE admin_login_challenges SET attempts = attempts + 1
+         WHERE token_hash = $1 AND completed_at IS NULL AND expires_at > $2 AND attempts < $3
+         AND NOT EXISTS (SELECT 1 FROM users u WHERE u.id = admin_login_challenges.user_id AND u.totp_locked_until > $2)
+         RETURNING id, user_id`,
+		tokenHash, time.Now(), maxChallengeAttempts,
+	).Scan(&challengeID, &userID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return 0, 0, ErrChallengeInvalid
+	}
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return challengeID, userID, nil
+}
+
+// CompleteChallenge отмечает вход завершенным, чтобы токен нельзя было использовать повторно.
+// Возвращает ErrChallengeInvalid, если вход уже завершен параллельным запросом.
+func (r *TOTPRepository) CompleteChallenge(challengeID int64) error {
+	tag, err := database.DB.Exec(
+		context.Background(),
+		`UPDATE admin_login_challenges SET completed_at = $1 WHERE id = $2 AND completed_at IS NULL`,
+		time.Now(), challengeID,
+	)
+	if err != nil {
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return ErrChallengeInvalid
+	}
+
+	return nil
+}
+
+// RecordFailure учитывает неверный код второго фактора. Неверные коды считаются
+// по пользователю, а не по входу, поэтому новый вход по паролю не сбрасывает
+// счетчик. После maxFailures неверных кодов подряд вход блокируется на lockout,
+// незавершенные входы удаляются и возвращается true.
+func (r *TOTPRepository) RecordFailure(userID int64, maxFailures int, lockout time.Duration) (bool, error) {
+	ctx := context.Background()
+	now := time.Now()
+
+	tx, err := database.DB.Begin(ctx)
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback(ctx)
+
+	var locked bool
+	err = tx.QueryRow(
+		ctx,
+		`UPDATE users SET
+         totp_failed_attempts = CASE WHEN totp_failed_attempts + 1 >= $2 THEN 0 ELSE totp_failed_attempts + 1 END,
+         totp_locked_until = CASE WHEN totp_failed_attempts + 1 >= $2 THEN $3 ELSE totp_locked_until END
+         WHERE id = $1
+         RETURNING COALESCE(totp_locked_until > $4, FALSE)`,
+		userID, maxFailures, now.Add(lockout), now,
+	).Scan(&locked)
+	if err != nil {
+		return false, err
+	}
+
+	if locked {
+		_, err = tx.Exec(
+			ctx,
+			`DELETE FROM admin_login_challenges WHERE user_id = $1 AND completed_at IS NULL`,
+			userID,
+		)
+		if err != nil {
+			return false, err
+		}
+	}
+
+	return locked, tx.Commit(ctx)
+}
+
+// ResetFailures обнуляет счетчик неверных кодов после успешного входа
+func (r *TOTPRepository) ResetFailures(userID int64) error {
+	_, err := database.DB.Exec(
+		context.Background(),
+		`UPDATE users SET totp_failed_attempts = 0 WHERE id = $1`,
+		userID,
+	)
+
+	return err
+}
@@ -0,0 +1,123 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/trofimovm/summvideo/database"
+	"github.com/trofimovm/summvideo/models"
+	"github.com/trofimovm/summvideo/utils"
+)
+
+// TranscriptionCacheRepository предоставляет методы для работы с кэшем транскрипций.
+// Запись кэша определяется хешем содержимого файла, моделью и языком распознавания.
+type TranscriptionCacheRepository struct{}
+
+// TTL возвращает срок жизни записей кэша. Значение задается администратором,
+// по умолчанию берется из TRANSCRIPTION_CACHE_TTL_HOURS. Ноль отключает кэш.
+func (r *TranscriptionCacheRepository) TTL() (time.Duration, error) {
+	settingsRepo := SettingsRepository{}
+	hours, err := settingsRepo.GetInt(
+		SettingTranscriptionCacheTTL,
+		utils.GetEnvInt("TRANSCRIPTION_CACHE_TTL_HOURS", 720),
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	return time.Duration(hours) * time.Hour, nil
+}
+
+// Find ищет транскрипцию, сохраненную не раньше чем ttl назад, и отмечает
+// её использование. Если записи нет, возвращает pgx.ErrNoRows.
+func (r *TranscriptionCacheRepository) Find(contentHash, model, language string, ttl time.Duration) (*models.Transcription, error) {
+	var transcription models.Transcription
+	now := time.Now()
+
+	err := database.DB.QueryRow(
+		context.Background(),
+		`UPDATE transcription_cache
+         SET hits = hits + 1, last_used_at = $1
+         WHERE content_hash = $2 AND transcription_model = $3 AND language = $4 AND created_at > $5
+         RETURNING COALESCE(transcription, ''), COALESCE(segments, '[]'::jsonb), COALESCE(detected_language, '')`,
+		now, contentHash, model, language, now.Add(-ttl),
+	).Scan(&transcription.Text, &transcription.Segments, &transcription.Language)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &transcription, nil
+}
+
+// Save сохраняет транскрипцию в кэш, заменяя устаревшую запись с тем же ключом
+func (r *TranscriptionCacheRepository) Save(contentHash, model, language string, transcription *models.Transcription) error {
+	now := time.Now()
+
+	_, err := database.DB.Exec(
+		context.Background(),
+		`INSERT INTO transcription_cache (content_hash, transcription_model, language,
+         transcription, segments, detected_language, created_at, last_used_at)
+         VALUES ($1, $2, $3, $4, $5, $6, $7, $7)
+         ON CONFLICT (content_hash, transcription_model, language) DO UPDATE
+         SET transcription = EXCLUDED.transcription, segments = EXCLUDED.segments,
+         detected_language = EXCLUDED.detected_language, hits = 0,
+         created_at = EXCLUDED.created_at, last_used_at = EXCLUDED.last_used_at`,
+		contentHash, model, language, transcription.Text, transcription.Segments, transcription.Language, now,
+	)
+
+	return err
+}
+
+// GetStats возвращает число записей кэша, из них устаревших, и суммарное число попаданий
+func (r *TranscriptionCacheRepository) GetStats(ttl time.Duration) (entries, expired int, hits int64, err error) {
+	err = database.DB.QueryRow(
+		context.Background(),
+		`SELECT COUNT(*), COUNT(*) FILTER (WHERE created_at <= $1), COALESCE(SUM(hits), 0)
+         FROM transcription_cache`,
+		time.Now().Add(-ttl),
+	).Scan(&entries, &expired, &hits)
+
+	return entries, expired, hits, err
+}
+
+// PurgeExpired удаляет записи старше ttl и возвращает их количество
+func (r *TranscriptionCacheRepository) PurgeExpired(ttl time.Duration) (int64, error) {
+	tag, err := database.DB.Exec(
+		context.Background(),
+		`DELETE FROM transcription_cache WHERE created_at <= $1`,
+		time.Now().Add(-ttl),
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	return tag.RowsAffected(), nil
+}
+
+// PurgeByHash удаляет все записи для файла с указанным хешем
+func (r *TranscriptionCacheRepository) PurgeByHash(contentHash string) (int64, error) {
+	tag, err := database.DB.Exec(
+		context.Background(),
+		`DELETE FROM transcription_cache WHERE content_hash = $1`,
+		contentHash,
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	return tag.RowsAffected(), nil
+}
+
+// PurgeAll очищает кэш полностью
+func (r *TranscriptionCacheRepository) PurgeAll() (int64, error) {
+	tag, err := database.DB.Exec(
+		context.Background(),
+		`DELETE FROM transcription_cache`,
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	return tag.RowsAffected(), nil
+}
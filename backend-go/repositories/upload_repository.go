@@ -0,0 +1,228 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/trofimovm/summvideo/database"
+	"github.com/trofimovm/summvideo/models"
+)
+
+// ErrUploadCompleted возвращается, если по загрузке уже создана задача
+var ErrUploadCompleted = errors.New("загрузка уже завершена")
+
+// ErrUploadLocked возвращается, если часть загрузки уже передается другим запросом
+// или блокировка запроса истекла и перехвачена
+var ErrUploadLocked = errors.New("часть этой загрузки уже передается")
+
+// UploadRepository предоставляет методы для работы с возобновляемыми загрузками
+type UploadRepository struct{}
+
+// uploadColumns перечисляет поля загрузки в порядке, ожидаемом scanUpload
+const uploadColumns = `id, user_id, file_name, file_path, size, received, checksum, prompt_text,
+         COALESCE(summary_strategy, ''), job_id, created_at, updated_at, expires_at`
+
+// scanUpload считывает загрузку из строки результата запроса
+func scanUpload(row pgx.Row) (*models.Upload, error) {
+	var upload models.Upload
+
+	err := row.Scan(
+		&upload.ID, &upload.UserID, &upload.FileName, &upload.FilePath, &upload.Size, &upload.Offset,
+		&upload.Checksum, &upload.PromptText, &upload.SummaryStrategy, &upload.JobID,
+		&upload.CreatedAt, &upload.UpdatedAt, &upload.ExpiresAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &upload, nil
+}
+
+// Create сохраняет новую загрузку
+func (r *UploadRepository) Create(upload *models.Upload) (*models.Upload, error) {
+	return scanUpload(database.DB.QueryRow(
+		context.Background(),
+		`INSERT INTO uploads (user_id, file_name, file_path, size, checksum, prompt_text,
+         summary_strategy, expires_at)
+         VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+         RETURNING `+uploadColumns,
+		upload.UserID, upload.FileName, upload.FilePath, upload.Size, upload.Checksum, upload.PromptText,
+		upload.SummaryStrategy, upload.ExpiresAt,
+	))
+}
+
+// FindByID ищет неистекшую загрузку пользователя. Если загрузки нет,
+// возвращает pgx.ErrNoRows.
+func (r *UploadRepository) FindByID(id, userID int64) (*models.Upload, error) {
+	return scanUpload(database.DB.QueryRow(
+		context.Background(),
+		`SELECT `+uploadColumns+` FROM uploads
+         WHERE id = $1 AND user_id = $2 AND expires_at > $3`,
+		id, userID, time.Now(),
+	))
+}
+
+// Lock блокирует незавершенную загрузку на время lease для записи части.
+// Блокировка хранится в БД, поэтому действует для всех экземпляров сервиса.
+// Возвращает загрузку в состоянии на момент блокировки. Если загрузка уже
+// заблокирована, возвращает ErrUploadLocked, если завершена — ErrUploadCompleted.
+func (r *UploadRepository) Lock(id int64, token string, lease time.Duration) (*models.Upload, error) {
+	now := time.Now()
+
+	upload, err := scanUpload(database.DB.QueryRow(
+		context.Background(),
+		`UPDATE uploads SET lock_token = $1, locked_until = $2
+         WHERE id = $3 AND job_id IS NULL AND (locked_until IS NULL OR locked_until <= $4)
+         RETURNING `+uploadColumns,
+		token, now.Add(lease), id, now,
+	))
+	if !errors.Is(err, pgx.ErrNoRows) {
+		return upload, err
+	}
+
+	var completed bool
+	err = database.DB.QueryRow(
+		context.Background(),
+		`SELECT job_id IS NOT NULL FROM uploads WHERE id = $1`,
+		id,
+	).Scan(&completed)
+	if err != nil {
+		return nil, err
+	}
+	if completed {
+		return nil, ErrUploadCompleted
+	}
+	return nil, ErrUploadLocked
+}
+
+// ExtendLock продлевает блокировку, полученную в Lock. Если блокировка
+// истекла и перехвачена другим запросом, возвращает ErrUploadLocked.
+func (r *UploadRepository) ExtendLock(id int64, token string, lease time.Duration) error {
+	tag, err := database.DB.Exec(
+		context.Background(),
+		`UPDATE uploads SET locked_until = $1 WHERE id = $2 AND lock_token = $3`,
+		time.Now().Add(lease), id, token,
+	)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrUploadLocked
+	}
+
+	return nil
+}
+
+// Unlock снимает блокировку, полученную в Lock
+func (r *UploadRepository) Unlock(id int64, token string) error {
+	_, err := database.DB.Exec(
+		context.Background(),
+		`UPDATE uploads SET lock_token = NULL, locked_until = NULL WHERE id = $1 AND lock_token = $2`,
+		id, token,
+	)
+
+	return err
+}
+
+// SetOffset сохраняет число полученных байт, продлевает срок жизни загрузки
+// и снимает блокировку, полученную в Lock. Если блокировка истекла и
+// перехвачена другим запросом, возвращает ErrUploadLocked.
+func (r *UploadRepository) SetOffset(id int64, token string, offset int64, expiresAt time.Time) error {
+	tag, err := database.DB.Exec(
+		context.Background(),
+		`UPDATE uploads
+         SET received = $1, expires_at = $2, updated_at = $3, lock_token = NULL, locked_until = NULL
+         WHERE id = $4 AND lock_token = $5 AND job_id IS NULL`,
+		offset, expiresAt, time.Now(), id, token,
+	)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrUploadLocked
+	}
+
+	return nil
+}
+
+// Delete удаляет загрузку, если её часть сейчас не передается.
+// Иначе возвращает ErrUploadLocked.
+func (r *UploadRepository) Delete(id int64) error {
+	now := time.Now()
+
+	tag, err := database.DB.Exec(
+		context.Background(),
+		`DELETE FROM uploads WHERE id = $1 AND (locked_until IS NULL OR locked_until <= $2)`,
+		id, now,
+	)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrUploadLocked
+	}
+
+	return nil
+}
+
+// DeleteExpired удаляет истекшие загрузки и возвращает пути к файлам тех из них,
+// по которым задача не создана. Файлы завершенных загрузок принадлежат задачам.
+func (r *UploadRepository) DeleteExpired() ([]string, error) {
+	rows, err := database.DB.Query(
+		context.Background(),
+		`DELETE FROM uploads WHERE expires_at <= $1
+         RETURNING file_path, job_id IS NULL`,
+		time.Now(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var paths []string
+	for rows.Next() {
+		var path string
+		var incomplete bool
+		if err := rows.Scan(&path, &incomplete); err != nil {
+			return nil, err
+		}
+		if incomplete {
+			paths = append(paths, path)
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return paths, nil
+}
+
+// FindIncompletePaths возвращает пути к файлам незавершенных загрузок
+func (r *UploadRepository) FindIncompletePaths() ([]string, error) {
+	rows, err := database.DB.Query(
+		context.Background(),
+		`SELECT file_path FROM uploads WHERE job_id IS NULL`,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var paths []string
+	for rows.Next() {
+		var path string
+		if err := rows.Scan(&path); err != nil {
+			return nil, err
+		}
+		paths = append(paths, path)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return paths, nil
+}
@@ -3,6 +3,7 @@ package repositories
 import (
 	"context"
 
+	"github.com/jackc/pgx/v4"
 	"github.com/trofimovm/summvideo/database"
 	"github.com/trofimovm/summvideo/models"
 )
@@ -10,21 +11,21 @@ import (
 // UsageRepository предоставляет методы для работы с историей использования
 type UsageRepository struct{}
 
-// Create создает новую запись об использовании сервиса
-func (r *UsageRepository) Create(userID int64, videoName, promptText string, summaryLength, processingTime int) (*models.UsageHistory, error) {
+// usageColumns перечисляет поля записи истории в порядке, ожидаемом scanUsage
+const usageColumns = `id, user_id, video_name, prompt_text, summary_length, COALESCE(media_seconds, 0), processing_time,
+         status, COALESCE(error_class, ''), COALESCE(error_message, ''), refunded_seconds, COALESCE(refund_rule, ''),
+         COALESCE(source_url, ''), created_at`
+
+// scanUsage считывает запись истории из строки результата запроса
+func scanUsage(row pgx.Row) (*models.UsageHistory, error) {
 	var usage models.UsageHistory
 
-	err := database.DB.QueryRow(
-		context.Background(),
-		`INSERT INTO usage_history (user_id, video_name, prompt_text, summary_length, processing_time) 
-         VALUES ($1, $2, $3, $4, $5) 
-         RETURNING id, user_id, video_name, prompt_text, summary_length, processing_time, created_at`,
-		userID, videoName, promptText, summaryLength, processingTime,
-	).Scan(
+	err := row.Scan(
 		&usage.ID, &usage.UserID, &usage.VideoName, &usage.PromptText,
-		&usage.SummaryLength, &usage.ProcessingTime, &usage.CreatedAt,
+		&usage.SummaryLength, &usage.MediaSeconds, &usage.ProcessingTime,
+		&usage.Status, &usage.ErrorClass, &usage.ErrorMessage, &usage.RefundedSeconds, &usage.RefundRule,
+		&usage.SourceURL, &usage.CreatedAt,
 	)
-
 	if err != nil {
 		return nil, err
 	}
@@ -32,11 +33,39 @@ func (r *UsageRepository) Create(userID int64, videoName, promptText string, sum
 	return &usage, nil
 }
 
+// Create создает новую запись об использовании сервиса. MediaSeconds — списанная
+// длительность записи, ProcessingTime — фактическое время обработки. Для неудачных
+// обработок заполняются класс ошибки и возвращенное пользователю время.
+func (r *UsageRepository) Create(usage *models.UsageHistory) (*models.UsageHistory, error) {
+	if usage.Status == "" {
+		usage.Status = models.UsageStatusCompleted
+	}
+
+	return scanUsage(database.DB.QueryRow(
+		context.Background(),
+		`INSERT INTO usage_history (user_id, video_name, prompt_text, summary_length, media_seconds, processing_time,
+         status, error_class, error_message, refunded_seconds, refund_rule, source_url)
+         VALUES ($1, $2, $3, $4, $5, $6, $7, NULLIF($8, ''), NULLIF($9, ''), $10, NULLIF($11, ''), NULLIF($12, ''))
+         RETURNING `+usageColumns,
+		usage.UserID, usage.VideoName, usage.PromptText, usage.SummaryLength, usage.MediaSeconds, usage.ProcessingTime,
+		usage.Status, usage.ErrorClass, usage.ErrorMessage, usage.RefundedSeconds, usage.RefundRule, usage.SourceURL,
+	))
+}
+
+// FindByID ищет запись об использовании по ID
+func (r *UsageRepository) FindByID(id int64) (*models.UsageHistory, error) {
+	return scanUsage(database.DB.QueryRow(
+		context.Background(),
+		`SELECT `+usageColumns+` FROM usage_history WHERE id = $1`,
+		id,
+	))
+}
+
 // FindByUserID возвращает историю использования конкретным пользователем
 func (r *UsageRepository) FindByUserID(userID int64, limit, offset int) ([]models.UsageHistory, error) {
 	rows, err := database.DB.Query(
 		context.Background(),
-		`SELECT id, user_id, video_name, prompt_text, summary_length, processing_time, created_at 
+		`SELECT `+usageColumns+`
          FROM usage_history 
          WHERE user_id = $1 
          ORDER BY created_at DESC 
@@ -50,14 +79,11 @@ func (r *UsageRepository) FindByUserID(userID int64, limit, offset int) ([]model
 
 	var usageList []models.UsageHistory
 	for rows.Next() {
-		var usage models.UsageHistory
-		if err := rows.Scan(
-			&usage.ID, &usage.UserID, &usage.VideoName, &usage.PromptText,
-			&usage.SummaryLength, &usage.ProcessingTime, &usage.CreatedAt,
-		); err != nil {
+		usage, err := scanUsage(rows)
+		if err != nil {
 			return nil, err
 		}
-		usageList = append(usageList, usage)
+		usageList = append(usageList, *usage)
 	}
 
 	if err := rows.Err(); err != nil {
@@ -75,7 +101,7 @@ func (r *UsageRepository) GetTotalCount(userID int64) (int, error) {
 		"SELECT COUNT(*) FROM usage_history WHERE user_id = $1",
 		userID,
 	).Scan(&count)
-	
+
 	return count, err
 }
 
@@ -83,7 +109,7 @@ func (r *UsageRepository) GetTotalCount(userID int64) (int, error) {
 func (r *UsageRepository) GetRecentActivity(limit int) ([]models.UsageHistory, error) {
 	rows, err := database.DB.Query(
 		context.Background(),
-		`SELECT id, user_id, video_name, prompt_text, summary_length, processing_time, created_at 
+		`SELECT `+usageColumns+`
          FROM usage_history 
          ORDER BY created_at DESC 
          LIMIT $1`,
@@ -96,14 +122,11 @@ func (r *UsageRepository) GetRecentActivity(limit int) ([]models.UsageHistory, e
 
 	var usageList []models.UsageHistory
 	for rows.Next() {
-		var usage models.UsageHistory
-		if err := rows.Scan(
-			&usage.ID, &usage.UserID, &usage.VideoName, &usage.PromptText,
-			&usage.SummaryLength, &usage.ProcessingTime, &usage.CreatedAt,
-		); err != nil {
+		usage, err := scanUsage(rows)
+		if err != nil {
 			return nil, err
 		}
-		usageList = append(usageList, usage)
+		usageList = append(usageList, *usage)
 	}
 
 	if err := rows.Err(); err != nil {
@@ -111,4 +134,4 @@ func (r *UsageRepository) GetRecentActivity(limit int) ([]models.UsageHistory, e
 	}
 
 	return usageList, nil
-}
\ No newline at end of file
+}
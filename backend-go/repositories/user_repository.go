@@ -5,32 +5,35 @@ import (
 	"errors"
 	"time"
 
+	"github.com/jackc/pgx/v4"
 	"github.com/trofimovm/summvideo/database"
 	"github.com/trofimovm/summvideo/models"
+	"github.com/trofimovm/summvideo/utils"
 	"golang.org/x/crypto/bcrypt"
 )
 
 // UserRepository предоставляет методы для работы с пользователями в БД
 type UserRepository struct{}
 
-// FindByTelegramID ищет пользователя по ID Telegram
-func (r *UserRepository) FindByTelegramID(telegramID int64) (*models.User, error) {
+// userColumns перечисляет поля пользователя в порядке, ожидаемом scanUser.
+// Необязательные поля могут быть NULL: у администраторов с паролем нет данных
+// Telegram, а у пользователей Telegram нет пароля.
+const userColumns = `id, COALESCE(telegram_id, 0), COALESCE(username, ''), COALESCE(first_name, ''),
+         COALESCE(last_name, ''), COALESCE(photo_url, ''), COALESCE(auth_date, 0), COALESCE(hash, ''),
+         created_at, updated_at, last_login, COALESCE(is_active, FALSE), COALESCE(is_admin, FALSE),
+         COALESCE(usage_limit_secs, 0), COALESCE(usage_total_secs, 0), COALESCE(password_hash, ''),
+         must_change_password`
+
+// scanUser считывает пользователя из строки результата запроса
+func scanUser(row pgx.Row) (*models.User, error) {
 	var user models.User
 
-	err := database.DB.QueryRow(
-		context.Background(),
-		`SELECT id, telegram_id, username, first_name, last_name, photo_url, 
-         auth_date, hash, created_at, updated_at, last_login, is_active,
-         is_admin, usage_limit_secs, usage_total_secs, password_hash
-         FROM users WHERE telegram_id = $1`,
-		telegramID,
-	).Scan(
+	err := row.Scan(
 		&user.ID, &user.TelegramID, &user.Username, &user.FirstName, &user.LastName,
-		&user.PhotoURL, &user.AuthDate, &user.Hash, &user.CreatedAt, &user.UpdatedAt, 
-		&user.LastLogin, &user.IsActive, &user.IsAdmin, &user.UsageLimitSecs, 
-		&user.UsageTotalSecs, &user.PasswordHash,
+		&user.PhotoURL, &user.AuthDate, &user.Hash, &user.CreatedAt, &user.UpdatedAt,
+		&user.LastLogin, &user.IsActive, &user.IsAdmin, &user.UsageLimitSecs,
+		&user.UsageTotalSecs, &user.PasswordHash, &user.MustChangePassword,
 	)
-
 	if err != nil {
 		return nil, err
 	}
@@ -38,56 +41,35 @@ func (r *UserRepository) FindByTelegramID(telegramID int64) (*models.User, error
 	return &user, nil
 }
 
+// FindByTelegramID ищет пользователя по ID Telegram
+func (r *UserRepository) FindByTelegramID(telegramID int64) (*models.User, error) {
+	return scanUser(database.DB.QueryRow(
+		context.Background(),
+		`SELECT `+userColumns+` FROM users WHERE telegram_id = $1`,
+		telegramID,
+	))
+}
+
 // FindByUsername ищет пользователя по имени пользователя
 func (r *UserRepository) FindByUsername(username string) (*models.User, error) {
-	var user models.User
-
-	err := database.DB.QueryRow(
+	return scanUser(database.DB.QueryRow(
 		context.Background(),
-		`SELECT id, telegram_id, username, first_name, last_name, photo_url, 
-         auth_date, hash, created_at, updated_at, last_login, is_active,
-         is_admin, usage_limit_secs, usage_total_secs, password_hash
-         FROM users WHERE username = $1`,
+		`SELECT `+userColumns+` FROM users WHERE username = $1`,
 		username,
-	).Scan(
-		&user.ID, &user.TelegramID, &user.Username, &user.FirstName, &user.LastName,
-		&user.PhotoURL, &user.AuthDate, &user.Hash, &user.CreatedAt, &user.UpdatedAt, 
-		&user.LastLogin, &user.IsActive, &user.IsAdmin, &user.UsageLimitSecs, 
-		&user.UsageTotalSecs, &user.PasswordHash,
-	)
-
-	if err != nil {
-		return nil, err
-	}
-
-	return &user, nil
+	))
 }
 
 // Create создает нового пользователя в БД
 func (r *UserRepository) Create(user *models.TelegramAuthData) (*models.User, error) {
-	var newUser models.User
 	defaultUsageLimit := 3600 // 1 час в секундах
 
-	err := database.DB.QueryRow(
+	return scanUser(database.DB.QueryRow(
 		context.Background(),
 		`INSERT INTO users (telegram_id, username, first_name, last_name, photo_url, auth_date, hash, usage_limit_secs) 
          VALUES ($1, $2, $3, $4, $5, $6, $7, $8) 
-         RETURNING id, telegram_id, username, first_name, last_name, photo_url, 
-         auth_date, hash, created_at, updated_at, last_login, is_active,
-         is_admin, usage_limit_secs, usage_total_secs, password_hash`,
+         RETURNING `+userColumns,
 		user.ID, user.Username, user.FirstName, user.LastName, user.PhotoURL, user.AuthDate, user.Hash, defaultUsageLimit,
-	).Scan(
-		&newUser.ID, &newUser.TelegramID, &newUser.Username, &newUser.FirstName, &newUser.LastName,
-		&newUser.PhotoURL, &newUser.AuthDate, &newUser.Hash, &newUser.CreatedAt, &newUser.UpdatedAt, 
-		&newUser.LastLogin, &newUser.IsActive, &newUser.IsAdmin, &newUser.UsageLimitSecs, 
-		&newUser.UsageTotalSecs, &newUser.PasswordHash,
-	)
-
-	if err != nil {
-		return nil, err
-	}
-
-	return &newUser, nil
+	))
 }
 
 // UpdateLoginTime обновляет время последнего входа пользователя
@@ -105,27 +87,11 @@ func (r *UserRepository) UpdateLoginTime(telegramID int64) error {
 
 // FindByID ищет пользователя по ID в базе данных
 func (r *UserRepository) FindByID(id int64) (*models.User, error) {
-	var user models.User
-
-	err := database.DB.QueryRow(
+	return scanUser(database.DB.QueryRow(
 		context.Background(),
-		`SELECT id, telegram_id, username, first_name, last_name, photo_url, 
-         auth_date, hash, created_at, updated_at, last_login, is_active,
-         is_admin, usage_limit_secs, usage_total_secs, password_hash
-         FROM users WHERE id = $1`,
+		`SELECT `+userColumns+` FROM users WHERE id = $1`,
 		id,
-	).Scan(
-		&user.ID, &user.TelegramID, &user.Username, &user.FirstName, &user.LastName,
-		&user.PhotoURL, &user.AuthDate, &user.Hash, &user.CreatedAt, &user.UpdatedAt, 
-		&user.LastLogin, &user.IsActive, &user.IsAdmin, &user.UsageLimitSecs, 
-		&user.UsageTotalSecs, &user.PasswordHash,
-	)
-
-	if err != nil {
-		return nil, err
-	}
-
-	return &user, nil
+	))
 }
 
 // Deactivate деактивирует пользователя
@@ -143,7 +109,7 @@ func (r *UserRepository) Deactivate(telegramID int64) error {
 func (r *UserRepository) CreateOrUpdate(authData *models.TelegramAuthData) (*models.User, error) {
 	// Проверяем существует ли пользователь
 	existingUser, err := r.FindByTelegramID(authData.ID)
-	
+
 	// Если пользователь найден, обновляем информацию
 	if err == nil && existingUser != nil {
 		_, err := database.DB.Exec(
@@ -167,36 +133,98 @@ func (r *UserRepository) CreateOrUpdate(authData *models.TelegramAuthData) (*mod
 	return r.Create(authData)
 }
 
-// UpdateUsage обновляет использованное время пользователя
+// UpdateUsage обновляет использованное время пользователя и списывает его в журнале
 func (r *UserRepository) UpdateUsage(userID int64, additionalSeconds int) error {
-	_, err := database.DB.Exec(
-		context.Background(),
+	ctx := context.Background()
+
+	tx, err := database.DB.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	_, err = tx.Exec(
+		ctx,
 		`UPDATE users SET usage_total_secs = usage_total_secs + $1, updated_at = $2 WHERE id = $3`,
 		additionalSeconds, time.Now(), userID,
 	)
+	if err != nil {
+		return err
+	}
 
-	return err
+	err = appendLedgerEntry(ctx, tx, &models.LedgerEntry{
+		UserID:    userID,
+		Direction: models.LedgerDebit,
+		Seconds:   additionalSeconds,
+		Reason:    models.LedgerReasonUsage,
+	})
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
 }
 
-// UpdateUsageLimit обновляет лимит использования для пользователя
-func (r *UserRepository) UpdateUsageLimit(userID int64, limitSeconds int) error {
-	_, err := database.DB.Exec(
-		context.Background(),
+// UpdateUsageLimit устанавливает остаток квоты пользователя в текущем периоде.
+// Разница с текущим остатком периода (как его считает expireBalance: без
+// зарезервированного времени и бонусных начислений) записывается в журнал
+// как начисление или списание от имени adminID. Установленный остаток действует
+// до конца периода: при обновлении квоты он сгорает, как и обычный остаток.
+// Постоянно изменить квоту можно назначением плана. usage_limit_secs
+// сохраняется только для отображения последнего установленного значения.
+func (r *UserRepository) UpdateUsageLimit(userID int64, limitSeconds int, adminID int64) error {
+	ctx := context.Background()
+
+	tx, err := database.DB.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	tag, err := tx.Exec(
+		ctx,
 		`UPDATE users SET usage_limit_secs = $1, updated_at = $2 WHERE id = $3`,
 		limitSeconds, time.Now(), userID,
 	)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
 
-	return err
+	// Закончившийся период обновляется до расчета, чтобы остаток относился к текущему
+	if _, err := ensureCurrentPeriod(ctx, tx, userID); err != nil {
+		return err
+	}
+
+	balance, err := periodBalance(ctx, tx, userID)
+	if err != nil {
+		return err
+	}
+
+	entry := &models.LedgerEntry{
+		UserID:    userID,
+		Direction: models.LedgerCredit,
+		Seconds:   limitSeconds - balance,
+		Reason:    models.LedgerReasonAdminAdjustment,
+		AdminID:   &adminID,
+	}
+	if entry.Seconds < 0 {
+		entry.Direction, entry.Seconds = models.LedgerDebit, -entry.Seconds
+	}
+	if err := appendLedgerEntry(ctx, tx, entry); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
 }
 
 // GetAllUsers возвращает список всех пользователей (для админа)
 func (r *UserRepository) GetAllUsers(limit, offset int) ([]models.User, error) {
 	rows, err := database.DB.Query(
 		context.Background(),
-		`SELECT id, telegram_id, username, first_name, last_name, photo_url, 
-         auth_date, hash, created_at, updated_at, last_login, is_active,
-         is_admin, usage_limit_secs, usage_total_secs, password_hash
-         FROM users 
+		`SELECT `+userColumns+` FROM users 
          ORDER BY created_at DESC 
          LIMIT $1 OFFSET $2`,
 		limit, offset,
@@ -208,16 +236,11 @@ func (r *UserRepository) GetAllUsers(limit, offset int) ([]models.User, error) {
 
 	var users []models.User
 	for rows.Next() {
-		var user models.User
-		if err := rows.Scan(
-			&user.ID, &user.TelegramID, &user.Username, &user.FirstName, &user.LastName,
-			&user.PhotoURL, &user.AuthDate, &user.Hash, &user.CreatedAt, &user.UpdatedAt,
-			&user.LastLogin, &user.IsActive, &user.IsAdmin, &user.UsageLimitSecs,
-			&user.UsageTotalSecs, &user.PasswordHash,
-		); err != nil {
+		user, err := scanUser(rows)
+		if err != nil {
 			return nil, err
 		}
-		users = append(users, user)
+		users = append(users, *user)
 	}
 
 	if err := rows.Err(); err != nil {
@@ -227,26 +250,174 @@ func (r *UserRepository) GetAllUsers(limit, offset int) ([]models.User, error) {
 	return users, nil
 }
 
-// GetRemainingUsageSeconds возвращает оставшееся время использования для пользователя в секундах
+// GetRemainingUsageSeconds возвращает оставшееся время использования для пользователя в секундах.
+// Остаток вычисляется по журналу в текущем периоде тарифного плана, время,
+// зарезервированное под обрабатываемые задачи, считается использованным.
 func (r *UserRepository) GetRemainingUsageSeconds(userID int64) (int, error) {
-	var limit, used int
-	
-	err := database.DB.QueryRow(
-		context.Background(),
-		`SELECT usage_limit_secs, usage_total_secs FROM users WHERE id = $1`,
-		userID,
-	).Scan(&limit, &used)
-	
+	planRepo := PlanRepository{}
+	quota, err := planRepo.GetQuota(userID)
+	if err != nil {
+		return 0, err
+	}
+
+	return quota.RemainingSeconds, nil
+}
+
+// Статусы резервирования лимита использования
+const (
+	ReservationActive   = "active"   // лимит зарезервирован под обрабатываемую задачу
+	ReservationSettled  = "settled"  // задача выполнена, списано фактическое время
+	ReservationReleased = "released" // задача не выполнена, резерв возвращен
+	ReservationExpired  = "expired"  // резерв не был погашен вовремя и снят
+)
+
+// ErrQuotaExceeded возвращается, если оставшегося лимита не хватает для резервирования
+var ErrQuotaExceeded = errors.New("недостаточно оставшегося лимита использования")
+
+// ReserveForProcessing резервирует seconds секунд лимита пользователя под
+// обработку. Резерв, не погашенный за QUOTA_RESERVATION_TTL_HOURS часов
+// (24 по умолчанию), снимается автоматически, например после аварийного
+// завершения обработчика.
+func (r *UserRepository) ReserveForProcessing(userID int64, seconds int) (int64, error) {
+	ttl := time.Duration(utils.GetEnvInt("QUOTA_RESERVATION_TTL_HOURS", 24)) * time.Hour
+	return r.ReserveUsage(userID, seconds, ttl)
+}
+
+// ReserveUsage резервирует seconds секунд лимита пользователя на время обработки.
+// Строка пользователя блокируется до конца транзакции, поэтому параллельные
+// загрузки не могут вместе зарезервировать больше оставшегося лимита.
+// Если лимита не хватает, возвращает ErrQuotaExceeded.
+func (r *UserRepository) ReserveUsage(userID int64, seconds int, ttl time.Duration) (int64, error) {
+	ctx := context.Background()
+
+	tx, err := database.DB.Begin(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback(ctx)
+
+	// Блокировка строки пользователя упорядочивает параллельные резервирования
+	_, err = tx.Exec(ctx, `SELECT 1 FROM users WHERE id = $1 FOR UPDATE`, userID)
+	if err != nil {
+		return 0, err
+	}
+
+	if _, err := ensureCurrentPeriod(ctx, tx, userID); err != nil {
+		return 0, err
+	}
+
+	balance, err := ledgerBalance(ctx, tx, userID)
 	if err != nil {
 		return 0, err
 	}
-	
-	remaining := limit - used
-	if remaining < 0 {
-		remaining = 0
+
+	reserved, err := reservedSeconds(ctx, tx, userID)
+	if err != nil {
+		return 0, err
+	}
+
+	if balance-reserved < seconds {
+		return 0, ErrQuotaExceeded
+	}
+
+	now := time.Now()
+	var reservationID int64
+	err = tx.QueryRow(
+		ctx,
+		`INSERT INTO usage_reservations (user_id, seconds, status, created_at, expires_at)
+         VALUES ($1, $2, $3, $4, $5)
+         RETURNING id`,
+		userID, seconds, ReservationActive, now, now.Add(ttl),
+	).Scan(&reservationID)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return 0, err
+	}
+
+	return reservationID, nil
+}
+
+// SettleReservation погашает резерв и списывает с пользователя фактическое время.
+// Списание выполняется и для резерва, снятого по истечении срока: задача всё же
+// была выполнена. Повторный вызов для уже погашенного резерва ничего не делает.
+func (r *UserRepository) SettleReservation(reservationID int64, actualSeconds int) error {
+	ctx := context.Background()
+
+	tx, err := database.DB.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	now := time.Now()
+	var userID int64
+	err = tx.QueryRow(
+		ctx,
+		`UPDATE usage_reservations
+         SET status = $1, settled_seconds = $2, finished_at = $3
+         WHERE id = $4 AND status IN ($5, $6)
+         RETURNING user_id`,
+		ReservationSettled, actualSeconds, now, reservationID, ReservationActive, ReservationExpired,
+	).Scan(&userID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(
+		ctx,
+		`UPDATE users SET usage_total_secs = usage_total_secs + $1, updated_at = $2 WHERE id = $3`,
+		actualSeconds, now, userID,
+	)
+	if err != nil {
+		return err
+	}
+
+	err = appendLedgerEntry(ctx, tx, &models.LedgerEntry{
+		UserID:        userID,
+		Direction:     models.LedgerDebit,
+		Seconds:       actualSeconds,
+		Reason:        models.LedgerReasonUsage,
+		ReservationID: &reservationID,
+	})
+	if err != nil {
+		return err
 	}
-	
-	return remaining, nil
+
+	return tx.Commit(ctx)
+}
+
+// ReleaseReservation возвращает зарезервированное время, если задача не выполнена
+func (r *UserRepository) ReleaseReservation(reservationID int64) error {
+	_, err := database.DB.Exec(
+		context.Background(),
+		`UPDATE usage_reservations SET status = $1, finished_at = $2 WHERE id = $3 AND status = $4`,
+		ReservationReleased, time.Now(), reservationID, ReservationActive,
+	)
+
+	return err
+}
+
+// ExpireStaleReservations снимает резервы, срок которых истек, например, оставшиеся
+// после аварийного завершения обработчика. Возвращает число снятых резервов.
+func (r *UserRepository) ExpireStaleReservations() (int64, error) {
+	now := time.Now()
+
+	tag, err := database.DB.Exec(
+		context.Background(),
+		`UPDATE usage_reservations SET status = $1, finished_at = $2 WHERE status = $3 AND expires_at < $2`,
+		ReservationExpired, now, ReservationActive,
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	return tag.RowsAffected(), nil
 }
 
 // CheckAdminCredentials проверяет учетные данные администратора
@@ -255,28 +426,32 @@ func (r *UserRepository) CheckAdminCredentials(username, password string) (*mode
 	if err != nil {
 		return nil, err
 	}
-	
+
 	if !user.IsAdmin {
 		return nil, errors.New("пользователь не является администратором")
 	}
-	
+
+	if !user.IsActive {
+		return nil, errors.New("учетная запись отключена")
+	}
+
 	// Проверяем пароль
 	err = bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(password))
 	if err != nil {
 		return nil, errors.New("неверный пароль")
 	}
-	
+
 	return user, nil
 }
 
 // GetTotalUsersCount возвращает общее количество пользователей
 func (r *UserRepository) GetTotalUsersCount() (int, error) {
 	var count int
-	
+
 	err := database.DB.QueryRow(
 		context.Background(),
 		`SELECT COUNT(*) FROM users`,
 	).Scan(&count)
-	
+
 	return count, err
-}
\ No newline at end of file
+}
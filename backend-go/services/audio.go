@@ -1,36 +1,342 @@
 package services
 
 import (
+	"bufio"
+	"bytes"
+	"context"
 	"fmt"
+	"math"
 	"os"
 	"os/exec"
 	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/trofimovm/summvideo/models"
+	"github.com/trofimovm/summvideo/utils"
 )
 
-// ExtractAudio извлекает аудио из видео файла
-func ExtractAudio(videoPath string) (string, error) {
-	// Создаем временный файл для аудио
-	audioFile := filepath.Join(os.TempDir(), fmt.Sprintf("%s.wav", filepath.Base(videoPath)))
+// ProgressFunc получает долю выполненной работы в диапазоне от 0 до 1
+type ProgressFunc func(fraction float64)
 
-	// Используем ffmpeg для извлечения аудио
-	cmd := exec.Command("ffmpeg", "-i", videoPath, "-vn", "-acodec", "pcm_s16le", "-ar", "44100", "-ac", "2", audioFile)
-	if err := cmd.Run(); err != nil {
-		return "", fmt.Errorf("ошибка извлечения аудио: %v", err)
+// Способы подготовки аудио для транскрибации, сохраняются в результате обработки
+const (
+	AudioPathPassthrough = "passthrough" // аудиофайл передан провайдеру без перекодирования
+	AudioPathTranscoded  = "transcoded"  // аудиофайл перекодирован
+	AudioPathExtracted   = "extracted"   // звуковая дорожка извлечена из видео
+	AudioPathCached      = "cached"      // транскрипция взята из кэша, аудио не обрабатывалось
+)
+
+// passthroughFormats перечисляет контейнеры и кодеки аудиофайлов, которые
+// провайдер транскрибации принимает как есть, и допустимые для них расширения.
+// Первое расширение используется, если у исходного файла другое.
+var passthroughFormats = map[string]map[string][]string{
+	models.ContainerMP3:  {"mp3": {".mp3"}},
+	models.ContainerMP4:  {"aac": {".m4a", ".mp4"}},
+	models.ContainerOgg:  {"opus": {".ogg", ".oga", ".opus"}, "vorbis": {".ogg", ".oga"}},
+	models.ContainerFLAC: {"flac": {".flac"}},
+}
+
+// PreparedAudio описывает аудиофайл, подготовленный для транскрибации
+type PreparedAudio struct {
+	Path string // путь к аудиофайлу
+	Mode string // способ подготовки, одна из констант AudioPath*
+}
+
+// PrepareAudio готовит аудио для транскрибации в рабочем каталоге задачи workDir.
+// Аудиофайлы с кодеком, который принимает провайдер, и битрейтом не выше
+// AUDIO_PASSTHROUGH_MAX_KBPS (по умолчанию 160) передаются без перекодирования.
+// Остальные файлы за один проход ffmpeg перекодируются в моно MP3 16 кГц,
+// которого достаточно для распознавания речи. Отмена ctx останавливает ffmpeg.
+func PrepareAudio(ctx context.Context, input, workDir string, progress ProgressFunc) (*PreparedAudio, error) {
+	// Задачи, загруженные до проверки файлов, могут не пройти её; их просто перекодируем
+	media, err := probeUploadedMedia(ctx, input)
+	if err == nil && !media.HasVideo {
+		if path, ok := passthroughAudio(input, workDir, media); ok {
+			if progress != nil {
+				progress(1)
+			}
+			return &PreparedAudio{Path: path, Mode: AudioPathPassthrough}, nil
+		}
+	}
+
+	mode := AudioPathTranscoded
+	if err != nil || media.HasVideo {
+		mode = AudioPathExtracted
 	}
 
-	return audioFile, nil
+	// Имя не зависит от имени исходного файла: каталог принадлежит одной задаче.
+	// -y перезаписывает файл, оставшийся от прерванной попытки обработки
+	mp3File := filepath.Join(workDir, "audio.mp3")
+	args := []string{
+		"-y", "-i", input, "-vn", "-sn", "-dn",
+		"-ac", "1", "-ar", "16000",
+		"-codec:a", "libmp3lame", "-b:a", "32k",
+		mp3File,
+	}
+	if err := runFFmpeg(ctx, input, args, progress); err != nil {
+		return nil, fmt.Errorf("ошибка подготовки аудио: %v", err)
+	}
+
+	return &PreparedAudio{Path: mp3File, Mode: mode}, nil
+}
+
+// probeUploadedMedia определяет контейнер и звуковую дорожку сохраненного файла
+func probeUploadedMedia(ctx context.Context, path string) (*MediaInfo, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	container, err := DetectContainer(file)
+	file.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	return InspectMedia(ctx, path, container)
 }
 
-// ConvertToMP3 конвертирует аудио файл в MP3 формат
-func ConvertToMP3(audioFile string) (string, error) {
-	// Создаем имя для MP3 файла
-	mp3File := filepath.Join(os.TempDir(), fmt.Sprintf("%s.mp3", filepath.Base(audioFile)))
+// passthroughAudio возвращает путь к аудиофайлу, который можно отправить
+// провайдеру без перекодирования. Если у исходного файла неподходящее
+// расширение, в workDir создается жесткая ссылка с нужным расширением.
+func passthroughAudio(input, workDir string, media *MediaInfo) (string, bool) {
+	extensions, ok := passthroughFormats[media.Container][media.AudioCodec]
+	if !ok {
+		return "", false
+	}
+
+	maxBitRate := utils.GetEnvInt("AUDIO_PASSTHROUGH_MAX_KBPS", 160) * 1000
+	if media.BitRate <= 0 || media.BitRate > maxBitRate {
+		return "", false
+	}
+
+	if slices.Contains(extensions, strings.ToLower(filepath.Ext(input))) {
+		return input, true
+	}
+
+	link := filepath.Join(workDir, "audio"+extensions[0])
+	os.Remove(link)
+	if err := os.Link(input, link); err != nil {
+		return "", false
+	}
+
+	return link, true
+}
+
+// ProbeDuration возвращает длительность медиафайла в секундах с помощью ffprobe
+func ProbeDuration(ctx context.Context, path string) (float64, error) {
+	out, err := exec.CommandContext(
+		ctx,
+		"ffprobe", "-v", "error",
+		"-show_entries", "format=duration",
+		"-of", "default=noprint_wrappers=1:nokey=1",
+		path,
+	).Output()
+	if err != nil {
+		return 0, fmt.Errorf("ошибка определения длительности: %v", err)
+	}
+
+	duration, err := strconv.ParseFloat(strings.TrimSpace(string(out)), 64)
+	if err != nil {
+		return 0, fmt.Errorf("ошибка разбора длительности: %v", err)
+	}
+
+	return duration, nil
+}
+
+// BillableSeconds возвращает длительность записи, списываемую с лимита
+// пользователя: неполная секунда округляется вверх
+func BillableSeconds(duration float64) int {
+	return int(math.Ceil(duration))
+}
+
+// ResummarySeconds возвращает время, списываемое за новое саммари сохраненной
+// транскрипции: percent процентов оплачиваемой длительности записи, округленные
+// вверх, но не меньше секунды. Списание не зависит от времени ответа провайдера.
+// При percent <= 0 новые саммари не списываются.
+func ResummarySeconds(duration float64, percent int) int {
+	if percent <= 0 {
+		return 0
+	}
+
+	return max((BillableSeconds(duration)*percent+99)/100, 1)
+}
 
-	// Используем ffmpeg для конвертации в MP3
-	cmd := exec.Command("ffmpeg", "-i", audioFile, "-codec:a", "libmp3lame", "-qscale:a", "2", "-b:a", "32k", mp3File)
+// AudioChunk описывает фрагмент аудио, вырезанный из исходного файла
+type AudioChunk struct {
+	Path  string  // путь к файлу фрагмента
+	Start float64 // начало фрагмента в исходном файле, секунды
+	Cut   float64 // граница с предыдущим фрагментом, до неё идет перекрытие
+	End   float64 // конец фрагмента в исходном файле, секунды
+}
+
+// SplitAudio делит аудио файл на фрагменты длительностью около segment,
+// соседние фрагменты перекрываются на overlap. Если splitOnSilence включен,
+// границы по возможности сдвигаются на ближайшую паузу перед целевой точкой.
+// Фрагменты сохраняются в dir без перекодирования. Отмена ctx останавливает ffmpeg.
+func SplitAudio(ctx context.Context, audioFile, dir string, segment, overlap time.Duration, splitOnSilence bool) ([]AudioChunk, error) {
+	duration, err := ProbeDuration(ctx, audioFile)
+	if err != nil {
+		return nil, err
+	}
+
+	segmentSecs := segment.Seconds()
+	overlapSecs := overlap.Seconds()
+	if segmentSecs <= 0 || duration <= segmentSecs+overlapSecs {
+		return []AudioChunk{{Path: audioFile, Start: 0, Cut: 0, End: duration}}, nil
+	}
+
+	var silences []float64
+	if splitOnSilence {
+		// Паузы нужны только для выбора границ, ошибка поиска не критична
+		silences, _ = detectSilences(ctx, audioFile)
+	}
+
+	cuts := splitPoints(duration, segmentSecs, silences)
+
+	ext := filepath.Ext(audioFile)
+	chunks := make([]AudioChunk, 0, len(cuts)-1)
+	for i := 0; i < len(cuts)-1; i++ {
+		start := math.Max(0, cuts[i]-overlapSecs)
+		end := cuts[i+1]
+
+		chunkPath := filepath.Join(dir, fmt.Sprintf("chunk_%03d%s", i, ext))
+		cmd := exec.CommandContext(
+			ctx,
+			"ffmpeg", "-y",
+			"-ss", strconv.FormatFloat(start, 'f', 3, 64),
+			"-i", audioFile,
+			"-t", strconv.FormatFloat(end-start, 'f', 3, 64),
+			"-c", "copy",
+			chunkPath,
+		)
+		if err := cmd.Run(); err != nil {
+			return nil, fmt.Errorf("ошибка нарезки аудио на фрагменты: %v", err)
+		}
+
+		chunks = append(chunks, AudioChunk{Path: chunkPath, Start: start, Cut: cuts[i], End: end})
+	}
+
+	return chunks, nil
+}
+
+// splitPoints вычисляет границы фрагментов, начиная с 0 и заканчивая duration.
+// Граница ставится на середину паузы, ближайшей к целевой точке, если пауза
+// находится не дальше пятой части сегмента перед ней.
+func splitPoints(duration, segment float64, silences []float64) []float64 {
+	window := segment / 5
+	cuts := []float64{0}
+
+	pos := 0.0
+	for pos+segment < duration {
+		target := pos + segment
+		cut := target
+		for _, silence := range silences {
+			// Паузы отсортированы по времени, последняя подходящая ближе всего к цели
+			if silence > pos && silence >= target-window && silence <= target {
+				cut = silence
+			}
+		}
+
+		cuts = append(cuts, cut)
+		pos = cut
+	}
+
+	return append(cuts, duration)
+}
+
+var (
+	silenceStartRe = regexp.MustCompile(`silence_start: (-?[0-9.]+)`)
+	silenceEndRe   = regexp.MustCompile(`silence_end: ([0-9.]+)`)
+)
+
+// detectSilences находит паузы в аудио с помощью фильтра silencedetect
+// и возвращает их середины в секундах
+func detectSilences(ctx context.Context, audioFile string) ([]float64, error) {
+	var stderr bytes.Buffer
+	cmd := exec.CommandContext(
+		ctx,
+		"ffmpeg", "-nostats", "-i", audioFile,
+		"-af", "silencedetect=noise=-35dB:d=0.5",
+		"-f", "null", "-",
+	)
+	cmd.Stderr = &stderr
 	if err := cmd.Run(); err != nil {
-		return "", fmt.Errorf("ошибка конвертации аудио в MP3: %v", err)
+		return nil, fmt.Errorf("ошибка поиска пауз: %v", err)
+	}
+
+	var midpoints []float64
+	start := -1.0
+	for _, line := range strings.Split(stderr.String(), "\n") {
+		if m := silenceStartRe.FindStringSubmatch(line); m != nil {
+			start, _ = strconv.ParseFloat(m[1], 64)
+			continue
+		}
+		if m := silenceEndRe.FindStringSubmatch(line); m != nil && start >= 0 {
+			end, _ := strconv.ParseFloat(m[1], 64)
+			midpoints = append(midpoints, math.Max(0, (start+end)/2))
+			start = -1
+		}
+	}
+
+	return midpoints, nil
+}
+
+// runFFmpeg запускает ffmpeg с указанными аргументами. Если передан progress,
+// ffmpeg выводит ход выполнения в stdout, а доля выполнения вычисляется
+// относительно длительности входного файла input.
+func runFFmpeg(ctx context.Context, input string, args []string, progress ProgressFunc) error {
+	if progress == nil {
+		return exec.CommandContext(ctx, "ffmpeg", args...).Run()
 	}
 
-	return mp3File, nil
-}
\ No newline at end of file
+	// Без длительности долю выполнения не вычислить, сообщаем только о завершении
+	duration, err := ProbeDuration(ctx, input)
+	if err != nil || duration <= 0 {
+		if err := exec.CommandContext(ctx, "ffmpeg", args...).Run(); err != nil {
+			return err
+		}
+		progress(1)
+		return nil
+	}
+
+	cmd := exec.CommandContext(ctx, "ffmpeg", append([]string{"-progress", "pipe:1", "-nostats"}, args...)...)
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return err
+	}
+	if err := cmd.Start(); err != nil {
+		return err
+	}
+
+	// ffmpeg выводит блоки key=value, время обработанного фрагмента в микросекундах
+	scanner := bufio.NewScanner(stdout)
+	for scanner.Scan() {
+		key, value, found := strings.Cut(scanner.Text(), "=")
+		if !found {
+			continue
+		}
+
+		switch key {
+		case "out_time_us", "out_time_ms":
+			microseconds, err := strconv.ParseInt(value, 10, 64)
+			if err != nil || microseconds < 0 {
+				continue
+			}
+			fraction := float64(microseconds) / 1e6 / duration
+			if fraction > 1 {
+				fraction = 1
+			}
+			progress(fraction)
+		case "progress":
+			if value == "end" {
+				progress(1)
+			}
+		}
+	}
+
+	return cmd.Wait()
+}
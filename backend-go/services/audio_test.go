@@ -0,0 +1,25 @@
+package services
+
+import "testing"
+
+func TestResummarySeconds(t *testing.T) {
+	tests := []struct {
+		duration float64
+		percent  int
+		want     int
+	}{
+		{3600, 10, 360},
+		{3600.2, 10, 361}, // 3601 с оплачиваемой длительности
+		{95, 10, 10},
+		{5, 10, 1},
+		{0, 10, 1}, // записи без измеренной длительности
+		{600, 100, 600},
+		{600, 0, 0},
+		{600, -5, 0},
+	}
+	for _, tt := range tests {
+		if got := ResummarySeconds(tt.duration, tt.percent); got != tt.want {
+			t.Errorf("ResummarySeconds(%v, %d) = %d, want %d", tt.duration, tt.percent, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,9 @@
+//go:build !linux && !darwin
+
+package services
+
+// freeDiskSpace на остальных платформах не определяет свободное место
+// и возвращает -1, проверка места при этом пропускается
+func freeDiskSpace(dir string) (int64, error) {
+	return -1, nil
+}
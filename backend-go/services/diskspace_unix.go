@@ -0,0 +1,16 @@
+//go:build linux || darwin
+
+package services
+
+import "syscall"
+
+// freeDiskSpace возвращает число байт, доступных непривилегированному процессу
+// на файловой системе каталога dir
+func freeDiskSpace(dir string) (int64, error) {
+	var stat syscall.Statfs_t
+	if err := syscall.Statfs(dir, &stat); err != nil {
+		return 0, err
+	}
+
+	return int64(stat.Bavail) * int64(stat.Bsize), nil
+}
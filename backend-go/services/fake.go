@@ -0,0 +1,96 @@
+package services
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/trofimovm/summvideo/models"
+)
+
+// Параметры детерминированного результата FakeProvider
+const (
+	fakeSummaryWords   = 30 // длина саммари в словах
+	fakeSegmentWords   = 4  // число слов в сегменте транскрипции
+	fakeSegmentSeconds = 2  // длительность сегмента транскрипции
+
+	fakeModel    = "fake"
+	fakeLanguage = "ru"
+)
+
+// FakeProvider — детерминированная реализация Transcriber и Summarizer без
+// сетевых запросов. Используется для тестов и локальной разработки:
+// одинаковые входные данные всегда дают одинаковый результат.
+type FakeProvider struct{}
+
+// NewFakeProvider создает заглушку провайдера
+func NewFakeProvider() *FakeProvider {
+	return &FakeProvider{}
+}
+
+// Transcribe возвращает текст, построенный из имени и хеша содержимого файла,
+// разбитый на сегменты фиксированной длительности
+func (p *FakeProvider) Transcribe(ctx context.Context, audioFile string) (*models.Transcription, error) {
+	f, err := os.Open(audioFile)
+	if err != nil {
+		return nil, fmt.Errorf("ошибка открытия аудио файла: %v", err)
+	}
+	defer f.Close()
+
+	hash := sha256.New()
+	size, err := io.Copy(hash, f)
+	if err != nil {
+		return nil, fmt.Errorf("ошибка чтения аудио файла: %v", err)
+	}
+
+	text := fmt.Sprintf(
+		"Тестовая транскрипция файла %s размером %d байт с хешем %s.",
+		filepath.Base(audioFile), size, hex.EncodeToString(hash.Sum(nil))[:16],
+	)
+
+	transcription := &models.Transcription{Text: text, Language: fakeLanguage}
+	words := strings.Fields(text)
+	for i := 0; i < len(words); i += fakeSegmentWords {
+		end := min(i+fakeSegmentWords, len(words))
+		index := float64(len(transcription.Segments))
+		transcription.Segments = append(transcription.Segments, models.TranscriptSegment{
+			Start: index * fakeSegmentSeconds,
+			End:   (index + 1) * fakeSegmentSeconds,
+			Text:  strings.Join(words[i:end], " "),
+		})
+	}
+
+	return transcription, nil
+}
+
+// TranscriptionModel возвращает название модели заглушки
+func (p *FakeProvider) TranscriptionModel() string {
+	return fakeModel
+}
+
+// TranscriptionLanguage возвращает язык заглушки
+func (p *FakeProvider) TranscriptionLanguage() string {
+	return fakeLanguage
+}
+
+// SummaryModel возвращает название модели заглушки
+func (p *FakeProvider) SummaryModel() string {
+	return fakeModel
+}
+
+// Summarize возвращает первую строку промта и начало транскрипции
+func (p *FakeProvider) Summarize(ctx context.Context, transcript, prompt string) (string, error) {
+	title, _, _ := strings.Cut(strings.TrimSpace(prompt), "\n")
+
+	words := strings.Fields(transcript)
+	if len(words) > fakeSummaryWords {
+		words = words[:fakeSummaryWords]
+	}
+
+	return fmt.Sprintf("## %s\n\n%s", title, strings.Join(words, " ")), nil
+}
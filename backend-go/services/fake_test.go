@@ -0,0 +1,157 @@
+package services
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/trofimovm/summvideo/models"
+)
+
+// unsetEnv удаляет переменные окружения на время теста: пустое значение
+// переменной считается заданным и не заменяется значением по умолчанию
+func unsetEnv(t *testing.T, keys ...string) {
+	t.Helper()
+
+	for _, key := range keys {
+		t.Setenv(key, "")
+		os.Unsetenv(key)
+	}
+}
+
+// newFakeProviders создает провайдеров так же, как при запуске сервиса с AI_PROVIDER=fake
+func newFakeProviders(t *testing.T) (Transcriber, Summarizer) {
+	t.Helper()
+
+	t.Setenv("AI_PROVIDER", ProviderFake)
+	unsetEnv(t, "TRANSCRIPTION_PROVIDER", "SUMMARY_PROVIDER")
+
+	transcriber, summarizer, err := NewProvidersFromEnv()
+	if err != nil {
+		t.Fatalf("NewProvidersFromEnv: %v", err)
+	}
+	if _, ok := transcriber.(*FakeProvider); !ok {
+		t.Fatalf("got transcriber %T, want *FakeProvider", transcriber)
+	}
+	if _, ok := summarizer.(*FakeProvider); !ok {
+		t.Fatalf("got summarizer %T, want *FakeProvider", summarizer)
+	}
+	return transcriber, summarizer
+}
+
+// transcribeFake транскрибирует файл так же, как этап транскрибации в очереди задач
+func transcribeFake(t *testing.T, transcriber Transcriber, path string) *models.Transcription {
+	t.Helper()
+
+	ctx := context.Background()
+	calls := 0
+	transcription, err := TranscribeChunked(ctx, path, ChunkingConfig{MaxFileSize: 1 << 20},
+		func(p string) (*models.Transcription, error) {
+			return transcriber.Transcribe(ctx, p)
+		},
+		func(done, total int) { calls++ },
+	)
+	if err != nil {
+		t.Fatalf("TranscribeChunked: %v", err)
+	}
+	if calls == 0 {
+		t.Errorf("progress was not reported")
+	}
+	return transcription
+}
+
+func TestFakeProviderPipeline(t *testing.T) {
+	transcriber, summarizer := newFakeProviders(t)
+
+	path := filepath.Join(t.TempDir(), "lecture.mp3")
+	if err := os.WriteFile(path, []byte("audio"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	transcription := transcribeFake(t, transcriber, path)
+	if !strings.Contains(transcription.Text, "lecture.mp3") || !strings.Contains(transcription.Text, "5 байт") {
+		t.Errorf("transcript does not describe the file: %q", transcription.Text)
+	}
+	if transcription.Language != "ru" || transcriber.TranscriptionModel() != "fake" {
+		t.Errorf("got language %q and model %q", transcription.Language, transcriber.TranscriptionModel())
+	}
+	if len(transcription.Segments) < 2 {
+		t.Fatalf("got %d segments, want several", len(transcription.Segments))
+	}
+	var words []string
+	for i, segment := range transcription.Segments {
+		if segment.Start != float64(i*fakeSegmentSeconds) || segment.End != segment.Start+fakeSegmentSeconds {
+			t.Errorf("segment %d: got %v-%v", i, segment.Start, segment.End)
+		}
+		words = append(words, segment.Text)
+	}
+	if strings.Join(words, " ") != transcription.Text {
+		t.Errorf("segments do not add up to the transcript")
+	}
+
+	// Повторная транскрибация того же файла дает тот же результат
+	again := transcribeFake(t, transcriber, path)
+	if again.Text != transcription.Text || len(again.Segments) != len(transcription.Segments) {
+		t.Errorf("transcript is not deterministic: %q and %q", transcription.Text, again.Text)
+	}
+
+	// Другое содержимое дает другую транскрипцию
+	if err := os.WriteFile(path, []byte("other audio"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if other := transcribeFake(t, transcriber, path); other.Text == transcription.Text {
+		t.Errorf("different files gave the same transcript %q", other.Text)
+	}
+
+	prompt := "Краткое содержание\nВыдели главные мысли"
+	cfg := SummarizationConfig{ContextTokens: 8000, OutputTokens: 1000, Parallelism: 2}
+	summary, strategy, err := SummarizeTranscript(context.Background(), summarizer, transcription.Text, prompt, "", cfg, nil)
+	if err != nil {
+		t.Fatalf("SummarizeTranscript: %v", err)
+	}
+	if strategy != SummaryStrategySingle {
+		t.Errorf("got strategy %q, want %q", strategy, SummaryStrategySingle)
+	}
+	if summary != "## Краткое содержание\n\n"+transcription.Text {
+		t.Errorf("got summary %q", summary)
+	}
+
+	// Длинная транскрипция проходит через суммаризацию по фрагментам
+	long := strings.Repeat(transcription.Text+" ", 40)
+	cfg.ChunkTokens = 300
+	for _, strategy := range []string{SummaryStrategyMapReduce, SummaryStrategyRefine} {
+		requests := 0
+		summary, applied, err := SummarizeTranscript(context.Background(), summarizer, long, prompt, strategy, cfg,
+			func(done, total int) { requests = done },
+		)
+		if err != nil {
+			t.Fatalf("%s: %v", strategy, err)
+		}
+		if requests < 2 {
+			t.Errorf("%s: got %d requests, want the transcript split into chunks", strategy, requests)
+		}
+		if applied != strategy || !strings.HasPrefix(summary, "## Краткое содержание\n\n") {
+			t.Errorf("%s: got strategy %q and summary %q", strategy, applied, summary)
+		}
+	}
+}
+
+func TestFakeProviderOverride(t *testing.T) {
+	// Заглушку можно подключить только для одного из этапов
+	t.Setenv("AI_PROVIDER", ProviderOpenAI)
+	t.Setenv("TRANSCRIPTION_PROVIDER", ProviderFake)
+	unsetEnv(t, "SUMMARY_PROVIDER")
+
+	transcriber, summarizer, err := NewProvidersFromEnv()
+	if err != nil {
+		t.Fatalf("NewProvidersFromEnv: %v", err)
+	}
+	if _, ok := transcriber.(*FakeProvider); !ok {
+		t.Errorf("got transcriber %T, want *FakeProvider", transcriber)
+	}
+	if _, ok := summarizer.(*FakeProvider); ok {
+		t.Errorf("summarizer should stay the default provider")
+	}
+}
@@ -0,0 +1,283 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/trofimovm/summvideo/utils"
+)
+
+// FetchError описывает причину, по которой запись не удалось загрузить по адресу.
+// Code передается клиенту, чтобы он мог различать причины отказа.
+type FetchError struct {
+	Code    string
+	Message string
+}
+
+func (e *FetchError) Error() string {
+	return e.Message
+}
+
+// Причины отказа в загрузке записи по адресу
+var (
+	ErrInvalidURL       = &FetchError{Code: "invalid_url", Message: "неверный адрес"}
+	ErrURLNotAllowed    = &FetchError{Code: "url_not_allowed", Message: "адрес недоступен для загрузки"}
+	ErrTooManyRedirects = &FetchError{Code: "too_many_redirects", Message: "слишком много перенаправлений"}
+	ErrRemoteStatus     = &FetchError{Code: "remote_error", Message: "сервер вернул ошибку"}
+	ErrRemoteFailed     = &FetchError{Code: "remote_unreachable", Message: "не удалось загрузить файл"}
+	ErrRemoteTooLarge   = &FetchError{Code: "file_too_large", Message: "файл слишком большой"}
+	ErrRemoteNotMedia   = &FetchError{Code: "unsupported_content_type", Message: "по адресу находится не медиафайл"}
+)
+
+// blockedNetworks — адреса, закрытые для загрузки, если их нет в списке
+// разрешенных: внутренние сети, локальные и служебные диапазоны
+var blockedNetworks = parseCIDRs(
+	"0.0.0.0/8", "10.0.0.0/8", "100.64.0.0/10", "127.0.0.0/8", "169.254.0.0/16",
+	"172.16.0.0/12", "192.0.0.0/24", "192.0.2.0/24", "192.168.0.0/16", "198.18.0.0/15",
+	"198.51.100.0/24", "203.0.113.0/24", "224.0.0.0/4", "240.0.0.0/4",
+	"::/128", "::1/128", "64:ff9b::/96", "100::/64", "2001:db8::/32", "fc00::/7", "fe80::/10", "ff00::/8",
+)
+
+// FetchConfig содержит ограничения загрузки записи по адресу
+type FetchConfig struct {
+	MaxBytes     int64         // максимальный размер файла
+	MaxRedirects int           // максимальное число перенаправлений
+	Timeout      time.Duration // время на всю загрузку
+	// AllowedHosts перечисляет имена хостов (*.example.com — с поддоменами)
+	// и сети в нотации CIDR, которые доступны, даже если находятся во внутренней сети
+	AllowedHosts []string
+}
+
+// FetchConfigFromEnv читает ограничения загрузки по адресу из переменных окружения
+func FetchConfigFromEnv(maxBytes int64) FetchConfig {
+	var allowed []string
+	for _, host := range strings.Split(utils.GetEnv("URL_FETCH_ALLOWLIST", ""), ",") {
+		if host = strings.ToLower(strings.TrimSpace(host)); host != "" {
+			allowed = append(allowed, host)
+		}
+	}
+
+	return FetchConfig{
+		MaxBytes:     maxBytes,
+		MaxRedirects: utils.GetEnvInt("URL_FETCH_MAX_REDIRECTS", 3),
+		Timeout:      time.Duration(utils.GetEnvInt("URL_FETCH_TIMEOUT_SECONDS", 600)) * time.Second,
+		AllowedHosts: allowed,
+	}
+}
+
+// FetchResult описывает файл, загруженный по адресу
+type FetchResult struct {
+	FileName    string // имя файла из Content-Disposition или пути адреса
+	ContentType string
+	Size        int64
+}
+
+// ParseFetchURL проверяет, что адрес абсолютный, использует http или https
+// и не содержит учетных данных: адрес сохраняется в задаче и истории
+func ParseFetchURL(rawURL string) (*url.URL, error) {
+	u, err := url.Parse(strings.TrimSpace(rawURL))
+	if err != nil || u.Host == "" || (u.Scheme != "http" && u.Scheme != "https") || u.User != nil {
+		return nil, ErrInvalidURL
+	}
+	return u, nil
+}
+
+// FetchURL загружает файл по адресу u в dst. Адреса внутренних сетей закрыты,
+// если хост или сеть не перечислены в cfg.AllowedHosts; проверяется адрес,
+// к которому действительно выполняется подключение, поэтому ни перенаправление,
+// ни подмена DNS не позволят обратиться к внутреннему сервису. Ответ должен
+// иметь тип медиафайла и не превышать cfg.MaxBytes.
+func FetchURL(ctx context.Context, u *url.URL, cfg FetchConfig, dst io.Writer) (*FetchResult, error) {
+	ctx, cancel := context.WithTimeout(ctx, cfg.Timeout)
+	defer cancel()
+
+	client := &http.Client{
+		Transport: &http.Transport{
+			// Прокси из окружения обошел бы проверку адресов
+			Proxy:                 nil,
+			DialContext:           cfg.dialContext,
+			TLSHandshakeTimeout:   30 * time.Second,
+			ResponseHeaderTimeout: time.Minute,
+			// Транспорт создается на одну загрузку, соединения не переиспользуются
+			DisableKeepAlives: true,
+		},
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			if len(via) > cfg.MaxRedirects {
+				return ErrTooManyRedirects
+			}
+			if req.URL.Scheme != "http" && req.URL.Scheme != "https" {
+				return ErrInvalidURL
+			}
+			return nil
+		},
+	}
+	defer client.CloseIdleConnections()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, ErrInvalidURL
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		var fetchErr *FetchError
+		if errors.As(err, &fetchErr) {
+			return nil, fetchErr
+		}
+		return nil, fmt.Errorf("%w: %v", ErrRemoteFailed, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%w: %s", ErrRemoteStatus, resp.Status)
+	}
+
+	contentType := resp.Header.Get("Content-Type")
+	if !isMediaContentType(contentType) {
+		return nil, fmt.Errorf("%w: %s", ErrRemoteNotMedia, contentType)
+	}
+
+	if resp.ContentLength > cfg.MaxBytes {
+		return nil, ErrRemoteTooLarge
+	}
+
+	// Читаем на байт больше лимита, чтобы отличить файл ровно в лимит от большего
+	size, err := io.Copy(dst, io.LimitReader(resp.Body, cfg.MaxBytes+1))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrRemoteFailed, err)
+	}
+	if size > cfg.MaxBytes {
+		return nil, ErrRemoteTooLarge
+	}
+
+	return &FetchResult{
+		FileName:    remoteFileName(resp),
+		ContentType: contentType,
+		Size:        size,
+	}, nil
+}
+
+// dialContext подключается к хосту, проверяя адрес после разрешения имени.
+// Для хостов из списка разрешенных проверка не выполняется.
+func (cfg FetchConfig) dialContext(ctx context.Context, network, address string) (net.Conn, error) {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return nil, err
+	}
+
+	dialer := &net.Dialer{Timeout: 30 * time.Second}
+	if !cfg.hostAllowed(host) {
+		dialer.Control = func(network, address string, _ syscall.RawConn) error {
+			ipHost, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			if ip := net.ParseIP(ipHost); ip == nil || !cfg.ipAllowed(ip) {
+				return fmt.Errorf("%w: %s", ErrURLNotAllowed, host)
+			}
+			return nil
+		}
+	}
+
+	return dialer.DialContext(ctx, network, address)
+}
+
+// hostAllowed проверяет, что имя хоста есть в списке разрешенных
+func (cfg FetchConfig) hostAllowed(host string) bool {
+	host = strings.ToLower(strings.TrimSuffix(host, "."))
+	for _, allowed := range cfg.AllowedHosts {
+		if strings.HasPrefix(allowed, "*.") {
+			if strings.HasSuffix(host, allowed[1:]) {
+				return true
+			}
+			continue
+		}
+		if host == allowed {
+			return true
+		}
+	}
+	return false
+}
+
+// ipAllowed проверяет, что адрес не относится к закрытым сетям или что его
+// сеть есть в списке разрешенных
+func (cfg FetchConfig) ipAllowed(ip net.IP) bool {
+	// IPv4, записанный как IPv6, проверяется по правилам IPv4
+	if v4 := ip.To4(); v4 != nil {
+		ip = v4
+	}
+
+	for _, allowed := range cfg.AllowedHosts {
+		if _, network, err := net.ParseCIDR(allowed); err == nil && network.Contains(ip) {
+			return true
+		}
+	}
+
+	for _, network := range blockedNetworks {
+		if network.Contains(ip) {
+			return false
+		}
+	}
+	return true
+}
+
+// isMediaContentType проверяет, что сервер отдает медиафайл или произвольные
+// двоичные данные. Формат файла затем проверяется по сигнатуре.
+func isMediaContentType(contentType string) bool {
+	if contentType == "" {
+		return true
+	}
+
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+
+	switch {
+	case strings.HasPrefix(mediaType, "video/"), strings.HasPrefix(mediaType, "audio/"):
+		return true
+	case mediaType == "application/octet-stream", mediaType == "application/ogg",
+		mediaType == "application/mp4", mediaType == "binary/octet-stream":
+		return true
+	}
+	return false
+}
+
+// remoteFileName возвращает имя файла из Content-Disposition, последнего
+// сегмента пути или, если их нет, имя хоста
+func remoteFileName(resp *http.Response) string {
+	if _, params, err := mime.ParseMediaType(resp.Header.Get("Content-Disposition")); err == nil {
+		if name := path.Base(params["filename"]); params["filename"] != "" && name != "/" && name != "." {
+			return name
+		}
+	}
+
+	// После перенаправлений resp.Request содержит итоговый адрес
+	if name := path.Base(resp.Request.URL.Path); name != "/" && name != "." {
+		return name
+	}
+	return resp.Request.URL.Hostname()
+}
+
+// parseCIDRs разбирает список сетей в нотации CIDR
+func parseCIDRs(cidrs ...string) []*net.IPNet {
+	networks := make([]*net.IPNet, 0, len(cidrs))
+	for _, cidr := range cidrs {
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil {
+			panic(err)
+		}
+		networks = append(networks, network)
+	}
+	return networks
+}
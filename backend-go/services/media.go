@@ -0,0 +1,262 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"slices"
+	"strconv"
+
+	"github.com/trofimovm/summvideo/models"
+)
+
+// sniffLength — сколько байт начала файла читается для определения контейнера
+const sniffLength = 512
+
+// MediaError описывает причину, по которой загруженный файл нельзя обработать.
+// Code передается клиенту, чтобы он мог различать причины отказа.
+type MediaError struct {
+	Code    string
+	Message string
+}
+
+func (e *MediaError) Error() string {
+	return e.Message
+}
+
+// Причины отказа в обработке загруженного файла
+var (
+	ErrUnknownContainer    = &MediaError{Code: "unknown_container", Message: "формат файла не распознан"}
+	ErrContainerNotAllowed = &MediaError{Code: "container_not_allowed", Message: "формат файла не разрешен"}
+	ErrUnreadableMedia     = &MediaError{Code: "unreadable_media", Message: "файл поврежден или не читается"}
+	ErrNoAudioStream       = &MediaError{Code: "no_audio_stream", Message: "в файле нет звуковой дорожки"}
+	ErrUnsupportedAudio    = &MediaError{Code: "unsupported_audio_codec", Message: "кодек звуковой дорожки не поддерживается"}
+	ErrEmptyAudio          = &MediaError{Code: "empty_audio", Message: "звуковая дорожка пуста"}
+)
+
+// MediaInfo содержит сведения о загруженном файле, полученные при проверке
+type MediaInfo struct {
+	Container  string  // контейнер, определенный по сигнатуре
+	Duration   float64 // длительность записи в секундах
+	AudioCodec string  // кодек первой звуковой дорожки
+	BitRate    int     // битрейт звуковой дорожки в бит/с, 0 — неизвестен
+	HasVideo   bool    // есть ли в файле видеодорожка
+}
+
+// DetectContainer определяет контейнер медиафайла по сигнатуре в начале файла.
+// Возвращает ErrUnknownContainer, если сигнатура не распознана.
+func DetectContainer(r io.ReaderAt) (string, error) {
+	head, err := readAt(r, 0, sniffLength)
+	if err != nil {
+		return "", err
+	}
+
+	// Аудиофайлы часто начинаются с тега ID3, за которым идет сам поток
+	if len(head) >= 10 && bytes.HasPrefix(head, []byte("ID3")) {
+		size := int64(head[6])<<21 | int64(head[7])<<14 | int64(head[8])<<7 | int64(head[9])
+		if head, err = readAt(r, 10+size, sniffLength); err != nil {
+			return "", err
+		}
+		switch {
+		case bytes.HasPrefix(head, []byte("fLaC")):
+			return models.ContainerFLAC, nil
+		case isADTS(head):
+			return models.ContainerAAC, nil
+		default:
+			// MP3 с тегом ID3 может начинаться с мусора до первого кадра
+			return models.ContainerMP3, nil
+		}
+	}
+
+	switch {
+	case len(head) >= 12 && bytes.Equal(head[4:8], []byte("ftyp")):
+		return models.ContainerMP4, nil
+	case len(head) >= 8 && (bytes.Equal(head[4:8], []byte("moov")) || bytes.Equal(head[4:8], []byte("mdat")) ||
+		bytes.Equal(head[4:8], []byte("wide")) || bytes.Equal(head[4:8], []byte("free"))):
+		// Старые файлы QuickTime без атома ftyp
+		return models.ContainerMP4, nil
+	case bytes.HasPrefix(head, []byte{0x1A, 0x45, 0xDF, 0xA3}):
+		return models.ContainerMatroska, nil
+	case len(head) >= 12 && bytes.HasPrefix(head, []byte("RIFF")) && bytes.Equal(head[8:12], []byte("AVI ")):
+		return models.ContainerAVI, nil
+	case len(head) >= 12 && (bytes.HasPrefix(head, []byte("RIFF")) || bytes.HasPrefix(head, []byte("RF64"))) &&
+		bytes.Equal(head[8:12], []byte("WAVE")):
+		return models.ContainerWAV, nil
+	case isMPEGTS(head):
+		return models.ContainerMPEGTS, nil
+	case bytes.HasPrefix(head, []byte{0x00, 0x00, 0x01, 0xBA}):
+		return models.ContainerMPEGPS, nil
+	case bytes.HasPrefix(head, []byte("FLV\x01")):
+		return models.ContainerFLV, nil
+	case bytes.HasPrefix(head, []byte{0x30, 0x26, 0xB2, 0x75, 0x8E, 0x66, 0xCF, 0x11}):
+		return models.ContainerASF, nil
+	case bytes.HasPrefix(head, []byte("OggS")):
+		return models.ContainerOgg, nil
+	case bytes.HasPrefix(head, []byte("fLaC")):
+		return models.ContainerFLAC, nil
+	case isADTS(head):
+		return models.ContainerAAC, nil
+	case isMPEGAudio(head):
+		return models.ContainerMP3, nil
+	}
+
+	return "", ErrUnknownContainer
+}
+
+// readAt читает до n байт начиная с offset. Файл короче offset дает пустой результат.
+func readAt(r io.ReaderAt, offset int64, n int) ([]byte, error) {
+	buf := make([]byte, n)
+	read, err := r.ReadAt(buf, offset)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("ошибка чтения файла: %v", err)
+	}
+	return buf[:read], nil
+}
+
+// isMPEGTS проверяет байт синхронизации в начале трех подряд идущих пакетов по 188 байт
+func isMPEGTS(head []byte) bool {
+	if len(head) < 377 {
+		return false
+	}
+	return head[0] == 0x47 && head[188] == 0x47 && head[376] == 0x47
+}
+
+// isADTS проверяет заголовок кадра AAC ADTS: синхрослово и нулевой слой
+func isADTS(head []byte) bool {
+	return len(head) >= 2 && head[0] == 0xFF && head[1]&0xF6 == 0xF0
+}
+
+// isMPEGAudio проверяет заголовок кадра MPEG Audio: синхрослово, допустимые
+// версия, слой и частота дискретизации
+func isMPEGAudio(head []byte) bool {
+	if len(head) < 4 || head[0] != 0xFF || head[1]&0xE0 != 0xE0 {
+		return false
+	}
+	version := head[1] >> 3 & 0x03
+	layer := head[1] >> 1 & 0x03
+	bitrate := head[2] >> 4
+	sampleRate := head[2] >> 2 & 0x03
+	return version != 0x01 && layer != 0 && bitrate != 0x0F && sampleRate != 0x03
+}
+
+// ffprobeOutput — часть вывода ffprobe -of json, нужная для проверки файла
+type ffprobeOutput struct {
+	Streams []struct {
+		CodecType  string `json:"codec_type"`
+		CodecName  string `json:"codec_name"`
+		SampleRate string `json:"sample_rate"`
+		Channels   int    `json:"channels"`
+		BitRate    string `json:"bit_rate"`
+		Duration   string `json:"duration"`
+		// Обложки альбомов в аудиофайлах выглядят как видеодорожка
+		Disposition struct {
+			AttachedPic int `json:"attached_pic"`
+		} `json:"disposition"`
+	} `json:"streams"`
+	Format struct {
+		Duration string `json:"duration"`
+		BitRate  string `json:"bit_rate"`
+	} `json:"format"`
+}
+
+// CheckContainer определяет контейнер файла по сигнатуре и проверяет, что он
+// есть в списке allowed. Вызывается до сохранения файла на диск.
+func CheckContainer(r io.ReaderAt, allowed []string) (string, error) {
+	container, err := DetectContainer(r)
+	if err != nil {
+		return "", err
+	}
+
+	if !slices.Contains(allowed, container) {
+		return "", fmt.Errorf("%w: %s", ErrContainerNotAllowed, container)
+	}
+
+	return container, nil
+}
+
+// CheckFileContainer определяет контейнер сохраненного файла по сигнатуре
+// и проверяет, что он есть в списке allowed
+func CheckFileContainer(path string, allowed []string) (string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	return CheckContainer(file, allowed)
+}
+
+// InspectMedia проверяет сохраненный файл с контейнером container с помощью
+// ffprobe: в файле должна быть непустая звуковая дорожка с известным кодеком.
+// Ошибки проверки имеют тип *MediaError. Отмена ctx останавливает ffprobe.
+func InspectMedia(ctx context.Context, path string, container string) (*MediaInfo, error) {
+	out, err := exec.CommandContext(
+		ctx,
+		"ffprobe", "-v", "error",
+		"-show_entries", "format=duration,bit_rate:stream=codec_type,codec_name,sample_rate,channels,bit_rate,duration:stream_disposition=attached_pic",
+		"-of", "json",
+		path,
+	).Output()
+	if err != nil {
+		return nil, ErrUnreadableMedia
+	}
+
+	var probe ffprobeOutput
+	if err := json.Unmarshal(out, &probe); err != nil {
+		return nil, ErrUnreadableMedia
+	}
+
+	info := &MediaInfo{Container: container}
+	audioIndex := -1
+	for i, stream := range probe.Streams {
+		switch stream.CodecType {
+		case "audio":
+			if audioIndex < 0 {
+				audioIndex = i
+			}
+		case "video":
+			if stream.Disposition.AttachedPic == 0 {
+				info.HasVideo = true
+			}
+		}
+	}
+	if audioIndex < 0 {
+		return nil, ErrNoAudioStream
+	}
+
+	audio := probe.Streams[audioIndex]
+	sampleRate, _ := strconv.Atoi(audio.SampleRate)
+	if audio.CodecName == "" || audio.CodecName == "none" || sampleRate <= 0 || audio.Channels <= 0 {
+		return nil, ErrUnsupportedAudio
+	}
+	info.AudioCodec = audio.CodecName
+
+	// У некоторых контейнеров битрейт известен только для всего файла
+	info.BitRate, _ = strconv.Atoi(audio.BitRate)
+	if info.BitRate <= 0 {
+		info.BitRate, _ = strconv.Atoi(probe.Format.BitRate)
+	}
+
+	// Не во всех контейнерах у дорожки есть своя длительность
+	formatDuration, _ := strconv.ParseFloat(probe.Format.Duration, 64)
+	audioDuration, err := strconv.ParseFloat(audio.Duration, 64)
+	if err != nil {
+		audioDuration = formatDuration
+	}
+	if audioDuration <= 0 {
+		return nil, ErrEmptyAudio
+	}
+
+	// Лимит списывается по длительности всей записи, как и раньше
+	info.Duration = formatDuration
+	if info.Duration <= 0 {
+		info.Duration = audioDuration
+	}
+
+	return info, nil
+}
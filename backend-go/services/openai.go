@@ -2,60 +2,123 @@ package services
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"strings"
 	"time"
 
 	"github.com/sashabaranov/go-openai"
+	"github.com/trofimovm/summvideo/models"
 )
 
-// TranscribeAudio транскрибирует аудио файл через OpenAI API
-func TranscribeAudio(apiKey, audioFile string) (string, error) {
-	// Создаем клиента OpenAI
-	client := openai.NewClient(apiKey)
+// OpenAIProvider транскрибирует и суммаризирует через OpenAI API
+// или любой совместимый с ним сервер
+type OpenAIProvider struct {
+	client             *openai.Client
+	transcriptionModel string
+	summaryModel       string
+	language           string
+}
+
+// NewOpenAIProvider создает провайдера для OpenAI API
+func NewOpenAIProvider(apiKey string, cfg ProviderConfig) *OpenAIProvider {
+	return newOpenAIProvider(openai.DefaultConfig(apiKey), cfg)
+}
+
+// NewOpenAICompatibleProvider создает провайдера для сервера с OpenAI-совместимым
+// API по адресу baseURL (например, самостоятельно развернутого whisper или LLM)
+func NewOpenAICompatibleProvider(baseURL, apiKey string, cfg ProviderConfig) (*OpenAIProvider, error) {
+	if baseURL == "" {
+		return nil, errors.New("не указан адрес OpenAI-совместимого сервера")
+	}
+
+	clientConfig := openai.DefaultConfig(apiKey)
+	clientConfig.BaseURL = strings.TrimRight(baseURL, "/")
+	return newOpenAIProvider(clientConfig, cfg), nil
+}
+
+func newOpenAIProvider(clientConfig openai.ClientConfig, cfg ProviderConfig) *OpenAIProvider {
+	return &OpenAIProvider{
+		client:             openai.NewClientWithConfig(clientConfig),
+		transcriptionModel: cfg.TranscriptionModel,
+		summaryModel:       cfg.SummaryModel,
+		language:           cfg.Language,
+	}
+}
 
-	// Открываем аудио файл
+// Transcribe транскрибирует аудио файл. Ответ запрашивается в формате
+// verbose_json, чтобы получить сегменты с временными метками.
+func (p *OpenAIProvider) Transcribe(ctx context.Context, audioFile string) (*models.Transcription, error) {
+	// Проверяем, что аудио файл доступен
 	audioData, err := os.Open(audioFile)
 	if err != nil {
-		return "", fmt.Errorf("ошибка открытия аудио файла: %v", err)
+		return nil, fmt.Errorf("ошибка открытия аудио файла: %v", err)
 	}
-	defer audioData.Close()
+	audioData.Close()
 
 	// Устанавливаем контекст с таймаутом
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Minute)
 	defer cancel()
 
 	// Отправляем запрос на транскрипцию
-	resp, err := client.CreateTranscription(
+	resp, err := p.client.CreateTranscription(
 		ctx,
 		openai.AudioRequest{
-			Model:    openai.Whisper1,
+			Model:    p.transcriptionModel,
 			FilePath: audioFile,
-			Language: "ru",
+			Language: p.language,
+			Format:   openai.AudioResponseFormatVerboseJSON,
 		},
 	)
 	if err != nil {
-		return "", fmt.Errorf("ошибка транскрипции аудио: %v", err)
+		return nil, fmt.Errorf("ошибка транскрипции аудио: %w", err)
 	}
 
-	return resp.Text, nil
+	// Если язык не задан явно, берем определенный моделью
+	language := p.language
+	if language == "" {
+		language = resp.Language
+	}
+
+	transcription := &models.Transcription{Text: resp.Text, Language: language}
+	for _, segment := range resp.Segments {
+		transcription.Segments = append(transcription.Segments, models.TranscriptSegment{
+			Start: segment.Start,
+			End:   segment.End,
+			Text:  strings.TrimSpace(segment.Text),
+		})
+	}
+
+	return transcription, nil
+}
+
+// TranscriptionModel возвращает название модели распознавания речи
+func (p *OpenAIProvider) TranscriptionModel() string {
+	return p.transcriptionModel
 }
 
-// GenerateSummary генерирует саммари на основе транскрипции и промта
-func GenerateSummary(apiKey, transcript, prompt string) (string, error) {
-	// Создаем клиента OpenAI
-	client := openai.NewClient(apiKey)
+// TranscriptionLanguage возвращает заданный язык распознавания
+func (p *OpenAIProvider) TranscriptionLanguage() string {
+	return p.language
+}
 
+// SummaryModel возвращает название модели генерации саммари
+func (p *OpenAIProvider) SummaryModel() string {
+	return p.summaryModel
+}
+
+// Summarize генерирует саммари на основе транскрипции и промта
+func (p *OpenAIProvider) Summarize(ctx context.Context, transcript, prompt string) (string, error) {
 	// Устанавливаем контекст с таймаутом
-	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Minute)
+	ctx, cancel := context.WithTimeout(ctx, 3*time.Minute)
 	defer cancel()
 
 	// Отправляем запрос на генерацию текста
-	resp, err := client.CreateChatCompletion(
+	resp, err := p.client.CreateChatCompletion(
 		ctx,
 		openai.ChatCompletionRequest{
-			Model: "gpt-4o-mini",
+			Model: p.summaryModel,
 			Messages: []openai.ChatCompletionMessage{
 				{
 					Role:    openai.ChatMessageRoleSystem,
@@ -70,7 +133,7 @@ func GenerateSummary(apiKey, transcript, prompt string) (string, error) {
 		},
 	)
 	if err != nil {
-		return "", fmt.Errorf("ошибка генерации саммари: %v", err)
+		return "", fmt.Errorf("ошибка генерации саммари: %w", err)
 	}
 
 	// Проверяем наличие ответа
@@ -81,4 +144,4 @@ func GenerateSummary(apiKey, transcript, prompt string) (string, error) {
 	// Собираем результат
 	summary := strings.TrimSpace(resp.Choices[0].Message.Content)
 	return summary, nil
-}
\ No newline at end of file
+}
@@ -0,0 +1,97 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/trofimovm/summvideo/models"
+	"github.com/trofimovm/summvideo/utils"
+)
+
+// Поддерживаемые провайдеры транскрибации и суммаризации
+const (
+	ProviderOpenAI     = "openai"     // OpenAI API
+	ProviderCompatible = "compatible" // сервер с OpenAI-совместимым API
+	ProviderFake       = "fake"       // детерминированная заглушка без сетевых запросов
+)
+
+// Transcriber преобразует речь из аудио файла в текст с временными метками
+type Transcriber interface {
+	Transcribe(ctx context.Context, audioFile string) (*models.Transcription, error)
+	// TranscriptionModel возвращает название используемой модели распознавания
+	TranscriptionModel() string
+	// TranscriptionLanguage возвращает заданный язык распознавания
+	// (пустая строка — язык определяется моделью)
+	TranscriptionLanguage() string
+}
+
+// Summarizer генерирует саммари транскрипции по промту пользователя
+type Summarizer interface {
+	Summarize(ctx context.Context, transcript, prompt string) (string, error)
+	// SummaryModel возвращает название используемой модели генерации
+	SummaryModel() string
+}
+
+// ProviderConfig содержит общие настройки моделей провайдера
+type ProviderConfig struct {
+	TranscriptionModel string // модель распознавания речи
+	SummaryModel       string // модель генерации саммари
+	Language           string // язык записи для распознавания
+}
+
+// NewProvidersFromEnv создает провайдеров транскрибации и суммаризации по
+// переменным окружения. Провайдер выбирается через AI_PROVIDER и может быть
+// переопределен отдельно через TRANSCRIPTION_PROVIDER и SUMMARY_PROVIDER,
+// например, чтобы распознавать речь локальным whisper, а саммари делать в OpenAI.
+func NewProvidersFromEnv() (Transcriber, Summarizer, error) {
+	cfg := ProviderConfig{
+		TranscriptionModel: utils.GetEnv("TRANSCRIPTION_MODEL", "whisper-1"),
+		SummaryModel:       utils.GetEnv("SUMMARY_MODEL", "gpt-4o-mini"),
+		Language:           utils.GetEnv("TRANSCRIPTION_LANGUAGE", "ru"),
+	}
+
+	defaultProvider := utils.GetEnv("AI_PROVIDER", ProviderOpenAI)
+	defaultBaseURL := utils.GetEnv("OPENAI_BASE_URL", "")
+
+	transcriber, err := newProvider(
+		utils.GetEnv("TRANSCRIPTION_PROVIDER", defaultProvider),
+		utils.GetEnv("TRANSCRIPTION_BASE_URL", defaultBaseURL),
+		cfg,
+	)
+	if err != nil {
+		return nil, nil, fmt.Errorf("провайдер транскрибации: %v", err)
+	}
+
+	summarizer, err := newProvider(
+		utils.GetEnv("SUMMARY_PROVIDER", defaultProvider),
+		utils.GetEnv("SUMMARY_BASE_URL", defaultBaseURL),
+		cfg,
+	)
+	if err != nil {
+		return nil, nil, fmt.Errorf("провайдер суммаризации: %v", err)
+	}
+
+	return transcriber, summarizer, nil
+}
+
+// provider объединяет обе возможности, которые реализуют все встроенные провайдеры
+type provider interface {
+	Transcriber
+	Summarizer
+}
+
+// newProvider создает провайдера по имени
+func newProvider(name, baseURL string, cfg ProviderConfig) (provider, error) {
+	apiKey := utils.GetEnv("OPENAI_API_KEY", "")
+
+	switch name {
+	case ProviderOpenAI:
+		return NewOpenAIProvider(apiKey, cfg), nil
+	case ProviderCompatible:
+		return NewOpenAICompatibleProvider(baseURL, apiKey, cfg)
+	case ProviderFake:
+		return NewFakeProvider(), nil
+	default:
+		return nil, fmt.Errorf("неизвестный провайдер %q", name)
+	}
+}
@@ -0,0 +1,126 @@
+package services
+
+import (
+	"context"
+	"errors"
+)
+
+// Классы ошибок обработки видео
+const (
+	FailureProviderError   = "provider_error"   // провайдер транскрибации или суммаризации недоступен или вернул ошибку
+	FailureMediaProcessing = "media_processing" // сбой ffmpeg при обработке записи на сервере
+	FailureInvalidMedia    = "invalid_media"    // файл не читается как аудио или видео
+	FailureSourceFetch     = "source_fetch"     // запись не удалось загрузить по адресу
+	FailureQuotaExceeded   = "quota_exceeded"   // длительность записи превышает оставшийся лимит
+	FailureCancelled       = "cancelled"        // обработка отменена пользователем
+	FailureInternal        = "internal"         // ошибка сервера: БД, файловая система, перезапуск
+)
+
+// ProcessingError описывает ошибку обработки видео с её классом
+// и этапом, до которого дошла обработка
+type ProcessingError struct {
+	Class       string // класс ошибки
+	Stage       string // этап обработки, на котором произошла ошибка
+	Transcribed bool   // транскрипция была получена до ошибки
+	Err         error
+}
+
+// Error возвращает текст исходной ошибки
+func (e *ProcessingError) Error() string {
+	return e.Err.Error()
+}
+
+// Unwrap возвращает исходную ошибку
+func (e *ProcessingError) Unwrap() error {
+	return e.Err
+}
+
+// NewProcessingError оборачивает ошибку этапа обработки. Если ошибка уже
+// классифицирована на более низком уровне, её класс сохраняется.
+func NewProcessingError(class, stage string, transcribed bool, err error) *ProcessingError {
+	var processingErr *ProcessingError
+	if errors.As(err, &processingErr) {
+		class = processingErr.Class
+	}
+
+	return &ProcessingError{Class: class, Stage: stage, Transcribed: transcribed, Err: err}
+}
+
+// ClassifyError возвращает класс ошибки обработки. Ошибки без класса считаются
+// ошибками сервера, прерванный контекст — отменой.
+func ClassifyError(err error) string {
+	var processingErr *ProcessingError
+	if errors.As(err, &processingErr) {
+		return processingErr.Class
+	}
+	if errors.Is(err, context.Canceled) {
+		return FailureCancelled
+	}
+	return FailureInternal
+}
+
+// RefundRule сопоставляет класс ошибки с решением о возврате времени
+type RefundRule struct {
+	Name               string // название правила, сохраняется в истории
+	Class              string // класс ошибки, пустая строка — любой
+	AfterTranscription bool   // правило применяется, только если транскрипция уже получена
+	Charge             bool   // списать длительность записи вместо возврата
+}
+
+// RefundPolicy решает, возвращается ли пользователю время за неудачную обработку.
+// Правила проверяются по порядку, применяется первое подходящее.
+type RefundPolicy struct {
+	Rules []RefundRule
+}
+
+// RefundDecision описывает решение политики возвратов для неудачной обработки
+type RefundDecision struct {
+	Rule            string // примененное правило
+	Class           string // класс ошибки
+	ChargedSeconds  int    // списываемое время
+	RefundedSeconds int    // возвращаемое время
+}
+
+// DefaultRefundPolicy возвращает время за сбои на стороне сервиса и провайдеров.
+// Списывается только обработка, отмененная пользователем после транскрибации:
+// основная работа к этому моменту уже выполнена.
+var DefaultRefundPolicy = RefundPolicy{
+	Rules: []RefundRule{
+		{Name: "cancelled_after_transcription", Class: FailureCancelled, AfterTranscription: true, Charge: true},
+		{Name: "cancelled_before_transcription", Class: FailureCancelled},
+		{Name: "provider_outage", Class: FailureProviderError},
+		{Name: "media_processing_failure", Class: FailureMediaProcessing},
+		{Name: "invalid_media", Class: FailureInvalidMedia},
+		{Name: "source_fetch_failure", Class: FailureSourceFetch},
+		{Name: "quota_exceeded", Class: FailureQuotaExceeded},
+		{Name: "internal_failure"},
+	},
+}
+
+// Decide применяет политику к ошибке обработки записи длительностью mediaSeconds.
+// Если ни одно правило не подошло, время возвращается.
+func (p RefundPolicy) Decide(err error, mediaSeconds int) RefundDecision {
+	class := ClassifyError(err)
+
+	var transcribed bool
+	var processingErr *ProcessingError
+	if errors.As(err, &processingErr) {
+		transcribed = processingErr.Transcribed
+	}
+
+	for _, rule := range p.Rules {
+		if rule.Class != "" && rule.Class != class {
+			continue
+		}
+		if rule.AfterTranscription && !transcribed {
+			continue
+		}
+
+		if rule.Charge {
+			return RefundDecision{Rule: rule.Name, Class: class, ChargedSeconds: mediaSeconds}
+		}
+		return RefundDecision{Rule: rule.Name, Class: class, RefundedSeconds: mediaSeconds}
+	}
+
+	return RefundDecision{Class: class, RefundedSeconds: mediaSeconds}
+}
@@ -0,0 +1,126 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestDefaultRefundPolicy(t *testing.T) {
+	cause := errors.New("ошибка")
+	ffmpegErr := NewProcessingError(FailureMediaProcessing, "extracting", false, cause)
+
+	tests := []struct {
+		name    string
+		err     error
+		rule    string
+		class   string
+		charged bool
+	}{
+		{
+			name:  "cancelled before transcription",
+			err:   NewProcessingError(FailureCancelled, "transcribing", false, context.Canceled),
+			rule:  "cancelled_before_transcription",
+			class: FailureCancelled,
+		},
+		{
+			name:    "cancelled after transcription",
+			err:     NewProcessingError(FailureCancelled, "summarizing", true, context.Canceled),
+			rule:    "cancelled_after_transcription",
+			class:   FailureCancelled,
+			charged: true,
+		},
+		{
+			name:  "provider error",
+			err:   NewProcessingError(FailureProviderError, "transcribing", false, cause),
+			rule:  "provider_outage",
+			class: FailureProviderError,
+		},
+		{
+			name:  "provider error after transcription",
+			err:   NewProcessingError(FailureProviderError, "summarizing", true, cause),
+			rule:  "provider_outage",
+			class: FailureProviderError,
+		},
+		{
+			name:  "wrapping keeps the inner class",
+			err:   NewProcessingError(FailureProviderError, "transcribing", false, ffmpegErr),
+			rule:  "media_processing_failure",
+			class: FailureMediaProcessing,
+		},
+		{
+			name:  "wrapped with fmt.Errorf",
+			err:   fmt.Errorf("задача 1: %w", NewProcessingError(FailureInvalidMedia, "extracting", false, cause)),
+			rule:  "invalid_media",
+			class: FailureInvalidMedia,
+		},
+		{
+			name:  "source fetch",
+			err:   NewProcessingError(FailureSourceFetch, "fetching", false, cause),
+			rule:  "source_fetch_failure",
+			class: FailureSourceFetch,
+		},
+		{
+			name:  "quota exceeded",
+			err:   NewProcessingError(FailureQuotaExceeded, "fetching", false, cause),
+			rule:  "quota_exceeded",
+			class: FailureQuotaExceeded,
+		},
+		{
+			name:  "plain context.Canceled",
+			err:   context.Canceled,
+			rule:  "cancelled_before_transcription",
+			class: FailureCancelled,
+		},
+		{
+			name:  "wrapped context.Canceled",
+			err:   fmt.Errorf("запрос: %w", context.Canceled),
+			rule:  "cancelled_before_transcription",
+			class: FailureCancelled,
+		},
+		{
+			name:  "unclassified error",
+			err:   cause,
+			rule:  "internal_failure",
+			class: FailureInternal,
+		},
+		{
+			name:  "deadline exceeded",
+			err:   context.DeadlineExceeded,
+			rule:  "internal_failure",
+			class: FailureInternal,
+		},
+	}
+	for _, tt := range tests {
+		decision := DefaultRefundPolicy.Decide(tt.err, 90)
+		if decision.Rule != tt.rule || decision.Class != tt.class {
+			t.Errorf("%s: got rule %q, class %q, want %q, %q", tt.name, decision.Rule, decision.Class, tt.rule, tt.class)
+		}
+
+		charged, refunded := 0, 90
+		if tt.charged {
+			charged, refunded = 90, 0
+		}
+		if decision.ChargedSeconds != charged || decision.RefundedSeconds != refunded {
+			t.Errorf("%s: got charged %d, refunded %d, want %d, %d",
+				tt.name, decision.ChargedSeconds, decision.RefundedSeconds, charged, refunded)
+		}
+	}
+}
+
+func TestRefundPolicyWithoutMatchingRule(t *testing.T) {
+	policy := RefundPolicy{Rules: []RefundRule{
+		{Name: "charge_invalid_media", Class: FailureInvalidMedia, Charge: true},
+	}}
+
+	decision := policy.Decide(errors.New("ошибка"), 30)
+	if decision.Rule != "" || decision.Class != FailureInternal || decision.RefundedSeconds != 30 || decision.ChargedSeconds != 0 {
+		t.Errorf("got %+v, want a refund without a rule", decision)
+	}
+
+	decision = policy.Decide(NewProcessingError(FailureInvalidMedia, "extracting", false, errors.New("ошибка")), 30)
+	if decision.Rule != "charge_invalid_media" || decision.ChargedSeconds != 30 {
+		t.Errorf("got %+v, want the charge rule", decision)
+	}
+}
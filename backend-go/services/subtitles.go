@@ -0,0 +1,91 @@
+package services
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/trofimovm/summvideo/models"
+)
+
+// Форматы экспорта транскрипции
+const (
+	TranscriptFormatSRT  = "srt"
+	TranscriptFormatVTT  = "vtt"
+	TranscriptFormatText = "txt"
+)
+
+// FormatTranscript преобразует сегменты транскрипции в указанный формат
+func FormatTranscript(segments []models.TranscriptSegment, format string) (string, error) {
+	switch format {
+	case TranscriptFormatSRT:
+		return FormatSRT(segments), nil
+	case TranscriptFormatVTT:
+		return FormatWebVTT(segments), nil
+	case TranscriptFormatText:
+		return FormatTimestampedText(segments), nil
+	default:
+		return "", fmt.Errorf("неподдерживаемый формат %q", format)
+	}
+}
+
+// FormatSRT формирует субтитры в формате SubRip
+func FormatSRT(segments []models.TranscriptSegment) string {
+	var b strings.Builder
+
+	for i, segment := range segments {
+		fmt.Fprintf(&b, "%d\n%s --> %s\n%s\n\n",
+			i+1,
+			formatTimestamp(segment.Start, ","),
+			formatTimestamp(segment.End, ","),
+			strings.TrimSpace(segment.Text),
+		)
+	}
+
+	return b.String()
+}
+
+// FormatWebVTT формирует субтитры в формате WebVTT
+func FormatWebVTT(segments []models.TranscriptSegment) string {
+	var b strings.Builder
+	b.WriteString("WEBVTT\n\n")
+
+	for _, segment := range segments {
+		fmt.Fprintf(&b, "%s --> %s\n%s\n\n",
+			formatTimestamp(segment.Start, "."),
+			formatTimestamp(segment.End, "."),
+			strings.TrimSpace(segment.Text),
+		)
+	}
+
+	return b.String()
+}
+
+// FormatTimestampedText формирует текст, где каждая реплика начинается с метки времени
+func FormatTimestampedText(segments []models.TranscriptSegment) string {
+	var b strings.Builder
+
+	for _, segment := range segments {
+		fmt.Fprintf(&b, "[%s] %s\n",
+			formatTimestamp(segment.Start, ".")[:8],
+			strings.TrimSpace(segment.Text),
+		)
+	}
+
+	return b.String()
+}
+
+// formatTimestamp форматирует время в секундах как ЧЧ:ММ:СС<sep>ммм
+func formatTimestamp(seconds float64, sep string) string {
+	if seconds < 0 {
+		seconds = 0
+	}
+
+	ms := int64(math.Round(seconds * 1000))
+	hours := ms / 3600000
+	minutes := ms / 60000 % 60
+	secs := ms / 1000 % 60
+	millis := ms % 1000
+
+	return fmt.Sprintf("%02d:%02d:%02d%s%03d", hours, minutes, secs, sep, millis)
+}
@@ -0,0 +1,373 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"unicode"
+
+	"github.com/trofimovm/summvideo/utils"
+)
+
+// Стратегии суммаризации транскрипции
+const (
+	SummaryStrategyAuto      = "auto"       // одним запросом, если транскрипция помещается в контекст, иначе map_reduce
+	SummaryStrategySingle    = "single"     // вся транскрипция одним запросом
+	SummaryStrategyMapReduce = "map_reduce" // саммари фрагментов, затем их объединение
+	SummaryStrategyRefine    = "refine"     // последовательное уточнение саммари по фрагментам
+)
+
+// Служебные инструкции, добавляемые к промту пользователя на этапах суммаризации
+const (
+	mapInstruction = "Ниже приведен фрагмент %d из %d транскрипции длинной записи. " +
+		"Составь саммари этого фрагмента по инструкции выше, не додумывая то, чего в нем нет."
+	reduceInstruction = "Ниже приведены саммари последовательных фрагментов одной записи. " +
+		"Объедини их в одно итоговое саммари по инструкции выше, убрав повторы."
+	refineInstruction = "Ниже приведено текущее саммари начала записи и следующий фрагмент транскрипции " +
+		"(%d из %d). Дополни и уточни саммари с учетом нового фрагмента по инструкции выше " +
+		"и верни саммари целиком."
+)
+
+// instructionTokens — запас токенов на служебные инструкции и разметку фрагментов
+const instructionTokens = 200
+
+// SummarizationConfig содержит настройки суммаризации длинных транскрипций
+type SummarizationConfig struct {
+	ContextTokens int // размер контекстного окна модели, токены
+	OutputTokens  int // запас под ответ модели, токены
+	ChunkTokens   int // максимальный размер фрагмента; 0 — по размеру контекста
+	Parallelism   int // число одновременно суммаризируемых фрагментов
+	MaxRetries    int // число повторных попыток для запроса
+}
+
+// SummarizationConfigFromEnv читает настройки суммаризации из переменных окружения
+func SummarizationConfigFromEnv() SummarizationConfig {
+	return SummarizationConfig{
+		ContextTokens: utils.GetEnvInt("SUMMARY_CONTEXT_TOKENS", 128000),
+		OutputTokens:  utils.GetEnvInt("SUMMARY_OUTPUT_TOKENS", 4096),
+		ChunkTokens:   utils.GetEnvInt("SUMMARY_CHUNK_TOKENS", 0),
+		Parallelism:   utils.GetEnvInt("SUMMARY_PARALLELISM", 3),
+		MaxRetries:    utils.GetEnvInt("SUMMARY_MAX_RETRIES", 2),
+	}
+}
+
+// ParseSummaryStrategy проверяет название стратегии суммаризации.
+// Пустая строка означает стратегию по умолчанию.
+func ParseSummaryStrategy(strategy string) (string, error) {
+	switch strategy {
+	case "":
+		return SummaryStrategyAuto, nil
+	case SummaryStrategyAuto, SummaryStrategySingle, SummaryStrategyMapReduce, SummaryStrategyRefine:
+		return strategy, nil
+	default:
+		return "", fmt.Errorf("неизвестная стратегия суммаризации %q, доступны auto, single, map_reduce и refine", strategy)
+	}
+}
+
+// EstimateTokens оценивает число токенов в тексте. Точный подсчет зависит от
+// токенизатора модели, поэтому оценка сделана с запасом: латиница и цифры
+// считаются по 4 символа на токен, кириллица и прочие буквы — по 2,
+// знаки препинания — по одному токену.
+func EstimateTokens(text string) int {
+	tokens := 0
+	for _, word := range strings.Fields(text) {
+		ascii, other := 0, 0
+		for _, r := range word {
+			switch {
+			case r < unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r)):
+				ascii++
+			case unicode.IsLetter(r) || unicode.IsDigit(r):
+				other++
+			default:
+				tokens++
+			}
+		}
+		tokens += (ascii+3)/4 + (other+1)/2
+	}
+	return tokens
+}
+
+// SummarizeTranscript генерирует саммари транскрипции выбранной стратегией.
+// Транскрипции, не помещающиеся в контекст модели, разбиваются на фрагменты.
+// progress вызывается после каждого запроса к модели с числом выполненных
+// и запланированных запросов. Возвращает саммари и фактически примененную стратегию.
+func SummarizeTranscript(ctx context.Context, summarizer Summarizer, transcript, prompt, strategy string, cfg SummarizationConfig, progress func(done, total int)) (string, string, error) {
+	strategy, err := ParseSummaryStrategy(strategy)
+	if err != nil {
+		return "", "", err
+	}
+
+	// Сколько токенов транскрипции помещается в один запрос вместе с промтом и ответом
+	budget := cfg.ContextTokens - cfg.OutputTokens - EstimateTokens(prompt) - instructionTokens
+	if budget <= 0 {
+		return "", "", fmt.Errorf("промт не помещается в контекст модели (%d токенов)", cfg.ContextTokens)
+	}
+
+	transcriptTokens := EstimateTokens(transcript)
+	if strategy == SummaryStrategyAuto {
+		strategy = SummaryStrategySingle
+		if transcriptTokens > budget {
+			strategy = SummaryStrategyMapReduce
+		}
+	}
+
+	s := &chunkSummarizer{ctx: ctx, summarizer: summarizer, prompt: prompt, cfg: cfg, progress: progress}
+
+	var summary string
+	switch strategy {
+	case SummaryStrategySingle:
+		if transcriptTokens > budget {
+			return "", "", fmt.Errorf(
+				"транскрипция (~%d токенов) не помещается в контекст модели (%d токенов), выберите стратегию map_reduce или refine",
+				transcriptTokens, cfg.ContextTokens,
+			)
+		}
+		s.total = 1
+		summary, err = s.summarize(transcript, prompt)
+	case SummaryStrategyMapReduce:
+		summary, err = s.mapReduce(transcript, chunkLimit(budget, cfg.ChunkTokens))
+	case SummaryStrategyRefine:
+		// В каждый запрос вместе с фрагментом попадает текущее саммари
+		summary, err = s.refine(transcript, chunkLimit(budget-cfg.OutputTokens, cfg.ChunkTokens))
+	}
+	if err != nil {
+		return "", "", err
+	}
+
+	return summary, strategy, nil
+}
+
+// chunkLimit возвращает размер фрагмента с учетом настройки ChunkTokens
+func chunkLimit(budget, chunkTokens int) int {
+	if chunkTokens > 0 && chunkTokens < budget {
+		return chunkTokens
+	}
+	return max(budget, 1)
+}
+
+// chunkSummarizer выполняет запросы к модели и считает их для отчета о прогрессе
+type chunkSummarizer struct {
+	ctx        context.Context
+	summarizer Summarizer
+	prompt     string
+	cfg        SummarizationConfig
+	progress   func(done, total int)
+
+	mu    sync.Mutex
+	done  int
+	total int
+}
+
+// summarize выполняет один запрос к модели с повторными попытками
+func (s *chunkSummarizer) summarize(transcript, prompt string) (string, error) {
+	summary, err := withRetry(s.ctx, s.cfg.MaxRetries, func() (string, error) {
+		return s.summarizer.Summarize(s.ctx, transcript, prompt)
+	})
+	if err != nil {
+		return "", err
+	}
+
+	s.mu.Lock()
+	s.done++
+	if s.progress != nil {
+		s.progress(s.done, max(s.total, s.done))
+	}
+	s.mu.Unlock()
+
+	return summary, nil
+}
+
+// mapReduce суммаризирует фрагменты параллельно и объединяет их саммари.
+// Если саммари фрагментов вместе не помещаются в контекст, объединение
+// выполняется в несколько уровней.
+func (s *chunkSummarizer) mapReduce(transcript string, limit int) (string, error) {
+	chunks := splitByTokens(transcript, limit)
+	s.total = len(chunks)
+	if len(chunks) > 1 {
+		s.total++ // объединение саммари фрагментов
+	}
+
+	partials, err := s.mapChunks(chunks, func(i int) string {
+		return s.prompt + "\n\n" + fmt.Sprintf(mapInstruction, i+1, len(chunks))
+	})
+	if err != nil {
+		return "", err
+	}
+
+	reducePrompt := s.prompt + "\n\n" + reduceInstruction
+	for len(partials) > 1 {
+		groups := groupByTokens(partials, limit)
+		if len(groups) == len(partials) {
+			// Ни одну пару саммари нельзя объединить в пределах фрагмента
+			return "", fmt.Errorf("саммари фрагментов не помещаются в контекст модели даже попарно, увеличьте SUMMARY_CHUNK_TOKENS")
+		}
+
+		s.mu.Lock()
+		s.total += len(groups) - 1
+		s.mu.Unlock()
+
+		partials, err = s.mapChunks(groups, func(int) string { return reducePrompt })
+		if err != nil {
+			return "", err
+		}
+	}
+
+	return partials[0], nil
+}
+
+// mapChunks суммаризирует фрагменты параллельно, сохраняя их порядок
+func (s *chunkSummarizer) mapChunks(chunks []string, prompt func(i int) string) ([]string, error) {
+	parallelism := max(s.cfg.Parallelism, 1)
+
+	results := make([]string, len(chunks))
+	errs := make([]error, len(chunks))
+	semaphore := make(chan struct{}, parallelism)
+
+	var wg sync.WaitGroup
+	for i, chunk := range chunks {
+		wg.Add(1)
+		go func(i int, chunk string) {
+			defer wg.Done()
+
+			semaphore <- struct{}{}
+			defer func() { <-semaphore }()
+
+			summary, err := s.summarize(chunk, prompt(i))
+			if err != nil {
+				errs[i] = fmt.Errorf("фрагмент %d из %d: %v", i+1, len(chunks), err)
+				return
+			}
+			results[i] = summary
+		}(i, chunk)
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return results, nil
+}
+
+// refine строит саммари первого фрагмента и последовательно уточняет его
+// остальными фрагментами
+func (s *chunkSummarizer) refine(transcript string, limit int) (string, error) {
+	chunks := splitByTokens(transcript, limit)
+	s.total = len(chunks)
+
+	summary, err := s.summarize(chunks[0], s.prompt+"\n\n"+fmt.Sprintf(mapInstruction, 1, len(chunks)))
+	if err != nil {
+		return "", err
+	}
+
+	for i := 1; i < len(chunks); i++ {
+		input := "Текущее саммари:\n" + summary + "\n\nСледующий фрагмент транскрипции:\n" + chunks[i]
+		summary, err = s.summarize(input, s.prompt+"\n\n"+fmt.Sprintf(refineInstruction, i+1, len(chunks)))
+		if err != nil {
+			return "", fmt.Errorf("фрагмент %d из %d: %v", i+1, len(chunks), err)
+		}
+	}
+
+	return summary, nil
+}
+
+// groupByTokens объединяет соседние саммари в группы, помещающиеся в limit токенов
+func groupByTokens(parts []string, limit int) []string {
+	var groups []string
+	var current strings.Builder
+	currentTokens := 0
+
+	for i, part := range parts {
+		block := fmt.Sprintf("Фрагмент %d:\n%s", i+1, part)
+		tokens := EstimateTokens(block)
+		if current.Len() > 0 && currentTokens+tokens > limit {
+			groups = append(groups, current.String())
+			current.Reset()
+			currentTokens = 0
+		}
+		if current.Len() > 0 {
+			current.WriteString("\n\n")
+		}
+		current.WriteString(block)
+		currentTokens += tokens
+	}
+	if current.Len() > 0 {
+		groups = append(groups, current.String())
+	}
+
+	return groups
+}
+
+// splitByTokens разбивает текст на фрагменты не больше limit токенов,
+// стараясь резать по границам предложений. Предложения длиннее limit
+// разбиваются по словам.
+func splitByTokens(text string, limit int) []string {
+	var chunks []string
+	var current []string
+	currentTokens := 0
+
+	flush := func() {
+		if len(current) > 0 {
+			chunks = append(chunks, strings.Join(current, " "))
+			current = nil
+			currentTokens = 0
+		}
+	}
+
+	add := func(piece string, tokens int) {
+		if currentTokens+tokens > limit {
+			flush()
+		}
+		current = append(current, piece)
+		currentTokens += tokens
+	}
+
+	for _, sentence := range splitSentences(text) {
+		tokens := EstimateTokens(sentence)
+		if tokens <= limit {
+			add(sentence, tokens)
+			continue
+		}
+		for _, word := range strings.Fields(sentence) {
+			add(word, EstimateTokens(word))
+		}
+	}
+	flush()
+
+	if len(chunks) == 0 {
+		return []string{""}
+	}
+	return chunks
+}
+
+// splitSentences разбивает текст на предложения по завершающим знакам препинания
+// и переводам строк
+func splitSentences(text string) []string {
+	var sentences []string
+	var current strings.Builder
+
+	flush := func() {
+		if sentence := strings.TrimSpace(current.String()); sentence != "" {
+			sentences = append(sentences, sentence)
+		}
+		current.Reset()
+	}
+
+	runes := []rune(text)
+	for i, r := range runes {
+		if r == '\n' {
+			flush()
+			continue
+		}
+		current.WriteRune(r)
+		if strings.ContainsRune(".!?…", r) && (i+1 == len(runes) || unicode.IsSpace(runes[i+1])) {
+			flush()
+		}
+	}
+	flush()
+
+	return sentences
+}
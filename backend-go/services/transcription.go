@@ -0,0 +1,294 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+
+	"github.com/sashabaranov/go-openai"
+	"github.com/trofimovm/summvideo/models"
+	"github.com/trofimovm/summvideo/utils"
+)
+
+// maxOverlapWords ограничивает число слов, сравниваемых при склейке соседних фрагментов
+const maxOverlapWords = 80
+
+// minOverlapWords задает минимальную длину совпадения, которое считается повтором
+const minOverlapWords = 3
+
+// maxOverlapOffset задает, сколько обрезанных слов в начале фрагмента допускается перед повтором
+const maxOverlapOffset = 3
+
+// ChunkingConfig содержит настройки транскрибации длинных записей по фрагментам
+type ChunkingConfig struct {
+	MaxFileSize     int64         // файлы не больше этого размера отправляются целиком, байты
+	SegmentDuration time.Duration // целевая длительность фрагмента
+	Overlap         time.Duration // перекрытие соседних фрагментов
+	SplitOnSilence  bool          // сдвигать границы фрагментов на паузы
+	Parallelism     int           // число одновременно транскрибируемых фрагментов
+	MaxRetries      int           // число повторных попыток для фрагмента
+}
+
+// ChunkingConfigFromEnv читает настройки нарезки из переменных окружения
+func ChunkingConfigFromEnv() ChunkingConfig {
+	return ChunkingConfig{
+		MaxFileSize:     int64(utils.GetEnvInt("TRANSCRIBE_MAX_FILE_MB", 24)) << 20,
+		SegmentDuration: time.Duration(utils.GetEnvInt("TRANSCRIBE_SEGMENT_SECONDS", 600)) * time.Second,
+		Overlap:         time.Duration(utils.GetEnvInt("TRANSCRIBE_OVERLAP_SECONDS", 5)) * time.Second,
+		SplitOnSilence:  utils.GetEnv("TRANSCRIBE_SPLIT_ON_SILENCE", "true") == "true",
+		Parallelism:     utils.GetEnvInt("TRANSCRIBE_PARALLELISM", 3),
+		MaxRetries:      utils.GetEnvInt("TRANSCRIBE_MAX_RETRIES", 3),
+	}
+}
+
+// TranscribeChunked транскрибирует аудио файл, при необходимости разбивая его
+// на перекрывающиеся фрагменты. Фрагменты обрабатываются параллельно функцией
+// transcribe, а результаты склеиваются по порядку с удалением повторов на стыках.
+// Временные метки сегментов пересчитываются относительно начала исходного файла.
+// progress вызывается после каждого готового фрагмента с их числом.
+// Отмена ctx прерывает нарезку и ожидание повторных попыток.
+func TranscribeChunked(ctx context.Context, audioFile string, cfg ChunkingConfig, transcribe func(path string) (*models.Transcription, error), progress func(done, total int)) (*models.Transcription, error) {
+	info, err := os.Stat(audioFile)
+	if err != nil {
+		return nil, NewProcessingError(FailureInternal, "", false, fmt.Errorf("ошибка открытия аудио файла: %v", err))
+	}
+
+	// Небольшие файлы отправляем целиком
+	if info.Size() <= cfg.MaxFileSize {
+		transcription, err := withRetry(ctx, cfg.MaxRetries, func() (*models.Transcription, error) {
+			return transcribe(audioFile)
+		})
+		if err != nil {
+			return nil, err
+		}
+		if progress != nil {
+			progress(1, 1)
+		}
+		return transcription, nil
+	}
+
+	// Фрагменты создаются рядом с аудио, в рабочем каталоге задачи
+	chunkDir, err := os.MkdirTemp(filepath.Dir(audioFile), "chunks-*")
+	if err != nil {
+		return nil, NewProcessingError(FailureInternal, "", false, fmt.Errorf("ошибка создания каталога для фрагментов: %v", err))
+	}
+	defer os.RemoveAll(chunkDir)
+
+	// Ошибки нарезки относятся к ffmpeg, а не к провайдеру транскрибации
+	chunks, err := SplitAudio(ctx, audioFile, chunkDir, cfg.SegmentDuration, cfg.Overlap, cfg.SplitOnSilence)
+	if err != nil {
+		return nil, NewProcessingError(FailureMediaProcessing, "", false, err)
+	}
+
+	parallelism := cfg.Parallelism
+	if parallelism < 1 {
+		parallelism = 1
+	}
+
+	results := make([]*models.Transcription, len(chunks))
+	errs := make([]error, len(chunks))
+	semaphore := make(chan struct{}, parallelism)
+
+	var (
+		wg     sync.WaitGroup
+		mu     sync.Mutex
+		done   int
+		failed bool
+	)
+
+	for i, chunk := range chunks {
+		wg.Add(1)
+		go func(i int, chunk AudioChunk) {
+			defer wg.Done()
+
+			semaphore <- struct{}{}
+			defer func() { <-semaphore }()
+
+			// После первой окончательной ошибки остальные фрагменты не отправляем
+			mu.Lock()
+			skip := failed
+			mu.Unlock()
+			if skip {
+				return
+			}
+
+			transcription, err := withRetry(ctx, cfg.MaxRetries, func() (*models.Transcription, error) {
+				return transcribe(chunk.Path)
+			})
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			if err != nil {
+				errs[i] = fmt.Errorf("фрагмент %d из %d: %v", i+1, len(chunks), err)
+				failed = true
+				return
+			}
+
+			results[i] = transcription
+			done++
+			if progress != nil {
+				progress(done, len(chunks))
+			}
+		}(i, chunk)
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return mergeChunkTranscriptions(chunks, results), nil
+}
+
+// retryBaseDelay — задержка перед первой повторной попыткой, затем она удваивается
+var retryBaseDelay = time.Second
+
+// withRetry выполняет fn, повторяя попытку при временной ошибке с экспоненциальной
+// задержкой. Ожидание прерывается вместе с ctx, например при отмене задачи.
+func withRetry[T any](ctx context.Context, retries int, fn func() (T, error)) (T, error) {
+	delay := retryBaseDelay
+
+	for attempt := 0; ; attempt++ {
+		result, err := fn()
+		if err == nil || attempt >= retries || !isTransient(err) || ctx.Err() != nil {
+			return result, err
+		}
+
+		log.Printf("Попытка %d не удалась: %v, повтор через %s", attempt+1, err, delay)
+		select {
+		case <-ctx.Done():
+			var zero T
+			return zero, ctx.Err()
+		case <-time.After(delay):
+		}
+		delay *= 2
+	}
+}
+
+// isTransient проверяет, имеет ли смысл повторить запрос к провайдеру. Ответы
+// 4xx, кроме 429 Too Many Requests, означают ошибку в самом запросе, и повтор
+// её не исправит. Ошибки сети и 5xx считаются временными.
+func isTransient(err error) bool {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+
+	status := 0
+	var apiErr *openai.APIError
+	var requestErr *openai.RequestError
+	switch {
+	case errors.As(err, &apiErr):
+		status = apiErr.HTTPStatusCode
+	case errors.As(err, &requestErr):
+		status = requestErr.HTTPStatusCode
+	}
+
+	if status >= 400 && status < 500 {
+		return status == http.StatusTooManyRequests
+	}
+	return true
+}
+
+// mergeChunkTranscriptions объединяет результаты фрагментов. Если у всех фрагментов
+// есть сегменты, из перекрытия берутся сегменты, середина которых лежит после
+// границы фрагмента. Иначе тексты склеиваются по совпадающим словам на стыках.
+func mergeChunkTranscriptions(chunks []AudioChunk, results []*models.Transcription) *models.Transcription {
+	withSegments := true
+	for _, result := range results {
+		if len(result.Segments) == 0 {
+			withSegments = false
+			break
+		}
+	}
+
+	if !withSegments {
+		texts := make([]string, len(results))
+		for i, result := range results {
+			texts[i] = result.Text
+		}
+		return &models.Transcription{Text: mergeTranscripts(texts), Language: results[0].Language}
+	}
+
+	merged := &models.Transcription{Language: results[0].Language}
+	var texts []string
+	for i, result := range results {
+		for _, segment := range result.Segments {
+			segment.Start += chunks[i].Start
+			segment.End += chunks[i].Start
+
+			// Сегмент из перекрытия уже есть в предыдущем фрагменте
+			if i > 0 && (segment.Start+segment.End)/2 < chunks[i].Cut {
+				continue
+			}
+
+			merged.Segments = append(merged.Segments, segment)
+			texts = append(texts, strings.TrimSpace(segment.Text))
+		}
+	}
+	merged.Text = strings.Join(texts, " ")
+
+	return merged
+}
+
+// mergeTranscripts склеивает тексты фрагментов, убирая из начала каждого
+// следующего фрагмента слова, повторяющие конец предыдущего из-за перекрытия
+func mergeTranscripts(texts []string) string {
+	var merged []string
+
+	for _, text := range texts {
+		words := strings.Fields(text)
+		if len(words) == 0 {
+			continue
+		}
+
+		skip := overlapLength(merged, words)
+		merged = append(merged, words[skip:]...)
+	}
+
+	return strings.Join(merged, " ")
+}
+
+// overlapLength возвращает число слов в начале next, повторяющих конец prev.
+// Первые слова next могут быть обрезаны на границе фрагмента, поэтому
+// совпадение ищется с небольшим сдвигом. Слова сравниваются без учета
+// регистра и пунктуации.
+func overlapLength(prev, next []string) int {
+	for n := min(maxOverlapWords, len(prev), len(next)); n >= minOverlapWords; n-- {
+		tail := prev[len(prev)-n:]
+		for offset := 0; offset <= maxOverlapOffset && offset+n <= len(next); offset++ {
+			if wordsEqual(tail, next[offset:offset+n]) {
+				return offset + n
+			}
+		}
+	}
+
+	return 0
+}
+
+// wordsEqual сравнивает последовательности слов после нормализации
+func wordsEqual(a, b []string) bool {
+	for i := range a {
+		if normalizeWord(a[i]) != normalizeWord(b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// normalizeWord приводит слово к нижнему регистру и удаляет пунктуацию
+func normalizeWord(word string) string {
+	return strings.ToLower(strings.TrimFunc(word, func(r rune) bool {
+		return unicode.IsPunct(r) || unicode.IsSymbol(r)
+	}))
+}
@@ -0,0 +1,82 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/sashabaranov/go-openai"
+)
+
+func TestWithRetry(t *testing.T) {
+	defer func(delay time.Duration) { retryBaseDelay = delay }(retryBaseDelay)
+	retryBaseDelay = time.Millisecond
+
+	tests := []struct {
+		name  string
+		err   error
+		calls int
+	}{
+		{"network error", errors.New("connection reset"), 4},
+		{"server error", &openai.APIError{HTTPStatusCode: http.StatusBadGateway}, 4},
+		{"rate limit", &openai.RequestError{HTTPStatusCode: http.StatusTooManyRequests}, 4},
+		{"bad request", &openai.APIError{HTTPStatusCode: http.StatusBadRequest}, 1},
+		{"unauthorized", &openai.RequestError{HTTPStatusCode: http.StatusUnauthorized}, 1},
+		{"wrapped bad request", NewProcessingError(FailureProviderError, "", false, &openai.APIError{HTTPStatusCode: http.StatusRequestEntityTooLarge}), 1},
+	}
+	for _, tt := range tests {
+		calls := 0
+		_, err := withRetry(context.Background(), 3, func() (string, error) {
+			calls++
+			return "", tt.err
+		})
+		if err != tt.err || calls != tt.calls {
+			t.Errorf("%s: got %d calls and %v, want %d calls", tt.name, calls, err, tt.calls)
+		}
+	}
+
+	calls := 0
+	result, err := withRetry(context.Background(), 3, func() (string, error) {
+		calls++
+		if calls < 3 {
+			return "", errors.New("timeout")
+		}
+		return "ok", nil
+	})
+	if err != nil || result != "ok" || calls != 3 {
+		t.Errorf("recovered: got %q, %v after %d calls", result, err, calls)
+	}
+}
+
+func TestWithRetryStopsOnCancel(t *testing.T) {
+	defer func(delay time.Duration) { retryBaseDelay = delay }(retryBaseDelay)
+	retryBaseDelay = time.Hour
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(10*time.Millisecond, cancel)
+
+	start := time.Now()
+	calls := 0
+	_, err := withRetry(ctx, 5, func() (string, error) {
+		calls++
+		return "", errors.New("connection reset")
+	})
+	if !errors.Is(err, context.Canceled) || calls != 1 {
+		t.Fatalf("got %d calls and %v, want 1 call and context.Canceled", calls, err)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Fatalf("cancelled retry waited %s", elapsed)
+	}
+
+	// Уже отмененный контекст не ждет и не повторяет запрос
+	calls = 0
+	withRetry(ctx, 5, func() (string, error) {
+		calls++
+		return "", errors.New("connection reset")
+	})
+	if calls != 1 {
+		t.Fatalf("got %d calls with a cancelled context, want 1", calls)
+	}
+}
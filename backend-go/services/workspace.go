@@ -0,0 +1,196 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/trofimovm/summvideo/utils"
+)
+
+// workspacePrefix начинает имя рабочего каталога задачи
+const workspacePrefix = "job-"
+
+// sourceFileName — имя исходного файла в рабочем каталоге. Имя файла,
+// переданное клиентом, сохраняется только в задаче для отображения.
+const sourceFileName = "source"
+
+// maxExtensionLength ограничивает длину расширения исходного файла
+const maxExtensionLength = 10
+
+// ErrInsufficientDiskSpace возвращается, если на диске не хватает места для загрузки
+var ErrInsufficientDiskSpace = errors.New("недостаточно места на диске")
+
+// WorkspaceRoot возвращает каталог, в котором создаются рабочие каталоги задач
+// (UPLOAD_DIR). Каталог должен переживать перезапуск сервера, чтобы задачи
+// можно было продолжить.
+func WorkspaceRoot() string {
+	return utils.GetEnv("UPLOAD_DIR", filepath.Join(os.TempDir(), "summvideo"))
+}
+
+// NewWorkspace создает рабочий каталог задачи со случайным именем,
+// доступный только владельцу процесса
+func NewWorkspace() (string, error) {
+	root := WorkspaceRoot()
+	if err := os.MkdirAll(root, 0o700); err != nil {
+		return "", fmt.Errorf("ошибка создания каталога загрузок: %v", err)
+	}
+
+	dir, err := os.MkdirTemp(root, workspacePrefix+"*")
+	if err != nil {
+		return "", fmt.Errorf("ошибка создания рабочего каталога: %v", err)
+	}
+
+	return dir, nil
+}
+
+// SourcePath возвращает путь к исходному файлу в рабочем каталоге. От имени
+// файла клиента берется только расширение, и только из латинских букв и цифр.
+func SourcePath(workspace, clientFilename string) string {
+	ext := strings.ToLower(filepath.Ext(filepath.Base(clientFilename)))
+	if !validExtension(ext) {
+		ext = ""
+	}
+
+	return filepath.Join(workspace, sourceFileName+ext)
+}
+
+// validExtension проверяет, что расширение состоит из точки и не более
+// maxExtensionLength латинских букв и цифр
+func validExtension(ext string) bool {
+	if len(ext) < 2 || len(ext) > maxExtensionLength+1 {
+		return false
+	}
+
+	for _, r := range ext[1:] {
+		if !(r >= 'a' && r <= 'z' || r >= '0' && r <= '9') {
+			return false
+		}
+	}
+
+	return true
+}
+
+// JobWorkspace возвращает рабочий каталог, в котором лежит исходный файл задачи.
+// Для задач, сохраненных до появления рабочих каталогов, возвращает пустую строку.
+func JobWorkspace(filePath string) string {
+	dir := filepath.Dir(filePath)
+	if !isWorkspace(dir) {
+		return ""
+	}
+	return dir
+}
+
+// RemoveJobFiles удаляет рабочий каталог задачи вместе со всеми промежуточными
+// файлами, а для старых задач — только исходный файл
+func RemoveJobFiles(filePath string) {
+	if filePath == "" {
+		return
+	}
+
+	if dir := JobWorkspace(filePath); dir != "" {
+		os.RemoveAll(dir)
+		return
+	}
+	os.Remove(filePath)
+}
+
+// RemoveWorkspace удаляет рабочий каталог. Каталоги вне WorkspaceRoot не удаляются.
+func RemoveWorkspace(dir string) error {
+	if !isWorkspace(dir) {
+		return fmt.Errorf("%s не является рабочим каталогом", dir)
+	}
+	return os.RemoveAll(dir)
+}
+
+// isWorkspace проверяет, что dir — рабочий каталог непосредственно внутри WorkspaceRoot
+func isWorkspace(dir string) bool {
+	root, err := filepath.Abs(WorkspaceRoot())
+	if err != nil {
+		return false
+	}
+	abs, err := filepath.Abs(dir)
+	if err != nil {
+		return false
+	}
+
+	return filepath.Dir(abs) == root && strings.HasPrefix(filepath.Base(abs), workspacePrefix)
+}
+
+// SweepWorkspaces удаляет из WorkspaceRoot рабочие каталоги и файлы старых
+// загрузок, которые не принадлежат незавершенным задачам из active и не
+// изменялись дольше grace. Так убираются остатки задач после аварийного
+// завершения сервера. Возвращает число удаленных записей.
+func SweepWorkspaces(active []string, grace time.Duration) (int, error) {
+	root := WorkspaceRoot()
+	entries, err := os.ReadDir(root)
+	if errors.Is(err, os.ErrNotExist) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	keep := make(map[string]bool, len(active))
+	for _, filePath := range active {
+		if dir := JobWorkspace(filePath); dir != "" {
+			keep[filepath.Base(dir)] = true
+		} else {
+			keep[filepath.Base(filePath)] = true
+		}
+	}
+
+	removed := 0
+	for _, entry := range entries {
+		name := entry.Name()
+		if keep[name] {
+			continue
+		}
+		// Загрузки до появления рабочих каталогов сохранялись как upload-*
+		if !(entry.IsDir() && strings.HasPrefix(name, workspacePrefix)) &&
+			!(!entry.IsDir() && strings.HasPrefix(name, "upload-")) {
+			continue
+		}
+
+		info, err := entry.Info()
+		if err != nil || time.Since(info.ModTime()) < grace {
+			continue
+		}
+
+		if err := os.RemoveAll(filepath.Join(root, name)); err != nil {
+			return removed, err
+		}
+		removed++
+	}
+
+	return removed, nil
+}
+
+// EnsureDiskSpace проверяет, что после записи need байт в WorkspaceRoot на диске
+// останется не меньше MIN_FREE_DISK_MB мегабайт (1024 по умолчанию)
+func EnsureDiskSpace(need int64) error {
+	root := WorkspaceRoot()
+	if err := os.MkdirAll(root, 0o700); err != nil {
+		return fmt.Errorf("ошибка создания каталога загрузок: %v", err)
+	}
+
+	free, err := freeDiskSpace(root)
+	if err != nil {
+		return err
+	}
+	if free < 0 {
+		// Объем свободного места на этой платформе не определяется
+		return nil
+	}
+
+	reserve := int64(utils.GetEnvInt("MIN_FREE_DISK_MB", 1024)) << 20
+	if free-need < reserve {
+		return fmt.Errorf("%w: свободно %d МБ, требуется %d МБ",
+			ErrInsufficientDiskSpace, free>>20, (need+reserve)>>20)
+	}
+
+	return nil
+}
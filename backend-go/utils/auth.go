@@ -2,10 +2,14 @@ package utils
 
 import (
 	"crypto/hmac"
+	"crypto/rand"
 	"crypto/sha256"
+	"encoding/base64"
 	"encoding/hex"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"net/url"
 	"os"
 	"sort"
 	"strconv"
@@ -18,112 +22,226 @@ import (
 
 // JWT claims структура
 type Claims struct {
-	UserID int64 `json:"user_id"`
+	UserID    int64 `json:"user_id"`
+	SessionID int64 `json:"sid"` // сессия, выдавшая токен; отзыв сессии делает токен недействительным
 	jwt.RegisteredClaims
 }
 
-// ValidateTelegramAuth проверяет данные аутентификации от Telegram
-func ValidateTelegramAuth(authData *models.TelegramAuthData) error {
-	// Проверяем, что auth_date не слишком старый (не более 24 часов)
-	authTime := time.Unix(authData.AuthDate, 0)
-	if time.Since(authTime) > 24*time.Hour {
-		return errors.New("auth data is expired")
+// telegramAuthMaxAge ограничивает возраст данных авторизации Telegram
+const telegramAuthMaxAge = 24 * time.Hour
+
+// telegramNow возвращает текущее время для проверки auth_date; подменяется в тестах
+var telegramNow = time.Now
+
+// ValidateTelegramLogin проверяет параметры, переданные Telegram Login Widget.
+// Ключ проверки — SHA256(bot_token), строка проверки собирается из всех
+// полученных полей, кроме hash, отсортированных по имени.
+func ValidateTelegramLogin(params url.Values) (*models.TelegramAuthData, error) {
+	botToken := os.Getenv("TELEGRAM_BOT_TOKEN")
+	if botToken == "" {
+		return nil, errors.New("TELEGRAM_BOT_TOKEN is not set")
 	}
 
-	// Получаем ключ бота
+	secretKey := sha256.Sum256([]byte(botToken))
+	if err := checkTelegramHash(params, secretKey[:]); err != nil {
+		return nil, err
+	}
+
+	authData := &models.TelegramAuthData{
+		FirstName: params.Get("first_name"),
+		LastName:  params.Get("last_name"),
+		Username:  params.Get("username"),
+		PhotoURL:  params.Get("photo_url"),
+		Hash:      params.Get("hash"),
+	}
+	authData.ID, _ = strconv.ParseInt(params.Get("id"), 10, 64)
+	if authData.ID == 0 {
+		return nil, errors.New("user id is missing")
+	}
+
+	authDate, err := checkTelegramAuthDate(params)
+	if err != nil {
+		return nil, err
+	}
+	authData.AuthDate = authDate
+
+	return authData, nil
+}
+
+// ValidateTelegramWebAppData проверяет initData, переданные Telegram Mini App.
+// Ключ проверки — HMAC_SHA256 токена бота с ключом "WebAppData", данные
+// пользователя передаются в поле user в виде JSON.
+func ValidateTelegramWebAppData(initData string) (*models.TelegramAuthData, error) {
 	botToken := os.Getenv("TELEGRAM_BOT_TOKEN")
 	if botToken == "" {
-		return errors.New("TELEGRAM_BOT_TOKEN is not set")
+		return nil, errors.New("TELEGRAM_BOT_TOKEN is not set")
+	}
+
+	params, err := url.ParseQuery(initData)
+	if err != nil {
+		return nil, fmt.Errorf("invalid init data: %v", err)
+	}
+
+	mac := hmac.New(sha256.New, []byte("WebAppData"))
+	mac.Write([]byte(botToken))
+	if err := checkTelegramHash(params, mac.Sum(nil)); err != nil {
+		return nil, err
 	}
 
-	// Создаем secret key из token
-	secretKey := sha256.Sum256([]byte("WebAppData"))
-	
-	// Собираем все поля для проверки
-	dataCheckString := createDataCheckString(authData)
-	
-	// Проверяем hash
-	if !validateHash(dataCheckString, authData.Hash, secretKey[:]) {
+	var user struct {
+		ID        int64  `json:"id"`
+		FirstName string `json:"first_name"`
+		LastName  string `json:"last_name"`
+		Username  string `json:"username"`
+		PhotoURL  string `json:"photo_url"`
+	}
+	if err := json.Unmarshal([]byte(params.Get("user")), &user); err != nil || user.ID == 0 {
+		return nil, errors.New("user data is missing")
+	}
+
+	authDate, err := checkTelegramAuthDate(params)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.TelegramAuthData{
+		ID:        user.ID,
+		FirstName: user.FirstName,
+		LastName:  user.LastName,
+		Username:  user.Username,
+		PhotoURL:  user.PhotoURL,
+		AuthDate:  authDate,
+		Hash:      params.Get("hash"),
+	}, nil
+}
+
+// checkTelegramHash сверяет hash с HMAC_SHA256 строки проверки на ключе secretKey
+func checkTelegramHash(params url.Values, secretKey []byte) error {
+	hash, err := hex.DecodeString(params.Get("hash"))
+	if err != nil || len(hash) == 0 {
+		return errors.New("hash is missing")
+	}
+
+	mac := hmac.New(sha256.New, secretKey)
+	mac.Write([]byte(telegramDataCheckString(params)))
+
+	// Сравнение за постоянное время не раскрывает совпавшую часть подписи
+	if !hmac.Equal(mac.Sum(nil), hash) {
 		return errors.New("invalid hash")
 	}
 
 	return nil
 }
 
-// createDataCheckString создает строку для проверки hash
-func createDataCheckString(authData *models.TelegramAuthData) string {
-	// Создаем карту из полей
-	fields := map[string]string{
-		"auth_date": strconv.FormatInt(authData.AuthDate, 10),
-		"first_name": authData.FirstName,
-		"id": strconv.FormatInt(authData.ID, 10),
-		"username": authData.Username,
-	}
-	
-	if authData.LastName != "" {
-		fields["last_name"] = authData.LastName
-	}
-	
-	if authData.PhotoURL != "" {
-		fields["photo_url"] = authData.PhotoURL
-	}
-	
-	// Сортируем ключи
-	var keys []string
-	for k := range fields {
-		keys = append(keys, k)
-	}
-	sort.Strings(keys)
-	
-	// Собираем строку для проверки
-	var dataCheckParts []string
-	for _, k := range keys {
+// telegramDataCheckString собирает строку проверки: все полученные поля, кроме
+// hash, в виде key=value, отсортированные по имени и разделенные переводом строки
+func telegramDataCheckString(params url.Values) string {
+	keys := make([]string, 0, len(params))
+	for k := range params {
 		if k != "hash" {
-			dataCheckParts = append(dataCheckParts, fmt.Sprintf("%s=%s", k, fields[k]))
+			keys = append(keys, k)
 		}
 	}
-	
-	return strings.Join(dataCheckParts, "\n")
+	sort.Strings(keys)
+
+	parts := make([]string, len(keys))
+	for i, k := range keys {
+		parts[i] = k + "=" + params.Get(k)
+	}
+
+	return strings.Join(parts, "\n")
 }
 
-// validateHash проверяет корректность hash
-func validateHash(dataCheckString, hash string, secretKey []byte) bool {
-	h := hmac.New(sha256.New, secretKey)
-	h.Write([]byte(dataCheckString))
-	expectedHash := hex.EncodeToString(h.Sum(nil))
-	return expectedHash == hash
+// checkTelegramAuthDate проверяет, что данные авторизации не старше telegramAuthMaxAge
+func checkTelegramAuthDate(params url.Values) (int64, error) {
+	authDate, err := strconv.ParseInt(params.Get("auth_date"), 10, 64)
+	if err != nil || authDate == 0 {
+		return 0, errors.New("auth_date is missing")
+	}
+
+	if telegramNow().Sub(time.Unix(authDate, 0)) > telegramAuthMaxAge {
+		return 0, errors.New("auth data is expired")
+	}
+
+	return authDate, nil
 }
 
-// GenerateJWT создает JWT токен для пользователя
-func GenerateJWT(userID int64) (string, error) {
+// GenerateJWT создает короткоживущий токен доступа для сессии пользователя.
+// Срок действия задается ACCESS_TOKEN_TTL_MINUTES (по умолчанию 15 минут).
+func GenerateJWT(userID, sessionID int64) (string, time.Time, error) {
 	jwtSecret := os.Getenv("JWT_SECRET")
 	if jwtSecret == "" {
-		return "", errors.New("JWT_SECRET is not set")
+		return "", time.Time{}, errors.New("JWT_SECRET is not set")
 	}
 
-	// Устанавливаем срок действия токена (30 дней)
-	expirationTime := time.Now().Add(30 * 24 * time.Hour)
-	
+	// Устанавливаем срок действия токена
+	expirationTime := time.Now().Add(time.Duration(GetEnvInt("ACCESS_TOKEN_TTL_MINUTES", 15)) * time.Minute)
+
 	// Создаем claims
 	claims := &Claims{
-		UserID: userID,
+		UserID:    userID,
+		SessionID: sessionID,
 		RegisteredClaims: jwt.RegisteredClaims{
 			ExpiresAt: jwt.NewNumericDate(expirationTime),
 			IssuedAt:  jwt.NewNumericDate(time.Now()),
 			Issuer:    "summvideo",
 		},
 	}
-	
+
 	// Создаем токен
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	
+
 	// Подписываем токен секретным ключом
 	tokenString, err := token.SignedString([]byte(jwtSecret))
 	if err != nil {
-		return "", err
+		return "", time.Time{}, err
+	}
+
+	return tokenString, expirationTime, nil
+}
+
+// GenerateRefreshToken создает случайный обновляющий токен и возвращает его
+// вместе с хэшем, который сохраняется в БД вместо самого токена
+func GenerateRefreshToken() (string, string, error) {
+	return generateOpaqueToken()
+}
+
+// GenerateChallengeToken создает токен незавершенного входа администратора
+// и его хэш для хранения в БД
+func GenerateChallengeToken() (string, string, error) {
+	return generateOpaqueToken()
+}
+
+// generateOpaqueToken создает случайный токен и возвращает его вместе с хэшем
+func generateOpaqueToken() (string, string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", "", err
 	}
-	
-	return tokenString, nil
+
+	token := base64.RawURLEncoding.EncodeToString(buf)
+	return token, HashToken(token), nil
+}
+
+// APIKeyPrefix начинает каждый персональный API-ключ и отличает его от JWT
+const APIKeyPrefix = "svk_"
+
+// GenerateAPIKey создает персональный API-ключ. Возвращает ключ, его начало для
+// отображения в списке ключей и хэш, который сохраняется в БД
+func GenerateAPIKey() (string, string, string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", "", "", err
+	}
+
+	key := APIKeyPrefix + base64.RawURLEncoding.EncodeToString(buf)
+	return key, key[:len(APIKeyPrefix)+6], HashToken(key), nil
+}
+
+// HashToken возвращает SHA-256 токена в шестнадцатеричном виде
+func HashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
 }
 
 // ValidateJWT проверяет и возвращает claims из JWT токена
@@ -132,7 +250,7 @@ func ValidateJWT(tokenString string) (*Claims, error) {
 	if jwtSecret == "" {
 		return nil, errors.New("JWT_SECRET is not set")
 	}
-	
+
 	// Парсим токен
 	claims := &Claims{}
 	token, err := jwt.ParseWithClaims(tokenString, claims, func(token *jwt.Token) (interface{}, error) {
@@ -142,14 +260,14 @@ func ValidateJWT(tokenString string) (*Claims, error) {
 		}
 		return []byte(jwtSecret), nil
 	})
-	
+
 	if err != nil {
 		return nil, err
 	}
-	
+
 	if !token.Valid {
 		return nil, errors.New("invalid token")
 	}
-	
+
 	return claims, nil
-}
\ No newline at end of file
+}
@@ -0,0 +1,186 @@
+package utils
+
+import (
+	"net/url"
+	"testing"
+	"time"
+)
+
+// Векторы посчитаны независимо от кода по документации Telegram:
+// Login Widget — HMAC_SHA256(data_check_string, SHA256(bot_token)),
+// Mini App — HMAC_SHA256(data_check_string, HMAC_SHA256(bot_token, "WebAppData"))
+const (
+	testBotToken = "123456789:AAHdqTcvCH1vGWJxfSeofSAs0K5PALDsaw"
+	testAuthDate = 1700000000
+
+	loginHash      = "52d0517e03684966c1556f34533c80cff347bf75c84b47ac76700ee277b634cb"
+	loginExtraHash = "25d593e0f50fd9068fad823d4ecc3bd88af6334803c45faacc6de62a5c963c51"
+
+	webAppUser = `{"id":42,"first_name":"Ivan","last_name":"Petrov","username":"ivanp","language_code":"ru"}`
+	webAppHash = "2d1fa481d499d7204f53db80f19393952f69189961784b5f5c132313dcb143f4"
+	// Подпись тех же данных ключом Login Widget
+	webAppLoginKeyHash = "e53eb4c48d0cf68fb514d612530c16fcc879ce8fedf63b0d89656bfcbeec05a1"
+)
+
+// setupTelegram задает токен бота и время проверки через час после testAuthDate
+func setupTelegram(t *testing.T) {
+	t.Helper()
+
+	t.Setenv("TELEGRAM_BOT_TOKEN", testBotToken)
+	setTelegramNow(t, time.Unix(testAuthDate, 0).Add(time.Hour))
+}
+
+// setTelegramNow подменяет текущее время проверки auth_date на время теста
+func setTelegramNow(t *testing.T, now time.Time) {
+	t.Helper()
+
+	previous := telegramNow
+	telegramNow = func() time.Time { return now }
+	t.Cleanup(func() { telegramNow = previous })
+}
+
+// loginParams возвращает подписанные параметры Login Widget
+func loginParams() url.Values {
+	return url.Values{
+		"id":         {"42"},
+		"first_name": {"Ivan"},
+		"last_name":  {"Petrov"},
+		"username":   {"ivanp"},
+		"photo_url":  {"https://t.me/i/userpic/320/ivanp.jpg"},
+		"auth_date":  {"1700000000"},
+		"hash":       {loginHash},
+	}
+}
+
+// webAppParams возвращает подписанные initData Mini App
+func webAppParams() url.Values {
+	return url.Values{
+		"query_id":  {"AAHdF6IQAAAAAN0XohDhrOrc"},
+		"user":      {webAppUser},
+		"auth_date": {"1700000000"},
+		"hash":      {webAppHash},
+	}
+}
+
+func TestValidateTelegramLogin(t *testing.T) {
+	setupTelegram(t)
+
+	data, err := ValidateTelegramLogin(loginParams())
+	if err != nil {
+		t.Fatalf("valid login: %v", err)
+	}
+	if data.ID != 42 || data.Username != "ivanp" || data.FirstName != "Ivan" || data.AuthDate != testAuthDate {
+		t.Errorf("valid login: got %+v", data)
+	}
+
+	// Неизвестное поле входит в строку проверки
+	extra := loginParams()
+	extra.Set("extra", "1")
+	extra.Set("hash", loginExtraHash)
+	if _, err := ValidateTelegramLogin(extra); err != nil {
+		t.Errorf("extra field signed by Telegram: %v", err)
+	}
+
+	tests := []struct {
+		name   string
+		change func(url.Values)
+	}{
+		{"tampered field", func(p url.Values) { p.Set("username", "admin") }},
+		{"tampered id", func(p url.Values) { p.Set("id", "43") }},
+		{"unsigned extra field", func(p url.Values) { p.Set("extra", "1") }},
+		{"extra field removed", func(p url.Values) { p.Set("hash", loginExtraHash) }},
+		{"missing hash", func(p url.Values) { p.Del("hash") }},
+		{"malformed hash", func(p url.Values) { p.Set("hash", "not-hex") }},
+		{"truncated hash", func(p url.Values) { p.Set("hash", loginHash[:32]) }},
+		{"uppercase field name", func(p url.Values) { p["Username"] = p["username"]; p.Del("username") }},
+	}
+	for _, tt := range tests {
+		params := loginParams()
+		tt.change(params)
+		if _, err := ValidateTelegramLogin(params); err == nil {
+			t.Errorf("%s: accepted", tt.name)
+		}
+	}
+}
+
+func TestValidateTelegramLoginExpired(t *testing.T) {
+	setupTelegram(t)
+
+	setTelegramNow(t, time.Unix(testAuthDate, 0).Add(telegramAuthMaxAge-time.Minute))
+	if _, err := ValidateTelegramLogin(loginParams()); err != nil {
+		t.Errorf("auth_date within max age: %v", err)
+	}
+
+	setTelegramNow(t, time.Unix(testAuthDate, 0).Add(telegramAuthMaxAge+time.Minute))
+	if _, err := ValidateTelegramLogin(loginParams()); err == nil {
+		t.Error("expired auth_date: accepted")
+	}
+}
+
+func TestValidateTelegramLoginWithoutToken(t *testing.T) {
+	setupTelegram(t)
+	t.Setenv("TELEGRAM_BOT_TOKEN", "")
+
+	if _, err := ValidateTelegramLogin(loginParams()); err == nil {
+		t.Error("empty bot token: accepted")
+	}
+}
+
+func TestValidateTelegramWebAppData(t *testing.T) {
+	setupTelegram(t)
+
+	data, err := ValidateTelegramWebAppData(webAppParams().Encode())
+	if err != nil {
+		t.Fatalf("valid init data: %v", err)
+	}
+	if data.ID != 42 || data.Username != "ivanp" || data.LastName != "Petrov" || data.AuthDate != testAuthDate {
+		t.Errorf("valid init data: got %+v", data)
+	}
+
+	tests := []struct {
+		name   string
+		change func(url.Values)
+	}{
+		{"tampered user", func(p url.Values) { p.Set("user", `{"id":1,"first_name":"Ivan"}`) }},
+		{"tampered query_id", func(p url.Values) { p.Set("query_id", "other") }},
+		{"unsigned extra field", func(p url.Values) { p.Set("start_param", "ref") }},
+		{"login widget key", func(p url.Values) { p.Set("hash", webAppLoginKeyHash) }},
+		{"missing hash", func(p url.Values) { p.Del("hash") }},
+		{"malformed hash", func(p url.Values) { p.Set("hash", "zz") }},
+	}
+	for _, tt := range tests {
+		params := webAppParams()
+		tt.change(params)
+		if _, err := ValidateTelegramWebAppData(params.Encode()); err == nil {
+			t.Errorf("%s: accepted", tt.name)
+		}
+	}
+
+	// Подпись Mini App не подходит для Login Widget и наоборот
+	login := url.Values{}
+	for k, v := range webAppParams() {
+		login[k] = v
+	}
+	if _, err := ValidateTelegramLogin(login); err == nil {
+		t.Error("Mini App init data accepted by the Login Widget check")
+	}
+
+	setTelegramNow(t, time.Unix(testAuthDate, 0).Add(telegramAuthMaxAge+time.Minute))
+	if _, err := ValidateTelegramWebAppData(webAppParams().Encode()); err == nil {
+		t.Error("expired auth_date: accepted")
+	}
+}
+
+func TestTelegramDataCheckString(t *testing.T) {
+	params := url.Values{
+		"username":  {"ivanp"},
+		"auth_date": {"1700000000"},
+		"id":        {"42"},
+		"hash":      {"abc"},
+	}
+
+	want := "auth_date=1700000000\nid=42\nusername=ivanp"
+	if got := telegramDataCheckString(params); got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
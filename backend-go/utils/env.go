@@ -1,6 +1,9 @@
 package utils
 
-import "os"
+import (
+	"os"
+	"strconv"
+)
 
 // GetEnv возвращает значение переменной окружения или значение по умолчанию,
 // если переменная не найдена
@@ -9,4 +12,19 @@ func GetEnv(key, defaultValue string) string {
 		return value
 	}
 	return defaultValue
-}
\ No newline at end of file
+}
+
+// GetEnvInt возвращает целочисленное значение переменной окружения или значение
+// по умолчанию, если переменная не найдена или не является числом
+func GetEnvInt(key string, defaultValue int) int {
+	value, exists := os.LookupEnv(key)
+	if !exists {
+		return defaultValue
+	}
+
+	parsed, err := strconv.Atoi(value)
+	if err != nil {
+		return defaultValue
+	}
+	return parsed
+}
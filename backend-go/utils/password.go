@@ -0,0 +1,62 @@
+package utils
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// maxPasswordBytes — bcrypt учитывает только первые 72 байта пароля
+const maxPasswordBytes = 72
+
+// ValidatePassword проверяет пароль администратора: длина не меньше
+// ADMIN_PASSWORD_MIN_LENGTH символов (12 по умолчанию), строчные и заглавные
+// буквы, цифры и отсутствие имени пользователя в пароле
+func ValidatePassword(password, username string) error {
+	minLength := GetEnvInt("ADMIN_PASSWORD_MIN_LENGTH", 12)
+	if utf8.RuneCountInString(password) < minLength {
+		return fmt.Errorf("пароль должен содержать не меньше %d символов", minLength)
+	}
+	if len(password) > maxPasswordBytes {
+		return fmt.Errorf("пароль не должен быть длиннее %d байт", maxPasswordBytes)
+	}
+
+	var hasLower, hasUpper, hasDigit bool
+	for _, r := range password {
+		switch {
+		case unicode.IsLower(r):
+			hasLower = true
+		case unicode.IsUpper(r):
+			hasUpper = true
+		case unicode.IsDigit(r):
+			hasDigit = true
+		}
+	}
+	if !hasLower || !hasUpper || !hasDigit {
+		return errors.New("пароль должен содержать строчные и заглавные буквы и цифры")
+	}
+
+	if username != "" && strings.Contains(strings.ToLower(password), strings.ToLower(username)) {
+		return errors.New("пароль не должен содержать имя пользователя")
+	}
+
+	return nil
+}
+
+// HashPassword возвращает bcrypt-хэш пароля
+func HashPassword(password string) (string, error) {
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return "", err
+	}
+	return string(hash), nil
+}
+
+// CheckPassword сверяет пароль с bcrypt-хэшем
+func CheckPassword(hash, password string) bool {
+	return bcrypt.CompareHashAndPassword([]byte(hash), []byte(password)) == nil
+}
@@ -45,6 +45,114 @@ const ApiService = {
       console.error('Error uploading video:', error);
       throw error;
     }
+  },
+
+  /**
+   * Get processing job status and result
+   * @param {Number} jobId - The job ID returned by uploadVideo
+   * @param {String} token - JWT auth token
+   */
+  async getJob(jobId, token) {
+    try {
+      const headers = {};
+      if (token) {
+        headers['Authorization'] = `Bearer ${token}`;
+      }
+
+      const response = await axios.get(`${API_URL}/jobs/${jobId}`, { headers });
+
+      return response.data;
+    } catch (error) {
+      console.error('Error fetching job:', error);
+      throw error;
+    }
+  },
+
+  /**
+   * Download job transcript with timestamps and save it as a file
+   * @param {Number} jobId - The job ID
+   * @param {String} format - srt, vtt or txt
+   * @param {String} token - JWT auth token
+   */
+  async downloadTranscript(jobId, format, token) {
+    try {
+      const headers = {};
+      if (token) {
+        headers['Authorization'] = `Bearer ${token}`;
+      }
+
+      const response = await axios.get(`${API_URL}/jobs/${jobId}/transcript/${format}`, {
+        headers,
+        responseType: 'blob'
+      });
+
+      const url = URL.createObjectURL(response.data);
+      const link = document.createElement('a');
+      link.href = url;
+      link.download = `transcript.${format}`;
+      link.click();
+      URL.revokeObjectURL(url);
+    } catch (error) {
+      console.error('Error downloading transcript:', error);
+      throw error;
+    }
+  },
+
+  /**
+   * Subscribe to processing job progress events (Server-Sent Events).
+   * EventSource cannot send the Authorization header, so the stream is read via fetch.
+   * Resolves when the stream ends; the last received event is returned.
+   * @param {Number} jobId - The job ID returned by uploadVideo
+   * @param {String} token - JWT auth token
+   * @param {Function} onEvent - Called with every progress event
+   */
+  async subscribeJobEvents(jobId, token, onEvent) {
+    const headers = { 'Accept': 'text/event-stream' };
+    if (token) {
+      headers['Authorization'] = `Bearer ${token}`;
+    }
+
+    const response = await fetch(`${API_URL}/jobs/${jobId}/events`, { headers });
+    if (!response.ok || !response.body) {
+      const error = new Error(`Event stream error: ${response.status}`);
+      error.status = response.status;
+      throw error;
+    }
+
+    const reader = response.body.getReader();
+    const decoder = new TextDecoder();
+    let buffer = '';
+    let lastEvent = null;
+
+    for (;;) {
+      const { value, done } = await reader.read();
+      if (done) {
+        break;
+      }
+
+      buffer += decoder.decode(value, { stream: true });
+
+      // События разделены пустой строкой
+      let separator;
+      while ((separator = buffer.indexOf('\n\n')) !== -1) {
+        const block = buffer.slice(0, separator);
+        buffer = buffer.slice(separator + 2);
+
+        const data = block
+          .split('\n')
+          .filter(line => line.startsWith('data:'))
+          .map(line => line.slice(5).trim())
+          .join('\n');
+        if (!data) {
+          continue;
+        }
+
+        lastEvent = JSON.parse(data);
+        onEvent(lastEvent);
+      }
+    }
+
+    return lastEvent;
   }
 };
 
@@ -2,6 +2,11 @@ import { createStore } from 'vuex'
 import ApiService from '../services/ApiService'
 import auth from './modules/auth'
 
+// Интервал опроса статуса задачи обработки (мс)
+const JOB_POLL_INTERVAL = 3000;
+
+const sleep = ms => new Promise(resolve => setTimeout(resolve, ms));
+
 export default createStore({
   modules: {
     auth
@@ -11,6 +16,8 @@ export default createStore({
     isProcessing: false,
     summary: '',
     transcription: '',
+    jobId: null,
+    progress: null,
     error: null
   },
   getters: {
@@ -18,6 +25,8 @@ export default createStore({
     isProcessing: state => state.isProcessing,
     getSummary: state => state.summary,
     getTranscription: state => state.transcription,
+    getJobId: state => state.jobId,
+    getProgress: state => state.progress,
     getError: state => state.error
   },
   mutations: {
@@ -33,12 +42,20 @@ export default createStore({
     SET_TRANSCRIPTION(state, transcription) {
       state.transcription = transcription;
     },
+    SET_JOB_ID(state, jobId) {
+      state.jobId = jobId;
+    },
+    SET_PROGRESS(state, progress) {
+      state.progress = progress;
+    },
     SET_ERROR(state, error) {
       state.error = error;
     },
     CLEAR_RESULTS(state) {
       state.summary = '';
       state.transcription = '';
+      state.jobId = null;
+      state.progress = null;
       state.error = null;
     }
   },
@@ -52,17 +69,40 @@ export default createStore({
         console.error('Error loading prompt templates:', error);
       }
     },
-    async processVideo({ commit, rootGetters }, { file, prompt }) {
+    async processVideo({ commit, dispatch }, { file, prompt }) {
       commit('CLEAR_RESULTS');
       commit('SET_PROCESSING', true);
       
       try {
-        // Получаем токен для авторизованного запроса
-        const token = rootGetters['auth/getToken'];
-        
-        const result = await ApiService.uploadVideo(file, prompt, token);
-        commit('SET_SUMMARY', result.summary);
-        commit('SET_TRANSCRIPTION', result.transcription);
+        // Каждый запрос выполняется с действующим токеном доступа: истекающий
+        // токен обновляется заранее, отклоненный сервером — после ответа 401
+        const withToken = request => dispatch('auth/withToken', request);
+
+        const { job_id: jobId } = await withToken(token => ApiService.uploadVideo(file, prompt, token));
+        commit('SET_JOB_ID', jobId);
+
+        // Следим за ходом обработки через SSE; при обрыве потока переходим на опрос
+        try {
+          await withToken(token => ApiService.subscribeJobEvents(jobId, token, event => {
+            commit('SET_PROGRESS', event);
+          }));
+        } catch (streamError) {
+          console.error('Error reading job events:', streamError);
+        }
+
+        // Опрашиваем задачу, пока обработка не завершится
+        let job = await withToken(token => ApiService.getJob(jobId, token));
+        while (job.status !== 'done' && job.status !== 'failed') {
+          await sleep(JOB_POLL_INTERVAL);
+          job = await withToken(token => ApiService.getJob(jobId, token));
+        }
+
+        if (job.status === 'failed') {
+          throw new Error(job.error || 'неизвестная ошибка');
+        }
+
+        commit('SET_SUMMARY', job.summary);
+        commit('SET_TRANSCRIPTION', job.transcription);
       } catch (error) {
         commit('SET_ERROR', 'Ошибка обработки видео: ' + error.message);
         console.error('Error processing video:', error);
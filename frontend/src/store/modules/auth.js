@@ -1,5 +1,19 @@
+// За сколько до истечения токена доступа его нужно обновить
+const REFRESH_MARGIN = 60 * 1000;
+
+// Выполняемый запрос обновления токенов. Параллельные запросы ждут его, а не
+// отправляют обновляющий токен повторно: повторно предъявленный токен
+// сервер считает украденным и завершает всю сессию
+let refreshing = null;
+
+// Проверяет, что сервер отклонил токен доступа (ответ 401 у axios и fetch)
+const isUnauthorized = error =>
+  (error.response && error.response.status === 401) || error.status === 401;
+
 const state = {
   token: localStorage.getItem('token') || '',
+  refreshToken: localStorage.getItem('refresh_token') || '',
+  expiresAt: Number(localStorage.getItem('token_expires_at')) || 0,
   user: JSON.parse(localStorage.getItem('user') || 'null'),
   status: ''
 };
@@ -16,6 +30,14 @@ const mutations = {
     state.token = token;
     localStorage.setItem('token', token);
   },
+  SET_SESSION(state, { token, expires_at: expiresAt, refresh_token: refreshToken }) {
+    state.token = token;
+    state.refreshToken = refreshToken;
+    state.expiresAt = new Date(expiresAt).getTime();
+    localStorage.setItem('token', token);
+    localStorage.setItem('refresh_token', refreshToken);
+    localStorage.setItem('token_expires_at', String(state.expiresAt));
+  },
   SET_USER(state, user) {
     state.user = user;
     localStorage.setItem('user', JSON.stringify(user));
@@ -25,9 +47,13 @@ const mutations = {
   },
   LOGOUT(state) {
     state.token = '';
+    state.refreshToken = '';
+    state.expiresAt = 0;
     state.user = null;
     state.status = '';
     localStorage.removeItem('token');
+    localStorage.removeItem('refresh_token');
+    localStorage.removeItem('token_expires_at');
     localStorage.removeItem('user');
   }
 };
@@ -49,7 +75,7 @@ const actions = {
             commit('SET_STATUS', 'error');
             reject(data.error);
           } else {
-            commit('SET_TOKEN', data.token);
+            commit('SET_SESSION', data);
             commit('SET_USER', data.user);
             commit('SET_STATUS', 'success');
             resolve(data);
@@ -62,15 +88,71 @@ const actions = {
     });
   },
 
-  // Действие для проверки текущего статуса авторизации
-  checkAuth({ commit, state }) {
-    return new Promise((resolve, reject) => {
-      if (!state.token) {
+  // Действие для обмена обновляющего токена на новую пару токенов
+  refresh({ commit, state }) {
+    if (refreshing) {
+      return refreshing;
+    }
+
+    refreshing = fetch('/auth/refresh', {
+      method: 'POST',
+      headers: {
+        'Content-Type': 'application/json'
+      },
+      body: JSON.stringify({ refresh_token: state.refreshToken })
+    })
+      .then(response => response.json().then(data => {
+        if (!response.ok) {
+          throw new Error(data.error || 'Session expired');
+        }
+        commit('SET_SESSION', data);
+        commit('SET_USER', data.user);
+        return data.token;
+      }))
+      .catch(err => {
         commit('LOGOUT');
-        reject(new Error('No auth token'));
-        return;
+        throw err;
+      })
+      .finally(() => {
+        refreshing = null;
+      });
+
+    return refreshing;
+  },
+
+  // Действие, возвращающее действующий токен доступа; истекающий токен обновляется
+  ensureToken({ dispatch, state }) {
+    if (state.refreshToken && Date.now() > state.expiresAt - REFRESH_MARGIN) {
+      return dispatch('refresh');
+    }
+    return Promise.resolve(state.token);
+  },
+
+  // Действие, выполняющее запрос request(token) с действующим токеном доступа.
+  // Если сервер все же отклонил токен (например, из-за расхождения часов),
+  // токен обновляется и запрос повторяется один раз
+  async withToken({ dispatch, state }, request) {
+    const token = await dispatch('ensureToken');
+    try {
+      return await request(token);
+    } catch (error) {
+      if (!isUnauthorized(error) || !state.refreshToken) {
+        throw error;
       }
+      return request(await dispatch('refresh'));
+    }
+  },
+
+  // Действие для проверки текущего статуса авторизации
+  async checkAuth({ commit, dispatch, state }) {
+    if (!state.token) {
+      commit('LOGOUT');
+      throw new Error('No auth token');
+    }
+
+    await dispatch('ensureToken');
 
+    return new Promise((resolve, reject) => {
       commit('SET_STATUS', 'loading');
       fetch('/profile', {
         headers: {
@@ -95,11 +177,20 @@ const actions = {
     });
   },
 
-  // Действие для выхода из системы
-  logout({ commit }) {
-    return new Promise(resolve => {
+  // Действие для выхода из системы: сессия завершается и на сервере
+  logout({ commit, state }) {
+    const request = state.refreshToken
+      ? fetch('/auth/logout', {
+        method: 'POST',
+        headers: {
+          'Content-Type': 'application/json'
+        },
+        body: JSON.stringify({ refresh_token: state.refreshToken })
+      }).catch(err => console.error('Ошибка завершения сессии:', err))
+      : Promise.resolve();
+
+    return request.then(() => {
       commit('LOGOUT');
-      resolve();
     });
   }
 };
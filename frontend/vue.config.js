@@ -6,6 +6,8 @@ module.exports = defineConfig({
   lintOnSave: false,
   // Output to the backend's static folder
   outputDir: '../static/vue',
+  // Fixed file names: templates/upload_video.html links the bundle directly
+  filenameHashing: false,
   // Set the base URL to root path
   publicPath: '/',
   // Configure the dev server to proxy API requests to the backend
@@ -15,6 +17,10 @@ module.exports = defineConfig({
         target: 'http://localhost:8000',
         changeOrigin: true
       },
+      '/jobs': {
+        target: 'http://localhost:8000',
+        changeOrigin: true
+      },
       '/static': {
         target: 'http://localhost:8000',
         changeOrigin: true
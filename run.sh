@@ -20,26 +20,20 @@ if [ ! -f .env ]; then
     echo -e "${YELLOW}Пожалуйста, отредактируйте файл .env и добавьте ваш OPENAI_API_KEY${NC}"
 fi
 
-# Проверка наличия директории static/vue
-if [ ! -d "static/vue" ]; then
-    echo -e "${YELLOW}Директория static/vue не найдена. Необходимо собрать фронтенд...${NC}"
-    
-    # Проверка наличия Node.js и npm
-    if command -v npm &> /dev/null; then
-        echo -e "${GREEN}Сборка фронтенда...${NC}"
-        cd frontend
-        npm install
-        npm run build
-        cd ..
-        echo -e "${GREEN}Фронтенд успешно собран!${NC}"
-    else
-        echo "Node.js и npm не установлены, но требуются для сборки фронтенда."
-        echo "Установите Node.js и npm, затем запустите скрипт снова."
-        echo "Или выполните следующие команды вручную:"
-        echo "cd frontend && npm install && npm run build"
-        exit 1
-    fi
+# Сборка фронтенда. Собранные файлы не хранятся в репозитории,
+# поэтому фронтенд собирается из frontend/src при каждом запуске.
+# Без локального npm сборка выполняется в контейнере Node.js
+echo -e "${GREEN}Сборка фронтенда...${NC}"
+if command -v npm &> /dev/null; then
+    (cd frontend && npm ci && npm run build)
+else
+    docker run --rm -v "$PWD":/src -w /src/frontend node:18-alpine sh -c "npm ci && npm run build"
 fi
+if [ $? -ne 0 ]; then
+    echo "Не удалось собрать фронтенд."
+    exit 1
+fi
+echo -e "${GREEN}Фронтенд успешно собран!${NC}"
 
 # Запуск Docker Compose
 echo -e "${GREEN}Запуск контейнеров Docker...${NC}"
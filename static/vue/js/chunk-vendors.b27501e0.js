@@ -1,21 +0,0 @@
-(self["webpackChunksummvideo_frontend"]=self["webpackChunksummvideo_frontend"]||[]).push([[504],{122:(t,e,r)=>{"use strict";var n=r(46518),i=r(22195),o=r(91955),a=r(79306),s=r(22812),u=r(79039),c=r(43724),l=u((function(){return c&&1!==Object.getOwnPropertyDescriptor(i,"queueMicrotask").value.length}));n({global:!0,enumerable:!0,dontCallGetSet:!0,forced:l},{queueMicrotask:function(t){s(arguments.length,1),o(a(t))}})},373:(t,e,r)=>{"use strict";var n=r(22195),i=r(27476),o=r(79039),a=r(79306),s=r(74488),u=r(94644),c=r(13709),l=r(13763),f=r(39519),p=r(3607),h=u.aTypedArray,d=u.exportTypedArrayMethod,v=n.Uint16Array,g=v&&i(v.prototype.sort),m=!!g&&!(o((function(){g(new v(2),null)}))&&o((function(){g(new v(2),{})}))),y=!!g&&!o((function(){if(f)return f<74;if(c)return c<67;if(l)return!0;if(p)return p<602;var t,e,r=new v(516),n=Array(516);for(t=0;t<516;t++)e=t%4,r[t]=515-t,n[t]=t-2*e+3;for(g(r,(function(t,e){return(t/4|0)-(e/4|0)})),t=0;t<516;t++)if(r[t]!==n[t])return!0})),b=function(t){return function(e,r){return void 0!==t?+t(e,r)||0:r!==r?-1:e!==e?1:0===e&&0===r?1/e>0&&1/r<0?1:-1:e>r}};d("sort",(function(t){return void 0!==t&&a(t),y?g(this,t):s(h(this),b(t))}),!y||m)},655:(t,e,r)=>{"use strict";var n=r(36955),i=String;t.exports=function(t){if("Symbol"===n(t))throw new TypeError("Cannot convert a Symbol value to a string");return i(t)}},747:(t,e,r)=>{"use strict";r(62062),r(44114),r(34782),r(18111),r(61701),r(26099),r(42762);var n=r(8700).isSpace;t.exports=function(t,e,r,i){var o,a,s,u,c=t.bMarks[e]+t.tShift[e],l=t.eMarks[e];if(t.sCount[e]-t.blkIndent>=4)return!1;if(o=t.src.charCodeAt(c),35!==o||c>=l)return!1;a=1,o=t.src.charCodeAt(++c);while(35===o&&c<l&&a<=6)a++,o=t.src.charCodeAt(++c);return!(a>6||c<l&&!n(o))&&(i||(l=t.skipSpacesBack(l,c),s=t.skipCharsBack(l,35,c),s>c&&n(t.src.charCodeAt(s-1))&&(l=s),t.line=e+1,u=t.push("heading_open","h"+String(a),1),u.markup="########".slice(0,a),u.map=[e,t.line],u=t.push("inline","",0),u.content=t.src.slice(c,l).trim(),u.map=[e,t.line],u.children=[],u=t.push("heading_close","h"+String(a),-1),u.markup="########".slice(0,a)),!0)}},1103:t=>{"use strict";t.exports=function(t){try{return{error:!1,value:t()}}catch(e){return{error:!0,value:e}}}},1469:(t,e,r)=>{"use strict";var n=r(87433);t.exports=function(t,e){return new(n(t))(0===e?0:e)}},1480:(t,e,r)=>{"use strict";var n=r(46518),i=r(79039),o=r(10298).f,a=i((function(){return!Object.getOwnPropertyNames(1)}));n({target:"Object",stat:!0,forced:a},{getOwnPropertyNames:o})},1548:(t,e,r)=>{"use strict";var n=r(22195),i=r(79039),o=r(39519),a=r(84215),s=n.structuredClone;t.exports=!!s&&!i((function(){if("DENO"===a&&o>92||"NODE"===a&&o>94||"BROWSER"===a&&o>97)return!1;var t=new ArrayBuffer(8),e=s(t,{transfer:[t]});return 0!==t.byteLength||8!==e.byteLength}))},1625:(t,e,r)=>{"use strict";var n=r(79504);t.exports=n({}.isPrototypeOf)},1688:(t,e,r)=>{"use strict";var n=r(46518),i=r(70380);n({target:"Date",proto:!0,forced:Date.prototype.toISOString!==i},{toISOString:i})},1767:t=>{"use strict";t.exports=function(t){return{iterator:t,next:t.next,done:!1}}},1951:(t,e,r)=>{"use strict";var n=r(78227);e.f=n},2008:(t,e,r)=>{"use strict";var n=r(46518),i=r(59213).filter,o=r(70597),a=o("filter");n({target:"Array",proto:!0,forced:!a},{filter:function(t){return i(this,t,arguments.length>1?arguments[1]:void 0)}})},2087:(t,e,r)=>{"use strict";var n=r(20034),i=Math.floor;t.exports=Number.isInteger||function(t){return!n(t)&&isFinite(t)&&i(t)===t}},2259:(t,e,r)=>{"use strict";var n=r(70511);n("iterator")},2293:(t,e,r)=>{"use strict";var n=r(28551),i=r(35548),o=r(64117),a=r(78227),s=a("species");t.exports=function(t,e){var r,a=n(t).constructor;return void 0===a||o(r=n(a)[s])?e:i(r)}},2360:(t,e,r)=>{"use strict";var n,i=r(28551),o=r(96801),a=r(88727),s=r(30421),u=r(20397),c=r(4055),l=r(66119),f=">",p="<",h="prototype",d="script",v=l("IE_PROTO"),g=function(){},m=function(t){return p+d+f+t+p+"/"+d+f},y=function(t){t.write(m("")),t.close();var e=t.parentWindow.Object;return t=null,e},b=function(){var t,e=c("iframe"),r="java"+d+":";return e.style.display="none",u.appendChild(e),e.src=String(r),t=e.contentWindow.document,t.open(),t.write(m("document.F=Object")),t.close(),t.F},w=function(){try{n=new ActiveXObject("htmlfile")}catch(e){}w="undefined"!=typeof document?document.domain&&n?y(n):b():y(n);var t=a.length;while(t--)delete w[h][a[t]];return w()};s[v]=!0,t.exports=Object.create||function(t,e){var r;return null!==t?(g[h]=i(t),r=new g,g[h]=null,r[v]=t):r=w(),void 0===e?r:o.f(r,e)}},2478:(t,e,r)=>{"use strict";var n=r(79504),i=r(48981),o=Math.floor,a=n("".charAt),s=n("".replace),u=n("".slice),c=/\$([$&'`]|\d{1,2}|<[^>]*>)/g,l=/\$([$&'`]|\d{1,2})/g;t.exports=function(t,e,r,n,f,p){var h=r+t.length,d=n.length,v=l;return void 0!==f&&(f=i(f),v=c),s(p,v,(function(i,s){var c;switch(a(s,0)){case"$":return"$";case"&":return t;case"`":return u(e,0,r);case"'":return u(e,h);case"<":c=f[u(s,1,-1)];break;default:var l=+s;if(0===l)return i;if(l>d){var p=o(l/10);return 0===p?i:p<=d?void 0===n[p-1]?a(s,1):n[p-1]+a(s,1):i}c=n[l-1]}return void 0===c?"":c}))}},2892:(t,e,r)=>{"use strict";var n=r(46518),i=r(96395),o=r(43724),a=r(22195),s=r(19167),u=r(79504),c=r(92796),l=r(39297),f=r(23167),p=r(1625),h=r(10757),d=r(72777),v=r(79039),g=r(38480).f,m=r(77347).f,y=r(24913).f,b=r(31240),w=r(43802).trim,_="Number",x=a[_],A=s[_],k=x.prototype,E=a.TypeError,S=u("".slice),C=u("".charCodeAt),O=function(t){var e=d(t,"number");return"bigint"==typeof e?e:T(e)},T=function(t){var e,r,n,i,o,a,s,u,c=d(t,"number");if(h(c))throw new E("Cannot convert a Symbol value to a number");if("string"==typeof c&&c.length>2)if(c=w(c),e=C(c,0),43===e||45===e){if(r=C(c,2),88===r||120===r)return NaN}else if(48===e){switch(C(c,1)){case 66:case 98:n=2,i=49;break;case 79:case 111:n=8,i=55;break;default:return+c}for(o=S(c,2),a=o.length,s=0;s<a;s++)if(u=C(o,s),u<48||u>i)return NaN;return parseInt(o,n)}return+c},R=c(_,!x(" 0o1")||!x("0b1")||x("+0x1")),D=function(t){return p(k,t)&&v((function(){b(t)}))},I=function(t){var e=arguments.length<1?0:x(O(t));return D(this)?f(Object(e),this,I):e};I.prototype=k,R&&!i&&(k.constructor=I),n({global:!0,constructor:!0,wrap:!0,forced:R},{Number:I});var L=function(t,e){for(var r,n=o?g(e):"MAX_VALUE,MIN_VALUE,NaN,NEGATIVE_INFINITY,POSITIVE_INFINITY,EPSILON,MAX_SAFE_INTEGER,MIN_SAFE_INTEGER,isFinite,isInteger,isNaN,isSafeInteger,parseFloat,parseInt,fromString,range".split(","),i=0;n.length>i;i++)l(e,r=n[i])&&!l(t,r)&&y(t,r,m(e,r))};i&&A&&L(s[_],A),(R||i)&&L(s[_],x)},3238:(t,e,r)=>{"use strict";var n=r(22195),i=r(77811),o=r(67394),a=n.DataView;t.exports=function(t){if(!i||0!==o(t))return!1;try{return new a(t),!1}catch(e){return!0}}},3296:(t,e,r)=>{"use strict";r(45806)},3362:(t,e,r)=>{"use strict";r(10436),r(16499),r(82003),r(7743),r(51481),r(40280)},3451:(t,e,r)=>{"use strict";var n=r(46518),i=r(79504),o=r(30421),a=r(20034),s=r(39297),u=r(24913).f,c=r(38480),l=r(10298),f=r(34124),p=r(33392),h=r(92744),d=!1,v=p("meta"),g=0,m=function(t){u(t,v,{value:{objectID:"O"+g++,weakData:{}}})},y=function(t,e){if(!a(t))return"symbol"==typeof t?t:("string"==typeof t?"S":"P")+t;if(!s(t,v)){if(!f(t))return"F";if(!e)return"E";m(t)}return t[v].objectID},b=function(t,e){if(!s(t,v)){if(!f(t))return!0;if(!e)return!1;m(t)}return t[v].weakData},w=function(t){return h&&d&&f(t)&&!s(t,v)&&m(t),t},_=function(){x.enable=function(){},d=!0;var t=c.f,e=i([].splice),r={};r[v]=1,t(r).length&&(c.f=function(r){for(var n=t(r),i=0,o=n.length;i<o;i++)if(n[i]===v){e(n,i,1);break}return n},n({target:"Object",stat:!0,forced:!0},{getOwnPropertyNames:l.f}))},x=t.exports={enable:_,fastKey:y,getWeakData:b,onFreeze:w};o[v]=!0},3470:t=>{"use strict";t.exports=Object.is||function(t,e){return t===e?0!==t||1/t===1/e:t!==t&&e!==e}},3531:(t,e,r)=>{"use strict";r.d(e,{A:()=>n});r(16280),r(76918);function n(){throw new TypeError("Invalid attempt to destructure non-iterable instance.\nIn order to be iterable, non-array objects must have a [Symbol.iterator]() method.")}},3604:(t,e,r)=>{"use strict";r.d(e,{A:()=>i});r(52675),r(89463),r(2259),r(16280),r(76918),r(64346),r(26099),r(47764),r(62953);var n=r(12635);function i(t,e){var r="undefined"!=typeof Symbol&&t[Symbol.iterator]||t["@@iterator"];if(!r){if(Array.isArray(t)||(r=(0,n.A)(t))||e&&t&&"number"==typeof t.length){r&&(t=r);var i=0,o=function(){};return{s:o,n:function(){return i>=t.length?{done:!0}:{done:!1,value:t[i++]}},e:function(t){throw t},f:o}}throw new TypeError("Invalid attempt to iterate non-iterable instance.\nIn order to be iterable, non-array objects must have a [Symbol.iterator]() method.")}var a,s=!0,u=!1;return{s:function(){r=r.call(t)},n:function(){var t=r.next();return s=t.done,t},e:function(t){u=!0,a=t},f:function(){try{s||null==r["return"]||r["return"]()}finally{if(u)throw a}}}}},3607:(t,e,r)=>{"use strict";var n=r(82839),i=n.match(/AppleWebKit\/(\d+)\./);t.exports=!!i&&+i[1]},3717:(t,e,r)=>{"use strict";var n=r(79504),i=2147483647,o=36,a=1,s=26,u=38,c=700,l=72,f=128,p="-",h=/[^\0-\u007E]/,d=/[.\u3002\uFF0E\uFF61]/g,v="Overflow: input needs wider integers to process",g=o-a,m=RangeError,y=n(d.exec),b=Math.floor,w=String.fromCharCode,_=n("".charCodeAt),x=n([].join),A=n([].push),k=n("".replace),E=n("".split),S=n("".toLowerCase),C=function(t){var e=[],r=0,n=t.length;while(r<n){var i=_(t,r++);if(i>=55296&&i<=56319&&r<n){var o=_(t,r++);56320===(64512&o)?A(e,((1023&i)<<10)+(1023&o)+65536):(A(e,i),r--)}else A(e,i)}return e},O=function(t){return t+22+75*(t<26)},T=function(t,e,r){var n=0;t=r?b(t/c):t>>1,t+=b(t/e);while(t>g*s>>1)t=b(t/g),n+=o;return b(n+(g+1)*t/(t+u))},R=function(t){var e=[];t=C(t);var r,n,u=t.length,c=f,h=0,d=l;for(r=0;r<t.length;r++)n=t[r],n<128&&A(e,w(n));var g=e.length,y=g;g&&A(e,p);while(y<u){var _=i;for(r=0;r<t.length;r++)n=t[r],n>=c&&n<_&&(_=n);var k=y+1;if(_-c>b((i-h)/k))throw new m(v);for(h+=(_-c)*k,c=_,r=0;r<t.length;r++){if(n=t[r],n<c&&++h>i)throw new m(v);if(n===c){var E=h,S=o;while(1){var R=S<=d?a:S>=d+s?s:S-d;if(E<R)break;var D=E-R,I=o-R;A(e,w(O(R+D%I))),E=b(D/I),S+=o}A(e,w(O(E))),d=T(h,k,y===g),h=0,y++}}h++,c++}return x(e,"")};t.exports=function(t){var e,r,n=[],i=E(k(S(t),d,"."),".");for(e=0;e<i.length;e++)r=i[e],A(n,y(h,r)?"xn--"+R(r):r);return x(n,".")}},3984:(t,e,r)=>{"use strict";r(34782);var n=r(8700).unescapeAll;t.exports=function(t,e,r){var i,o,a=0,s=e,u={ok:!1,pos:0,lines:0,str:""};if(e>=r)return u;if(o=t.charCodeAt(e),34!==o&&39!==o&&40!==o)return u;e++,40===o&&(o=41);while(e<r){if(i=t.charCodeAt(e),i===o)return u.pos=e+1,u.lines=a,u.str=n(t.slice(s+1,e)),u.ok=!0,u;if(40===i&&41===o)return u;10===i?a++:92===i&&e+1<r&&(e++,10===t.charCodeAt(e)&&a++),e++}return u}},4055:(t,e,r)=>{"use strict";var n=r(22195),i=r(20034),o=n.document,a=i(o)&&i(o.createElement);t.exports=function(t){return a?o.createElement(t):{}}},4495:(t,e,r)=>{"use strict";var n=r(39519),i=r(79039),o=r(22195),a=o.String;t.exports=!!Object.getOwnPropertySymbols&&!i((function(){var t=Symbol("symbol detection");return!a(t)||!(Object(t)instanceof Symbol)||!Symbol.sham&&n&&n<41}))},4673:(t,e,r)=>{"use strict";r(25276),r(62062),r(44114),r(18111),r(61701),r(26099),r(27495),r(90906),r(50375),r(42762);var n=r(8700).isSpace;function i(t,e){var r=t.bMarks[e]+t.tShift[e],n=t.eMarks[e];return t.src.substr(r,n-r)}function o(t){var e,r=[],n=0,i=t.length,o=!1,a=0,s="";e=t.charCodeAt(n);while(n<i)124===e&&(o?(s+=t.substring(a,n-1),a=n):(r.push(s+t.substring(a,n)),s="",a=n+1)),o=92===e,n++,e=t.charCodeAt(n);return r.push(s+t.substring(a)),r}t.exports=function(t,e,r,a){var s,u,c,l,f,p,h,d,v,g,m,y,b,w,_,x,A,k;if(e+2>r)return!1;if(p=e+1,t.sCount[p]<t.blkIndent)return!1;if(t.sCount[p]-t.blkIndent>=4)return!1;if(c=t.bMarks[p]+t.tShift[p],c>=t.eMarks[p])return!1;if(A=t.src.charCodeAt(c++),124!==A&&45!==A&&58!==A)return!1;if(c>=t.eMarks[p])return!1;if(k=t.src.charCodeAt(c++),124!==k&&45!==k&&58!==k&&!n(k))return!1;if(45===A&&n(k))return!1;while(c<t.eMarks[p]){if(s=t.src.charCodeAt(c),124!==s&&45!==s&&58!==s&&!n(s))return!1;c++}for(u=i(t,e+1),h=u.split("|"),g=[],l=0;l<h.length;l++){if(m=h[l].trim(),!m){if(0===l||l===h.length-1)continue;return!1}if(!/^:?-+:?$/.test(m))return!1;58===m.charCodeAt(m.length-1)?g.push(58===m.charCodeAt(0)?"center":"right"):58===m.charCodeAt(0)?g.push("left"):g.push("")}if(u=i(t,e).trim(),-1===u.indexOf("|"))return!1;if(t.sCount[e]-t.blkIndent>=4)return!1;if(h=o(u),h.length&&""===h[0]&&h.shift(),h.length&&""===h[h.length-1]&&h.pop(),d=h.length,0===d||d!==g.length)return!1;if(a)return!0;for(w=t.parentType,t.parentType="table",x=t.md.block.ruler.getRules("blockquote"),v=t.push("table_open","table",1),v.map=y=[e,0],v=t.push("thead_open","thead",1),v.map=[e,e+1],v=t.push("tr_open","tr",1),v.map=[e,e+1],l=0;l<h.length;l++)v=t.push("th_open","th",1),g[l]&&(v.attrs=[["style","text-align:"+g[l]]]),v=t.push("inline","",0),v.content=h[l].trim(),v.children=[],v=t.push("th_close","th",-1);for(v=t.push("tr_close","tr",-1),v=t.push("thead_close","thead",-1),p=e+2;p<r;p++){if(t.sCount[p]<t.blkIndent)break;for(_=!1,l=0,f=x.length;l<f;l++)if(x[l](t,p,r,!0)){_=!0;break}if(_)break;if(u=i(t,p).trim(),!u)break;if(t.sCount[p]-t.blkIndent>=4)break;for(h=o(u),h.length&&""===h[0]&&h.shift(),h.length&&""===h[h.length-1]&&h.pop(),p===e+2&&(v=t.push("tbody_open","tbody",1),v.map=b=[e+2,0]),v=t.push("tr_open","tr",1),v.map=[p,p+1],l=0;l<d;l++)v=t.push("td_open","td",1),g[l]&&(v.attrs=[["style","text-align:"+g[l]]]),v=t.push("inline","",0),v.content=h[l]?h[l].trim():"",v.children=[],v=t.push("td_close","td",-1);v=t.push("tr_close","tr",-1)}return b&&(v=t.push("tbody_close","tbody",-1),b[1]=p),v=t.push("table_close","table",-1),y[1]=p,t.parentType=w,t.line=p,!0}},4731:(t,e,r)=>{"use strict";var n=r(22195),i=r(10687);i(n.JSON,"JSON",!0)},4919:t=>{t.exports=/[\0-\x1F\x7F-\x9F]/},5240:(t,e,r)=>{"use strict";var n=r(16468),i=r(91625);n("WeakSet",(function(t){return function(){return t(this,arguments.length?arguments[0]:void 0)}}),i)},5506:(t,e,r)=>{"use strict";var n=r(46518),i=r(32357).entries;n({target:"Object",stat:!0},{entries:function(t){return i(t)}})},5746:(t,e,r)=>{"use strict";var n=r(69565),i=r(89228),o=r(28551),a=r(64117),s=r(67750),u=r(3470),c=r(655),l=r(55966),f=r(56682);i("search",(function(t,e,r){return[function(e){var r=s(this),i=a(e)?void 0:l(e,t);return i?n(i,e,r):new RegExp(e)[t](c(r))},function(t){var n=o(this),i=c(t),a=r(e,n,i);if(a.done)return a.value;var s=n.lastIndex;u(s,0)||(n.lastIndex=0);var l=f(n,i);return u(n.lastIndex,s)||(n.lastIndex=s),null===l?-1:l.index}]}))},6469:(t,e,r)=>{"use strict";var n=r(78227),i=r(2360),o=r(24913).f,a=n("unscopables"),s=Array.prototype;void 0===s[a]&&o(s,a,{configurable:!0,value:i(null)}),t.exports=function(t){s[a][t]=!0}},6562:(t,e,r)=>{"use strict";function n(t,e){(null==e||e>t.length)&&(e=t.length);for(var r=0,n=Array(e);r<e;r++)n[r]=t[r];return n}r.d(e,{A:()=>n})},6761:(t,e,r)=>{"use strict";var n=r(46518),i=r(22195),o=r(69565),a=r(79504),s=r(96395),u=r(43724),c=r(4495),l=r(79039),f=r(39297),p=r(1625),h=r(28551),d=r(25397),v=r(56969),g=r(655),m=r(6980),y=r(2360),b=r(71072),w=r(38480),_=r(10298),x=r(33717),A=r(77347),k=r(24913),E=r(96801),S=r(48773),C=r(36840),O=r(62106),T=r(25745),R=r(66119),D=r(30421),I=r(33392),L=r(78227),P=r(1951),j=r(70511),F=r(58242),M=r(10687),N=r(91181),q=r(59213).forEach,U=R("hidden"),B="Symbol",z="prototype",V=N.set,$=N.getterFor(B),G=Object[z],H=i.Symbol,W=H&&H[z],Z=i.RangeError,K=i.TypeError,Y=i.QObject,J=A.f,X=k.f,Q=_.f,tt=S.f,et=a([].push),rt=T("symbols"),nt=T("op-symbols"),it=T("wks"),ot=!Y||!Y[z]||!Y[z].findChild,at=function(t,e,r){var n=J(G,e);n&&delete G[e],X(t,e,r),n&&t!==G&&X(G,e,n)},st=u&&l((function(){return 7!==y(X({},"a",{get:function(){return X(this,"a",{value:7}).a}})).a}))?at:X,ut=function(t,e){var r=rt[t]=y(W);return V(r,{type:B,tag:t,description:e}),u||(r.description=e),r},ct=function(t,e,r){t===G&&ct(nt,e,r),h(t);var n=v(e);return h(r),f(rt,n)?(r.enumerable?(f(t,U)&&t[U][n]&&(t[U][n]=!1),r=y(r,{enumerable:m(0,!1)})):(f(t,U)||X(t,U,m(1,y(null))),t[U][n]=!0),st(t,n,r)):X(t,n,r)},lt=function(t,e){h(t);var r=d(e),n=b(r).concat(vt(r));return q(n,(function(e){u&&!o(pt,r,e)||ct(t,e,r[e])})),t},ft=function(t,e){return void 0===e?y(t):lt(y(t),e)},pt=function(t){var e=v(t),r=o(tt,this,e);return!(this===G&&f(rt,e)&&!f(nt,e))&&(!(r||!f(this,e)||!f(rt,e)||f(this,U)&&this[U][e])||r)},ht=function(t,e){var r=d(t),n=v(e);if(r!==G||!f(rt,n)||f(nt,n)){var i=J(r,n);return!i||!f(rt,n)||f(r,U)&&r[U][n]||(i.enumerable=!0),i}},dt=function(t){var e=Q(d(t)),r=[];return q(e,(function(t){f(rt,t)||f(D,t)||et(r,t)})),r},vt=function(t){var e=t===G,r=Q(e?nt:d(t)),n=[];return q(r,(function(t){!f(rt,t)||e&&!f(G,t)||et(n,rt[t])})),n};c||(H=function(){if(p(W,this))throw new K("Symbol is not a constructor");var t=arguments.length&&void 0!==arguments[0]?g(arguments[0]):void 0,e=I(t),r=function(t){var n=void 0===this?i:this;n===G&&o(r,nt,t),f(n,U)&&f(n[U],e)&&(n[U][e]=!1);var a=m(1,t);try{st(n,e,a)}catch(s){if(!(s instanceof Z))throw s;at(n,e,a)}};return u&&ot&&st(G,e,{configurable:!0,set:r}),ut(e,t)},W=H[z],C(W,"toString",(function(){return $(this).tag})),C(H,"withoutSetter",(function(t){return ut(I(t),t)})),S.f=pt,k.f=ct,E.f=lt,A.f=ht,w.f=_.f=dt,x.f=vt,P.f=function(t){return ut(L(t),t)},u&&(O(W,"description",{configurable:!0,get:function(){return $(this).description}}),s||C(G,"propertyIsEnumerable",pt,{unsafe:!0}))),n({global:!0,constructor:!0,wrap:!0,forced:!c,sham:!c},{Symbol:H}),q(b(it),(function(t){j(t)})),n({target:B,stat:!0,forced:!c},{useSetter:function(){ot=!0},useSimple:function(){ot=!1}}),n({target:"Object",stat:!0,forced:!c,sham:!u},{create:ft,defineProperty:ct,defineProperties:lt,getOwnPropertyDescriptor:ht}),n({target:"Object",stat:!0,forced:!c},{getOwnPropertyNames:dt}),F(),M(H,B),D[U]=!0},6980:t=>{"use strict";t.exports=function(t,e){return{enumerable:!(1&t),configurable:!(2&t),writable:!(4&t),value:e}}},7040:(t,e,r)=>{"use strict";var n=r(4495);t.exports=n&&!Symbol.sham&&"symbol"==typeof Symbol.iterator},7196:(t,e,r)=>{function n(t){if(Array.isArray(t))return t}r(64346),t.exports=n,t.exports.__esModule=!0,t.exports["default"]=t.exports},7588:(t,e,r)=>{"use strict";var n=r(46518),i=r(72652),o=r(79306),a=r(28551),s=r(1767);n({target:"Iterator",proto:!0,real:!0},{forEach:function(t){a(this),o(t);var e=s(this),r=0;i(e,(function(e){t(e,r++)}),{IS_RECORD:!0})}})},7743:(t,e,r)=>{"use strict";var n=r(46518),i=r(69565),o=r(79306),a=r(36043),s=r(1103),u=r(72652),c=r(90537);n({target:"Promise",stat:!0,forced:c},{race:function(t){var e=this,r=a.f(e),n=r.reject,c=s((function(){var a=o(e.resolve);u(t,(function(t){i(a,e,t).then(r.resolve,n)}))}));return c.error&&n(c.value),r.promise}})},7860:(t,e,r)=>{"use strict";var n=r(82839);t.exports=/web0s(?!.*chrome)/i.test(n)},8379:(t,e,r)=>{"use strict";var n=r(18745),i=r(25397),o=r(91291),a=r(26198),s=r(34598),u=Math.min,c=[].lastIndexOf,l=!!c&&1/[1].lastIndexOf(1,-0)<0,f=s("lastIndexOf"),p=l||!f;t.exports=p?function(t){if(l)return n(c,this,arguments)||0;var e=i(this),r=a(e);if(0===r)return-1;var s=r-1;for(arguments.length>1&&(s=u(s,o(arguments[1]))),s<0&&(s=r+s);s>=0;s--)if(s in e&&e[s]===t)return s||0;return-1}:c},8555:(t,e,r)=>{"use strict";r(16280),r(76918),r(28706),r(2008),r(51629),r(25276),r(64346),r(34782),r(18111),r(22489),r(7588),r(79432),r(26099),r(27495),r(90906),r(42762),r(23500);var n=r(8700),i=r(32345),o=r(88676),a=r(21428),s=r(37770),u=r(68854),c=r(70446),l=r(55672),f=r(41354),p={default:r(42469),zero:r(63988),commonmark:r(12650)},h=/^(vbscript|javascript|file|data):/,d=/^data:image\/(gif|png|jpeg|webp);/;function v(t){var e=t.trim().toLowerCase();return!h.test(e)||!!d.test(e)}var g=["http:","https:","mailto:"];function m(t){var e=l.parse(t,!0);if(e.hostname&&(!e.protocol||g.indexOf(e.protocol)>=0))try{e.hostname=f.toASCII(e.hostname)}catch(r){}return l.encode(l.format(e))}function y(t){var e=l.parse(t,!0);if(e.hostname&&(!e.protocol||g.indexOf(e.protocol)>=0))try{e.hostname=f.toUnicode(e.hostname)}catch(r){}return l.decode(l.format(e),l.decode.defaultChars+"%")}function b(t,e){if(!(this instanceof b))return new b(t,e);e||n.isString(t)||(e=t||{},t="default"),this.inline=new u,this.block=new s,this.core=new a,this.renderer=new o,this.linkify=new c,this.validateLink=v,this.normalizeLink=m,this.normalizeLinkText=y,this.utils=n,this.helpers=n.assign({},i),this.options={},this.configure(t),e&&this.set(e)}b.prototype.set=function(t){return n.assign(this.options,t),this},b.prototype.configure=function(t){var e,r=this;if(n.isString(t)&&(e=t,t=p[e],!t))throw new Error('Wrong `markdown-it` preset "'+e+'", check name');if(!t)throw new Error("Wrong `markdown-it` preset, can't be empty");return t.options&&r.set(t.options),t.components&&Object.keys(t.components).forEach((function(e){t.components[e].rules&&r[e].ruler.enableOnly(t.components[e].rules),t.components[e].rules2&&r[e].ruler2.enableOnly(t.components[e].rules2)})),this},b.prototype.enable=function(t,e){var r=[];Array.isArray(t)||(t=[t]),["core","block","inline"].forEach((function(e){r=r.concat(this[e].ruler.enable(t,!0))}),this),r=r.concat(this.inline.ruler2.enable(t,!0));var n=t.filter((function(t){return r.indexOf(t)<0}));if(n.length&&!e)throw new Error("MarkdownIt. Failed to enable unknown rule(s): "+n);return this},b.prototype.disable=function(t,e){var r=[];Array.isArray(t)||(t=[t]),["core","block","inline"].forEach((function(e){r=r.concat(this[e].ruler.disable(t,!0))}),this),r=r.concat(this.inline.ruler2.disable(t,!0));var n=t.filter((function(t){return r.indexOf(t)<0}));if(n.length&&!e)throw new Error("MarkdownIt. Failed to disable unknown rule(s): "+n);return this},b.prototype.use=function(t){var e=[this].concat(Array.prototype.slice.call(arguments,1));return t.apply(t,e),this},b.prototype.parse=function(t,e){if("string"!==typeof t)throw new Error("Input data should be a String");var r=new this.core.State(t,this,e);return this.core.process(r),r.tokens},b.prototype.render=function(t,e){return e=e||{},this.renderer.render(this.parse(t,e),this.options,e)},b.prototype.parseInline=function(t,e){var r=new this.core.State(t,this,e);return r.inlineMode=!0,this.core.process(r),r.tokens},b.prototype.renderInline=function(t,e){return e=e||{},this.renderer.render(this.parseInline(t,e),this.options,e)},t.exports=b},8700:(t,e,r)=>{"use strict";var n=r(47045)["default"];function i(t){return Object.prototype.toString.call(t)}function o(t){return"[object String]"===i(t)}r(16280),r(76918),r(28706),r(51629),r(25276),r(34782),r(23288),r(18111),r(7588),r(79432),r(26099),r(58940),r(84864),r(57465),r(27495),r(87745),r(90906),r(38781),r(25440),r(42762),r(23500);var a=Object.prototype.hasOwnProperty;function s(t,e){return a.call(t,e)}function u(t){var e=Array.prototype.slice.call(arguments,1);return e.forEach((function(e){if(e){if("object"!==n(e))throw new TypeError(e+"must be object");Object.keys(e).forEach((function(r){t[r]=e[r]}))}})),t}function c(t,e,r){return[].concat(t.slice(0,e),r,t.slice(e+1))}function l(t){return!(t>=55296&&t<=57343)&&(!(t>=64976&&t<=65007)&&(65535!==(65535&t)&&65534!==(65535&t)&&(!(t>=0&&t<=8)&&(11!==t&&(!(t>=14&&t<=31)&&(!(t>=127&&t<=159)&&!(t>1114111)))))))}function f(t){if(t>65535){t-=65536;var e=55296+(t>>10),r=56320+(1023&t);return String.fromCharCode(e,r)}return String.fromCharCode(t)}var p=/\\([!"#$%&'()*+,\-.\/:;<=>?@[\\\]^_`{|}~])/g,h=/&([a-z#][a-z0-9]{1,31});/gi,d=new RegExp(p.source+"|"+h.source,"gi"),v=/^#((?:x[a-f0-9]{1,8}|[0-9]{1,8}))/i,g=r(10198);function m(t,e){var r=0;return s(g,e)?g[e]:35===e.charCodeAt(0)&&v.test(e)&&(r="x"===e[1].toLowerCase()?parseInt(e.slice(2),16):parseInt(e.slice(1),10),l(r))?f(r):t}function y(t){return t.indexOf("\\")<0?t:t.replace(p,"$1")}function b(t){return t.indexOf("\\")<0&&t.indexOf("&")<0?t:t.replace(d,(function(t,e,r){return e||m(t,r)}))}var w=/[&<>"]/,_=/[&<>"]/g,x={"&":"&amp;","<":"&lt;",">":"&gt;",'"':"&quot;"};function A(t){return x[t]}function k(t){return w.test(t)?t.replace(_,A):t}var E=/[.?*+^$[\]\\(){}|-]/g;function S(t){return t.replace(E,"\\$&")}function C(t){switch(t){case 9:case 32:return!0}return!1}function O(t){if(t>=8192&&t<=8202)return!0;switch(t){case 9:case 10:case 11:case 12:case 13:case 32:case 160:case 5760:case 8239:case 8287:case 12288:return!0}return!1}var T=r(44557);function R(t){return T.test(t)}function D(t){switch(t){case 33:case 34:case 35:case 36:case 37:case 38:case 39:case 40:case 41:case 42:case 43:case 44:case 45:case 46:case 47:case 58:case 59:case 60:case 61:case 62:case 63:case 64:case 91:case 92:case 93:case 94:case 95:case 96:case 123:case 124:case 125:case 126:return!0;default:return!1}}function I(t){return t=t.trim().replace(/\s+/g," "),"Ṿ"==="ẞ".toLowerCase()&&(t=t.replace(/ẞ/g,"ß")),t.toLowerCase().toUpperCase()}e.lib={},e.lib.mdurl=r(55672),e.lib.ucmicro=r(82540),e.assign=u,e.isString=o,e.has=s,e.unescapeMd=y,e.unescapeAll=b,e.isValidEntityCode=l,e.fromCodePoint=f,e.escapeHtml=k,e.arrayReplaceAt=c,e.isSpace=C,e.isWhiteSpace=O,e.isMdAsciiPunct=D,e.isPunctChar=R,e.escapeRE=S,e.normalizeReference=I},8921:(t,e,r)=>{"use strict";var n=r(46518),i=r(8379);n({target:"Array",proto:!0,forced:i!==[].lastIndexOf},{lastIndexOf:i})},8995:(t,e,r)=>{"use strict";var n=r(94644),i=r(59213).map,o=n.aTypedArray,a=n.getTypedArrayConstructor,s=n.exportTypedArrayMethod;s("map",(function(t){return i(o(this),t,arguments.length>1?arguments[1]:void 0,(function(t,e){return new(a(t))(e)}))}))},9391:(t,e,r)=>{"use strict";var n=r(46518),i=r(96395),o=r(80550),a=r(79039),s=r(97751),u=r(94901),c=r(2293),l=r(93438),f=r(36840),p=o&&o.prototype,h=!!o&&a((function(){p["finally"].call({then:function(){}},(function(){}))}));if(n({target:"Promise",proto:!0,real:!0,forced:h},{finally:function(t){var e=c(this,s("Promise")),r=u(t);return this.then(r?function(r){return l(e,t()).then((function(){return r}))}:t,r?function(r){return l(e,t()).then((function(){throw r}))}:t)}}),!i&&u(o)){var d=s("Promise").prototype["finally"];p["finally"]!==d&&f(p,"finally",d,{unsafe:!0})}},9539:(t,e,r)=>{"use strict";var n=r(69565),i=r(28551),o=r(55966);t.exports=function(t,e,r){var a,s;i(t);try{if(a=o(t,"return"),!a){if("throw"===e)throw r;return r}a=n(a,t)}catch(u){s=!0,a=u}if("throw"===e)throw r;if(s)throw a;return i(a),r}},9678:(t,e,r)=>{"use strict";var n=r(46518),i=r(37628),o=r(25397),a=r(6469),s=Array;n({target:"Array",proto:!0},{toReversed:function(){return i(o(this),s)}}),a("toReversed")},10198:(t,e,r)=>{"use strict";t.exports=r(24357)},10201:(t,e,r)=>{"use strict";r(44114),r(34782);var n=r(8700).normalizeReference,i=r(8700).isSpace;t.exports=function(t,e){var r,o,a,s,u,c,l,f,p,h="",d="",v=t.pos,g=t.posMax,m=t.pos,y=!0;if(91!==t.src.charCodeAt(t.pos))return!1;if(u=t.pos+1,s=t.md.helpers.parseLinkLabel(t,t.pos,!0),s<0)return!1;if(c=s+1,c<g&&40===t.src.charCodeAt(c)){for(y=!1,c++;c<g;c++)if(o=t.src.charCodeAt(c),!i(o)&&10!==o)break;if(c>=g)return!1;if(m=c,l=t.md.helpers.parseLinkDestination(t.src,c,t.posMax),l.ok){for(h=t.md.normalizeLink(l.str),t.md.validateLink(h)?c=l.pos:h="",m=c;c<g;c++)if(o=t.src.charCodeAt(c),!i(o)&&10!==o)break;if(l=t.md.helpers.parseLinkTitle(t.src,c,t.posMax),c<g&&m!==c&&l.ok)for(d=l.str,c=l.pos;c<g;c++)if(o=t.src.charCodeAt(c),!i(o)&&10!==o)break}(c>=g||41!==t.src.charCodeAt(c))&&(y=!0),c++}if(y){if("undefined"===typeof t.env.references)return!1;if(c<g&&91===t.src.charCodeAt(c)?(m=c+1,c=t.md.helpers.parseLinkLabel(t,c),c>=0?a=t.src.slice(m,c++):c=s+1):c=s+1,a||(a=t.src.slice(u,s)),f=t.env.references[n(a)],!f)return t.pos=v,!1;h=f.href,d=f.title}return e||(t.pos=u,t.posMax=s,p=t.push("link_open","a",1),p.attrs=r=[["href",h]],d&&r.push(["title",d]),t.md.inline.tokenize(t),p=t.push("link_close","a",-1)),t.pos=c,t.posMax=g,!0}},10287:(t,e,r)=>{"use strict";var n=r(46518),i=r(52967);n({target:"Object",stat:!0},{setPrototypeOf:i})},10298:(t,e,r)=>{"use strict";var n=r(44576),i=r(25397),o=r(38480).f,a=r(67680),s="object"==typeof window&&window&&Object.getOwnPropertyNames?Object.getOwnPropertyNames(window):[],u=function(t){try{return o(t)}catch(e){return a(s)}};t.exports.f=function(t){return s&&"Window"===n(t)?u(t):o(i(t))}},10350:(t,e,r)=>{"use strict";var n=r(43724),i=r(39297),o=Function.prototype,a=n&&Object.getOwnPropertyDescriptor,s=i(o,"name"),u=s&&"something"===function(){}.name,c=s&&(!n||n&&a(o,"name").configurable);t.exports={EXISTS:s,PROPER:u,CONFIGURABLE:c}},10436:(t,e,r)=>{"use strict";var n,i,o,a,s=r(46518),u=r(96395),c=r(16193),l=r(22195),f=r(69565),p=r(36840),h=r(52967),d=r(10687),v=r(87633),g=r(79306),m=r(94901),y=r(20034),b=r(90679),w=r(2293),_=r(59225).set,x=r(91955),A=r(90757),k=r(1103),E=r(18265),S=r(91181),C=r(80550),O=r(10916),T=r(36043),R="Promise",D=O.CONSTRUCTOR,I=O.REJECTION_EVENT,L=O.SUBCLASSING,P=S.getterFor(R),j=S.set,F=C&&C.prototype,M=C,N=F,q=l.TypeError,U=l.document,B=l.process,z=T.f,V=z,$=!!(U&&U.createEvent&&l.dispatchEvent),G="unhandledrejection",H="rejectionhandled",W=0,Z=1,K=2,Y=1,J=2,X=function(t){var e;return!(!y(t)||!m(e=t.then))&&e},Q=function(t,e){var r,n,i,o=e.value,a=e.state===Z,s=a?t.ok:t.fail,u=t.resolve,c=t.reject,l=t.domain;try{s?(a||(e.rejection===J&&it(e),e.rejection=Y),!0===s?r=o:(l&&l.enter(),r=s(o),l&&(l.exit(),i=!0)),r===t.promise?c(new q("Promise-chain cycle")):(n=X(r))?f(n,r,u,c):u(r)):c(o)}catch(p){l&&!i&&l.exit(),c(p)}},tt=function(t,e){t.notified||(t.notified=!0,x((function(){var r,n=t.reactions;while(r=n.get())Q(r,t);t.notified=!1,e&&!t.rejection&&rt(t)})))},et=function(t,e,r){var n,i;$?(n=U.createEvent("Event"),n.promise=e,n.reason=r,n.initEvent(t,!1,!0),l.dispatchEvent(n)):n={promise:e,reason:r},!I&&(i=l["on"+t])?i(n):t===G&&A("Unhandled promise rejection",r)},rt=function(t){f(_,l,(function(){var e,r=t.facade,n=t.value,i=nt(t);if(i&&(e=k((function(){c?B.emit("unhandledRejection",n,r):et(G,r,n)})),t.rejection=c||nt(t)?J:Y,e.error))throw e.value}))},nt=function(t){return t.rejection!==Y&&!t.parent},it=function(t){f(_,l,(function(){var e=t.facade;c?B.emit("rejectionHandled",e):et(H,e,t.value)}))},ot=function(t,e,r){return function(n){t(e,n,r)}},at=function(t,e,r){t.done||(t.done=!0,r&&(t=r),t.value=e,t.state=K,tt(t,!0))},st=function(t,e,r){if(!t.done){t.done=!0,r&&(t=r);try{if(t.facade===e)throw new q("Promise can't be resolved itself");var n=X(e);n?x((function(){var r={done:!1};try{f(n,e,ot(st,r,t),ot(at,r,t))}catch(i){at(r,i,t)}})):(t.value=e,t.state=Z,tt(t,!1))}catch(i){at({done:!1},i,t)}}};if(D&&(M=function(t){b(this,N),g(t),f(n,this);var e=P(this);try{t(ot(st,e),ot(at,e))}catch(r){at(e,r)}},N=M.prototype,n=function(t){j(this,{type:R,done:!1,notified:!1,parent:!1,reactions:new E,rejection:!1,state:W,value:null})},n.prototype=p(N,"then",(function(t,e){var r=P(this),n=z(w(this,M));return r.parent=!0,n.ok=!m(t)||t,n.fail=m(e)&&e,n.domain=c?B.domain:void 0,r.state===W?r.reactions.add(n):x((function(){Q(n,r)})),n.promise})),i=function(){var t=new n,e=P(t);this.promise=t,this.resolve=ot(st,e),this.reject=ot(at,e)},T.f=z=function(t){return t===M||t===o?new i(t):V(t)},!u&&m(C)&&F!==Object.prototype)){a=F.then,L||p(F,"then",(function(t,e){var r=this;return new M((function(t,e){f(a,r,t,e)})).then(t,e)}),{unsafe:!0});try{delete F.constructor}catch(ut){}h&&h(F,N)}s({global:!0,constructor:!0,wrap:!0,forced:D},{Promise:M}),d(M,R,!1,!0),v(R)},10687:(t,e,r)=>{"use strict";var n=r(24913).f,i=r(39297),o=r(78227),a=o("toStringTag");t.exports=function(t,e,r){t&&!r&&(t=t.prototype),t&&!i(t,a)&&n(t,a,{configurable:!0,value:e})}},10757:(t,e,r)=>{"use strict";var n=r(97751),i=r(94901),o=r(1625),a=r(7040),s=Object;t.exports=a?function(t){return"symbol"==typeof t}:function(t){var e=n("Symbol");return i(e)&&o(e.prototype,s(t))}},10834:(t,e,r)=>{"use strict";r(44114);var n=r(31926),i=r(8700).isWhiteSpace,o=r(8700).isPunctChar,a=r(8700).isMdAsciiPunct;function s(t,e,r,n){this.src=t,this.env=r,this.md=e,this.tokens=n,this.tokens_meta=Array(n.length),this.pos=0,this.posMax=this.src.length,this.level=0,this.pending="",this.pendingLevel=0,this.cache={},this.delimiters=[],this._prev_delimiters=[],this.backticks={},this.backticksScanned=!1}s.prototype.pushPending=function(){var t=new n("text","",0);return t.content=this.pending,t.level=this.pendingLevel,this.tokens.push(t),this.pending="",t},s.prototype.push=function(t,e,r){this.pending&&this.pushPending();var i=new n(t,e,r),o=null;return r<0&&(this.level--,this.delimiters=this._prev_delimiters.pop()),i.level=this.level,r>0&&(this.level++,this._prev_delimiters.push(this.delimiters),this.delimiters=[],o={delimiters:this.delimiters}),this.pendingLevel=this.level,this.tokens.push(i),this.tokens_meta.push(o),i},s.prototype.scanDelims=function(t,e){var r,n,s,u,c,l,f,p,h,d=t,v=!0,g=!0,m=this.posMax,y=this.src.charCodeAt(t);r=t>0?this.src.charCodeAt(t-1):32;while(d<m&&this.src.charCodeAt(d)===y)d++;return s=d-t,n=d<m?this.src.charCodeAt(d):32,f=a(r)||o(String.fromCharCode(r)),h=a(n)||o(String.fromCharCode(n)),l=i(r),p=i(n),p?v=!1:h&&(l||f||(v=!1)),l?g=!1:f&&(p||h||(g=!1)),e?(u=v,c=g):(u=v&&(!g||f),c=g&&(!v||h)),{can_open:u,can_close:c,length:s}},s.prototype.Token=n,t.exports=s},10916:(t,e,r)=>{"use strict";var n=r(22195),i=r(80550),o=r(94901),a=r(92796),s=r(33706),u=r(78227),c=r(84215),l=r(96395),f=r(39519),p=i&&i.prototype,h=u("species"),d=!1,v=o(n.PromiseRejectionEvent),g=a("Promise",(function(){var t=s(i),e=t!==String(i);if(!e&&66===f)return!0;if(l&&(!p["catch"]||!p["finally"]))return!0;if(!f||f<51||!/native code/.test(t)){var r=new i((function(t){t(1)})),n=function(t){t((function(){}),(function(){}))},o=r.constructor={};if(o[h]=n,d=r.then((function(){}))instanceof n,!d)return!0}return!e&&("BROWSER"===c||"DENO"===c)&&!v}));t.exports={CONSTRUCTOR:g,REJECTION_EVENT:v,SUBCLASSING:d}},11056:(t,e,r)=>{"use strict";var n=r(24913).f;t.exports=function(t,e,r){r in t||n(t,r,{configurable:!0,get:function(){return e[r]},set:function(t){e[r]=t}})}},11392:(t,e,r)=>{"use strict";var n=r(46518),i=r(27476),o=r(77347).f,a=r(18014),s=r(655),u=r(60511),c=r(67750),l=r(41436),f=r(96395),p=i("".slice),h=Math.min,d=l("startsWith"),v=!f&&!d&&!!function(){var t=o(String.prototype,"startsWith");return t&&!t.writable}();n({target:"String",proto:!0,forced:!v&&!d},{startsWith:function(t){var e=s(c(this));u(t);var r=a(h(arguments.length>1?arguments[1]:void 0,e.length)),n=s(t);return p(e,r,r+n.length)===n}})},11451:(t,e,r)=>{"use strict";t.exports=r(8555)},11745:(t,e,r)=>{"use strict";var n=r(46518),i=r(27476),o=r(79039),a=r(66346),s=r(28551),u=r(35610),c=r(18014),l=a.ArrayBuffer,f=a.DataView,p=f.prototype,h=i(l.prototype.slice),d=i(p.getUint8),v=i(p.setUint8),g=o((function(){return!new l(2).slice(1,void 0).byteLength}));n({target:"ArrayBuffer",proto:!0,unsafe:!0,forced:g},{slice:function(t,e){if(h&&void 0===e)return h(s(this),t);var r=s(this).byteLength,n=u(t,r),i=u(void 0===e?r:e,r),o=new l(c(i-n)),a=new f(this),p=new f(o),g=0;while(n<i)v(p,g++,d(a,n++));return o}})},12211:(t,e,r)=>{"use strict";var n=r(79039);t.exports=!n((function(){function t(){}return t.prototype.constructor=null,Object.getPrototypeOf(new t)!==t.prototype}))},12635:(t,e,r)=>{"use strict";r.d(e,{A:()=>i});r(76918),r(23418),r(34782),r(23288),r(62010),r(26099),r(27495),r(90906),r(38781),r(47764);var n=r(6562);function i(t,e){if(t){if("string"==typeof t)return(0,n.A)(t,e);var r={}.toString.call(t).slice(8,-1);return"Object"===r&&t.constructor&&(r=t.constructor.name),"Map"===r||"Set"===r?Array.from(t):"Arguments"===r||/^(?:Ui|I)nt(?:8|16|32)(?:Clamped)?Array$/.test(r)?(0,n.A)(t,e):void 0}}},12650:t=>{"use strict";t.exports={options:{html:!0,xhtmlOut:!0,breaks:!1,langPrefix:"language-",linkify:!1,typographer:!1,quotes:"“”‘’",highlight:null,maxNesting:20},components:{core:{rules:["normalize","block","inline"]},block:{rules:["blockquote","code","fence","heading","hr","html_block","lheading","list","reference","paragraph"]},inline:{rules:["autolink","backticks","emphasis","entity","escape","html_inline","image","link","newline","text"],rules2:["balance_pairs","emphasis","text_collapse"]}}}},12887:(t,e,r)=>{"use strict";var n=r(22195),i=r(79039),o=r(79504),a=r(94644),s=r(23792),u=r(78227),c=u("iterator"),l=n.Uint8Array,f=o(s.values),p=o(s.keys),h=o(s.entries),d=a.aTypedArray,v=a.exportTypedArrayMethod,g=l&&l.prototype,m=!i((function(){g[c].call([1])})),y=!!g&&g.values&&g[c]===g.values&&"values"===g.values.name,b=function(){return f(d(this))};v("entries",(function(){return h(d(this))}),m),v("keys",(function(){return p(d(this))}),m),v("values",b,m||!y,{name:"values"}),v(c,b,m||!y,{name:"values"})},13579:(t,e,r)=>{"use strict";var n=r(46518),i=r(72652),o=r(79306),a=r(28551),s=r(1767);n({target:"Iterator",proto:!0,real:!0},{some:function(t){a(this),o(t);var e=s(this),r=0;return i(e,(function(e,n){if(t(e,r++))return n()}),{IS_RECORD:!0,INTERRUPTED:!0}).stopped}})},13609:(t,e,r)=>{"use strict";var n=r(46518),i=r(48981),o=r(26198),a=r(34527),s=r(84606),u=r(96837),c=1!==[].unshift(0),l=function(){try{Object.defineProperty([],"length",{writable:!1}).unshift()}catch(t){return t instanceof TypeError}},f=c||!l();n({target:"Array",proto:!0,arity:1,forced:f},{unshift:function(t){var e=i(this),r=o(e),n=arguments.length;if(n){u(r+n);var c=r;while(c--){var l=c+n;c in e?e[l]=e[c]:s(e,l)}for(var f=0;f<n;f++)e[f]=arguments[f]}return a(e,r+n)}})},13611:(t,e,r)=>{"use strict";var n=r(46518),i=r(22195),o=r(62106),a=r(43724),s=TypeError,u=Object.defineProperty,c=i.self!==i;try{if(a){var l=Object.getOwnPropertyDescriptor(i,"self");!c&&l&&l.get&&l.enumerable||o(i,"self",{get:function(){return i},set:function(t){if(this!==i)throw new s("Illegal invocation");u(i,"self",{value:t,writable:!0,configurable:!0,enumerable:!0})},configurable:!0,enumerable:!0})}else n({global:!0,simple:!0,forced:c},{self:i})}catch(f){}},13709:(t,e,r)=>{"use strict";var n=r(82839),i=n.match(/firefox\/(\d+)/i);t.exports=!!i&&+i[1]},13763:(t,e,r)=>{"use strict";var n=r(82839);t.exports=/MSIE|Trident/.test(n)},13925:(t,e,r)=>{"use strict";var n=r(20034);t.exports=function(t){return n(t)||null===t}},14048:(t,e,r)=>{"use strict";r.d(e,{A:()=>i});r(52675),r(89463),r(66412),r(2259),r(78125),r(16280),r(76918),r(51629),r(44114),r(94490),r(34782),r(62010),r(18111),r(7588),r(4731),r(60479),r(59904),r(84185),r(40875),r(63548),r(10287),r(26099),r(47764),r(23500),r(62953);var n=r(54119);function i(){
-/*! regenerator-runtime -- Copyright (c) 2014-present, Facebook, Inc. -- license (MIT): https://github.com/facebook/regenerator/blob/main/LICENSE */
-i=function(){return e};var t,e={},r=Object.prototype,o=r.hasOwnProperty,a=Object.defineProperty||function(t,e,r){t[e]=r.value},s="function"==typeof Symbol?Symbol:{},u=s.iterator||"@@iterator",c=s.asyncIterator||"@@asyncIterator",l=s.toStringTag||"@@toStringTag";function f(t,e,r){return Object.defineProperty(t,e,{value:r,enumerable:!0,configurable:!0,writable:!0}),t[e]}try{f({},"")}catch(t){f=function(t,e,r){return t[e]=r}}function p(t,e,r,n){var i=e&&e.prototype instanceof b?e:b,o=Object.create(i.prototype),s=new I(n||[]);return a(o,"_invoke",{value:O(t,r,s)}),o}function h(t,e,r){try{return{type:"normal",arg:t.call(e,r)}}catch(t){return{type:"throw",arg:t}}}e.wrap=p;var d="suspendedStart",v="suspendedYield",g="executing",m="completed",y={};function b(){}function w(){}function _(){}var x={};f(x,u,(function(){return this}));var A=Object.getPrototypeOf,k=A&&A(A(L([])));k&&k!==r&&o.call(k,u)&&(x=k);var E=_.prototype=b.prototype=Object.create(x);function S(t){["next","throw","return"].forEach((function(e){f(t,e,(function(t){return this._invoke(e,t)}))}))}function C(t,e){function r(i,a,s,u){var c=h(t[i],t,a);if("throw"!==c.type){var l=c.arg,f=l.value;return f&&"object"==(0,n.A)(f)&&o.call(f,"__await")?e.resolve(f.__await).then((function(t){r("next",t,s,u)}),(function(t){r("throw",t,s,u)})):e.resolve(f).then((function(t){l.value=t,s(l)}),(function(t){return r("throw",t,s,u)}))}u(c.arg)}var i;a(this,"_invoke",{value:function(t,n){function o(){return new e((function(e,i){r(t,n,e,i)}))}return i=i?i.then(o,o):o()}})}function O(e,r,n){var i=d;return function(o,a){if(i===g)throw Error("Generator is already running");if(i===m){if("throw"===o)throw a;return{value:t,done:!0}}for(n.method=o,n.arg=a;;){var s=n.delegate;if(s){var u=T(s,n);if(u){if(u===y)continue;return u}}if("next"===n.method)n.sent=n._sent=n.arg;else if("throw"===n.method){if(i===d)throw i=m,n.arg;n.dispatchException(n.arg)}else"return"===n.method&&n.abrupt("return",n.arg);i=g;var c=h(e,r,n);if("normal"===c.type){if(i=n.done?m:v,c.arg===y)continue;return{value:c.arg,done:n.done}}"throw"===c.type&&(i=m,n.method="throw",n.arg=c.arg)}}}function T(e,r){var n=r.method,i=e.iterator[n];if(i===t)return r.delegate=null,"throw"===n&&e.iterator["return"]&&(r.method="return",r.arg=t,T(e,r),"throw"===r.method)||"return"!==n&&(r.method="throw",r.arg=new TypeError("The iterator does not provide a '"+n+"' method")),y;var o=h(i,e.iterator,r.arg);if("throw"===o.type)return r.method="throw",r.arg=o.arg,r.delegate=null,y;var a=o.arg;return a?a.done?(r[e.resultName]=a.value,r.next=e.nextLoc,"return"!==r.method&&(r.method="next",r.arg=t),r.delegate=null,y):a:(r.method="throw",r.arg=new TypeError("iterator result is not an object"),r.delegate=null,y)}function R(t){var e={tryLoc:t[0]};1 in t&&(e.catchLoc=t[1]),2 in t&&(e.finallyLoc=t[2],e.afterLoc=t[3]),this.tryEntries.push(e)}function D(t){var e=t.completion||{};e.type="normal",delete e.arg,t.completion=e}function I(t){this.tryEntries=[{tryLoc:"root"}],t.forEach(R,this),this.reset(!0)}function L(e){if(e||""===e){var r=e[u];if(r)return r.call(e);if("function"==typeof e.next)return e;if(!isNaN(e.length)){var i=-1,a=function r(){for(;++i<e.length;)if(o.call(e,i))return r.value=e[i],r.done=!1,r;return r.value=t,r.done=!0,r};return a.next=a}}throw new TypeError((0,n.A)(e)+" is not iterable")}return w.prototype=_,a(E,"constructor",{value:_,configurable:!0}),a(_,"constructor",{value:w,configurable:!0}),w.displayName=f(_,l,"GeneratorFunction"),e.isGeneratorFunction=function(t){var e="function"==typeof t&&t.constructor;return!!e&&(e===w||"GeneratorFunction"===(e.displayName||e.name))},e.mark=function(t){return Object.setPrototypeOf?Object.setPrototypeOf(t,_):(t.__proto__=_,f(t,l,"GeneratorFunction")),t.prototype=Object.create(E),t},e.awrap=function(t){return{__await:t}},S(C.prototype),f(C.prototype,c,(function(){return this})),e.AsyncIterator=C,e.async=function(t,r,n,i,o){void 0===o&&(o=Promise);var a=new C(p(t,r,n,i),o);return e.isGeneratorFunction(r)?a:a.next().then((function(t){return t.done?t.value:a.next()}))},S(E),f(E,l,"Generator"),f(E,u,(function(){return this})),f(E,"toString",(function(){return"[object Generator]"})),e.keys=function(t){var e=Object(t),r=[];for(var n in e)r.push(n);return r.reverse(),function t(){for(;r.length;){var n=r.pop();if(n in e)return t.value=n,t.done=!1,t}return t.done=!0,t}},e.values=L,I.prototype={constructor:I,reset:function(e){if(this.prev=0,this.next=0,this.sent=this._sent=t,this.done=!1,this.delegate=null,this.method="next",this.arg=t,this.tryEntries.forEach(D),!e)for(var r in this)"t"===r.charAt(0)&&o.call(this,r)&&!isNaN(+r.slice(1))&&(this[r]=t)},stop:function(){this.done=!0;var t=this.tryEntries[0].completion;if("throw"===t.type)throw t.arg;return this.rval},dispatchException:function(e){if(this.done)throw e;var r=this;function n(n,i){return s.type="throw",s.arg=e,r.next=n,i&&(r.method="next",r.arg=t),!!i}for(var i=this.tryEntries.length-1;i>=0;--i){var a=this.tryEntries[i],s=a.completion;if("root"===a.tryLoc)return n("end");if(a.tryLoc<=this.prev){var u=o.call(a,"catchLoc"),c=o.call(a,"finallyLoc");if(u&&c){if(this.prev<a.catchLoc)return n(a.catchLoc,!0);if(this.prev<a.finallyLoc)return n(a.finallyLoc)}else if(u){if(this.prev<a.catchLoc)return n(a.catchLoc,!0)}else{if(!c)throw Error("try statement without catch or finally");if(this.prev<a.finallyLoc)return n(a.finallyLoc)}}}},abrupt:function(t,e){for(var r=this.tryEntries.length-1;r>=0;--r){var n=this.tryEntries[r];if(n.tryLoc<=this.prev&&o.call(n,"finallyLoc")&&this.prev<n.finallyLoc){var i=n;break}}i&&("break"===t||"continue"===t)&&i.tryLoc<=e&&e<=i.finallyLoc&&(i=null);var a=i?i.completion:{};return a.type=t,a.arg=e,i?(this.method="next",this.next=i.finallyLoc,y):this.complete(a)},complete:function(t,e){if("throw"===t.type)throw t.arg;return"break"===t.type||"continue"===t.type?this.next=t.arg:"return"===t.type?(this.rval=this.arg=t.arg,this.method="return",this.next="end"):"normal"===t.type&&e&&(this.next=e),y},finish:function(t){for(var e=this.tryEntries.length-1;e>=0;--e){var r=this.tryEntries[e];if(r.finallyLoc===t)return this.complete(r.completion,r.afterLoc),D(r),y}},catch:function(t){for(var e=this.tryEntries.length-1;e>=0;--e){var r=this.tryEntries[e];if(r.tryLoc===t){var n=r.completion;if("throw"===n.type){var i=n.arg;D(r)}return i}}throw Error("illegal catch attempt")},delegateYield:function(e,r,n){return this.delegate={iterator:L(e),resultName:r,nextLoc:n},"next"===this.method&&(this.arg=t),y}},e}},14601:(t,e,r)=>{"use strict";var n=r(97751),i=r(39297),o=r(66699),a=r(1625),s=r(52967),u=r(77740),c=r(11056),l=r(23167),f=r(32603),p=r(77584),h=r(80747),d=r(43724),v=r(96395);t.exports=function(t,e,r,g){var m="stackTraceLimit",y=g?2:1,b=t.split("."),w=b[b.length-1],_=n.apply(null,b);if(_){var x=_.prototype;if(!v&&i(x,"cause")&&delete x.cause,!r)return _;var A=n("Error"),k=e((function(t,e){var r=f(g?e:t,void 0),n=g?new _(t):new _;return void 0!==r&&o(n,"message",r),h(n,k,n.stack,2),this&&a(x,this)&&l(n,this,k),arguments.length>y&&p(n,arguments[y]),n}));if(k.prototype=x,"Error"!==w?s?s(k,A):u(k,A,{name:!0}):d&&m in _&&(c(k,_,m),c(k,_,"prepareStackTrace")),u(k,_),!v)try{x.name!==w&&o(x,"name",w),x.constructor=k}catch(E){}return k}}},14603:(t,e,r)=>{"use strict";var n=r(36840),i=r(79504),o=r(655),a=r(22812),s=URLSearchParams,u=s.prototype,c=i(u.append),l=i(u["delete"]),f=i(u.forEach),p=i([].push),h=new s("a=1&a=2&b=3");h["delete"]("a",1),h["delete"]("b",void 0),h+""!=="a=2"&&n(u,"delete",(function(t){var e=arguments.length,r=e<2?void 0:arguments[1];if(e&&void 0===r)return l(this,t);var n=[];f(this,(function(t,e){p(n,{key:e,value:t})})),a(e,1);var i,s=o(t),u=o(r),h=0,d=0,v=!1,g=n.length;while(h<g)i=n[h++],v||i.key===s?(v=!0,l(this,i.key)):d++;while(d<g)i=n[d++],i.key===s&&i.value===u||c(this,i.key,i.value)}),{enumerable:!0,unsafe:!0})},15024:(t,e,r)=>{"use strict";var n=r(46518),i=r(83650),o=r(84916);n({target:"Set",proto:!0,real:!0,forced:!o("symmetricDifference")},{symmetricDifference:i})},15086:(t,e,r)=>{"use strict";var n=r(46518),i=r(59213).some,o=r(34598),a=o("some");n({target:"Array",proto:!0,forced:!a},{some:function(t){return i(this,t,arguments.length>1?arguments[1]:void 0)}})},15575:(t,e,r)=>{"use strict";var n=r(46518),i=r(22195),o=r(79472),a=o(i.setInterval,!0);n({global:!0,bind:!0,forced:i.setInterval!==a},{setInterval:a})},15617:(t,e,r)=>{"use strict";var n=r(33164),i=1.1920928955078125e-7,o=34028234663852886e22,a=11754943508222875e-54;t.exports=Math.fround||function(t){return n(t,i,o,a)}},15652:(t,e,r)=>{"use strict";var n=r(79039);t.exports=n((function(){if("function"==typeof ArrayBuffer){var t=new ArrayBuffer(8);Object.isExtensible(t)&&Object.defineProperty(t,"a",{value:8})}}))},15654:(t,e,r)=>{"use strict";r(25276),r(44114),r(34782),r(27495),r(25440),t.exports=function(t,e){var r,n,i,o,a,s,u,c,l=t.pos,f=t.src.charCodeAt(l);if(96!==f)return!1;r=l,l++,n=t.posMax;while(l<n&&96===t.src.charCodeAt(l))l++;if(i=t.src.slice(r,l),u=i.length,t.backticksScanned&&(t.backticks[u]||0)<=r)return e||(t.pending+=i),t.pos+=u,!0;a=s=l;while(-1!==(a=t.src.indexOf("`",s))){s=a+1;while(s<n&&96===t.src.charCodeAt(s))s++;if(c=s-a,c===u)return e||(o=t.push("code_inline","code",0),o.markup=i,o.content=t.src.slice(l,a).replace(/\n/g," ").replace(/^ (.+) $/,"$1")),t.pos=s,!0;t.backticks[c]=a}return t.backticksScanned=!0,e||(t.pending+=i),t.pos+=u,!0}},15823:(t,e,r)=>{"use strict";var n=r(46518),i=r(22195),o=r(69565),a=r(43724),s=r(72805),u=r(94644),c=r(66346),l=r(90679),f=r(6980),p=r(66699),h=r(2087),d=r(18014),v=r(57696),g=r(58229),m=r(58319),y=r(56969),b=r(39297),w=r(36955),_=r(20034),x=r(10757),A=r(2360),k=r(1625),E=r(52967),S=r(38480).f,C=r(43251),O=r(59213).forEach,T=r(87633),R=r(62106),D=r(24913),I=r(77347),L=r(35370),P=r(91181),j=r(23167),F=P.get,M=P.set,N=P.enforce,q=D.f,U=I.f,B=i.RangeError,z=c.ArrayBuffer,V=z.prototype,$=c.DataView,G=u.NATIVE_ARRAY_BUFFER_VIEWS,H=u.TYPED_ARRAY_TAG,W=u.TypedArray,Z=u.TypedArrayPrototype,K=u.isTypedArray,Y="BYTES_PER_ELEMENT",J="Wrong length",X=function(t,e){R(t,e,{configurable:!0,get:function(){return F(this)[e]}})},Q=function(t){var e;return k(V,t)||"ArrayBuffer"===(e=w(t))||"SharedArrayBuffer"===e},tt=function(t,e){return K(t)&&!x(e)&&e in t&&h(+e)&&e>=0},et=function(t,e){return e=y(e),tt(t,e)?f(2,t[e]):U(t,e)},rt=function(t,e,r){return e=y(e),!(tt(t,e)&&_(r)&&b(r,"value"))||b(r,"get")||b(r,"set")||r.configurable||b(r,"writable")&&!r.writable||b(r,"enumerable")&&!r.enumerable?q(t,e,r):(t[e]=r.value,t)};a?(G||(I.f=et,D.f=rt,X(Z,"buffer"),X(Z,"byteOffset"),X(Z,"byteLength"),X(Z,"length")),n({target:"Object",stat:!0,forced:!G},{getOwnPropertyDescriptor:et,defineProperty:rt}),t.exports=function(t,e,r){var a=t.match(/\d+/)[0]/8,u=t+(r?"Clamped":"")+"Array",c="get"+t,f="set"+t,h=i[u],y=h,b=y&&y.prototype,w={},x=function(t,e){var r=F(t);return r.view[c](e*a+r.byteOffset,!0)},k=function(t,e,n){var i=F(t);i.view[f](e*a+i.byteOffset,r?m(n):n,!0)},R=function(t,e){q(t,e,{get:function(){return x(this,e)},set:function(t){return k(this,e,t)},enumerable:!0})};G?s&&(y=e((function(t,e,r,n){return l(t,b),j(function(){return _(e)?Q(e)?void 0!==n?new h(e,g(r,a),n):void 0!==r?new h(e,g(r,a)):new h(e):K(e)?L(y,e):o(C,y,e):new h(v(e))}(),t,y)})),E&&E(y,W),O(S(h),(function(t){t in y||p(y,t,h[t])})),y.prototype=b):(y=e((function(t,e,r,n){l(t,b);var i,s,u,c=0,f=0;if(_(e)){if(!Q(e))return K(e)?L(y,e):o(C,y,e);i=e,f=g(r,a);var p=e.byteLength;if(void 0===n){if(p%a)throw new B(J);if(s=p-f,s<0)throw new B(J)}else if(s=d(n)*a,s+f>p)throw new B(J);u=s/a}else u=v(e),s=u*a,i=new z(s);M(t,{buffer:i,byteOffset:f,byteLength:s,length:u,view:new $(i)});while(c<u)R(t,c++)})),E&&E(y,W),b=y.prototype=A(Z)),b.constructor!==y&&p(b,"constructor",y),N(b).TypedArrayConstructor=y,H&&p(b,H,u);var D=y!==h;w[u]=y,n({global:!0,constructor:!0,forced:D,sham:!G},w),Y in y||p(y,Y,a),Y in b||p(b,Y,a),T(u)}):t.exports=function(){}},16193:(t,e,r)=>{"use strict";var n=r(84215);t.exports="NODE"===n},16280:(t,e,r)=>{"use strict";var n=r(46518),i=r(22195),o=r(18745),a=r(14601),s="WebAssembly",u=i[s],c=7!==new Error("e",{cause:7}).cause,l=function(t,e){var r={};r[t]=a(t,e,c),n({global:!0,constructor:!0,arity:1,forced:c},r)},f=function(t,e){if(u&&u[t]){var r={};r[t]=a(s+"."+t,e,c),n({target:s,stat:!0,constructor:!0,arity:1,forced:c},r)}};l("Error",(function(t){return function(e){return o(t,this,arguments)}})),l("EvalError",(function(t){return function(e){return o(t,this,arguments)}})),l("RangeError",(function(t){return function(e){return o(t,this,arguments)}})),l("ReferenceError",(function(t){return function(e){return o(t,this,arguments)}})),l("SyntaxError",(function(t){return function(e){return o(t,this,arguments)}})),l("TypeError",(function(t){return function(e){return o(t,this,arguments)}})),l("URIError",(function(t){return function(e){return o(t,this,arguments)}})),f("CompileError",(function(t){return function(e){return o(t,this,arguments)}})),f("LinkError",(function(t){return function(e){return o(t,this,arguments)}})),f("RuntimeError",(function(t){return function(e){return o(t,this,arguments)}}))},16468:(t,e,r)=>{"use strict";var n=r(46518),i=r(22195),o=r(79504),a=r(92796),s=r(36840),u=r(3451),c=r(72652),l=r(90679),f=r(94901),p=r(64117),h=r(20034),d=r(79039),v=r(84428),g=r(10687),m=r(23167);t.exports=function(t,e,r){var y=-1!==t.indexOf("Map"),b=-1!==t.indexOf("Weak"),w=y?"set":"add",_=i[t],x=_&&_.prototype,A=_,k={},E=function(t){var e=o(x[t]);s(x,t,"add"===t?function(t){return e(this,0===t?0:t),this}:"delete"===t?function(t){return!(b&&!h(t))&&e(this,0===t?0:t)}:"get"===t?function(t){return b&&!h(t)?void 0:e(this,0===t?0:t)}:"has"===t?function(t){return!(b&&!h(t))&&e(this,0===t?0:t)}:function(t,r){return e(this,0===t?0:t,r),this})},S=a(t,!f(_)||!(b||x.forEach&&!d((function(){(new _).entries().next()}))));if(S)A=r.getConstructor(e,t,y,w),u.enable();else if(a(t,!0)){var C=new A,O=C[w](b?{}:-0,1)!==C,T=d((function(){C.has(1)})),R=v((function(t){new _(t)})),D=!b&&d((function(){var t=new _,e=5;while(e--)t[w](e,e);return!t.has(-0)}));R||(A=e((function(t,e){l(t,x);var r=m(new _,t,A);return p(e)||c(e,r[w],{that:r,AS_ENTRIES:y}),r})),A.prototype=x,x.constructor=A),(T||D)&&(E("delete"),E("has"),y&&E("get")),(D||O)&&E(w),b&&x.clear&&delete x.clear}return k[t]=A,n({global:!0,constructor:!0,forced:A!==_},k),g(A,t),b||r.setStrong(A,t,y),A}},16499:(t,e,r)=>{"use strict";var n=r(46518),i=r(69565),o=r(79306),a=r(36043),s=r(1103),u=r(72652),c=r(90537);n({target:"Promise",stat:!0,forced:c},{all:function(t){var e=this,r=a.f(e),n=r.resolve,c=r.reject,l=s((function(){var r=o(e.resolve),a=[],s=0,l=1;u(t,(function(t){var o=s++,u=!1;l++,i(r,e,t).then((function(t){u||(u=!0,a[o]=t,--l||n(a))}),c)})),--l||n(a)}));return l.error&&c(l.value),r.promise}})},16573:(t,e,r)=>{"use strict";var n=r(43724),i=r(62106),o=r(3238),a=ArrayBuffer.prototype;n&&!("detached"in a)&&i(a,"detached",{configurable:!0,get:function(){return o(this)}})},16575:(t,e,r)=>{"use strict";var n=r(39297);t.exports=function(t){return void 0!==t&&(n(t,"value")||n(t,"writable"))}},16823:t=>{"use strict";var e=String;t.exports=function(t){try{return e(t)}catch(r){return"Object"}}},17642:(t,e,r)=>{"use strict";var n=r(46518),i=r(83440),o=r(84916),a=!o("difference",(function(t){return 0===t.size}));n({target:"Set",proto:!0,real:!0,forced:a},{difference:i})},18014:(t,e,r)=>{"use strict";var n=r(91291),i=Math.min;t.exports=function(t){var e=n(t);return e>0?i(e,9007199254740991):0}},18107:(t,e,r)=>{"use strict";var n=r(46518),i=r(48981),o=r(26198),a=r(91291),s=r(6469);n({target:"Array",proto:!0},{at:function(t){var e=i(this),r=o(e),n=a(t),s=n>=0?n:r+n;return s<0||s>=r?void 0:e[s]}}),s("at")},18111:(t,e,r)=>{"use strict";var n=r(46518),i=r(22195),o=r(90679),a=r(28551),s=r(94901),u=r(42787),c=r(62106),l=r(97040),f=r(79039),p=r(39297),h=r(78227),d=r(57657).IteratorPrototype,v=r(43724),g=r(96395),m="constructor",y="Iterator",b=h("toStringTag"),w=TypeError,_=i[y],x=g||!s(_)||_.prototype!==d||!f((function(){_({})})),A=function(){if(o(this,d),u(this)===d)throw new w("Abstract class Iterator not directly constructable")},k=function(t,e){v?c(d,t,{configurable:!0,get:function(){return e},set:function(e){if(a(this),this===d)throw new w("You can't redefine this property");p(this,t)?this[t]=e:l(this,t,e)}}):d[t]=e};p(d,b)||k(b,y),!x&&p(d,m)&&d[m]!==Object||k(m,A),A.prototype=d,n({global:!0,constructor:!0,forced:x},{Iterator:A})},18237:(t,e,r)=>{"use strict";var n=r(46518),i=r(72652),o=r(79306),a=r(28551),s=r(1767),u=TypeError;n({target:"Iterator",proto:!0,real:!0},{reduce:function(t){a(this),o(t);var e=s(this),r=arguments.length<2,n=r?void 0:arguments[1],c=0;if(i(e,(function(e){r?(r=!1,n=e):n=t(n,e,c),c++}),{IS_RECORD:!0}),r)throw new u("Reduce of empty iterator with no initial value");return n}})},18265:t=>{"use strict";var e=function(){this.head=null,this.tail=null};e.prototype={add:function(t){var e={item:t,next:null},r=this.tail;r?r.next=e:this.head=e,this.tail=e},get:function(){var t=this.head;if(t){var e=this.head=t.next;return null===e&&(this.tail=null),t.item}}},t.exports=e},18727:(t,e,r)=>{"use strict";var n=r(36955);t.exports=function(t){var e=n(t);return"BigInt64Array"===e||"BigUint64Array"===e}},18745:(t,e,r)=>{"use strict";var n=r(40616),i=Function.prototype,o=i.apply,a=i.call;t.exports="object"==typeof Reflect&&Reflect.apply||(n?a.bind(o):function(){return a.apply(o,arguments)})},18814:(t,e,r)=>{"use strict";var n=r(79039),i=r(22195),o=i.RegExp;t.exports=n((function(){var t=o("(?<a>b)","g");return"b"!==t.exec("b").groups.a||"bc"!=="b".replace(t,"$<a>c")}))},19129:(t,e,r)=>{"use strict";r.d(e,{A:()=>i});r(16280),r(76918),r(59904),r(84185),r(94170),r(63548),r(10287);function n(t,e){return n=Object.setPrototypeOf?Object.setPrototypeOf.bind():function(t,e){return t.__proto__=e,t},n(t,e)}function i(t,e){if("function"!=typeof e&&null!==e)throw new TypeError("Super expression must either be null or a function");t.prototype=Object.create(e&&e.prototype,{constructor:{value:t,writable:!0,configurable:!0}}),Object.defineProperty(t,"prototype",{writable:!1}),e&&n(t,e)}},19167:(t,e,r)=>{"use strict";var n=r(22195);t.exports=n},19369:(t,e,r)=>{"use strict";var n=r(94644),i=r(79504),o=n.aTypedArray,a=n.exportTypedArrayMethod,s=i([].join);a("join",(function(t){return s(o(this),t)}))},19462:(t,e,r)=>{"use strict";var n=r(69565),i=r(2360),o=r(66699),a=r(56279),s=r(78227),u=r(91181),c=r(55966),l=r(57657).IteratorPrototype,f=r(62529),p=r(9539),h=s("toStringTag"),d="IteratorHelper",v="WrapForValidIterator",g=u.set,m=function(t){var e=u.getterFor(t?v:d);return a(i(l),{next:function(){var r=e(this);if(t)return r.nextHandler();if(r.done)return f(void 0,!0);try{var n=r.nextHandler();return r.returnHandlerResult?n:f(n,r.done)}catch(i){throw r.done=!0,i}},return:function(){var r=e(this),i=r.iterator;if(r.done=!0,t){var o=c(i,"return");return o?n(o,i):f(void 0,!0)}if(r.inner)try{p(r.inner.iterator,"normal")}catch(a){return p(i,"throw",a)}return i&&p(i,"normal"),f(void 0,!0)}})},y=m(!0),b=m(!1);o(b,h,"Iterator Helper"),t.exports=function(t,e,r){var n=function(n,i){i?(i.iterator=n.iterator,i.next=n.next):i=n,i.type=e?v:d,i.returnHandlerResult=!!r,i.nextHandler=t,i.counter=0,i.done=!1,g(this,i)};return n.prototype=e?y:b,n}},19617:(t,e,r)=>{"use strict";var n=r(25397),i=r(35610),o=r(26198),a=function(t){return function(e,r,a){var s=n(e),u=o(s);if(0===u)return!t&&-1;var c,l=i(a,u);if(t&&r!==r){while(u>l)if(c=s[l++],c!==c)return!0}else for(;u>l;l++)if((t||l in s)&&s[l]===r)return t||l||0;return!t&&-1}};t.exports={includes:a(!0),indexOf:a(!1)}},20034:(t,e,r)=>{"use strict";var n=r(94901);t.exports=function(t){return"object"==typeof t?null!==t:n(t)}},20116:(t,e,r)=>{"use strict";var n=r(46518),i=r(72652),o=r(79306),a=r(28551),s=r(1767);n({target:"Iterator",proto:!0,real:!0},{find:function(t){a(this),o(t);var e=s(this),r=0;return i(e,(function(e,n){if(t(e,r++))return n(e)}),{IS_RECORD:!0,INTERRUPTED:!0}).result}})},20326:(t,e,r)=>{"use strict";var n=r(70511);n("unscopables")},20397:(t,e,r)=>{"use strict";var n=r(97751);t.exports=n("document","documentElement")},20713:(t,e,r)=>{"use strict";var n=r(69565),i=r(79306),o=r(28551),a=r(1767),s=r(19462),u=r(96319),c=s((function(){var t=this.iterator,e=o(n(this.next,t)),r=this.done=!!e.done;if(!r)return u(t,this.mapper,[e.value,this.counter++],!0)}));t.exports=function(t){return o(this),i(t),new c(a(this),{mapper:t})}},21211:(t,e,r)=>{"use strict";var n=r(46518),i=r(28551),o=r(77347).f;n({target:"Reflect",stat:!0},{deleteProperty:function(t,e){var r=o(i(t),e);return!(r&&!r.configurable)&&delete t[e]}})},21212:(t,e,r)=>{"use strict";r(76918),r(44114),r(34782),r(23288),r(26099),r(58940),r(27495),r(38781),r(25440);var n={};function i(t){var e,r,i=n[t];if(i)return i;for(i=n[t]=[],e=0;e<128;e++)r=String.fromCharCode(e),i.push(r);for(e=0;e<t.length;e++)r=t.charCodeAt(e),i[r]="%"+("0"+r.toString(16).toUpperCase()).slice(-2);return i}function o(t,e){var r;return"string"!==typeof e&&(e=o.defaultChars),r=i(e),t.replace(/(%[a-f0-9]{2})+/gi,(function(t){var e,n,i,o,a,s,u,c="";for(e=0,n=t.length;e<n;e+=3)i=parseInt(t.slice(e+1,e+3),16),i<128?c+=r[i]:192===(224&i)&&e+3<n&&(o=parseInt(t.slice(e+4,e+6),16),128===(192&o))?(u=i<<6&1984|63&o,c+=u<128?"��":String.fromCharCode(u),e+=3):224===(240&i)&&e+6<n&&(o=parseInt(t.slice(e+4,e+6),16),a=parseInt(t.slice(e+7,e+9),16),128===(192&o)&&128===(192&a))?(u=i<<12&61440|o<<6&4032|63&a,c+=u<2048||u>=55296&&u<=57343?"���":String.fromCharCode(u),e+=6):240===(248&i)&&e+9<n&&(o=parseInt(t.slice(e+4,e+6),16),a=parseInt(t.slice(e+7,e+9),16),s=parseInt(t.slice(e+10,e+12),16),128===(192&o)&&128===(192&a)&&128===(192&s))?(u=i<<18&1835008|o<<12&258048|a<<6&4032|63&s,u<65536||u>1114111?c+="����":(u-=65536,c+=String.fromCharCode(55296+(u>>10),56320+(1023&u))),e+=9):c+="�";return c}))}o.defaultChars=";/?:@&=+$,#",o.componentChars="",t.exports=o},21428:(t,e,r)=>{"use strict";r(44114);var n=r(43011),i=[["normalize",r(25872)],["block",r(37682)],["inline",r(81134)],["linkify",r(32845)],["replacements",r(87782)],["smartquotes",r(53087)]];function o(){this.ruler=new n;for(var t=0;t<i.length;t++)this.ruler.push(i[t][0],i[t][1])}o.prototype.process=function(t){var e,r,n;for(n=this.ruler.getRules(""),e=0,r=n.length;e<r;e++)n[e](t)},o.prototype.State=r(24154),t.exports=o},21489:(t,e,r)=>{"use strict";var n=r(15823);n("Uint8",(function(t){return function(e,r,n){return t(this,e,r,n)}}))},21699:(t,e,r)=>{"use strict";var n=r(46518),i=r(79504),o=r(60511),a=r(67750),s=r(655),u=r(41436),c=i("".indexOf);n({target:"String",proto:!0,forced:!u("includes")},{includes:function(t){return!!~c(s(a(this)),s(o(t)),arguments.length>1?arguments[1]:void 0)}})},21903:(t,e,r)=>{"use strict";var n=r(94644),i=r(43839).findLast,o=n.aTypedArray,a=n.exportTypedArrayMethod;a("findLast",(function(t){return i(o(this),t,arguments.length>1?arguments[1]:void 0)}))},22036:(t,e,r)=>{"use strict";r.d(e,{A:()=>n});r(52675),r(89463),r(2259),r(23418),r(26099),r(47764),r(62953);function n(t){if("undefined"!=typeof Symbol&&null!=t[Symbol.iterator]||null!=t["@@iterator"])return Array.from(t)}},22195:function(t,e,r){"use strict";var n=function(t){return t&&t.Math===Math&&t};t.exports=n("object"==typeof globalThis&&globalThis)||n("object"==typeof window&&window)||n("object"==typeof self&&self)||n("object"==typeof r.g&&r.g)||n("object"==typeof this&&this)||function(){return this}()||Function("return this")()},22489:(t,e,r)=>{"use strict";var n=r(46518),i=r(69565),o=r(79306),a=r(28551),s=r(1767),u=r(19462),c=r(96319),l=r(96395),f=u((function(){var t,e,r,n=this.iterator,o=this.predicate,s=this.next;while(1){if(t=a(i(s,n)),e=this.done=!!t.done,e)return;if(r=t.value,c(n,o,[r,this.counter++],!0))return r}}));n({target:"Iterator",proto:!0,real:!0,forced:l},{filter:function(t){return a(this),o(t),new f(s(this),{predicate:t})}})},22729:(t,e,r)=>{function n(){throw new TypeError("Invalid attempt to destructure non-iterable instance.\nIn order to be iterable, non-array objects must have a [Symbol.iterator]() method.")}r(16280),r(76918),t.exports=n,t.exports.__esModule=!0,t.exports["default"]=t.exports},22812:t=>{"use strict";var e=TypeError;t.exports=function(t,r){if(t<r)throw new e("Not enough arguments");return t}},23061:(t,e,r)=>{"use strict";var n=r(79039);t.exports=function(t){return n((function(){var e=""[t]('"');return e!==e.toLowerCase()||e.split('"').length>3}))}},23167:(t,e,r)=>{"use strict";var n=r(94901),i=r(20034),o=r(52967);t.exports=function(t,e,r){var a,s;return o&&n(a=e.constructor)&&a!==r&&i(s=a.prototype)&&s!==r.prototype&&o(t,s),t}},23288:(t,e,r)=>{"use strict";var n=r(79504),i=r(36840),o=Date.prototype,a="Invalid Date",s="toString",u=n(o[s]),c=n(o.getTime);String(new Date(NaN))!==a&&i(o,s,(function(){var t=c(this);return t===t?u(this):a}))},23418:(t,e,r)=>{"use strict";var n=r(46518),i=r(97916),o=r(84428),a=!o((function(t){Array.from(t)}));n({target:"Array",stat:!0,forced:a},{from:i})},23500:(t,e,r)=>{"use strict";var n=r(22195),i=r(67400),o=r(79296),a=r(90235),s=r(66699),u=function(t){if(t&&t.forEach!==a)try{s(t,"forEach",a)}catch(e){t.forEach=a}};for(var c in i)i[c]&&u(n[c]&&n[c].prototype);u(o)},23772:(t,e,r)=>{"use strict";r.d(e,{A:()=>i});r(84185);var n=r(33051);function i(t,e,r){return(e=(0,n.A)(e))in t?Object.defineProperty(t,e,{value:r,enumerable:!0,configurable:!0,writable:!0}):t[e]=r,t}},23792:(t,e,r)=>{"use strict";var n=r(25397),i=r(6469),o=r(26269),a=r(91181),s=r(24913).f,u=r(51088),c=r(62529),l=r(96395),f=r(43724),p="Array Iterator",h=a.set,d=a.getterFor(p);t.exports=u(Array,"Array",(function(t,e){h(this,{type:p,target:n(t),index:0,kind:e})}),(function(){var t=d(this),e=t.target,r=t.index++;if(!e||r>=e.length)return t.target=null,c(void 0,!0);switch(t.kind){case"keys":return c(r,!1);case"values":return c(e[r],!1)}return c([r,e[r]],!1)}),"values");var v=o.Arguments=o.Array;if(i("keys"),i("values"),i("entries"),!l&&f&&"values"!==v.name)try{s(v,"name",{value:"values"})}catch(g){}},24154:(t,e,r)=>{"use strict";var n=r(31926);function i(t,e,r){this.src=t,this.env=r,this.tokens=[],this.inlineMode=!1,this.md=e}i.prototype.Token=n,t.exports=i},24232:(t,e,r)=>{"use strict";r.d(e,{$3:()=>w,$H:()=>$,BH:()=>J,BX:()=>ut,Bm:()=>O,C4:()=>nt,CE:()=>x,CP:()=>g,DY:()=>G,Gv:()=>T,J$:()=>ot,Kg:()=>C,MZ:()=>f,Mp:()=>v,NO:()=>d,Oj:()=>p,PT:()=>q,Qd:()=>P,Ro:()=>Z,SU:()=>F,TF:()=>y,Tg:()=>B,Tn:()=>S,Tr:()=>X,We:()=>K,X$:()=>m,Y2:()=>at,ZH:()=>z,Zf:()=>L,bB:()=>W,cy:()=>_,gd:()=>E,pD:()=>c,rU:()=>V,tE:()=>h,u3:()=>ct,vM:()=>A,v_:()=>ft,yI:()=>j,yL:()=>R,yQ:()=>H});var n,i=r(81587),o=r(74721),a=r(23772),s=r(54119),u=r(3604);r(52675),r(89463),r(76918),r(28706),r(2008),r(48980),r(51629),r(25276),r(64346),r(48598),r(62062),r(44114),r(72712),r(34782),r(54554),r(60739),r(23288),r(55081),r(18111),r(22489),r(7588),r(61701),r(18237),r(33110),r(2892),r(59904),r(84185),r(52811),r(29908),r(79432),r(26099),r(78459),r(58940),r(27495),r(90906),r(38781),r(42781),r(25440),r(90744),r(11392),r(42762),r(23500),r(62953),r(13611);
-/**
-* @vue/shared v3.5.13
-* (c) 2018-present Yuxi (Evan) You and Vue contributors
-* @license MIT
-**/
-/*! #__NO_SIDE_EFFECTS__ */
-function c(t){var e,r=Object.create(null),n=(0,u.A)(t.split(","));try{for(n.s();!(e=n.n()).done;){var i=e.value;r[i]=1}}catch(o){n.e(o)}finally{n.f()}return function(t){return t in r}}var l,f={},p=[],h=function(){},d=function(){return!1},v=function(t){return 111===t.charCodeAt(0)&&110===t.charCodeAt(1)&&(t.charCodeAt(2)>122||t.charCodeAt(2)<97)},g=function(t){return t.startsWith("onUpdate:")},m=Object.assign,y=function(t,e){var r=t.indexOf(e);r>-1&&t.splice(r,1)},b=Object.prototype.hasOwnProperty,w=function(t,e){return b.call(t,e)},_=Array.isArray,x=function(t){return"[object Map]"===I(t)},A=function(t){return"[object Set]"===I(t)},k=function(t){return"[object Date]"===I(t)},E=function(t){return"[object RegExp]"===I(t)},S=function(t){return"function"===typeof t},C=function(t){return"string"===typeof t},O=function(t){return"symbol"===(0,s.A)(t)},T=function(t){return null!==t&&"object"===(0,s.A)(t)},R=function(t){return(T(t)||S(t))&&S(t.then)&&S(t["catch"])},D=Object.prototype.toString,I=function(t){return D.call(t)},L=function(t){return I(t).slice(8,-1)},P=function(t){return"[object Object]"===I(t)},j=function(t){return C(t)&&"NaN"!==t&&"-"!==t[0]&&""+parseInt(t,10)===t},F=c(",key,ref,ref_for,ref_key,onVnodeBeforeMount,onVnodeMounted,onVnodeBeforeUpdate,onVnodeUpdated,onVnodeBeforeUnmount,onVnodeUnmounted"),M=function(t){var e=Object.create(null);return function(r){var n=e[r];return n||(e[r]=t(r))}},N=/-(\w)/g,q=M((function(t){return t.replace(N,(function(t,e){return e?e.toUpperCase():""}))})),U=/\B([A-Z])/g,B=M((function(t){return t.replace(U,"-$1").toLowerCase()})),z=M((function(t){return t.charAt(0).toUpperCase()+t.slice(1)})),V=M((function(t){var e=t?"on".concat(z(t)):"";return e})),$=function(t,e){return!Object.is(t,e)},G=function(t){for(var e=arguments.length,r=new Array(e>1?e-1:0),n=1;n<e;n++)r[n-1]=arguments[n];for(var i=0;i<t.length;i++)t[i].apply(t,r)},H=function(t,e,r){var n=arguments.length>3&&void 0!==arguments[3]&&arguments[3];Object.defineProperty(t,e,{configurable:!0,enumerable:!1,writable:n,value:r})},W=function(t){var e=parseFloat(t);return isNaN(e)?t:e},Z=function(t){var e=C(t)?Number(t):NaN;return isNaN(e)?t:e},K=function(){return l||(l="undefined"!==typeof globalThis?globalThis:"undefined"!==typeof self?self:"undefined"!==typeof window?window:"undefined"!==typeof r.g?r.g:{})};n={},(0,a.A)((0,a.A)((0,a.A)((0,a.A)((0,a.A)((0,a.A)((0,a.A)((0,a.A)((0,a.A)((0,a.A)(n,1,"TEXT"),2,"CLASS"),4,"STYLE"),8,"PROPS"),16,"FULL_PROPS"),32,"NEED_HYDRATION"),64,"STABLE_FRAGMENT"),128,"KEYED_FRAGMENT"),256,"UNKEYED_FRAGMENT"),512,"NEED_PATCH"),(0,a.A)((0,a.A)((0,a.A)((0,a.A)(n,1024,"DYNAMIC_SLOTS"),2048,"DEV_ROOT_FRAGMENT"),-1,"HOISTED"),-2,"BAIL"),(0,a.A)((0,a.A)((0,a.A)({},1,"STABLE"),2,"DYNAMIC"),3,"FORWARDED");var Y="Infinity,undefined,NaN,isFinite,isNaN,parseFloat,parseInt,decodeURI,decodeURIComponent,encodeURI,encodeURIComponent,Math,Number,Date,Array,Object,Boolean,String,RegExp,Map,Set,JSON,Intl,BigInt,console,Error,Symbol",J=c(Y);function X(t){if(_(t)){for(var e={},r=0;r<t.length;r++){var n=t[r],i=C(n)?rt(n):X(n);if(i)for(var o in i)e[o]=i[o]}return e}if(C(t)||T(t))return t}var Q=/;(?![^(]*\))/g,tt=/:([^]+)/,et=/\/\*[^]*?\*\//g;function rt(t){var e={};return t.replace(et,"").split(Q).forEach((function(t){if(t){var r=t.split(tt);r.length>1&&(e[r[0].trim()]=r[1].trim())}})),e}function nt(t){var e="";if(C(t))e=t;else if(_(t))for(var r=0;r<t.length;r++){var n=nt(t[r]);n&&(e+=n+" ")}else if(T(t))for(var i in t)t[i]&&(e+=i+" ");return e.trim()}var it="itemscope,allowfullscreen,formnovalidate,ismap,nomodule,novalidate,readonly",ot=c(it);function at(t){return!!t||""===t}function st(t,e){if(t.length!==e.length)return!1;for(var r=!0,n=0;r&&n<t.length;n++)r=ut(t[n],e[n]);return r}function ut(t,e){if(t===e)return!0;var r=k(t),n=k(e);if(r||n)return!(!r||!n)&&t.getTime()===e.getTime();if(r=O(t),n=O(e),r||n)return t===e;if(r=_(t),n=_(e),r||n)return!(!r||!n)&&st(t,e);if(r=T(t),n=T(e),r||n){if(!r||!n)return!1;var i=Object.keys(t).length,o=Object.keys(e).length;if(i!==o)return!1;for(var a in t){var s=t.hasOwnProperty(a),u=e.hasOwnProperty(a);if(s&&!u||!s&&u||!ut(t[a],e[a]))return!1}}return String(t)===String(e)}function ct(t,e){return t.findIndex((function(t){return ut(t,e)}))}var lt=function(t){return!(!t||!0!==t["__v_isRef"])},ft=function(t){return C(t)?t:null==t?"":_(t)||T(t)&&(t.toString===D||!S(t.toString))?lt(t)?ft(t.value):JSON.stringify(t,pt,2):String(t)},pt=function(t,e){return lt(e)?pt(t,e.value):x(e)?(0,a.A)({},"Map(".concat(e.size,")"),(0,o.A)(e.entries()).reduce((function(t,e,r){var n=(0,i.A)(e,2),o=n[0],a=n[1];return t[ht(o,r)+" =>"]=a,t}),{})):A(e)?(0,a.A)({},"Set(".concat(e.size,")"),(0,o.A)(e.values()).map((function(t){return ht(t)}))):O(e)?ht(e):!T(e)||_(e)||P(e)?e:String(e)},ht=function(t){var e,r=arguments.length>1&&void 0!==arguments[1]?arguments[1]:"";return O(t)?"Symbol(".concat(null!=(e=t.description)?e:r,")"):t}},24357:t=>{"use strict";t.exports=JSON.parse('{"Aacute":"Á","aacute":"á","Abreve":"Ă","abreve":"ă","ac":"∾","acd":"∿","acE":"∾̳","Acirc":"Â","acirc":"â","acute":"´","Acy":"А","acy":"а","AElig":"Æ","aelig":"æ","af":"⁡","Afr":"𝔄","afr":"𝔞","Agrave":"À","agrave":"à","alefsym":"ℵ","aleph":"ℵ","Alpha":"Α","alpha":"α","Amacr":"Ā","amacr":"ā","amalg":"⨿","amp":"&","AMP":"&","andand":"⩕","And":"⩓","and":"∧","andd":"⩜","andslope":"⩘","andv":"⩚","ang":"∠","ange":"⦤","angle":"∠","angmsdaa":"⦨","angmsdab":"⦩","angmsdac":"⦪","angmsdad":"⦫","angmsdae":"⦬","angmsdaf":"⦭","angmsdag":"⦮","angmsdah":"⦯","angmsd":"∡","angrt":"∟","angrtvb":"⊾","angrtvbd":"⦝","angsph":"∢","angst":"Å","angzarr":"⍼","Aogon":"Ą","aogon":"ą","Aopf":"𝔸","aopf":"𝕒","apacir":"⩯","ap":"≈","apE":"⩰","ape":"≊","apid":"≋","apos":"\'","ApplyFunction":"⁡","approx":"≈","approxeq":"≊","Aring":"Å","aring":"å","Ascr":"𝒜","ascr":"𝒶","Assign":"≔","ast":"*","asymp":"≈","asympeq":"≍","Atilde":"Ã","atilde":"ã","Auml":"Ä","auml":"ä","awconint":"∳","awint":"⨑","backcong":"≌","backepsilon":"϶","backprime":"‵","backsim":"∽","backsimeq":"⋍","Backslash":"∖","Barv":"⫧","barvee":"⊽","barwed":"⌅","Barwed":"⌆","barwedge":"⌅","bbrk":"⎵","bbrktbrk":"⎶","bcong":"≌","Bcy":"Б","bcy":"б","bdquo":"„","becaus":"∵","because":"∵","Because":"∵","bemptyv":"⦰","bepsi":"϶","bernou":"ℬ","Bernoullis":"ℬ","Beta":"Β","beta":"β","beth":"ℶ","between":"≬","Bfr":"𝔅","bfr":"𝔟","bigcap":"⋂","bigcirc":"◯","bigcup":"⋃","bigodot":"⨀","bigoplus":"⨁","bigotimes":"⨂","bigsqcup":"⨆","bigstar":"★","bigtriangledown":"▽","bigtriangleup":"△","biguplus":"⨄","bigvee":"⋁","bigwedge":"⋀","bkarow":"⤍","blacklozenge":"⧫","blacksquare":"▪","blacktriangle":"▴","blacktriangledown":"▾","blacktriangleleft":"◂","blacktriangleright":"▸","blank":"␣","blk12":"▒","blk14":"░","blk34":"▓","block":"█","bne":"=⃥","bnequiv":"≡⃥","bNot":"⫭","bnot":"⌐","Bopf":"𝔹","bopf":"𝕓","bot":"⊥","bottom":"⊥","bowtie":"⋈","boxbox":"⧉","boxdl":"┐","boxdL":"╕","boxDl":"╖","boxDL":"╗","boxdr":"┌","boxdR":"╒","boxDr":"╓","boxDR":"╔","boxh":"─","boxH":"═","boxhd":"┬","boxHd":"╤","boxhD":"╥","boxHD":"╦","boxhu":"┴","boxHu":"╧","boxhU":"╨","boxHU":"╩","boxminus":"⊟","boxplus":"⊞","boxtimes":"⊠","boxul":"┘","boxuL":"╛","boxUl":"╜","boxUL":"╝","boxur":"└","boxuR":"╘","boxUr":"╙","boxUR":"╚","boxv":"│","boxV":"║","boxvh":"┼","boxvH":"╪","boxVh":"╫","boxVH":"╬","boxvl":"┤","boxvL":"╡","boxVl":"╢","boxVL":"╣","boxvr":"├","boxvR":"╞","boxVr":"╟","boxVR":"╠","bprime":"‵","breve":"˘","Breve":"˘","brvbar":"¦","bscr":"𝒷","Bscr":"ℬ","bsemi":"⁏","bsim":"∽","bsime":"⋍","bsolb":"⧅","bsol":"\\\\","bsolhsub":"⟈","bull":"•","bullet":"•","bump":"≎","bumpE":"⪮","bumpe":"≏","Bumpeq":"≎","bumpeq":"≏","Cacute":"Ć","cacute":"ć","capand":"⩄","capbrcup":"⩉","capcap":"⩋","cap":"∩","Cap":"⋒","capcup":"⩇","capdot":"⩀","CapitalDifferentialD":"ⅅ","caps":"∩︀","caret":"⁁","caron":"ˇ","Cayleys":"ℭ","ccaps":"⩍","Ccaron":"Č","ccaron":"č","Ccedil":"Ç","ccedil":"ç","Ccirc":"Ĉ","ccirc":"ĉ","Cconint":"∰","ccups":"⩌","ccupssm":"⩐","Cdot":"Ċ","cdot":"ċ","cedil":"¸","Cedilla":"¸","cemptyv":"⦲","cent":"¢","centerdot":"·","CenterDot":"·","cfr":"𝔠","Cfr":"ℭ","CHcy":"Ч","chcy":"ч","check":"✓","checkmark":"✓","Chi":"Χ","chi":"χ","circ":"ˆ","circeq":"≗","circlearrowleft":"↺","circlearrowright":"↻","circledast":"⊛","circledcirc":"⊚","circleddash":"⊝","CircleDot":"⊙","circledR":"®","circledS":"Ⓢ","CircleMinus":"⊖","CirclePlus":"⊕","CircleTimes":"⊗","cir":"○","cirE":"⧃","cire":"≗","cirfnint":"⨐","cirmid":"⫯","cirscir":"⧂","ClockwiseContourIntegral":"∲","CloseCurlyDoubleQuote":"”","CloseCurlyQuote":"’","clubs":"♣","clubsuit":"♣","colon":":","Colon":"∷","Colone":"⩴","colone":"≔","coloneq":"≔","comma":",","commat":"@","comp":"∁","compfn":"∘","complement":"∁","complexes":"ℂ","cong":"≅","congdot":"⩭","Congruent":"≡","conint":"∮","Conint":"∯","ContourIntegral":"∮","copf":"𝕔","Copf":"ℂ","coprod":"∐","Coproduct":"∐","copy":"©","COPY":"©","copysr":"℗","CounterClockwiseContourIntegral":"∳","crarr":"↵","cross":"✗","Cross":"⨯","Cscr":"𝒞","cscr":"𝒸","csub":"⫏","csube":"⫑","csup":"⫐","csupe":"⫒","ctdot":"⋯","cudarrl":"⤸","cudarrr":"⤵","cuepr":"⋞","cuesc":"⋟","cularr":"↶","cularrp":"⤽","cupbrcap":"⩈","cupcap":"⩆","CupCap":"≍","cup":"∪","Cup":"⋓","cupcup":"⩊","cupdot":"⊍","cupor":"⩅","cups":"∪︀","curarr":"↷","curarrm":"⤼","curlyeqprec":"⋞","curlyeqsucc":"⋟","curlyvee":"⋎","curlywedge":"⋏","curren":"¤","curvearrowleft":"↶","curvearrowright":"↷","cuvee":"⋎","cuwed":"⋏","cwconint":"∲","cwint":"∱","cylcty":"⌭","dagger":"†","Dagger":"‡","daleth":"ℸ","darr":"↓","Darr":"↡","dArr":"⇓","dash":"‐","Dashv":"⫤","dashv":"⊣","dbkarow":"⤏","dblac":"˝","Dcaron":"Ď","dcaron":"ď","Dcy":"Д","dcy":"д","ddagger":"‡","ddarr":"⇊","DD":"ⅅ","dd":"ⅆ","DDotrahd":"⤑","ddotseq":"⩷","deg":"°","Del":"∇","Delta":"Δ","delta":"δ","demptyv":"⦱","dfisht":"⥿","Dfr":"𝔇","dfr":"𝔡","dHar":"⥥","dharl":"⇃","dharr":"⇂","DiacriticalAcute":"´","DiacriticalDot":"˙","DiacriticalDoubleAcute":"˝","DiacriticalGrave":"`","DiacriticalTilde":"˜","diam":"⋄","diamond":"⋄","Diamond":"⋄","diamondsuit":"♦","diams":"♦","die":"¨","DifferentialD":"ⅆ","digamma":"ϝ","disin":"⋲","div":"÷","divide":"÷","divideontimes":"⋇","divonx":"⋇","DJcy":"Ђ","djcy":"ђ","dlcorn":"⌞","dlcrop":"⌍","dollar":"$","Dopf":"𝔻","dopf":"𝕕","Dot":"¨","dot":"˙","DotDot":"⃜","doteq":"≐","doteqdot":"≑","DotEqual":"≐","dotminus":"∸","dotplus":"∔","dotsquare":"⊡","doublebarwedge":"⌆","DoubleContourIntegral":"∯","DoubleDot":"¨","DoubleDownArrow":"⇓","DoubleLeftArrow":"⇐","DoubleLeftRightArrow":"⇔","DoubleLeftTee":"⫤","DoubleLongLeftArrow":"⟸","DoubleLongLeftRightArrow":"⟺","DoubleLongRightArrow":"⟹","DoubleRightArrow":"⇒","DoubleRightTee":"⊨","DoubleUpArrow":"⇑","DoubleUpDownArrow":"⇕","DoubleVerticalBar":"∥","DownArrowBar":"⤓","downarrow":"↓","DownArrow":"↓","Downarrow":"⇓","DownArrowUpArrow":"⇵","DownBreve":"̑","downdownarrows":"⇊","downharpoonleft":"⇃","downharpoonright":"⇂","DownLeftRightVector":"⥐","DownLeftTeeVector":"⥞","DownLeftVectorBar":"⥖","DownLeftVector":"↽","DownRightTeeVector":"⥟","DownRightVectorBar":"⥗","DownRightVector":"⇁","DownTeeArrow":"↧","DownTee":"⊤","drbkarow":"⤐","drcorn":"⌟","drcrop":"⌌","Dscr":"𝒟","dscr":"𝒹","DScy":"Ѕ","dscy":"ѕ","dsol":"⧶","Dstrok":"Đ","dstrok":"đ","dtdot":"⋱","dtri":"▿","dtrif":"▾","duarr":"⇵","duhar":"⥯","dwangle":"⦦","DZcy":"Џ","dzcy":"џ","dzigrarr":"⟿","Eacute":"É","eacute":"é","easter":"⩮","Ecaron":"Ě","ecaron":"ě","Ecirc":"Ê","ecirc":"ê","ecir":"≖","ecolon":"≕","Ecy":"Э","ecy":"э","eDDot":"⩷","Edot":"Ė","edot":"ė","eDot":"≑","ee":"ⅇ","efDot":"≒","Efr":"𝔈","efr":"𝔢","eg":"⪚","Egrave":"È","egrave":"è","egs":"⪖","egsdot":"⪘","el":"⪙","Element":"∈","elinters":"⏧","ell":"ℓ","els":"⪕","elsdot":"⪗","Emacr":"Ē","emacr":"ē","empty":"∅","emptyset":"∅","EmptySmallSquare":"◻","emptyv":"∅","EmptyVerySmallSquare":"▫","emsp13":" ","emsp14":" ","emsp":" ","ENG":"Ŋ","eng":"ŋ","ensp":" ","Eogon":"Ę","eogon":"ę","Eopf":"𝔼","eopf":"𝕖","epar":"⋕","eparsl":"⧣","eplus":"⩱","epsi":"ε","Epsilon":"Ε","epsilon":"ε","epsiv":"ϵ","eqcirc":"≖","eqcolon":"≕","eqsim":"≂","eqslantgtr":"⪖","eqslantless":"⪕","Equal":"⩵","equals":"=","EqualTilde":"≂","equest":"≟","Equilibrium":"⇌","equiv":"≡","equivDD":"⩸","eqvparsl":"⧥","erarr":"⥱","erDot":"≓","escr":"ℯ","Escr":"ℰ","esdot":"≐","Esim":"⩳","esim":"≂","Eta":"Η","eta":"η","ETH":"Ð","eth":"ð","Euml":"Ë","euml":"ë","euro":"€","excl":"!","exist":"∃","Exists":"∃","expectation":"ℰ","exponentiale":"ⅇ","ExponentialE":"ⅇ","fallingdotseq":"≒","Fcy":"Ф","fcy":"ф","female":"♀","ffilig":"ﬃ","fflig":"ﬀ","ffllig":"ﬄ","Ffr":"𝔉","ffr":"𝔣","filig":"ﬁ","FilledSmallSquare":"◼","FilledVerySmallSquare":"▪","fjlig":"fj","flat":"♭","fllig":"ﬂ","fltns":"▱","fnof":"ƒ","Fopf":"𝔽","fopf":"𝕗","forall":"∀","ForAll":"∀","fork":"⋔","forkv":"⫙","Fouriertrf":"ℱ","fpartint":"⨍","frac12":"½","frac13":"⅓","frac14":"¼","frac15":"⅕","frac16":"⅙","frac18":"⅛","frac23":"⅔","frac25":"⅖","frac34":"¾","frac35":"⅗","frac38":"⅜","frac45":"⅘","frac56":"⅚","frac58":"⅝","frac78":"⅞","frasl":"⁄","frown":"⌢","fscr":"𝒻","Fscr":"ℱ","gacute":"ǵ","Gamma":"Γ","gamma":"γ","Gammad":"Ϝ","gammad":"ϝ","gap":"⪆","Gbreve":"Ğ","gbreve":"ğ","Gcedil":"Ģ","Gcirc":"Ĝ","gcirc":"ĝ","Gcy":"Г","gcy":"г","Gdot":"Ġ","gdot":"ġ","ge":"≥","gE":"≧","gEl":"⪌","gel":"⋛","geq":"≥","geqq":"≧","geqslant":"⩾","gescc":"⪩","ges":"⩾","gesdot":"⪀","gesdoto":"⪂","gesdotol":"⪄","gesl":"⋛︀","gesles":"⪔","Gfr":"𝔊","gfr":"𝔤","gg":"≫","Gg":"⋙","ggg":"⋙","gimel":"ℷ","GJcy":"Ѓ","gjcy":"ѓ","gla":"⪥","gl":"≷","glE":"⪒","glj":"⪤","gnap":"⪊","gnapprox":"⪊","gne":"⪈","gnE":"≩","gneq":"⪈","gneqq":"≩","gnsim":"⋧","Gopf":"𝔾","gopf":"𝕘","grave":"`","GreaterEqual":"≥","GreaterEqualLess":"⋛","GreaterFullEqual":"≧","GreaterGreater":"⪢","GreaterLess":"≷","GreaterSlantEqual":"⩾","GreaterTilde":"≳","Gscr":"𝒢","gscr":"ℊ","gsim":"≳","gsime":"⪎","gsiml":"⪐","gtcc":"⪧","gtcir":"⩺","gt":">","GT":">","Gt":"≫","gtdot":"⋗","gtlPar":"⦕","gtquest":"⩼","gtrapprox":"⪆","gtrarr":"⥸","gtrdot":"⋗","gtreqless":"⋛","gtreqqless":"⪌","gtrless":"≷","gtrsim":"≳","gvertneqq":"≩︀","gvnE":"≩︀","Hacek":"ˇ","hairsp":" ","half":"½","hamilt":"ℋ","HARDcy":"Ъ","hardcy":"ъ","harrcir":"⥈","harr":"↔","hArr":"⇔","harrw":"↭","Hat":"^","hbar":"ℏ","Hcirc":"Ĥ","hcirc":"ĥ","hearts":"♥","heartsuit":"♥","hellip":"…","hercon":"⊹","hfr":"𝔥","Hfr":"ℌ","HilbertSpace":"ℋ","hksearow":"⤥","hkswarow":"⤦","hoarr":"⇿","homtht":"∻","hookleftarrow":"↩","hookrightarrow":"↪","hopf":"𝕙","Hopf":"ℍ","horbar":"―","HorizontalLine":"─","hscr":"𝒽","Hscr":"ℋ","hslash":"ℏ","Hstrok":"Ħ","hstrok":"ħ","HumpDownHump":"≎","HumpEqual":"≏","hybull":"⁃","hyphen":"‐","Iacute":"Í","iacute":"í","ic":"⁣","Icirc":"Î","icirc":"î","Icy":"И","icy":"и","Idot":"İ","IEcy":"Е","iecy":"е","iexcl":"¡","iff":"⇔","ifr":"𝔦","Ifr":"ℑ","Igrave":"Ì","igrave":"ì","ii":"ⅈ","iiiint":"⨌","iiint":"∭","iinfin":"⧜","iiota":"℩","IJlig":"Ĳ","ijlig":"ĳ","Imacr":"Ī","imacr":"ī","image":"ℑ","ImaginaryI":"ⅈ","imagline":"ℐ","imagpart":"ℑ","imath":"ı","Im":"ℑ","imof":"⊷","imped":"Ƶ","Implies":"⇒","incare":"℅","in":"∈","infin":"∞","infintie":"⧝","inodot":"ı","intcal":"⊺","int":"∫","Int":"∬","integers":"ℤ","Integral":"∫","intercal":"⊺","Intersection":"⋂","intlarhk":"⨗","intprod":"⨼","InvisibleComma":"⁣","InvisibleTimes":"⁢","IOcy":"Ё","iocy":"ё","Iogon":"Į","iogon":"į","Iopf":"𝕀","iopf":"𝕚","Iota":"Ι","iota":"ι","iprod":"⨼","iquest":"¿","iscr":"𝒾","Iscr":"ℐ","isin":"∈","isindot":"⋵","isinE":"⋹","isins":"⋴","isinsv":"⋳","isinv":"∈","it":"⁢","Itilde":"Ĩ","itilde":"ĩ","Iukcy":"І","iukcy":"і","Iuml":"Ï","iuml":"ï","Jcirc":"Ĵ","jcirc":"ĵ","Jcy":"Й","jcy":"й","Jfr":"𝔍","jfr":"𝔧","jmath":"ȷ","Jopf":"𝕁","jopf":"𝕛","Jscr":"𝒥","jscr":"𝒿","Jsercy":"Ј","jsercy":"ј","Jukcy":"Є","jukcy":"є","Kappa":"Κ","kappa":"κ","kappav":"ϰ","Kcedil":"Ķ","kcedil":"ķ","Kcy":"К","kcy":"к","Kfr":"𝔎","kfr":"𝔨","kgreen":"ĸ","KHcy":"Х","khcy":"х","KJcy":"Ќ","kjcy":"ќ","Kopf":"𝕂","kopf":"𝕜","Kscr":"𝒦","kscr":"𝓀","lAarr":"⇚","Lacute":"Ĺ","lacute":"ĺ","laemptyv":"⦴","lagran":"ℒ","Lambda":"Λ","lambda":"λ","lang":"⟨","Lang":"⟪","langd":"⦑","langle":"⟨","lap":"⪅","Laplacetrf":"ℒ","laquo":"«","larrb":"⇤","larrbfs":"⤟","larr":"←","Larr":"↞","lArr":"⇐","larrfs":"⤝","larrhk":"↩","larrlp":"↫","larrpl":"⤹","larrsim":"⥳","larrtl":"↢","latail":"⤙","lAtail":"⤛","lat":"⪫","late":"⪭","lates":"⪭︀","lbarr":"⤌","lBarr":"⤎","lbbrk":"❲","lbrace":"{","lbrack":"[","lbrke":"⦋","lbrksld":"⦏","lbrkslu":"⦍","Lcaron":"Ľ","lcaron":"ľ","Lcedil":"Ļ","lcedil":"ļ","lceil":"⌈","lcub":"{","Lcy":"Л","lcy":"л","ldca":"⤶","ldquo":"“","ldquor":"„","ldrdhar":"⥧","ldrushar":"⥋","ldsh":"↲","le":"≤","lE":"≦","LeftAngleBracket":"⟨","LeftArrowBar":"⇤","leftarrow":"←","LeftArrow":"←","Leftarrow":"⇐","LeftArrowRightArrow":"⇆","leftarrowtail":"↢","LeftCeiling":"⌈","LeftDoubleBracket":"⟦","LeftDownTeeVector":"⥡","LeftDownVectorBar":"⥙","LeftDownVector":"⇃","LeftFloor":"⌊","leftharpoondown":"↽","leftharpoonup":"↼","leftleftarrows":"⇇","leftrightarrow":"↔","LeftRightArrow":"↔","Leftrightarrow":"⇔","leftrightarrows":"⇆","leftrightharpoons":"⇋","leftrightsquigarrow":"↭","LeftRightVector":"⥎","LeftTeeArrow":"↤","LeftTee":"⊣","LeftTeeVector":"⥚","leftthreetimes":"⋋","LeftTriangleBar":"⧏","LeftTriangle":"⊲","LeftTriangleEqual":"⊴","LeftUpDownVector":"⥑","LeftUpTeeVector":"⥠","LeftUpVectorBar":"⥘","LeftUpVector":"↿","LeftVectorBar":"⥒","LeftVector":"↼","lEg":"⪋","leg":"⋚","leq":"≤","leqq":"≦","leqslant":"⩽","lescc":"⪨","les":"⩽","lesdot":"⩿","lesdoto":"⪁","lesdotor":"⪃","lesg":"⋚︀","lesges":"⪓","lessapprox":"⪅","lessdot":"⋖","lesseqgtr":"⋚","lesseqqgtr":"⪋","LessEqualGreater":"⋚","LessFullEqual":"≦","LessGreater":"≶","lessgtr":"≶","LessLess":"⪡","lesssim":"≲","LessSlantEqual":"⩽","LessTilde":"≲","lfisht":"⥼","lfloor":"⌊","Lfr":"𝔏","lfr":"𝔩","lg":"≶","lgE":"⪑","lHar":"⥢","lhard":"↽","lharu":"↼","lharul":"⥪","lhblk":"▄","LJcy":"Љ","ljcy":"љ","llarr":"⇇","ll":"≪","Ll":"⋘","llcorner":"⌞","Lleftarrow":"⇚","llhard":"⥫","lltri":"◺","Lmidot":"Ŀ","lmidot":"ŀ","lmoustache":"⎰","lmoust":"⎰","lnap":"⪉","lnapprox":"⪉","lne":"⪇","lnE":"≨","lneq":"⪇","lneqq":"≨","lnsim":"⋦","loang":"⟬","loarr":"⇽","lobrk":"⟦","longleftarrow":"⟵","LongLeftArrow":"⟵","Longleftarrow":"⟸","longleftrightarrow":"⟷","LongLeftRightArrow":"⟷","Longleftrightarrow":"⟺","longmapsto":"⟼","longrightarrow":"⟶","LongRightArrow":"⟶","Longrightarrow":"⟹","looparrowleft":"↫","looparrowright":"↬","lopar":"⦅","Lopf":"𝕃","lopf":"𝕝","loplus":"⨭","lotimes":"⨴","lowast":"∗","lowbar":"_","LowerLeftArrow":"↙","LowerRightArrow":"↘","loz":"◊","lozenge":"◊","lozf":"⧫","lpar":"(","lparlt":"⦓","lrarr":"⇆","lrcorner":"⌟","lrhar":"⇋","lrhard":"⥭","lrm":"‎","lrtri":"⊿","lsaquo":"‹","lscr":"𝓁","Lscr":"ℒ","lsh":"↰","Lsh":"↰","lsim":"≲","lsime":"⪍","lsimg":"⪏","lsqb":"[","lsquo":"‘","lsquor":"‚","Lstrok":"Ł","lstrok":"ł","ltcc":"⪦","ltcir":"⩹","lt":"<","LT":"<","Lt":"≪","ltdot":"⋖","lthree":"⋋","ltimes":"⋉","ltlarr":"⥶","ltquest":"⩻","ltri":"◃","ltrie":"⊴","ltrif":"◂","ltrPar":"⦖","lurdshar":"⥊","luruhar":"⥦","lvertneqq":"≨︀","lvnE":"≨︀","macr":"¯","male":"♂","malt":"✠","maltese":"✠","Map":"⤅","map":"↦","mapsto":"↦","mapstodown":"↧","mapstoleft":"↤","mapstoup":"↥","marker":"▮","mcomma":"⨩","Mcy":"М","mcy":"м","mdash":"—","mDDot":"∺","measuredangle":"∡","MediumSpace":" ","Mellintrf":"ℳ","Mfr":"𝔐","mfr":"𝔪","mho":"℧","micro":"µ","midast":"*","midcir":"⫰","mid":"∣","middot":"·","minusb":"⊟","minus":"−","minusd":"∸","minusdu":"⨪","MinusPlus":"∓","mlcp":"⫛","mldr":"…","mnplus":"∓","models":"⊧","Mopf":"𝕄","mopf":"𝕞","mp":"∓","mscr":"𝓂","Mscr":"ℳ","mstpos":"∾","Mu":"Μ","mu":"μ","multimap":"⊸","mumap":"⊸","nabla":"∇","Nacute":"Ń","nacute":"ń","nang":"∠⃒","nap":"≉","napE":"⩰̸","napid":"≋̸","napos":"ŉ","napprox":"≉","natural":"♮","naturals":"ℕ","natur":"♮","nbsp":" ","nbump":"≎̸","nbumpe":"≏̸","ncap":"⩃","Ncaron":"Ň","ncaron":"ň","Ncedil":"Ņ","ncedil":"ņ","ncong":"≇","ncongdot":"⩭̸","ncup":"⩂","Ncy":"Н","ncy":"н","ndash":"–","nearhk":"⤤","nearr":"↗","neArr":"⇗","nearrow":"↗","ne":"≠","nedot":"≐̸","NegativeMediumSpace":"​","NegativeThickSpace":"​","NegativeThinSpace":"​","NegativeVeryThinSpace":"​","nequiv":"≢","nesear":"⤨","nesim":"≂̸","NestedGreaterGreater":"≫","NestedLessLess":"≪","NewLine":"\\n","nexist":"∄","nexists":"∄","Nfr":"𝔑","nfr":"𝔫","ngE":"≧̸","nge":"≱","ngeq":"≱","ngeqq":"≧̸","ngeqslant":"⩾̸","nges":"⩾̸","nGg":"⋙̸","ngsim":"≵","nGt":"≫⃒","ngt":"≯","ngtr":"≯","nGtv":"≫̸","nharr":"↮","nhArr":"⇎","nhpar":"⫲","ni":"∋","nis":"⋼","nisd":"⋺","niv":"∋","NJcy":"Њ","njcy":"њ","nlarr":"↚","nlArr":"⇍","nldr":"‥","nlE":"≦̸","nle":"≰","nleftarrow":"↚","nLeftarrow":"⇍","nleftrightarrow":"↮","nLeftrightarrow":"⇎","nleq":"≰","nleqq":"≦̸","nleqslant":"⩽̸","nles":"⩽̸","nless":"≮","nLl":"⋘̸","nlsim":"≴","nLt":"≪⃒","nlt":"≮","nltri":"⋪","nltrie":"⋬","nLtv":"≪̸","nmid":"∤","NoBreak":"⁠","NonBreakingSpace":" ","nopf":"𝕟","Nopf":"ℕ","Not":"⫬","not":"¬","NotCongruent":"≢","NotCupCap":"≭","NotDoubleVerticalBar":"∦","NotElement":"∉","NotEqual":"≠","NotEqualTilde":"≂̸","NotExists":"∄","NotGreater":"≯","NotGreaterEqual":"≱","NotGreaterFullEqual":"≧̸","NotGreaterGreater":"≫̸","NotGreaterLess":"≹","NotGreaterSlantEqual":"⩾̸","NotGreaterTilde":"≵","NotHumpDownHump":"≎̸","NotHumpEqual":"≏̸","notin":"∉","notindot":"⋵̸","notinE":"⋹̸","notinva":"∉","notinvb":"⋷","notinvc":"⋶","NotLeftTriangleBar":"⧏̸","NotLeftTriangle":"⋪","NotLeftTriangleEqual":"⋬","NotLess":"≮","NotLessEqual":"≰","NotLessGreater":"≸","NotLessLess":"≪̸","NotLessSlantEqual":"⩽̸","NotLessTilde":"≴","NotNestedGreaterGreater":"⪢̸","NotNestedLessLess":"⪡̸","notni":"∌","notniva":"∌","notnivb":"⋾","notnivc":"⋽","NotPrecedes":"⊀","NotPrecedesEqual":"⪯̸","NotPrecedesSlantEqual":"⋠","NotReverseElement":"∌","NotRightTriangleBar":"⧐̸","NotRightTriangle":"⋫","NotRightTriangleEqual":"⋭","NotSquareSubset":"⊏̸","NotSquareSubsetEqual":"⋢","NotSquareSuperset":"⊐̸","NotSquareSupersetEqual":"⋣","NotSubset":"⊂⃒","NotSubsetEqual":"⊈","NotSucceeds":"⊁","NotSucceedsEqual":"⪰̸","NotSucceedsSlantEqual":"⋡","NotSucceedsTilde":"≿̸","NotSuperset":"⊃⃒","NotSupersetEqual":"⊉","NotTilde":"≁","NotTildeEqual":"≄","NotTildeFullEqual":"≇","NotTildeTilde":"≉","NotVerticalBar":"∤","nparallel":"∦","npar":"∦","nparsl":"⫽⃥","npart":"∂̸","npolint":"⨔","npr":"⊀","nprcue":"⋠","nprec":"⊀","npreceq":"⪯̸","npre":"⪯̸","nrarrc":"⤳̸","nrarr":"↛","nrArr":"⇏","nrarrw":"↝̸","nrightarrow":"↛","nRightarrow":"⇏","nrtri":"⋫","nrtrie":"⋭","nsc":"⊁","nsccue":"⋡","nsce":"⪰̸","Nscr":"𝒩","nscr":"𝓃","nshortmid":"∤","nshortparallel":"∦","nsim":"≁","nsime":"≄","nsimeq":"≄","nsmid":"∤","nspar":"∦","nsqsube":"⋢","nsqsupe":"⋣","nsub":"⊄","nsubE":"⫅̸","nsube":"⊈","nsubset":"⊂⃒","nsubseteq":"⊈","nsubseteqq":"⫅̸","nsucc":"⊁","nsucceq":"⪰̸","nsup":"⊅","nsupE":"⫆̸","nsupe":"⊉","nsupset":"⊃⃒","nsupseteq":"⊉","nsupseteqq":"⫆̸","ntgl":"≹","Ntilde":"Ñ","ntilde":"ñ","ntlg":"≸","ntriangleleft":"⋪","ntrianglelefteq":"⋬","ntriangleright":"⋫","ntrianglerighteq":"⋭","Nu":"Ν","nu":"ν","num":"#","numero":"№","numsp":" ","nvap":"≍⃒","nvdash":"⊬","nvDash":"⊭","nVdash":"⊮","nVDash":"⊯","nvge":"≥⃒","nvgt":">⃒","nvHarr":"⤄","nvinfin":"⧞","nvlArr":"⤂","nvle":"≤⃒","nvlt":"<⃒","nvltrie":"⊴⃒","nvrArr":"⤃","nvrtrie":"⊵⃒","nvsim":"∼⃒","nwarhk":"⤣","nwarr":"↖","nwArr":"⇖","nwarrow":"↖","nwnear":"⤧","Oacute":"Ó","oacute":"ó","oast":"⊛","Ocirc":"Ô","ocirc":"ô","ocir":"⊚","Ocy":"О","ocy":"о","odash":"⊝","Odblac":"Ő","odblac":"ő","odiv":"⨸","odot":"⊙","odsold":"⦼","OElig":"Œ","oelig":"œ","ofcir":"⦿","Ofr":"𝔒","ofr":"𝔬","ogon":"˛","Ograve":"Ò","ograve":"ò","ogt":"⧁","ohbar":"⦵","ohm":"Ω","oint":"∮","olarr":"↺","olcir":"⦾","olcross":"⦻","oline":"‾","olt":"⧀","Omacr":"Ō","omacr":"ō","Omega":"Ω","omega":"ω","Omicron":"Ο","omicron":"ο","omid":"⦶","ominus":"⊖","Oopf":"𝕆","oopf":"𝕠","opar":"⦷","OpenCurlyDoubleQuote":"“","OpenCurlyQuote":"‘","operp":"⦹","oplus":"⊕","orarr":"↻","Or":"⩔","or":"∨","ord":"⩝","order":"ℴ","orderof":"ℴ","ordf":"ª","ordm":"º","origof":"⊶","oror":"⩖","orslope":"⩗","orv":"⩛","oS":"Ⓢ","Oscr":"𝒪","oscr":"ℴ","Oslash":"Ø","oslash":"ø","osol":"⊘","Otilde":"Õ","otilde":"õ","otimesas":"⨶","Otimes":"⨷","otimes":"⊗","Ouml":"Ö","ouml":"ö","ovbar":"⌽","OverBar":"‾","OverBrace":"⏞","OverBracket":"⎴","OverParenthesis":"⏜","para":"¶","parallel":"∥","par":"∥","parsim":"⫳","parsl":"⫽","part":"∂","PartialD":"∂","Pcy":"П","pcy":"п","percnt":"%","period":".","permil":"‰","perp":"⊥","pertenk":"‱","Pfr":"𝔓","pfr":"𝔭","Phi":"Φ","phi":"φ","phiv":"ϕ","phmmat":"ℳ","phone":"☎","Pi":"Π","pi":"π","pitchfork":"⋔","piv":"ϖ","planck":"ℏ","planckh":"ℎ","plankv":"ℏ","plusacir":"⨣","plusb":"⊞","pluscir":"⨢","plus":"+","plusdo":"∔","plusdu":"⨥","pluse":"⩲","PlusMinus":"±","plusmn":"±","plussim":"⨦","plustwo":"⨧","pm":"±","Poincareplane":"ℌ","pointint":"⨕","popf":"𝕡","Popf":"ℙ","pound":"£","prap":"⪷","Pr":"⪻","pr":"≺","prcue":"≼","precapprox":"⪷","prec":"≺","preccurlyeq":"≼","Precedes":"≺","PrecedesEqual":"⪯","PrecedesSlantEqual":"≼","PrecedesTilde":"≾","preceq":"⪯","precnapprox":"⪹","precneqq":"⪵","precnsim":"⋨","pre":"⪯","prE":"⪳","precsim":"≾","prime":"′","Prime":"″","primes":"ℙ","prnap":"⪹","prnE":"⪵","prnsim":"⋨","prod":"∏","Product":"∏","profalar":"⌮","profline":"⌒","profsurf":"⌓","prop":"∝","Proportional":"∝","Proportion":"∷","propto":"∝","prsim":"≾","prurel":"⊰","Pscr":"𝒫","pscr":"𝓅","Psi":"Ψ","psi":"ψ","puncsp":" ","Qfr":"𝔔","qfr":"𝔮","qint":"⨌","qopf":"𝕢","Qopf":"ℚ","qprime":"⁗","Qscr":"𝒬","qscr":"𝓆","quaternions":"ℍ","quatint":"⨖","quest":"?","questeq":"≟","quot":"\\"","QUOT":"\\"","rAarr":"⇛","race":"∽̱","Racute":"Ŕ","racute":"ŕ","radic":"√","raemptyv":"⦳","rang":"⟩","Rang":"⟫","rangd":"⦒","range":"⦥","rangle":"⟩","raquo":"»","rarrap":"⥵","rarrb":"⇥","rarrbfs":"⤠","rarrc":"⤳","rarr":"→","Rarr":"↠","rArr":"⇒","rarrfs":"⤞","rarrhk":"↪","rarrlp":"↬","rarrpl":"⥅","rarrsim":"⥴","Rarrtl":"⤖","rarrtl":"↣","rarrw":"↝","ratail":"⤚","rAtail":"⤜","ratio":"∶","rationals":"ℚ","rbarr":"⤍","rBarr":"⤏","RBarr":"⤐","rbbrk":"❳","rbrace":"}","rbrack":"]","rbrke":"⦌","rbrksld":"⦎","rbrkslu":"⦐","Rcaron":"Ř","rcaron":"ř","Rcedil":"Ŗ","rcedil":"ŗ","rceil":"⌉","rcub":"}","Rcy":"Р","rcy":"р","rdca":"⤷","rdldhar":"⥩","rdquo":"”","rdquor":"”","rdsh":"↳","real":"ℜ","realine":"ℛ","realpart":"ℜ","reals":"ℝ","Re":"ℜ","rect":"▭","reg":"®","REG":"®","ReverseElement":"∋","ReverseEquilibrium":"⇋","ReverseUpEquilibrium":"⥯","rfisht":"⥽","rfloor":"⌋","rfr":"𝔯","Rfr":"ℜ","rHar":"⥤","rhard":"⇁","rharu":"⇀","rharul":"⥬","Rho":"Ρ","rho":"ρ","rhov":"ϱ","RightAngleBracket":"⟩","RightArrowBar":"⇥","rightarrow":"→","RightArrow":"→","Rightarrow":"⇒","RightArrowLeftArrow":"⇄","rightarrowtail":"↣","RightCeiling":"⌉","RightDoubleBracket":"⟧","RightDownTeeVector":"⥝","RightDownVectorBar":"⥕","RightDownVector":"⇂","RightFloor":"⌋","rightharpoondown":"⇁","rightharpoonup":"⇀","rightleftarrows":"⇄","rightleftharpoons":"⇌","rightrightarrows":"⇉","rightsquigarrow":"↝","RightTeeArrow":"↦","RightTee":"⊢","RightTeeVector":"⥛","rightthreetimes":"⋌","RightTriangleBar":"⧐","RightTriangle":"⊳","RightTriangleEqual":"⊵","RightUpDownVector":"⥏","RightUpTeeVector":"⥜","RightUpVectorBar":"⥔","RightUpVector":"↾","RightVectorBar":"⥓","RightVector":"⇀","ring":"˚","risingdotseq":"≓","rlarr":"⇄","rlhar":"⇌","rlm":"‏","rmoustache":"⎱","rmoust":"⎱","rnmid":"⫮","roang":"⟭","roarr":"⇾","robrk":"⟧","ropar":"⦆","ropf":"𝕣","Ropf":"ℝ","roplus":"⨮","rotimes":"⨵","RoundImplies":"⥰","rpar":")","rpargt":"⦔","rppolint":"⨒","rrarr":"⇉","Rrightarrow":"⇛","rsaquo":"›","rscr":"𝓇","Rscr":"ℛ","rsh":"↱","Rsh":"↱","rsqb":"]","rsquo":"’","rsquor":"’","rthree":"⋌","rtimes":"⋊","rtri":"▹","rtrie":"⊵","rtrif":"▸","rtriltri":"⧎","RuleDelayed":"⧴","ruluhar":"⥨","rx":"℞","Sacute":"Ś","sacute":"ś","sbquo":"‚","scap":"⪸","Scaron":"Š","scaron":"š","Sc":"⪼","sc":"≻","sccue":"≽","sce":"⪰","scE":"⪴","Scedil":"Ş","scedil":"ş","Scirc":"Ŝ","scirc":"ŝ","scnap":"⪺","scnE":"⪶","scnsim":"⋩","scpolint":"⨓","scsim":"≿","Scy":"С","scy":"с","sdotb":"⊡","sdot":"⋅","sdote":"⩦","searhk":"⤥","searr":"↘","seArr":"⇘","searrow":"↘","sect":"§","semi":";","seswar":"⤩","setminus":"∖","setmn":"∖","sext":"✶","Sfr":"𝔖","sfr":"𝔰","sfrown":"⌢","sharp":"♯","SHCHcy":"Щ","shchcy":"щ","SHcy":"Ш","shcy":"ш","ShortDownArrow":"↓","ShortLeftArrow":"←","shortmid":"∣","shortparallel":"∥","ShortRightArrow":"→","ShortUpArrow":"↑","shy":"­","Sigma":"Σ","sigma":"σ","sigmaf":"ς","sigmav":"ς","sim":"∼","simdot":"⩪","sime":"≃","simeq":"≃","simg":"⪞","simgE":"⪠","siml":"⪝","simlE":"⪟","simne":"≆","simplus":"⨤","simrarr":"⥲","slarr":"←","SmallCircle":"∘","smallsetminus":"∖","smashp":"⨳","smeparsl":"⧤","smid":"∣","smile":"⌣","smt":"⪪","smte":"⪬","smtes":"⪬︀","SOFTcy":"Ь","softcy":"ь","solbar":"⌿","solb":"⧄","sol":"/","Sopf":"𝕊","sopf":"𝕤","spades":"♠","spadesuit":"♠","spar":"∥","sqcap":"⊓","sqcaps":"⊓︀","sqcup":"⊔","sqcups":"⊔︀","Sqrt":"√","sqsub":"⊏","sqsube":"⊑","sqsubset":"⊏","sqsubseteq":"⊑","sqsup":"⊐","sqsupe":"⊒","sqsupset":"⊐","sqsupseteq":"⊒","square":"□","Square":"□","SquareIntersection":"⊓","SquareSubset":"⊏","SquareSubsetEqual":"⊑","SquareSuperset":"⊐","SquareSupersetEqual":"⊒","SquareUnion":"⊔","squarf":"▪","squ":"□","squf":"▪","srarr":"→","Sscr":"𝒮","sscr":"𝓈","ssetmn":"∖","ssmile":"⌣","sstarf":"⋆","Star":"⋆","star":"☆","starf":"★","straightepsilon":"ϵ","straightphi":"ϕ","strns":"¯","sub":"⊂","Sub":"⋐","subdot":"⪽","subE":"⫅","sube":"⊆","subedot":"⫃","submult":"⫁","subnE":"⫋","subne":"⊊","subplus":"⪿","subrarr":"⥹","subset":"⊂","Subset":"⋐","subseteq":"⊆","subseteqq":"⫅","SubsetEqual":"⊆","subsetneq":"⊊","subsetneqq":"⫋","subsim":"⫇","subsub":"⫕","subsup":"⫓","succapprox":"⪸","succ":"≻","succcurlyeq":"≽","Succeeds":"≻","SucceedsEqual":"⪰","SucceedsSlantEqual":"≽","SucceedsTilde":"≿","succeq":"⪰","succnapprox":"⪺","succneqq":"⪶","succnsim":"⋩","succsim":"≿","SuchThat":"∋","sum":"∑","Sum":"∑","sung":"♪","sup1":"¹","sup2":"²","sup3":"³","sup":"⊃","Sup":"⋑","supdot":"⪾","supdsub":"⫘","supE":"⫆","supe":"⊇","supedot":"⫄","Superset":"⊃","SupersetEqual":"⊇","suphsol":"⟉","suphsub":"⫗","suplarr":"⥻","supmult":"⫂","supnE":"⫌","supne":"⊋","supplus":"⫀","supset":"⊃","Supset":"⋑","supseteq":"⊇","supseteqq":"⫆","supsetneq":"⊋","supsetneqq":"⫌","supsim":"⫈","supsub":"⫔","supsup":"⫖","swarhk":"⤦","swarr":"↙","swArr":"⇙","swarrow":"↙","swnwar":"⤪","szlig":"ß","Tab":"\\t","target":"⌖","Tau":"Τ","tau":"τ","tbrk":"⎴","Tcaron":"Ť","tcaron":"ť","Tcedil":"Ţ","tcedil":"ţ","Tcy":"Т","tcy":"т","tdot":"⃛","telrec":"⌕","Tfr":"𝔗","tfr":"𝔱","there4":"∴","therefore":"∴","Therefore":"∴","Theta":"Θ","theta":"θ","thetasym":"ϑ","thetav":"ϑ","thickapprox":"≈","thicksim":"∼","ThickSpace":"  ","ThinSpace":" ","thinsp":" ","thkap":"≈","thksim":"∼","THORN":"Þ","thorn":"þ","tilde":"˜","Tilde":"∼","TildeEqual":"≃","TildeFullEqual":"≅","TildeTilde":"≈","timesbar":"⨱","timesb":"⊠","times":"×","timesd":"⨰","tint":"∭","toea":"⤨","topbot":"⌶","topcir":"⫱","top":"⊤","Topf":"𝕋","topf":"𝕥","topfork":"⫚","tosa":"⤩","tprime":"‴","trade":"™","TRADE":"™","triangle":"▵","triangledown":"▿","triangleleft":"◃","trianglelefteq":"⊴","triangleq":"≜","triangleright":"▹","trianglerighteq":"⊵","tridot":"◬","trie":"≜","triminus":"⨺","TripleDot":"⃛","triplus":"⨹","trisb":"⧍","tritime":"⨻","trpezium":"⏢","Tscr":"𝒯","tscr":"𝓉","TScy":"Ц","tscy":"ц","TSHcy":"Ћ","tshcy":"ћ","Tstrok":"Ŧ","tstrok":"ŧ","twixt":"≬","twoheadleftarrow":"↞","twoheadrightarrow":"↠","Uacute":"Ú","uacute":"ú","uarr":"↑","Uarr":"↟","uArr":"⇑","Uarrocir":"⥉","Ubrcy":"Ў","ubrcy":"ў","Ubreve":"Ŭ","ubreve":"ŭ","Ucirc":"Û","ucirc":"û","Ucy":"У","ucy":"у","udarr":"⇅","Udblac":"Ű","udblac":"ű","udhar":"⥮","ufisht":"⥾","Ufr":"𝔘","ufr":"𝔲","Ugrave":"Ù","ugrave":"ù","uHar":"⥣","uharl":"↿","uharr":"↾","uhblk":"▀","ulcorn":"⌜","ulcorner":"⌜","ulcrop":"⌏","ultri":"◸","Umacr":"Ū","umacr":"ū","uml":"¨","UnderBar":"_","UnderBrace":"⏟","UnderBracket":"⎵","UnderParenthesis":"⏝","Union":"⋃","UnionPlus":"⊎","Uogon":"Ų","uogon":"ų","Uopf":"𝕌","uopf":"𝕦","UpArrowBar":"⤒","uparrow":"↑","UpArrow":"↑","Uparrow":"⇑","UpArrowDownArrow":"⇅","updownarrow":"↕","UpDownArrow":"↕","Updownarrow":"⇕","UpEquilibrium":"⥮","upharpoonleft":"↿","upharpoonright":"↾","uplus":"⊎","UpperLeftArrow":"↖","UpperRightArrow":"↗","upsi":"υ","Upsi":"ϒ","upsih":"ϒ","Upsilon":"Υ","upsilon":"υ","UpTeeArrow":"↥","UpTee":"⊥","upuparrows":"⇈","urcorn":"⌝","urcorner":"⌝","urcrop":"⌎","Uring":"Ů","uring":"ů","urtri":"◹","Uscr":"𝒰","uscr":"𝓊","utdot":"⋰","Utilde":"Ũ","utilde":"ũ","utri":"▵","utrif":"▴","uuarr":"⇈","Uuml":"Ü","uuml":"ü","uwangle":"⦧","vangrt":"⦜","varepsilon":"ϵ","varkappa":"ϰ","varnothing":"∅","varphi":"ϕ","varpi":"ϖ","varpropto":"∝","varr":"↕","vArr":"⇕","varrho":"ϱ","varsigma":"ς","varsubsetneq":"⊊︀","varsubsetneqq":"⫋︀","varsupsetneq":"⊋︀","varsupsetneqq":"⫌︀","vartheta":"ϑ","vartriangleleft":"⊲","vartriangleright":"⊳","vBar":"⫨","Vbar":"⫫","vBarv":"⫩","Vcy":"В","vcy":"в","vdash":"⊢","vDash":"⊨","Vdash":"⊩","VDash":"⊫","Vdashl":"⫦","veebar":"⊻","vee":"∨","Vee":"⋁","veeeq":"≚","vellip":"⋮","verbar":"|","Verbar":"‖","vert":"|","Vert":"‖","VerticalBar":"∣","VerticalLine":"|","VerticalSeparator":"❘","VerticalTilde":"≀","VeryThinSpace":" ","Vfr":"𝔙","vfr":"𝔳","vltri":"⊲","vnsub":"⊂⃒","vnsup":"⊃⃒","Vopf":"𝕍","vopf":"𝕧","vprop":"∝","vrtri":"⊳","Vscr":"𝒱","vscr":"𝓋","vsubnE":"⫋︀","vsubne":"⊊︀","vsupnE":"⫌︀","vsupne":"⊋︀","Vvdash":"⊪","vzigzag":"⦚","Wcirc":"Ŵ","wcirc":"ŵ","wedbar":"⩟","wedge":"∧","Wedge":"⋀","wedgeq":"≙","weierp":"℘","Wfr":"𝔚","wfr":"𝔴","Wopf":"𝕎","wopf":"𝕨","wp":"℘","wr":"≀","wreath":"≀","Wscr":"𝒲","wscr":"𝓌","xcap":"⋂","xcirc":"◯","xcup":"⋃","xdtri":"▽","Xfr":"𝔛","xfr":"𝔵","xharr":"⟷","xhArr":"⟺","Xi":"Ξ","xi":"ξ","xlarr":"⟵","xlArr":"⟸","xmap":"⟼","xnis":"⋻","xodot":"⨀","Xopf":"𝕏","xopf":"𝕩","xoplus":"⨁","xotime":"⨂","xrarr":"⟶","xrArr":"⟹","Xscr":"𝒳","xscr":"𝓍","xsqcup":"⨆","xuplus":"⨄","xutri":"△","xvee":"⋁","xwedge":"⋀","Yacute":"Ý","yacute":"ý","YAcy":"Я","yacy":"я","Ycirc":"Ŷ","ycirc":"ŷ","Ycy":"Ы","ycy":"ы","yen":"¥","Yfr":"𝔜","yfr":"𝔶","YIcy":"Ї","yicy":"ї","Yopf":"𝕐","yopf":"𝕪","Yscr":"𝒴","yscr":"𝓎","YUcy":"Ю","yucy":"ю","yuml":"ÿ","Yuml":"Ÿ","Zacute":"Ź","zacute":"ź","Zcaron":"Ž","zcaron":"ž","Zcy":"З","zcy":"з","Zdot":"Ż","zdot":"ż","zeetrf":"ℨ","ZeroWidthSpace":"​","Zeta":"Ζ","zeta":"ζ","zfr":"𝔷","Zfr":"ℨ","ZHcy":"Ж","zhcy":"ж","zigrarr":"⇝","zopf":"𝕫","Zopf":"ℤ","Zscr":"𝒵","zscr":"𝓏","zwj":"‍","zwnj":"‌"}')},24359:(t,e,r)=>{"use strict";var n=r(46518),i=r(66346),o=r(77811);n({global:!0,constructor:!0,forced:!o},{DataView:i.DataView})},24599:(t,e,r)=>{"use strict";var n=r(46518),i=r(22195),o=r(79472),a=o(i.setTimeout,!0);n({global:!0,bind:!0,forced:i.setTimeout!==a},{setTimeout:a})},24659:(t,e,r)=>{"use strict";var n=r(79039),i=r(6980);t.exports=!n((function(){var t=new Error("a");return!("stack"in t)||(Object.defineProperty(t,"stack",i(1,7)),7!==t.stack)}))},24913:(t,e,r)=>{"use strict";var n=r(43724),i=r(35917),o=r(48686),a=r(28551),s=r(56969),u=TypeError,c=Object.defineProperty,l=Object.getOwnPropertyDescriptor,f="enumerable",p="configurable",h="writable";e.f=n?o?function(t,e,r){if(a(t),e=s(e),a(r),"function"===typeof t&&"prototype"===e&&"value"in r&&h in r&&!r[h]){var n=l(t,e);n&&n[h]&&(t[e]=r.value,r={configurable:p in r?r[p]:n[p],enumerable:f in r?r[f]:n[f],writable:!1})}return c(t,e,r)}:c:function(t,e,r){if(a(t),e=s(e),a(r),i)try{return c(t,e,r)}catch(n){}if("get"in r||"set"in r)throw new u("Accessors not supported");return"value"in r&&(t[e]=r.value),t}},25026:(t,e,r)=>{"use strict";r.d(e,{A:()=>n});r(16280),r(76918);function n(t,e){if(!(t instanceof e))throw new TypeError("Cannot call a class as a function")}},25170:(t,e,r)=>{"use strict";var n=r(46706),i=r(94402);t.exports=n(i.proto,"size","get")||function(t){return t.size}},25276:(t,e,r)=>{"use strict";var n=r(46518),i=r(27476),o=r(19617).indexOf,a=r(34598),s=i([].indexOf),u=!!s&&1/s([1],1,-0)<0,c=u||!a("indexOf");n({target:"Array",proto:!0,forced:c},{indexOf:function(t){var e=arguments.length>1?arguments[1]:void 0;return u?s(this,t,e)||0:o(this,t,e)}})},25397:(t,e,r)=>{"use strict";var n=r(47055),i=r(67750);t.exports=function(t){return n(i(t))}},25428:(t,e,r)=>{"use strict";var n=r(46518),i=r(50360);n({target:"Number",stat:!0},{isFinite:i})},25440:(t,e,r)=>{"use strict";var n=r(18745),i=r(69565),o=r(79504),a=r(89228),s=r(79039),u=r(28551),c=r(94901),l=r(64117),f=r(91291),p=r(18014),h=r(655),d=r(67750),v=r(57829),g=r(55966),m=r(2478),y=r(56682),b=r(78227),w=b("replace"),_=Math.max,x=Math.min,A=o([].concat),k=o([].push),E=o("".indexOf),S=o("".slice),C=function(t){return void 0===t?t:String(t)},O=function(){return"$0"==="a".replace(/./,"$0")}(),T=function(){return!!/./[w]&&""===/./[w]("a","$0")}(),R=!s((function(){var t=/./;return t.exec=function(){var t=[];return t.groups={a:"7"},t},"7"!=="".replace(t,"$<a>")}));a("replace",(function(t,e,r){var o=T?"$":"$0";return[function(t,r){var n=d(this),o=l(t)?void 0:g(t,w);return o?i(o,t,n,r):i(e,h(n),t,r)},function(t,i){var a=u(this),s=h(t);if("string"==typeof i&&-1===E(i,o)&&-1===E(i,"$<")){var l=r(e,a,s,i);if(l.done)return l.value}var d=c(i);d||(i=h(i));var g,b=a.global;b&&(g=a.unicode,a.lastIndex=0);var w,O=[];while(1){if(w=y(a,s),null===w)break;if(k(O,w),!b)break;var T=h(w[0]);""===T&&(a.lastIndex=v(s,p(a.lastIndex),g))}for(var R="",D=0,I=0;I<O.length;I++){w=O[I];for(var L,P=h(w[0]),j=_(x(f(w.index),s.length),0),F=[],M=1;M<w.length;M++)k(F,C(w[M]));var N=w.groups;if(d){var q=A([P],F,j,s);void 0!==N&&k(q,N),L=h(n(i,void 0,q))}else L=m(P,s,j,F,N,i);j>=D&&(R+=S(s,D,j)+L,D=j+P.length)}return R+S(s,D)}]}),!R||!O||T)},25745:(t,e,r)=>{"use strict";var n=r(77629);t.exports=function(t,e){return n[t]||(n[t]=e||{})}},25872:(t,e,r)=>{"use strict";r(27495),r(25440);var n=/\r\n?|\n/g,i=/\0/g;t.exports=function(t){var e;e=t.src.replace(n,"\n"),e=e.replace(i,"�"),t.src=e}},26099:(t,e,r)=>{"use strict";var n=r(92140),i=r(36840),o=r(53179);n||i(Object.prototype,"toString",o,{unsafe:!0})},26198:(t,e,r)=>{"use strict";var n=r(18014);t.exports=function(t){return n(t.length)}},26269:t=>{"use strict";t.exports={}},26910:(t,e,r)=>{"use strict";var n=r(46518),i=r(79504),o=r(79306),a=r(48981),s=r(26198),u=r(84606),c=r(655),l=r(79039),f=r(74488),p=r(34598),h=r(13709),d=r(13763),v=r(39519),g=r(3607),m=[],y=i(m.sort),b=i(m.push),w=l((function(){m.sort(void 0)})),_=l((function(){m.sort(null)})),x=p("sort"),A=!l((function(){if(v)return v<70;if(!(h&&h>3)){if(d)return!0;if(g)return g<603;var t,e,r,n,i="";for(t=65;t<76;t++){switch(e=String.fromCharCode(t),t){case 66:case 69:case 70:case 72:r=3;break;case 68:case 71:r=4;break;default:r=2}for(n=0;n<47;n++)m.push({k:e+n,v:r})}for(m.sort((function(t,e){return e.v-t.v})),n=0;n<m.length;n++)e=m[n].k.charAt(0),i.charAt(i.length-1)!==e&&(i+=e);return"DGBEFHACIJK"!==i}})),k=w||!_||!x||!A,E=function(t){return function(e,r){return void 0===r?-1:void 0===e?1:void 0!==t?+t(e,r)||0:c(e)>c(r)?1:-1}};n({target:"Array",proto:!0,forced:k},{sort:function(t){void 0!==t&&o(t);var e=a(this);if(A)return void 0===t?y(e):y(e,t);var r,n,i=[],c=s(e);for(n=0;n<c;n++)n in e&&b(i,e[n]);f(i,E(t)),r=s(i),n=0;while(n<r)e[n]=i[n++];while(n<c)u(e,n++);return e}})},27208:(t,e,r)=>{"use strict";var n=r(46518),i=r(69565);n({target:"URL",proto:!0,enumerable:!0},{toJSON:function(){return i(URL.prototype.toString,this)}})},27337:(t,e,r)=>{"use strict";var n=r(46518),i=r(79504),o=r(35610),a=RangeError,s=String.fromCharCode,u=String.fromCodePoint,c=i([].join),l=!!u&&1!==u.length;n({target:"String",stat:!0,arity:1,forced:l},{fromCodePoint:function(t){var e,r=[],n=arguments.length,i=0;while(n>i){if(e=+arguments[i++],o(e,1114111)!==e)throw new a(e+" is not a valid code point");r[i]=e<65536?s(e):s(55296+((e-=65536)>>10),e%1024+56320)}return c(r,"")}})},27476:(t,e,r)=>{"use strict";var n=r(44576),i=r(79504);t.exports=function(t){if("Function"===n(t))return i(t)}},27495:(t,e,r)=>{"use strict";var n=r(46518),i=r(57323);n({target:"RegExp",proto:!0,forced:/./.exec!==i},{exec:i})},28527:(t,e,r)=>{"use strict";var n=r(97080),i=r(94402).has,o=r(25170),a=r(83789),s=r(40507),u=r(9539);t.exports=function(t){var e=n(this),r=a(t);if(o(e)<r.size)return!1;var c=r.getIterator();return!1!==s(c,(function(t){if(!i(e,t))return u(c,"normal",!1)}))}},28543:(t,e,r)=>{"use strict";var n=r(46518),i=r(69565),o=r(27476),a=r(33994),s=r(62529),u=r(67750),c=r(18014),l=r(655),f=r(28551),p=r(64117),h=r(44576),d=r(60788),v=r(61034),g=r(55966),m=r(36840),y=r(79039),b=r(78227),w=r(2293),_=r(57829),x=r(56682),A=r(91181),k=r(96395),E=b("matchAll"),S="RegExp String",C=S+" Iterator",O=A.set,T=A.getterFor(C),R=RegExp.prototype,D=TypeError,I=o("".indexOf),L=o("".matchAll),P=!!L&&!y((function(){L("a",/./)})),j=a((function(t,e,r,n){O(this,{type:C,regexp:t,string:e,global:r,unicode:n,done:!1})}),S,(function(){var t=T(this);if(t.done)return s(void 0,!0);var e=t.regexp,r=t.string,n=x(e,r);return null===n?(t.done=!0,s(void 0,!0)):t.global?(""===l(n[0])&&(e.lastIndex=_(r,c(e.lastIndex),t.unicode)),s(n,!1)):(t.done=!0,s(n,!1))})),F=function(t){var e,r,n,i=f(this),o=l(t),a=w(i,RegExp),s=l(v(i));return e=new a(a===RegExp?i.source:i,s),r=!!~I(s,"g"),n=!!~I(s,"u"),e.lastIndex=c(i.lastIndex),new j(e,o,r,n)};n({target:"String",proto:!0,forced:P},{matchAll:function(t){var e,r,n,o,a=u(this);if(p(t)){if(P)return L(a,t)}else{if(d(t)&&(e=l(u(v(t))),!~I(e,"g")))throw new D("`.matchAll` does not allow non-global regexes");if(P)return L(a,t);if(n=g(t,E),void 0===n&&k&&"RegExp"===h(t)&&(n=F),n)return i(n,t,a)}return r=l(a),o=new RegExp(t,"g"),k?i(F,o,r):o[E](r)}}),k||E in R||m(R,E,F)},28551:(t,e,r)=>{"use strict";var n=r(20034),i=String,o=TypeError;t.exports=function(t){if(n(t))return t;throw new o(i(t)+" is not an object")}},28565:(t,e,r)=>{"use strict";r(44114),r(34782);var n=r(8700).isSpace;t.exports=function(t,e){var r,i,o,a=t.pos;if(10!==t.src.charCodeAt(a))return!1;if(r=t.pending.length-1,i=t.posMax,!e)if(r>=0&&32===t.pending.charCodeAt(r))if(r>=1&&32===t.pending.charCodeAt(r-1)){o=r-1;while(o>=1&&32===t.pending.charCodeAt(o-1))o--;t.pending=t.pending.slice(0,o),t.push("hardbreak","br",0)}else t.pending=t.pending.slice(0,-1),t.push("softbreak","br",0);else t.push("softbreak","br",0);a++;while(a<i&&n(t.src.charCodeAt(a)))a++;return t.pos=a,!0}},28706:(t,e,r)=>{"use strict";var n=r(46518),i=r(79039),o=r(34376),a=r(20034),s=r(48981),u=r(26198),c=r(96837),l=r(97040),f=r(1469),p=r(70597),h=r(78227),d=r(39519),v=h("isConcatSpreadable"),g=d>=51||!i((function(){var t=[];return t[v]=!1,t.concat()[0]!==t})),m=function(t){if(!a(t))return!1;var e=t[v];return void 0!==e?!!e:o(t)},y=!g||!p("concat");n({target:"Array",proto:!0,arity:1,forced:y},{concat:function(t){var e,r,n,i,o,a=s(this),p=f(a,0),h=0;for(e=-1,n=arguments.length;e<n;e++)if(o=-1===e?a:arguments[e],m(o))for(i=u(o),c(h+i),r=0;r<i;r++,h++)r in o&&l(p,h,o[r]);else c(h+1),l(p,h++,o);return p.length=h,p}})},28845:(t,e,r)=>{"use strict";var n=r(22195),i=r(69565),o=r(94644),a=r(26198),s=r(58229),u=r(48981),c=r(79039),l=n.RangeError,f=n.Int8Array,p=f&&f.prototype,h=p&&p.set,d=o.aTypedArray,v=o.exportTypedArrayMethod,g=!c((function(){var t=new Uint8ClampedArray(2);return i(h,t,{length:1,0:3},1),3!==t[1]})),m=g&&o.NATIVE_ARRAY_BUFFER_VIEWS&&c((function(){var t=new f(2);return t.set(1),t.set("2",1),0!==t[0]||2!==t[1]}));v("set",(function(t){d(this);var e=s(arguments.length>1?arguments[1]:void 0,1),r=u(t);if(g)return i(h,this,r,e);var n=this.length,o=a(r),c=0;if(o+e>n)throw new l("Wrong length");while(c<o)this[e+c]=r[c++]}),!g||m)},29309:(t,e,r)=>{"use strict";var n=r(46518),i=r(22195),o=r(59225).set,a=r(79472),s=i.setImmediate?a(o,!1):o;n({global:!0,bind:!0,enumerable:!0,forced:i.setImmediate!==s},{setImmediate:s})},29423:(t,e,r)=>{"use strict";var n=r(94644),i=r(79039),o=r(67680),a=n.aTypedArray,s=n.getTypedArrayConstructor,u=n.exportTypedArrayMethod,c=i((function(){new Int8Array(1).slice()}));u("slice",(function(t,e){var r=o(a(this),t,e),n=s(this),i=0,u=r.length,c=new n(u);while(u>i)c[i]=r[i++];return c}),c)},29908:(t,e,r)=>{"use strict";var n=r(46518),i=r(3470);n({target:"Object",stat:!0},{is:i})},29948:(t,e,r)=>{"use strict";var n=r(35370),i=r(94644).getTypedArrayConstructor;t.exports=function(t,e){return n(i(t),e)}},29990:(t,e,r)=>{"use strict";r.d(e,{A:()=>u});r(60825),r(94170),r(40875),r(63548),r(10287);function n(t){return n=Object.setPrototypeOf?Object.getPrototypeOf.bind():function(t){return t.__proto__||Object.getPrototypeOf(t)},n(t)}function i(){try{var t=!Boolean.prototype.valueOf.call(Reflect.construct(Boolean,[],(function(){})))}catch(t){}return(i=function(){return!!t})()}r(16280),r(76918);var o=r(54119);function a(t){if(void 0===t)throw new ReferenceError("this hasn't been initialised - super() hasn't been called");return t}function s(t,e){if(e&&("object"==(0,o.A)(e)||"function"==typeof e))return e;if(void 0!==e)throw new TypeError("Derived constructors may only return object or undefined");return a(t)}function u(t,e,r){return e=n(e),s(t,i()?Reflect.construct(e,r||[],n(t).constructor):e.apply(t,r))}},30388:(t,e,r)=>{"use strict";r.d(e,{A:()=>i});r(26099);function n(t,e,r,n,i,o,a){try{var s=t[o](a),u=s.value}catch(t){return void r(t)}s.done?e(u):Promise.resolve(u).then(n,i)}function i(t){return function(){var e=this,r=arguments;return new Promise((function(i,o){var a=t.apply(e,r);function s(t){n(a,i,o,s,u,"next",t)}function u(t){n(a,i,o,s,u,"throw",t)}s(void 0)}))}}},30421:t=>{"use strict";t.exports={}},30566:(t,e,r)=>{"use strict";var n=r(79504),i=r(79306),o=r(20034),a=r(39297),s=r(67680),u=r(40616),c=Function,l=n([].concat),f=n([].join),p={},h=function(t,e,r){if(!a(p,e)){for(var n=[],i=0;i<e;i++)n[i]="a["+i+"]";p[e]=c("C,a","return new C("+f(n,",")+")")}return p[e](t,r)};t.exports=u?c.bind:function(t){var e=i(this),r=e.prototype,n=s(arguments,1),a=function(){var r=l(n,s(arguments));return this instanceof a?h(e,r.length,r):e.apply(t,r)};return o(r)&&(a.prototype=r),a}},30958:(t,e,r)=>{"use strict";r(5240)},31240:(t,e,r)=>{"use strict";var n=r(79504);t.exports=n(1..valueOf)},31415:(t,e,r)=>{"use strict";r(92405)},31575:(t,e,r)=>{"use strict";var n=r(94644),i=r(80926).left,o=n.aTypedArray,a=n.exportTypedArrayMethod;a("reduce",(function(t){var e=arguments.length;return i(o(this),t,e,e>1?arguments[1]:void 0)}))},31694:(t,e,r)=>{"use strict";var n=r(94644),i=r(59213).find,o=n.aTypedArray,a=n.exportTypedArrayMethod;a("find",(function(t){return i(o(this),t,arguments.length>1?arguments[1]:void 0)}))},31698:(t,e,r)=>{"use strict";var n=r(46518),i=r(44204),o=r(84916);n({target:"Set",proto:!0,real:!0,forced:!o("union")},{union:i})},31926:(t,e,r)=>{"use strict";function n(t,e,r){this.type=t,this.tag=e,this.attrs=null,this.map=null,this.nesting=r,this.level=0,this.children=null,this.content="",this.markup="",this.info="",this.meta=null,this.block=!1,this.hidden=!1}r(62062),r(44114),r(18111),r(61701),r(26099),n.prototype.attrIndex=function(t){var e,r,n;if(!this.attrs)return-1;for(e=this.attrs,r=0,n=e.length;r<n;r++)if(e[r][0]===t)return r;return-1},n.prototype.attrPush=function(t){this.attrs?this.attrs.push(t):this.attrs=[t]},n.prototype.attrSet=function(t,e){var r=this.attrIndex(t),n=[t,e];r<0?this.attrPush(n):this.attrs[r]=n},n.prototype.attrGet=function(t){var e=this.attrIndex(t),r=null;return e>=0&&(r=this.attrs[e][1]),r},n.prototype.attrJoin=function(t,e){var r=this.attrIndex(t);r<0?this.attrPush([t,e]):this.attrs[r][1]=this.attrs[r][1]+" "+e},t.exports=n},32345:(t,e,r)=>{"use strict";e.parseLinkLabel=r(99136),e.parseLinkDestination=r(61010),e.parseLinkTitle=r(3984)},32357:(t,e,r)=>{"use strict";var n=r(43724),i=r(79039),o=r(79504),a=r(42787),s=r(71072),u=r(25397),c=r(48773).f,l=o(c),f=o([].push),p=n&&i((function(){var t=Object.create(null);return t[2]=2,!l(t,2)})),h=function(t){return function(e){var r,i=u(e),o=s(i),c=p&&null===a(i),h=o.length,d=0,v=[];while(h>d)r=o[d++],n&&!(c?r in i:l(i,r))||f(v,t?[r,i[r]]:i[r]);return v}};t.exports={entries:h(!0),values:h(!1)}},32475:(t,e,r)=>{"use strict";var n=r(46518),i=r(28527),o=r(84916),a=!o("isSupersetOf",(function(t){return!t}));n({target:"Set",proto:!0,real:!0,forced:a},{isSupersetOf:i})},32489:t=>{"use strict";t.exports=["address","article","aside","base","basefont","blockquote","body","caption","center","col","colgroup","dd","details","dialog","dir","div","dl","dt","fieldset","figcaption","figure","footer","form","frame","frameset","h1","h2","h3","h4","h5","h6","head","header","hr","html","iframe","legend","li","link","main","menu","menuitem","nav","noframes","ol","optgroup","option","p","param","section","source","summary","table","tbody","td","tfoot","th","thead","title","tr","track","ul"]},32603:(t,e,r)=>{"use strict";var n=r(655);t.exports=function(t,e){return void 0===t?arguments.length<2?"":e:n(t)}},32637:(t,e,r)=>{"use strict";var n=r(46518),i=r(2087);n({target:"Number",stat:!0},{isInteger:i})},32812:(t,e,r)=>{"use strict";var n=r(46518);n({target:"Reflect",stat:!0},{has:function(t,e){return e in t}})},32845:(t,e,r)=>{"use strict";r(44114),r(34782),r(27495),r(90906),r(71761),r(25440);var n=r(8700).arrayReplaceAt;function i(t){return/^<a[>\s]/i.test(t)}function o(t){return/^<\/a\s*>/i.test(t)}t.exports=function(t){var e,r,a,s,u,c,l,f,p,h,d,v,g,m,y,b,w,_=t.tokens;if(t.md.options.linkify)for(r=0,a=_.length;r<a;r++)if("inline"===_[r].type&&t.md.linkify.pretest(_[r].content))for(s=_[r].children,g=0,e=s.length-1;e>=0;e--)if(c=s[e],"link_close"!==c.type){if("html_inline"===c.type&&(i(c.content)&&g>0&&g--,o(c.content)&&g++),!(g>0)&&"text"===c.type&&t.md.linkify.test(c.content)){for(p=c.content,w=t.md.linkify.match(p),l=[],v=c.level,d=0,f=0;f<w.length;f++)m=w[f].url,y=t.md.normalizeLink(m),t.md.validateLink(y)&&(b=w[f].text,b=w[f].schema?"mailto:"!==w[f].schema||/^mailto:/i.test(b)?t.md.normalizeLinkText(b):t.md.normalizeLinkText("mailto:"+b).replace(/^mailto:/,""):t.md.normalizeLinkText("http://"+b).replace(/^http:\/\//,""),h=w[f].index,h>d&&(u=new t.Token("text","",0),u.content=p.slice(d,h),u.level=v,l.push(u)),u=new t.Token("link_open","a",1),u.attrs=[["href",y]],u.level=v++,u.markup="linkify",u.info="auto",l.push(u),u=new t.Token("text","",0),u.content=b,u.level=v,l.push(u),u=new t.Token("link_close","a",-1),u.level=--v,u.markup="linkify",u.info="auto",l.push(u),d=w[f].lastIndex);d<p.length&&(u=new t.Token("text","",0),u.content=p.slice(d),u.level=v,l.push(u)),_[r].children=s=n(s,e,l)}}else{e--;while(s[e].level!==c.level&&"link_open"!==s[e].type)e--}}},33051:(t,e,r)=>{"use strict";r.d(e,{A:()=>o});var n=r(54119);r(45700),r(16280),r(76918),r(89572),r(2892);function i(t,e){if("object"!=(0,n.A)(t)||!t)return t;var r=t[Symbol.toPrimitive];if(void 0!==r){var i=r.call(t,e||"default");if("object"!=(0,n.A)(i))return i;throw new TypeError("@@toPrimitive must return a primitive value.")}return("string"===e?String:Number)(t)}function o(t){var e=i(t,"string");return"symbol"==(0,n.A)(e)?e:e+""}},33110:(t,e,r)=>{"use strict";var n=r(46518),i=r(97751),o=r(18745),a=r(69565),s=r(79504),u=r(79039),c=r(94901),l=r(10757),f=r(67680),p=r(66933),h=r(4495),d=String,v=i("JSON","stringify"),g=s(/./.exec),m=s("".charAt),y=s("".charCodeAt),b=s("".replace),w=s(1..toString),_=/[\uD800-\uDFFF]/g,x=/^[\uD800-\uDBFF]$/,A=/^[\uDC00-\uDFFF]$/,k=!h||u((function(){var t=i("Symbol")("stringify detection");return"[null]"!==v([t])||"{}"!==v({a:t})||"{}"!==v(Object(t))})),E=u((function(){return'"\\udf06\\ud834"'!==v("\udf06\ud834")||'"\\udead"'!==v("\udead")})),S=function(t,e){var r=f(arguments),n=p(e);if(c(n)||void 0!==t&&!l(t))return r[1]=function(t,e){if(c(n)&&(e=a(n,this,d(t),e)),!l(e))return e},o(v,null,r)},C=function(t,e,r){var n=m(r,e-1),i=m(r,e+1);return g(x,t)&&!g(A,i)||g(A,t)&&!g(x,n)?"\\u"+w(y(t,0),16):t};v&&n({target:"JSON",stat:!0,arity:3,forced:k||E},{stringify:function(t,e,r){var n=f(arguments),i=o(k?S:v,null,n);return E&&"string"==typeof i?b(i,_,C):i}})},33164:(t,e,r)=>{"use strict";var n=r(77782),i=r(53602),o=Math.abs,a=2220446049250313e-31;t.exports=function(t,e,r,s){var u=+t,c=o(u),l=n(u);if(c<s)return l*i(c/s/e)*s*e;var f=(1+e/a)*c,p=f-(f-c);return p>r||p!==p?l*(1/0):l*p}},33206:(t,e,r)=>{"use strict";var n=r(94644),i=r(59213).forEach,o=n.aTypedArray,a=n.exportTypedArrayMethod;a("forEach",(function(t){i(o(this),t,arguments.length>1?arguments[1]:void 0)}))},33392:(t,e,r)=>{"use strict";var n=r(79504),i=0,o=Math.random(),a=n(1..toString);t.exports=function(t){return"Symbol("+(void 0===t?"":t)+")_"+a(++i+o,36)}},33517:(t,e,r)=>{"use strict";var n=r(79504),i=r(79039),o=r(94901),a=r(36955),s=r(97751),u=r(33706),c=function(){},l=s("Reflect","construct"),f=/^\s*(?:class|function)\b/,p=n(f.exec),h=!f.test(c),d=function(t){if(!o(t))return!1;try{return l(c,[],t),!0}catch(e){return!1}},v=function(t){if(!o(t))return!1;switch(a(t)){case"AsyncFunction":case"GeneratorFunction":case"AsyncGeneratorFunction":return!1}try{return h||!!p(f,u(t))}catch(e){return!0}};v.sham=!0,t.exports=!l||i((function(){var t;return d(d.call)||!d(Object)||!d((function(){t=!0}))||t}))?v:d},33684:(t,e,r)=>{"use strict";var n=r(94644).exportTypedArrayMethod,i=r(79039),o=r(22195),a=r(79504),s=o.Uint8Array,u=s&&s.prototype||{},c=[].toString,l=a([].join);i((function(){c.call({})}))&&(c=function(){return l(this)});var f=u.toString!==c;n("toString",c,f)},33706:(t,e,r)=>{"use strict";var n=r(79504),i=r(94901),o=r(77629),a=n(Function.toString);i(o.inspectSource)||(o.inspectSource=function(t){return a(t)}),t.exports=o.inspectSource},33717:(t,e)=>{"use strict";e.f=Object.getOwnPropertySymbols},33771:(t,e,r)=>{"use strict";var n=r(46518),i=r(84373),o=r(6469);n({target:"Array",proto:!0},{fill:i}),o("fill")},33853:(t,e,r)=>{"use strict";var n=r(46518),i=r(64449),o=r(84916),a=!o("isDisjointFrom",(function(t){return!t}));n({target:"Set",proto:!0,real:!0,forced:a},{isDisjointFrom:i})},33904:(t,e,r)=>{"use strict";var n=r(22195),i=r(79039),o=r(79504),a=r(655),s=r(43802).trim,u=r(47452),c=o("".charAt),l=n.parseFloat,f=n.Symbol,p=f&&f.iterator,h=1/l(u+"-0")!==-1/0||p&&!i((function(){l(Object(p))}));t.exports=h?function(t){var e=s(a(t)),r=l(e);return 0===r&&"-"===c(e,0)?-0:r}:l},33994:(t,e,r)=>{"use strict";var n=r(57657).IteratorPrototype,i=r(2360),o=r(6980),a=r(10687),s=r(26269),u=function(){return this};t.exports=function(t,e,r,c){var l=e+" Iterator";return t.prototype=i(n,{next:o(+!c,r)}),a(t,l,!1,!0),s[l]=u,t}},34124:(t,e,r)=>{"use strict";var n=r(79039),i=r(20034),o=r(44576),a=r(15652),s=Object.isExtensible,u=n((function(){s(1)}));t.exports=u||a?function(t){return!!i(t)&&((!a||"ArrayBuffer"!==o(t))&&(!s||s(t)))}:s},34268:(t,e,r)=>{"use strict";var n=r(46518),i=r(69565),o=r(28551),a=r(20034),s=r(16575),u=r(79039),c=r(24913),l=r(77347),f=r(42787),p=r(6980);function h(t,e,r){var n,u,d,v=arguments.length<4?t:arguments[3],g=l.f(o(t),e);if(!g){if(a(u=f(t)))return h(u,e,r,v);g=p(0)}if(s(g)){if(!1===g.writable||!a(v))return!1;if(n=l.f(v,e)){if(n.get||n.set||!1===n.writable)return!1;n.value=r,c.f(v,e,n)}else c.f(v,e,p(0,r))}else{if(d=g.set,void 0===d)return!1;i(d,v,r)}return!0}var d=u((function(){var t=function(){},e=c.f(new t,"a",{configurable:!0});return!1!==Reflect.set(t.prototype,"a",1,e)}));n({target:"Reflect",stat:!0,forced:d},{set:h})},34311:(t,e,r)=>{function n(t,e){var r=null==t?null:"undefined"!=typeof Symbol&&t[Symbol.iterator]||t["@@iterator"];if(null!=r){var n,i,o,a,s=[],u=!0,c=!1;try{if(o=(r=r.call(t)).next,0===e){if(Object(r)!==r)return;u=!1}else for(;!(u=(n=o.call(r)).done)&&(s.push(n.value),s.length!==e);u=!0);}catch(t){c=!0,i=t}finally{try{if(!u&&null!=r["return"]&&(a=r["return"](),Object(a)!==a))return}finally{if(c)throw i}}return s}}r(52675),r(89463),r(2259),r(44114),r(26099),r(47764),r(62953),t.exports=n,t.exports.__esModule=!0,t.exports["default"]=t.exports},34336:(t,e,r)=>{"use strict";r(44114),r(34782);var n=r(8700).normalizeReference,i=r(8700).isSpace;t.exports=function(t,e){var r,o,a,s,u,c,l,f,p,h,d,v,g,m="",y=t.pos,b=t.posMax;if(33!==t.src.charCodeAt(t.pos))return!1;if(91!==t.src.charCodeAt(t.pos+1))return!1;if(c=t.pos+2,u=t.md.helpers.parseLinkLabel(t,t.pos+1,!1),u<0)return!1;if(l=u+1,l<b&&40===t.src.charCodeAt(l)){for(l++;l<b;l++)if(o=t.src.charCodeAt(l),!i(o)&&10!==o)break;if(l>=b)return!1;for(g=l,p=t.md.helpers.parseLinkDestination(t.src,l,t.posMax),p.ok&&(m=t.md.normalizeLink(p.str),t.md.validateLink(m)?l=p.pos:m=""),g=l;l<b;l++)if(o=t.src.charCodeAt(l),!i(o)&&10!==o)break;if(p=t.md.helpers.parseLinkTitle(t.src,l,t.posMax),l<b&&g!==l&&p.ok){for(h=p.str,l=p.pos;l<b;l++)if(o=t.src.charCodeAt(l),!i(o)&&10!==o)break}else h="";if(l>=b||41!==t.src.charCodeAt(l))return t.pos=y,!1;l++}else{if("undefined"===typeof t.env.references)return!1;if(l<b&&91===t.src.charCodeAt(l)?(g=l+1,l=t.md.helpers.parseLinkLabel(t,l),l>=0?s=t.src.slice(g,l++):l=u+1):l=u+1,s||(s=t.src.slice(c,u)),f=t.env.references[n(s)],!f)return t.pos=y,!1;m=f.href,h=f.title}return e||(a=t.src.slice(c,u),t.md.inline.parse(a,t.md,t.env,v=[]),d=t.push("image","img",0),d.attrs=r=[["src",m],["alt",""]],d.children=v,d.content=a,h&&r.push(["title",h])),t.pos=l,t.posMax=b,!0}},34376:(t,e,r)=>{"use strict";var n=r(44576);t.exports=Array.isArray||function(t){return"Array"===n(t)}},34527:(t,e,r)=>{"use strict";var n=r(43724),i=r(34376),o=TypeError,a=Object.getOwnPropertyDescriptor,s=n&&!function(){if(void 0!==this)return!0;try{Object.defineProperty([],"length",{writable:!1}).length=1}catch(t){return t instanceof TypeError}}();t.exports=s?function(t,e){if(i(t)&&!a(t,"length").writable)throw new o("Cannot set read only .length");return t.length=e}:function(t,e){return t.length=e}},34598:(t,e,r)=>{"use strict";var n=r(79039);t.exports=function(t,e){var r=[][t];return!!r&&n((function(){r.call(null,e||function(){return 1},1)}))}},34782:(t,e,r)=>{"use strict";var n=r(46518),i=r(34376),o=r(33517),a=r(20034),s=r(35610),u=r(26198),c=r(25397),l=r(97040),f=r(78227),p=r(70597),h=r(67680),d=p("slice"),v=f("species"),g=Array,m=Math.max;n({target:"Array",proto:!0,forced:!d},{slice:function(t,e){var r,n,f,p=c(this),d=u(p),y=s(t,d),b=s(void 0===e?d:e,d);if(i(p)&&(r=p.constructor,o(r)&&(r===g||i(r.prototype))?r=void 0:a(r)&&(r=r[v],null===r&&(r=void 0)),r===g||void 0===r))return h(p,y,b);for(n=new(void 0===r?g:r)(m(b-y,0)),f=0;y<b;y++,f++)y in p&&l(n,f,p[y]);return n.length=f,n}})},35002:(t,e,r)=>{"use strict";r(48598),r(62062),r(44114),r(34782),r(18111),r(61701),r(26099),r(84864),r(57465),r(27495),r(87745),r(90906),r(38781);var n=r(32489),i=r(81890).p,o=[[/^<(script|pre|style|textarea)(?=(\s|>|$))/i,/<\/(script|pre|style|textarea)>/i,!0],[/^<!--/,/-->/,!0],[/^<\?/,/\?>/,!0],[/^<![A-Z]/,/>/,!0],[/^<!\[CDATA\[/,/\]\]>/,!0],[new RegExp("^</?("+n.join("|")+")(?=(\\s|/?>|$))","i"),/^$/,!0],[new RegExp(i.source+"\\s*$"),/^$/,!1]];t.exports=function(t,e,r,n){var i,a,s,u,c=t.bMarks[e]+t.tShift[e],l=t.eMarks[e];if(t.sCount[e]-t.blkIndent>=4)return!1;if(!t.md.options.html)return!1;if(60!==t.src.charCodeAt(c))return!1;for(u=t.src.slice(c,l),i=0;i<o.length;i++)if(o[i][0].test(u))break;if(i===o.length)return!1;if(n)return o[i][2];if(a=e+1,!o[i][1].test(u))for(;a<r;a++){if(t.sCount[a]<t.blkIndent)break;if(c=t.bMarks[a]+t.tShift[a],l=t.eMarks[a],u=t.src.slice(c,l),o[i][1].test(u)){0!==u.length&&a++;break}}return t.line=a,s=t.push("html_block","",0),s.map=[e,a],s.content=t.getLines(e,a,t.blkIndent,!0),!0}},35031:(t,e,r)=>{"use strict";var n=r(97751),i=r(79504),o=r(38480),a=r(33717),s=r(28551),u=i([].concat);t.exports=n("Reflect","ownKeys")||function(t){var e=o.f(s(t)),r=a.f;return r?u(e,r(t)):e}},35370:(t,e,r)=>{"use strict";var n=r(26198);t.exports=function(t,e,r){var i=0,o=arguments.length>2?r:n(e),a=new t(o);while(o>i)a[i]=e[i++];return a}},35548:(t,e,r)=>{"use strict";var n=r(33517),i=r(16823),o=TypeError;t.exports=function(t){if(n(t))return t;throw new o(i(t)+" is not a constructor")}},35610:(t,e,r)=>{"use strict";var n=r(91291),i=Math.max,o=Math.min;t.exports=function(t,e){var r=n(t);return r<0?i(r+e,0):o(r,e)}},35917:(t,e,r)=>{"use strict";var n=r(43724),i=r(79039),o=r(4055);t.exports=!n&&!i((function(){return 7!==Object.defineProperty(o("div"),"a",{get:function(){return 7}}).a}))},36033:(t,e,r)=>{"use strict";r(48523)},36043:(t,e,r)=>{"use strict";var n=r(79306),i=TypeError,o=function(t){var e,r;this.promise=new t((function(t,n){if(void 0!==e||void 0!==r)throw new i("Bad Promise constructor");e=t,r=n})),this.resolve=n(e),this.reject=n(r)};t.exports.f=function(t){return new o(t)}},36072:(t,e,r)=>{"use strict";var n=r(94644),i=r(80926).right,o=n.aTypedArray,a=n.exportTypedArrayMethod;a("reduceRight",(function(t){var e=arguments.length;return i(o(this),t,e,e>1?arguments[1]:void 0)}))},36840:(t,e,r)=>{"use strict";var n=r(94901),i=r(24913),o=r(50283),a=r(39433);t.exports=function(t,e,r,s){s||(s={});var u=s.enumerable,c=void 0!==s.name?s.name:e;if(n(r)&&o(r,c,s),s.global)u?t[e]=r:a(e,r);else{try{s.unsafe?t[e]&&(u=!0):delete t[e]}catch(l){}u?t[e]=r:i.f(t,e,{value:r,enumerable:!1,configurable:!s.nonConfigurable,writable:!s.nonWritable})}return t}},36955:(t,e,r)=>{"use strict";var n=r(92140),i=r(94901),o=r(44576),a=r(78227),s=a("toStringTag"),u=Object,c="Arguments"===o(function(){return arguments}()),l=function(t,e){try{return t[e]}catch(r){}};t.exports=n?o:function(t){var e,r,n;return void 0===t?"Undefined":null===t?"Null":"string"==typeof(r=l(e=u(t),s))?r:c?o(e):"Object"===(n=o(e))&&i(e.callee)?"Arguments":n}},37467:(t,e,r)=>{"use strict";var n=r(37628),i=r(94644),o=i.aTypedArray,a=i.exportTypedArrayMethod,s=i.getTypedArrayConstructor;a("toReversed",(function(){return n(o(this),s(this))}))},37628:(t,e,r)=>{"use strict";var n=r(26198);t.exports=function(t,e){for(var r=n(t),i=new e(r),o=0;o<r;o++)i[o]=t[r-o-1];return i}},37682:(t,e,r)=>{"use strict";r(62062),r(44114),r(18111),r(61701),r(26099),t.exports=function(t){var e;t.inlineMode?(e=new t.Token("inline","",0),e.content=t.src,e.map=[0,1],e.children=[],t.tokens.push(e)):t.md.block.parse(t.src,t.md,t.env,t.tokens)}},37770:(t,e,r)=>{"use strict";r(44114),r(34782);var n=r(43011),i=[["table",r(4673),["paragraph","reference"]],["code",r(85220)],["fence",r(61504),["paragraph","reference","blockquote","list"]],["blockquote",r(73322),["paragraph","reference","blockquote","list"]],["hr",r(54475),["paragraph","reference","blockquote","list"]],["list",r(40953),["paragraph","reference","blockquote"]],["reference",r(65676)],["html_block",r(35002),["paragraph","reference","blockquote"]],["heading",r(747),["paragraph","reference","blockquote"]],["lheading",r(80259)],["paragraph",r(45439)]];function o(){this.ruler=new n;for(var t=0;t<i.length;t++)this.ruler.push(i[t][0],i[t][1],{alt:(i[t][2]||[]).slice()})}o.prototype.tokenize=function(t,e,r){var n,i,o=this.ruler.getRules(""),a=o.length,s=e,u=!1,c=t.md.options.maxNesting;while(s<r){if(t.line=s=t.skipEmptyLines(s),s>=r)break;if(t.sCount[s]<t.blkIndent)break;if(t.level>=c){t.line=r;break}for(i=0;i<a;i++)if(n=o[i](t,s,r,!1),n)break;t.tight=!u,t.isEmpty(t.line-1)&&(u=!0),s=t.line,s<r&&t.isEmpty(s)&&(u=!0,s++,t.line=s)}},o.prototype.parse=function(t,e,r,n){var i;t&&(i=new this.State(t,e,r,n),this.tokenize(i,i.line,i.lineMax))},o.prototype.State=r(88090),t.exports=o},38309:(t,e,r)=>{"use strict";r(24359)},38469:(t,e,r)=>{"use strict";var n=r(79504),i=r(40507),o=r(94402),a=o.Set,s=o.proto,u=n(s.forEach),c=n(s.keys),l=c(new a).next;t.exports=function(t,e,r){return r?i({iterator:c(t),next:l},e):u(t,e)}},38480:(t,e,r)=>{"use strict";var n=r(61828),i=r(88727),o=i.concat("length","prototype");e.f=Object.getOwnPropertyNames||function(t){return n(t,o)}},38574:(t,e,r)=>{"use strict";var n=r(79504),i=Error,o=n("".replace),a=function(t){return String(new i(t).stack)}("zxcasd"),s=/\n\s*at [^:]*:[^\n]*/,u=s.test(a);t.exports=function(t,e){if(u&&"string"==typeof t&&!i.prepareStackTrace)while(e--)t=o(t,s,"");return t}},38781:(t,e,r)=>{"use strict";var n=r(10350).PROPER,i=r(36840),o=r(28551),a=r(655),s=r(79039),u=r(61034),c="toString",l=RegExp.prototype,f=l[c],p=s((function(){return"/a/b"!==f.call({source:"a",flags:"b"})})),h=n&&f.name!==c;(p||h)&&i(l,c,(function(){var t=o(this),e=a(t.source),r=a(u(t));return"/"+e+"/"+r}),{unsafe:!0})},38950:(t,e,r)=>{"use strict";function n(t,e){var r,n,i,o,a,s=[],u=e.length;for(r=0;r<u;r++)i=e[r],126===i.marker&&-1!==i.end&&(o=e[i.end],a=t.tokens[i.token],a.type="s_open",a.tag="s",a.nesting=1,a.markup="~~",a.content="",a=t.tokens[o.token],a.type="s_close",a.tag="s",a.nesting=-1,a.markup="~~",a.content="","text"===t.tokens[o.token-1].type&&"~"===t.tokens[o.token-1].content&&s.push(o.token-1));while(s.length){r=s.pop(),n=r+1;while(n<t.tokens.length&&"s_close"===t.tokens[n].type)n++;n--,r!==n&&(a=t.tokens[n],t.tokens[n]=t.tokens[r],t.tokens[r]=a)}}r(44114),t.exports.q=function(t,e){var r,n,i,o,a,s=t.pos,u=t.src.charCodeAt(s);if(e)return!1;if(126!==u)return!1;if(n=t.scanDelims(t.pos,!0),o=n.length,a=String.fromCharCode(u),o<2)return!1;for(o%2&&(i=t.push("text","",0),i.content=a,o--),r=0;r<o;r+=2)i=t.push("text","",0),i.content=a+a,t.delimiters.push({marker:u,length:0,token:t.tokens.length-1,end:-1,open:n.can_open,close:n.can_close});return t.pos+=n.length,!0},t.exports.g=function(t){var e,r=t.tokens_meta,i=t.tokens_meta.length;for(n(t,t.delimiters),e=0;e<i;e++)r[e]&&r[e].delimiters&&n(t,r[e].delimiters)}},39297:(t,e,r)=>{"use strict";var n=r(79504),i=r(48981),o=n({}.hasOwnProperty);t.exports=Object.hasOwn||function(t,e){return o(i(t),e)}},39433:(t,e,r)=>{"use strict";var n=r(22195),i=Object.defineProperty;t.exports=function(t,e){try{i(n,t,{value:e,configurable:!0,writable:!0})}catch(r){n[t]=e}return e}},39519:(t,e,r)=>{"use strict";var n,i,o=r(22195),a=r(82839),s=o.process,u=o.Deno,c=s&&s.versions||u&&u.version,l=c&&c.v8;l&&(n=l.split("."),i=n[0]>0&&n[0]<4?1:+(n[0]+n[1])),!i&&a&&(n=a.match(/Edge\/(\d+)/),(!n||n[1]>=74)&&(n=a.match(/Chrome\/(\d+)/),n&&(i=+n[1]))),t.exports=i},39928:(t,e,r)=>{"use strict";var n=r(26198),i=r(91291),o=RangeError;t.exports=function(t,e,r,a){var s=n(t),u=i(r),c=u<0?s+u:u;if(c>=s||c<0)throw new o("Incorrect index");for(var l=new e(s),f=0;f<s;f++)l[f]=f===c?a:t[f];return l}},40280:(t,e,r)=>{"use strict";var n=r(46518),i=r(97751),o=r(96395),a=r(80550),s=r(10916).CONSTRUCTOR,u=r(93438),c=i("Promise"),l=o&&!s;n({target:"Promise",stat:!0,forced:o||s},{resolve:function(t){return u(l&&this===c?a:this,t)}})},40507:(t,e,r)=>{"use strict";var n=r(69565);t.exports=function(t,e,r){var i,o,a=r?t:t.iterator,s=t.next;while(!(i=n(s,a)).done)if(o=e(i.value),void 0!==o)return o}},40616:(t,e,r)=>{"use strict";var n=r(79039);t.exports=!n((function(){var t=function(){}.bind();return"function"!=typeof t||t.hasOwnProperty("prototype")}))},40875:(t,e,r)=>{"use strict";var n=r(46518),i=r(79039),o=r(48981),a=r(42787),s=r(12211),u=i((function(){a(1)}));n({target:"Object",stat:!0,forced:u,sham:!s},{getPrototypeOf:function(t){return a(o(t))}})},40888:(t,e,r)=>{"use strict";var n=r(46518),i=r(69565),o=r(20034),a=r(28551),s=r(16575),u=r(77347),c=r(42787);function l(t,e){var r,n,f=arguments.length<3?t:arguments[2];return a(t)===f?t[e]:(r=u.f(t,e),r?s(r)?r.value:void 0===r.get?void 0:i(r.get,f):o(n=c(t))?l(n,e,f):void 0)}n({target:"Reflect",stat:!0},{get:l})},40953:(t,e,r)=>{"use strict";r(62062),r(44114),r(34782),r(18111),r(61701),r(2892),r(26099);var n=r(8700).isSpace;function i(t,e){var r,i,o,a;return i=t.bMarks[e]+t.tShift[e],o=t.eMarks[e],r=t.src.charCodeAt(i++),42!==r&&45!==r&&43!==r||i<o&&(a=t.src.charCodeAt(i),!n(a))?-1:i}function o(t,e){var r,i=t.bMarks[e]+t.tShift[e],o=i,a=t.eMarks[e];if(o+1>=a)return-1;if(r=t.src.charCodeAt(o++),r<48||r>57)return-1;for(;;){if(o>=a)return-1;if(r=t.src.charCodeAt(o++),!(r>=48&&r<=57)){if(41===r||46===r)break;return-1}if(o-i>=10)return-1}return o<a&&(r=t.src.charCodeAt(o),!n(r))?-1:o}function a(t,e){var r,n,i=t.level+2;for(r=e+2,n=t.tokens.length-2;r<n;r++)t.tokens[r].level===i&&"paragraph_open"===t.tokens[r].type&&(t.tokens[r+2].hidden=!0,t.tokens[r].hidden=!0,r+=2)}t.exports=function(t,e,r,n){var s,u,c,l,f,p,h,d,v,g,m,y,b,w,_,x,A,k,E,S,C,O,T,R,D,I,L,P,j=!1,F=!0;if(t.sCount[e]-t.blkIndent>=4)return!1;if(t.listIndent>=0&&t.sCount[e]-t.listIndent>=4&&t.sCount[e]<t.blkIndent)return!1;if(n&&"paragraph"===t.parentType&&t.sCount[e]>=t.blkIndent&&(j=!0),(T=o(t,e))>=0){if(h=!0,D=t.bMarks[e]+t.tShift[e],b=Number(t.src.slice(D,T-1)),j&&1!==b)return!1}else{if(!((T=i(t,e))>=0))return!1;h=!1}if(j&&t.skipSpaces(T)>=t.eMarks[e])return!1;if(y=t.src.charCodeAt(T-1),n)return!0;m=t.tokens.length,h?(P=t.push("ordered_list_open","ol",1),1!==b&&(P.attrs=[["start",b]])):P=t.push("bullet_list_open","ul",1),P.map=g=[e,0],P.markup=String.fromCharCode(y),_=e,R=!1,L=t.md.block.ruler.getRules("list"),k=t.parentType,t.parentType="list";while(_<r){O=T,w=t.eMarks[_],p=x=t.sCount[_]+T-(t.bMarks[e]+t.tShift[e]);while(O<w){if(s=t.src.charCodeAt(O),9===s)x+=4-(x+t.bsCount[_])%4;else{if(32!==s)break;x++}O++}if(u=O,f=u>=w?1:x-p,f>4&&(f=1),l=p+f,P=t.push("list_item_open","li",1),P.markup=String.fromCharCode(y),P.map=d=[e,0],h&&(P.info=t.src.slice(D,T-1)),C=t.tight,S=t.tShift[e],E=t.sCount[e],A=t.listIndent,t.listIndent=t.blkIndent,t.blkIndent=l,t.tight=!0,t.tShift[e]=u-t.bMarks[e],t.sCount[e]=x,u>=w&&t.isEmpty(e+1)?t.line=Math.min(t.line+2,r):t.md.block.tokenize(t,e,r,!0),t.tight&&!R||(F=!1),R=t.line-e>1&&t.isEmpty(t.line-1),t.blkIndent=t.listIndent,t.listIndent=A,t.tShift[e]=S,t.sCount[e]=E,t.tight=C,P=t.push("list_item_close","li",-1),P.markup=String.fromCharCode(y),_=e=t.line,d[1]=_,u=t.bMarks[e],_>=r)break;if(t.sCount[_]<t.blkIndent)break;if(t.sCount[e]-t.blkIndent>=4)break;for(I=!1,c=0,v=L.length;c<v;c++)if(L[c](t,_,r,!0)){I=!0;break}if(I)break;if(h){if(T=o(t,_),T<0)break;D=t.bMarks[_]+t.tShift[_]}else if(T=i(t,_),T<0)break;if(y!==t.src.charCodeAt(T-1))break}return P=h?t.push("ordered_list_close","ol",-1):t.push("bullet_list_close","ul",-1),P.markup=String.fromCharCode(y),g[1]=_,t.line=_,t.parentType=k,F&&a(t,m),!0}},41354:(t,e,r)=>{"use strict";r.r(e),r.d(e,{decode:()=>T,default:()=>P,encode:()=>R,toASCII:()=>I,toUnicode:()=>D,ucs2decode:()=>k,ucs2encode:()=>E});var n=r(3604),i=r(74721),o=(r(16280),r(76918),r(48598),r(8921),r(44114),r(34782),r(54554),r(27495),r(90906),r(27337),r(25440),2147483647),a=36,s=1,u=26,c=38,l=700,f=72,p=128,h="-",d=/^xn--/,v=/[^\0-\x7F]/,g=/[\x2E\u3002\uFF0E\uFF61]/g,m={overflow:"Overflow: input needs wider integers to process","not-basic":"Illegal input >= 0x80 (not a basic code point)","invalid-input":"Invalid input"},y=a-s,b=Math.floor,w=String.fromCharCode;function _(t){throw new RangeError(m[t])}function x(t,e){var r=[],n=t.length;while(n--)r[n]=e(t[n]);return r}function A(t,e){var r=t.split("@"),n="";r.length>1&&(n=r[0]+"@",t=r[1]),t=t.replace(g,".");var i=t.split("."),o=x(i,e).join(".");return n+o}function k(t){var e=[],r=0,n=t.length;while(r<n){var i=t.charCodeAt(r++);if(i>=55296&&i<=56319&&r<n){var o=t.charCodeAt(r++);56320==(64512&o)?e.push(((1023&i)<<10)+(1023&o)+65536):(e.push(i),r--)}else e.push(i)}return e}var E=function(t){return String.fromCodePoint.apply(String,(0,i.A)(t))},S=function(t){return t>=48&&t<58?t-48+26:t>=65&&t<91?t-65:t>=97&&t<123?t-97:a},C=function(t,e){return t+22+75*(t<26)-((0!=e)<<5)},O=function(t,e,r){var n=0;for(t=r?b(t/l):t>>1,t+=b(t/e);t>y*u>>1;n+=a)t=b(t/y);return b(n+(y+1)*t/(t+c))},T=function(t){var e=[],r=t.length,n=0,i=p,c=f,l=t.lastIndexOf(h);l<0&&(l=0);for(var d=0;d<l;++d)t.charCodeAt(d)>=128&&_("not-basic"),e.push(t.charCodeAt(d));for(var v=l>0?l+1:0;v<r;){for(var g=n,m=1,y=a;;y+=a){v>=r&&_("invalid-input");var w=S(t.charCodeAt(v++));w>=a&&_("invalid-input"),w>b((o-n)/m)&&_("overflow"),n+=w*m;var x=y<=c?s:y>=c+u?u:y-c;if(w<x)break;var A=a-x;m>b(o/A)&&_("overflow"),m*=A}var k=e.length+1;c=O(n-g,k,0==g),b(n/k)>o-i&&_("overflow"),i+=b(n/k),n%=k,e.splice(n++,0,i)}return String.fromCodePoint.apply(String,e)},R=function(t){var e=[];t=k(t);var r,i=t.length,c=p,l=0,d=f,v=(0,n.A)(t);try{for(v.s();!(r=v.n()).done;){var g=r.value;g<128&&e.push(w(g))}}catch(N){v.e(N)}finally{v.f()}var m=e.length,y=m;m&&e.push(h);while(y<i){var x,A=o,E=(0,n.A)(t);try{for(E.s();!(x=E.n()).done;){var S=x.value;S>=c&&S<A&&(A=S)}}catch(N){E.e(N)}finally{E.f()}var T=y+1;A-c>b((o-l)/T)&&_("overflow"),l+=(A-c)*T,c=A;var R,D=(0,n.A)(t);try{for(D.s();!(R=D.n()).done;){var I=R.value;if(I<c&&++l>o&&_("overflow"),I===c){for(var L=l,P=a;;P+=a){var j=P<=d?s:P>=d+u?u:P-d;if(L<j)break;var F=L-j,M=a-j;e.push(w(C(j+F%M,0))),L=b(F/M)}e.push(w(C(L,0))),d=O(l,T,y===m),l=0,++y}}}catch(N){D.e(N)}finally{D.f()}++l,++c}return e.join("")},D=function(t){return A(t,(function(t){return d.test(t)?T(t.slice(4).toLowerCase()):t}))},I=function(t){return A(t,(function(t){return v.test(t)?"xn--"+R(t):t}))},L={version:"2.3.1",ucs2:{decode:k,encode:E},decode:T,encode:R,toASCII:I,toUnicode:D};const P=L},41405:(t,e,r)=>{"use strict";var n=r(22195),i=r(18745),o=r(94644),a=r(79039),s=r(67680),u=n.Int8Array,c=o.aTypedArray,l=o.exportTypedArrayMethod,f=[].toLocaleString,p=!!u&&a((function(){f.call(new u(1))})),h=a((function(){return[1,2].toLocaleString()!==new u([1,2]).toLocaleString()}))||!a((function(){u.prototype.toLocaleString.call([1,2])}));l("toLocaleString",(function(){return i(f,p?s(c(this)):c(this),s(arguments))}),h)},41436:(t,e,r)=>{"use strict";var n=r(78227),i=n("match");t.exports=function(t){var e=/./;try{"/./"[t](e)}catch(r){try{return e[i]=!1,"/./"[t](e)}catch(n){}}return!1}},42207:(t,e,r)=>{"use strict";var n=r(46518),i=r(22195),o=r(97751),a=r(79504),s=r(69565),u=r(79039),c=r(655),l=r(22812),f=r(92804).i2c,p=o("btoa"),h=a("".charAt),d=a("".charCodeAt),v=!!p&&!u((function(){return"aGk="!==p("hi")})),g=v&&!u((function(){p()})),m=v&&u((function(){return"bnVsbA=="!==p(null)})),y=v&&1!==p.length;n({global:!0,bind:!0,enumerable:!0,forced:!v||g||m||y},{btoa:function(t){if(l(arguments.length,1),v)return s(p,i,c(t));var e,r,n=c(t),a="",u=0,g=f;while(h(n,u)||(g="=",u%1)){if(r=d(n,u+=3/4),r>255)throw new(o("DOMException"))("The string contains characters outside of the Latin1 range","InvalidCharacterError");e=e<<8|r,a+=h(g,63&e>>8-u%1*8)}return a}})},42469:t=>{"use strict";t.exports={options:{html:!1,xhtmlOut:!1,breaks:!1,langPrefix:"language-",linkify:!1,typographer:!1,quotes:"“”‘’",highlight:null,maxNesting:100},components:{core:{},block:{},inline:{}}}},42762:(t,e,r)=>{"use strict";var n=r(46518),i=r(43802).trim,o=r(60706);n({target:"String",proto:!0,forced:o("trim")},{trim:function(){return i(this)}})},42781:(t,e,r)=>{"use strict";var n=r(46518),i=r(72333);n({target:"String",proto:!0},{repeat:i})},42787:(t,e,r)=>{"use strict";var n=r(39297),i=r(94901),o=r(48981),a=r(66119),s=r(12211),u=a("IE_PROTO"),c=Object,l=c.prototype;t.exports=s?c.getPrototypeOf:function(t){var e=o(t);if(n(e,u))return e[u];var r=e.constructor;return i(r)&&e instanceof r?r.prototype:e instanceof c?l:null}},43011:(t,e,r)=>{"use strict";function n(){this.__rules__=[],this.__cache__=null}r(16280),r(76918),r(18107),r(51629),r(25276),r(64346),r(44114),r(54554),r(62010),r(18111),r(7588),r(26099),r(67357),r(23500),n.prototype.__find__=function(t){for(var e=0;e<this.__rules__.length;e++)if(this.__rules__[e].name===t)return e;return-1},n.prototype.__compile__=function(){var t=this,e=[""];t.__rules__.forEach((function(t){t.enabled&&t.alt.forEach((function(t){e.indexOf(t)<0&&e.push(t)}))})),t.__cache__={},e.forEach((function(e){t.__cache__[e]=[],t.__rules__.forEach((function(r){r.enabled&&(e&&r.alt.indexOf(e)<0||t.__cache__[e].push(r.fn))}))}))},n.prototype.at=function(t,e,r){var n=this.__find__(t),i=r||{};if(-1===n)throw new Error("Parser rule not found: "+t);this.__rules__[n].fn=e,this.__rules__[n].alt=i.alt||[],this.__cache__=null},n.prototype.before=function(t,e,r,n){var i=this.__find__(t),o=n||{};if(-1===i)throw new Error("Parser rule not found: "+t);this.__rules__.splice(i,0,{name:e,enabled:!0,fn:r,alt:o.alt||[]}),this.__cache__=null},n.prototype.after=function(t,e,r,n){var i=this.__find__(t),o=n||{};if(-1===i)throw new Error("Parser rule not found: "+t);this.__rules__.splice(i+1,0,{name:e,enabled:!0,fn:r,alt:o.alt||[]}),this.__cache__=null},n.prototype.push=function(t,e,r){var n=r||{};this.__rules__.push({name:t,enabled:!0,fn:e,alt:n.alt||[]}),this.__cache__=null},n.prototype.enable=function(t,e){Array.isArray(t)||(t=[t]);var r=[];return t.forEach((function(t){var n=this.__find__(t);if(n<0){if(e)return;throw new Error("Rules manager: invalid rule name "+t)}this.__rules__[n].enabled=!0,r.push(t)}),this),this.__cache__=null,r},n.prototype.enableOnly=function(t,e){Array.isArray(t)||(t=[t]),this.__rules__.forEach((function(t){t.enabled=!1})),this.enable(t,e)},n.prototype.disable=function(t,e){Array.isArray(t)||(t=[t]);var r=[];return t.forEach((function(t){var n=this.__find__(t);if(n<0){if(e)return;throw new Error("Rules manager: invalid rule name "+t)}this.__rules__[n].enabled=!1,r.push(t)}),this),this.__cache__=null,r},n.prototype.getRules=function(t){return null===this.__cache__&&this.__compile__(),this.__cache__[t]||[]},t.exports=n},43251:(t,e,r)=>{"use strict";var n=r(76080),i=r(69565),o=r(35548),a=r(48981),s=r(26198),u=r(70081),c=r(50851),l=r(44209),f=r(18727),p=r(94644).aTypedArrayConstructor,h=r(75854);t.exports=function(t){var e,r,d,v,g,m,y,b,w=o(this),_=a(t),x=arguments.length,A=x>1?arguments[1]:void 0,k=void 0!==A,E=c(_);if(E&&!l(E)){y=u(_,E),b=y.next,_=[];while(!(m=i(b,y)).done)_.push(m.value)}for(k&&x>2&&(A=n(A,arguments[2])),r=s(_),d=new(p(w))(r),v=f(d),e=0;r>e;e++)g=k?A(_[e],e):_[e],d[e]=v?h(g):+g;return d}},43724:(t,e,r)=>{"use strict";var n=r(79039);t.exports=!n((function(){return 7!==Object.defineProperty({},1,{get:function(){return 7}})[1]}))},43802:(t,e,r)=>{"use strict";var n=r(79504),i=r(67750),o=r(655),a=r(47452),s=n("".replace),u=RegExp("^["+a+"]+"),c=RegExp("(^|[^"+a+"])["+a+"]+$"),l=function(t){return function(e){var r=o(i(e));return 1&t&&(r=s(r,u,"")),2&t&&(r=s(r,c,"$1")),r}};t.exports={start:l(1),end:l(2),trim:l(3)}},43839:(t,e,r)=>{"use strict";var n=r(76080),i=r(47055),o=r(48981),a=r(26198),s=function(t){var e=1===t;return function(r,s,u){var c,l,f=o(r),p=i(f),h=a(p),d=n(s,u);while(h-- >0)if(c=p[h],l=d(c,h,f),l)switch(t){case 0:return c;case 1:return h}return e?-1:void 0}};t.exports={findLast:s(0),findLastIndex:s(1)}},44114:(t,e,r)=>{"use strict";var n=r(46518),i=r(48981),o=r(26198),a=r(34527),s=r(96837),u=r(79039),c=u((function(){return 4294967297!==[].push.call({length:4294967296},1)})),l=function(){try{Object.defineProperty([],"length",{writable:!1}).push()}catch(t){return t instanceof TypeError}},f=c||!l();n({target:"Array",proto:!0,arity:1,forced:f},{push:function(t){var e=i(this),r=o(e),n=arguments.length;s(r+n);for(var u=0;u<n;u++)e[r]=arguments[u],r++;return a(e,r),r}})},44124:(t,e,r)=>{"use strict";var n=r(22195);t.exports=function(t,e){var r=n[t],i=r&&r.prototype;return i&&i[e]}},44204:(t,e,r)=>{"use strict";var n=r(97080),i=r(94402).add,o=r(89286),a=r(83789),s=r(40507);t.exports=function(t){var e=n(this),r=a(t).getIterator(),u=o(e);return s(r,(function(t){i(u,t)})),u}},44209:(t,e,r)=>{"use strict";var n=r(78227),i=r(26269),o=n("iterator"),a=Array.prototype;t.exports=function(t){return void 0!==t&&(i.Array===t||a[o]===t)}},44213:(t,e,r)=>{"use strict";var n=r(43724),i=r(79504),o=r(69565),a=r(79039),s=r(71072),u=r(33717),c=r(48773),l=r(48981),f=r(47055),p=Object.assign,h=Object.defineProperty,d=i([].concat);t.exports=!p||a((function(){if(n&&1!==p({b:1},p(h({},"a",{enumerable:!0,get:function(){h(this,"b",{value:3,enumerable:!1})}}),{b:2})).b)return!0;var t={},e={},r=Symbol("assign detection"),i="abcdefghijklmnopqrst";return t[r]=7,i.split("").forEach((function(t){e[t]=t})),7!==p({},t)[r]||s(p({},e)).join("")!==i}))?function(t,e){var r=l(t),i=arguments.length,a=1,p=u.f,h=c.f;while(i>a){var v,g=f(arguments[a++]),m=p?d(s(g),p(g)):s(g),y=m.length,b=0;while(y>b)v=m[b++],n&&!o(h,g,v)||(r[v]=g[v])}return r}:p},44265:(t,e,r)=>{"use strict";var n=r(82839);t.exports=/ipad|iphone|ipod/i.test(n)&&"undefined"!=typeof Pebble},44496:(t,e,r)=>{"use strict";var n=r(94644),i=r(19617).includes,o=n.aTypedArray,a=n.exportTypedArrayMethod;a("includes",(function(t){return i(o(this),t,arguments.length>1?arguments[1]:void 0)}))},44557:t=>{t.exports=/[!-#%-\*,-\/:;\?@\[-\]_\{\}\xA1\xA7\xAB\xB6\xB7\xBB\xBF\u037E\u0387\u055A-\u055F\u0589\u058A\u05BE\u05C0\u05C3\u05C6\u05F3\u05F4\u0609\u060A\u060C\u060D\u061B\u061E\u061F\u066A-\u066D\u06D4\u0700-\u070D\u07F7-\u07F9\u0830-\u083E\u085E\u0964\u0965\u0970\u09FD\u0A76\u0AF0\u0C84\u0DF4\u0E4F\u0E5A\u0E5B\u0F04-\u0F12\u0F14\u0F3A-\u0F3D\u0F85\u0FD0-\u0FD4\u0FD9\u0FDA\u104A-\u104F\u10FB\u1360-\u1368\u1400\u166D\u166E\u169B\u169C\u16EB-\u16ED\u1735\u1736\u17D4-\u17D6\u17D8-\u17DA\u1800-\u180A\u1944\u1945\u1A1E\u1A1F\u1AA0-\u1AA6\u1AA8-\u1AAD\u1B5A-\u1B60\u1BFC-\u1BFF\u1C3B-\u1C3F\u1C7E\u1C7F\u1CC0-\u1CC7\u1CD3\u2010-\u2027\u2030-\u2043\u2045-\u2051\u2053-\u205E\u207D\u207E\u208D\u208E\u2308-\u230B\u2329\u232A\u2768-\u2775\u27C5\u27C6\u27E6-\u27EF\u2983-\u2998\u29D8-\u29DB\u29FC\u29FD\u2CF9-\u2CFC\u2CFE\u2CFF\u2D70\u2E00-\u2E2E\u2E30-\u2E4E\u3001-\u3003\u3008-\u3011\u3014-\u301F\u3030\u303D\u30A0\u30FB\uA4FE\uA4FF\uA60D-\uA60F\uA673\uA67E\uA6F2-\uA6F7\uA874-\uA877\uA8CE\uA8CF\uA8F8-\uA8FA\uA8FC\uA92E\uA92F\uA95F\uA9C1-\uA9CD\uA9DE\uA9DF\uAA5C-\uAA5F\uAADE\uAADF\uAAF0\uAAF1\uABEB\uFD3E\uFD3F\uFE10-\uFE19\uFE30-\uFE52\uFE54-\uFE61\uFE63\uFE68\uFE6A\uFE6B\uFF01-\uFF03\uFF05-\uFF0A\uFF0C-\uFF0F\uFF1A\uFF1B\uFF1F\uFF20\uFF3B-\uFF3D\uFF3F\uFF5B\uFF5D\uFF5F-\uFF65]|\uD800[\uDD00-\uDD02\uDF9F\uDFD0]|\uD801\uDD6F|\uD802[\uDC57\uDD1F\uDD3F\uDE50-\uDE58\uDE7F\uDEF0-\uDEF6\uDF39-\uDF3F\uDF99-\uDF9C]|\uD803[\uDF55-\uDF59]|\uD804[\uDC47-\uDC4D\uDCBB\uDCBC\uDCBE-\uDCC1\uDD40-\uDD43\uDD74\uDD75\uDDC5-\uDDC8\uDDCD\uDDDB\uDDDD-\uDDDF\uDE38-\uDE3D\uDEA9]|\uD805[\uDC4B-\uDC4F\uDC5B\uDC5D\uDCC6\uDDC1-\uDDD7\uDE41-\uDE43\uDE60-\uDE6C\uDF3C-\uDF3E]|\uD806[\uDC3B\uDE3F-\uDE46\uDE9A-\uDE9C\uDE9E-\uDEA2]|\uD807[\uDC41-\uDC45\uDC70\uDC71\uDEF7\uDEF8]|\uD809[\uDC70-\uDC74]|\uD81A[\uDE6E\uDE6F\uDEF5\uDF37-\uDF3B\uDF44]|\uD81B[\uDE97-\uDE9A]|\uD82F\uDC9F|\uD836[\uDE87-\uDE8B]|\uD83A[\uDD5E\uDD5F]/},44576:(t,e,r)=>{"use strict";var n=r(79504),i=n({}.toString),o=n("".slice);t.exports=function(t){return o(i(t),8,-1)}},44732:(t,e,r)=>{"use strict";var n=r(94644),i=r(79504),o=r(79306),a=r(35370),s=n.aTypedArray,u=n.getTypedArrayConstructor,c=n.exportTypedArrayMethod,l=i(n.TypedArrayPrototype.sort);c("toSorted",(function(t){void 0!==t&&o(t);var e=s(this),r=a(u(e),e);return l(r,t)}))},45130:(t,e,r)=>{"use strict";r.d(e,{D$:()=>Et,Ef:()=>Ot,Jo:()=>yt,eB:()=>E,u1:()=>bt});var n=r(69492),i=r(25026),o=(r(29990),r(19129),r(81587)),a=r(54119),s=r(3604),u=r(74721),c=(r(52675),r(89463),r(76918),r(28706),r(2008),r(51629),r(23418),r(74423),r(25276),r(48598),r(62062),r(44114),r(34782),r(15086),r(54554),r(59089),r(23288),r(94170),r(62010),r(18111),r(22489),r(7588),r(61701),r(13579),r(36033),r(2892),r(59904),r(84185),r(79432),r(26099),r(27495),r(90906),r(38781),r(31415),r(17642),r(58004),r(33853),r(45876),r(32475),r(15024),r(31698),r(21699),r(47764),r(71761),r(25440),r(90744),r(11392),r(42762),r(89907),r(73772),r(30958),r(23500),r(62953),r(76031),r(56768)),l=r(24232),f=(r(90144),void 0),p="undefined"!==typeof window&&window.trustedTypes;if(p)try{f=p.createPolicy("vue",{createHTML:function(t){return t}})}catch(Dt){}var h=f?function(t){return f.createHTML(t)}:function(t){return t},d="http://www.w3.org/2000/svg",v="http://www.w3.org/1998/Math/MathML",g="undefined"!==typeof document?document:null,m=g&&g.createElement("template"),y={insert:function(t,e,r){e.insertBefore(t,r||null)},remove:function(t){var e=t.parentNode;e&&e.removeChild(t)},createElement:function(t,e,r,n){var i="svg"===e?g.createElementNS(d,t):"mathml"===e?g.createElementNS(v,t):r?g.createElement(t,{is:r}):g.createElement(t);return"select"===t&&n&&null!=n.multiple&&i.setAttribute("multiple",n.multiple),i},createText:function(t){return g.createTextNode(t)},createComment:function(t){return g.createComment(t)},setText:function(t,e){t.nodeValue=e},setElementText:function(t,e){t.textContent=e},parentNode:function(t){return t.parentNode},nextSibling:function(t){return t.nextSibling},querySelector:function(t){return g.querySelector(t)},setScopeId:function(t,e){t.setAttribute(e,"")},insertStaticContent:function(t,e,r,n,i,o){var a=r?r.previousSibling:e.lastChild;if(i&&(i===o||i.nextSibling)){while(1)if(e.insertBefore(i.cloneNode(!0),r),i===o||!(i=i.nextSibling))break}else{m.innerHTML=h("svg"===n?"<svg>".concat(t,"</svg>"):"mathml"===n?"<math>".concat(t,"</math>"):t);var s=m.content;if("svg"===n||"mathml"===n){var u=s.firstChild;while(u.firstChild)s.appendChild(u.firstChild);s.removeChild(u)}e.insertBefore(s,r)}return[a?a.nextSibling:e.firstChild,r?r.previousSibling:e.lastChild]}},b="transition",w="animation",_=Symbol("_vtc"),x={name:String,type:String,css:{type:Boolean,default:!0},duration:[String,Number,Object],enterFromClass:String,enterActiveClass:String,enterToClass:String,appearFromClass:String,appearActiveClass:String,appearToClass:String,leaveFromClass:String,leaveActiveClass:String,leaveToClass:String},A=(0,l.X$)({},c.QP,x),k=function(t){return t.displayName="Transition",t.props=A,t},E=k((function(t,e){var r=e.slots;return(0,c.h)(c.pR,O(t),r)})),S=function(t){var e=arguments.length>1&&void 0!==arguments[1]?arguments[1]:[];(0,l.cy)(t)?t.forEach((function(t){return t.apply(void 0,(0,u.A)(e))})):t&&t.apply(void 0,(0,u.A)(e))},C=function(t){return!!t&&((0,l.cy)(t)?t.some((function(t){return t.length>1})):t.length>1)};function O(t){var e={};for(var r in t)r in x||(e[r]=t[r]);if(!1===t.css)return e;var n=t.name,i=void 0===n?"v":n,o=t.type,a=t.duration,s=t.enterFromClass,u=void 0===s?"".concat(i,"-enter-from"):s,c=t.enterActiveClass,f=void 0===c?"".concat(i,"-enter-active"):c,p=t.enterToClass,h=void 0===p?"".concat(i,"-enter-to"):p,d=t.appearFromClass,v=void 0===d?u:d,g=t.appearActiveClass,m=void 0===g?f:g,y=t.appearToClass,b=void 0===y?h:y,w=t.leaveFromClass,_=void 0===w?"".concat(i,"-leave-from"):w,A=t.leaveActiveClass,k=void 0===A?"".concat(i,"-leave-active"):A,E=t.leaveToClass,O=void 0===E?"".concat(i,"-leave-to"):E,R=T(a),P=R&&R[0],F=R&&R[1],M=e.onBeforeEnter,N=e.onEnter,U=e.onEnterCancelled,B=e.onLeave,z=e.onLeaveCancelled,V=e.onBeforeAppear,$=void 0===V?M:V,G=e.onAppear,H=void 0===G?N:G,W=e.onAppearCancelled,Z=void 0===W?U:W,K=function(t,e,r,n){t._enterCancelled=n,I(t,e?b:h),I(t,e?m:f),r&&r()},Y=function(t,e){t._isLeaving=!1,I(t,_),I(t,O),I(t,k),e&&e()},J=function(t){return function(e,r){var n=t?H:N,i=function(){return K(e,t,r)};S(n,[e,i]),L((function(){I(e,t?v:u),D(e,t?b:h),C(n)||j(e,o,P,i)}))}};return(0,l.X$)(e,{onBeforeEnter:function(t){S(M,[t]),D(t,u),D(t,f)},onBeforeAppear:function(t){S($,[t]),D(t,v),D(t,m)},onEnter:J(!1),onAppear:J(!0),onLeave:function(t,e){t._isLeaving=!0;var r=function(){return Y(t,e)};D(t,_),t._enterCancelled?(D(t,k),q()):(q(),D(t,k)),L((function(){t._isLeaving&&(I(t,_),D(t,O),C(B)||j(t,o,F,r))})),S(B,[t,r])},onEnterCancelled:function(t){K(t,!1,void 0,!0),S(U,[t])},onAppearCancelled:function(t){K(t,!0,void 0,!0),S(Z,[t])},onLeaveCancelled:function(t){Y(t),S(z,[t])}})}function T(t){if(null==t)return null;if((0,l.Gv)(t))return[R(t.enter),R(t.leave)];var e=R(t);return[e,e]}function R(t){var e=(0,l.Ro)(t);return e}function D(t,e){e.split(/\s+/).forEach((function(e){return e&&t.classList.add(e)})),(t[_]||(t[_]=new Set)).add(e)}function I(t,e){e.split(/\s+/).forEach((function(e){return e&&t.classList.remove(e)}));var r=t[_];r&&(r["delete"](e),r.size||(t[_]=void 0))}function L(t){requestAnimationFrame((function(){requestAnimationFrame(t)}))}var P=0;function j(t,e,r,n){var i=t._endId=++P,o=function(){i===t._endId&&n()};if(null!=r)return setTimeout(o,r);var a=F(t,e),s=a.type,u=a.timeout,c=a.propCount;if(!s)return n();var l=s+"end",f=0,p=function(){t.removeEventListener(l,h),o()},h=function(e){e.target===t&&++f>=c&&p()};setTimeout((function(){f<c&&p()}),u+1),t.addEventListener(l,h)}function F(t,e){var r=window.getComputedStyle(t),n=function(t){return(r[t]||"").split(", ")},i=n("".concat(b,"Delay")),o=n("".concat(b,"Duration")),a=M(i,o),s=n("".concat(w,"Delay")),u=n("".concat(w,"Duration")),c=M(s,u),l=null,f=0,p=0;e===b?a>0&&(l=b,f=a,p=o.length):e===w?c>0&&(l=w,f=c,p=u.length):(f=Math.max(a,c),l=f>0?a>c?b:w:null,p=l?l===b?o.length:u.length:0);var h=l===b&&/\b(transform|all)(,|$)/.test(n("".concat(b,"Property")).toString());return{type:l,timeout:f,propCount:p,hasTransform:h}}function M(t,e){while(t.length<e.length)t=t.concat(t);return Math.max.apply(Math,(0,u.A)(e.map((function(e,r){return N(e)+N(t[r])}))))}function N(t){return"auto"===t?0:1e3*Number(t.slice(0,-1).replace(",","."))}function q(){return document.body.offsetHeight}function U(t,e,r){var n=t[_];n&&(e=(e?[e].concat((0,u.A)(n)):(0,u.A)(n)).join(" ")),null==e?t.removeAttribute("class"):r?t.setAttribute("class",e):t.className=e}var B=Symbol("_vod"),z=Symbol("_vsh");var V=Symbol("");var $=/(^|;)\s*display\s*:/;function G(t,e,r){var n=t.style,i=(0,l.Kg)(r),o=!1;if(r&&!i){if(e)if((0,l.Kg)(e)){var a,u=(0,s.A)(e.split(";"));try{for(u.s();!(a=u.n()).done;){var c=a.value,f=c.slice(0,c.indexOf(":")).trim();null==r[f]&&W(n,f,"")}}catch(v){u.e(v)}finally{u.f()}}else for(var p in e)null==r[p]&&W(n,p,"");for(var h in r)"display"===h&&(o=!0),W(n,h,r[h])}else if(i){if(e!==r){var d=n[V];d&&(r+=";"+d),n.cssText=r,o=$.test(r)}}else e&&t.removeAttribute("style");B in t&&(t[B]=o?n.display:"",t[z]&&(n.display="none"))}var H=/\s*!important$/;function W(t,e,r){if((0,l.cy)(r))r.forEach((function(r){return W(t,e,r)}));else if(null==r&&(r=""),e.startsWith("--"))t.setProperty(e,r);else{var n=Y(t,e);H.test(r)?t.setProperty((0,l.Tg)(n),r.replace(H,""),"important"):t[n]=r}}var Z=["Webkit","Moz","ms"],K={};function Y(t,e){var r=K[e];if(r)return r;var n=(0,l.PT)(e);if("filter"!==n&&n in t)return K[e]=n;n=(0,l.ZH)(n);for(var i=0;i<Z.length;i++){var o=Z[i]+n;if(o in t)return K[e]=o}return e}var J="http://www.w3.org/1999/xlink";function X(t,e,r,n,i){var o=arguments.length>5&&void 0!==arguments[5]?arguments[5]:(0,l.J$)(e);n&&e.startsWith("xlink:")?null==r?t.removeAttributeNS(J,e.slice(6,e.length)):t.setAttributeNS(J,e,r):null==r||o&&!(0,l.Y2)(r)?t.removeAttribute(e):t.setAttribute(e,o?"":(0,l.Bm)(r)?String(r):r)}function Q(t,e,r,n,i){if("innerHTML"!==e&&"textContent"!==e){var o=t.tagName;if("value"===e&&"PROGRESS"!==o&&!o.includes("-")){var s="OPTION"===o?t.getAttribute("value")||"":t.value,u=null==r?"checkbox"===t.type?"on":"":String(r);return s===u&&"_value"in t||(t.value=u),null==r&&t.removeAttribute(e),void(t._value=r)}var c=!1;if(""===r||null==r){var f=(0,a.A)(t[e]);"boolean"===f?r=(0,l.Y2)(r):null==r&&"string"===f?(r="",c=!0):"number"===f&&(r=0,c=!0)}try{t[e]=r}catch(Dt){0}c&&t.removeAttribute(i||e)}else null!=r&&(t[e]="innerHTML"===e?h(r):r)}function tt(t,e,r,n){t.addEventListener(e,r,n)}function et(t,e,r,n){t.removeEventListener(e,r,n)}var rt=Symbol("_vei");function nt(t,e,r,n){var i=arguments.length>4&&void 0!==arguments[4]?arguments[4]:null,a=t[rt]||(t[rt]={}),s=a[e];if(n&&s)s.value=n;else{var u=ot(e),c=(0,o.A)(u,2),l=c[0],f=c[1];if(n){var p=a[e]=ct(n,i);tt(t,l,p,f)}else s&&(et(t,l,s,f),a[e]=void 0)}}var it=/(?:Once|Passive|Capture)$/;function ot(t){var e;if(it.test(t)){var r;e={};while(r=t.match(it))t=t.slice(0,t.length-r[0].length),e[r[0].toLowerCase()]=!0}var n=":"===t[2]?t.slice(3):(0,l.Tg)(t.slice(2));return[n,e]}var at=0,st=Promise.resolve(),ut=function(){return at||(st.then((function(){return at=0})),at=Date.now())};function ct(t,e){var r=function(t){if(t._vts){if(t._vts<=r.attached)return}else t._vts=Date.now();(0,c.qL)(lt(t,r.value),e,5,[t])};return r.value=t,r.attached=ut(),r}function lt(t,e){if((0,l.cy)(e)){var r=t.stopImmediatePropagation;return t.stopImmediatePropagation=function(){r.call(t),t._stopped=!0},e.map((function(t){return function(e){return!e._stopped&&t&&t(e)}}))}return e}var ft=function(t){return 111===t.charCodeAt(0)&&110===t.charCodeAt(1)&&t.charCodeAt(2)>96&&t.charCodeAt(2)<123},pt=function(t,e,r,n,i,o){var a="svg"===i;"class"===e?U(t,n,a):"style"===e?G(t,r,n):(0,l.Mp)(e)?(0,l.CP)(e)||nt(t,e,r,n,o):("."===e[0]?(e=e.slice(1),1):"^"===e[0]?(e=e.slice(1),0):ht(t,e,n,a))?(Q(t,e,n),t.tagName.includes("-")||"value"!==e&&"checked"!==e&&"selected"!==e||X(t,e,n,a,o,"value"!==e)):!t._isVueCE||!/[A-Z]/.test(e)&&(0,l.Kg)(n)?("true-value"===e?t._trueValue=n:"false-value"===e&&(t._falseValue=n),X(t,e,n,a)):Q(t,(0,l.PT)(e),n,o,e)};function ht(t,e,r,n){if(n)return"innerHTML"===e||"textContent"===e||!!(e in t&&ft(e)&&(0,l.Tn)(r));if("spellcheck"===e||"draggable"===e||"translate"===e)return!1;if("form"===e)return!1;if("list"===e&&"INPUT"===t.tagName)return!1;if("type"===e&&"TEXTAREA"===t.tagName)return!1;if("width"===e||"height"===e){var i=t.tagName;if("IMG"===i||"VIDEO"===i||"CANVAS"===i||"SOURCE"===i)return!1}return(!ft(e)||!(0,l.Kg)(r))&&e in t}
-/*! #__NO_SIDE_EFFECTS__ */
-"undefined"!==typeof HTMLElement&&HTMLElement;Symbol("_moveCb"),Symbol("_enterCb");var dt=function(t){var e=t.props["onUpdate:modelValue"]||!1;return(0,l.cy)(e)?function(t){return(0,l.DY)(e,t)}:e};function vt(t){t.target.composing=!0}function gt(t){var e=t.target;e.composing&&(e.composing=!1,e.dispatchEvent(new Event("input")))}var mt=Symbol("_assign"),yt={created:function(t,e,r){var n=e.modifiers,i=n.lazy,o=n.trim,a=n.number;t[mt]=dt(r);var s=a||r.props&&"number"===r.props.type;tt(t,i?"change":"input",(function(e){if(!e.target.composing){var r=t.value;o&&(r=r.trim()),s&&(r=(0,l.bB)(r)),t[mt](r)}})),o&&tt(t,"change",(function(){t.value=t.value.trim()})),i||(tt(t,"compositionstart",vt),tt(t,"compositionend",gt),tt(t,"change",gt))},mounted:function(t,e){var r=e.value;t.value=null==r?"":r},beforeUpdate:function(t,e,r){var n=e.value,i=e.oldValue,o=e.modifiers,a=o.lazy,s=o.trim,u=o.number;if(t[mt]=dt(r),!t.composing){var c=!u&&"number"!==t.type||/^0\d/.test(t.value)?t.value:(0,l.bB)(t.value),f=null==n?"":n;if(c!==f){if(document.activeElement===t&&"range"!==t.type){if(a&&n===i)return;if(s&&t.value.trim()===f)return}t.value=f}}}};var bt={deep:!0,created:function(t,e,r){var n=e.value,i=e.modifiers.number,o=(0,l.vM)(n);tt(t,"change",(function(){var e=Array.prototype.filter.call(t.options,(function(t){return t.selected})).map((function(t){return i?(0,l.bB)(_t(t)):_t(t)}));t[mt](t.multiple?o?new Set(e):e:e[0]),t._assigning=!0,(0,c.dY)((function(){t._assigning=!1}))})),t[mt]=dt(r)},mounted:function(t,e){var r=e.value;wt(t,r)},beforeUpdate:function(t,e,r){t[mt]=dt(r)},updated:function(t,e){var r=e.value;t._assigning||wt(t,r)}};function wt(t,e){var r=t.multiple,n=(0,l.cy)(e);if(!r||n||(0,l.vM)(e)){for(var i,o=function(){var i=t.options[s],o=_t(i);if(r)if(n){var u=(0,a.A)(o);i.selected="string"===u||"number"===u?e.some((function(t){return String(t)===String(o)})):(0,l.u3)(e,o)>-1}else i.selected=e.has(o);else if((0,l.BX)(_t(i),e))return t.selectedIndex!==s&&(t.selectedIndex=s),{v:void 0}},s=0,u=t.options.length;s<u;s++)if(i=o(),i)return i.v;r||-1===t.selectedIndex||(t.selectedIndex=-1)}}function _t(t){return"_value"in t?t._value:t.value}var xt,At=["ctrl","shift","alt","meta"],kt={stop:function(t){return t.stopPropagation()},prevent:function(t){return t.preventDefault()},self:function(t){return t.target!==t.currentTarget},ctrl:function(t){return!t.ctrlKey},shift:function(t){return!t.shiftKey},alt:function(t){return!t.altKey},meta:function(t){return!t.metaKey},left:function(t){return"button"in t&&0!==t.button},middle:function(t){return"button"in t&&1!==t.button},right:function(t){return"button"in t&&2!==t.button},exact:function(t,e){return At.some((function(r){return t["".concat(r,"Key")]&&!e.includes(r)}))}},Et=function(t,e){var r=t._withMods||(t._withMods={}),n=e.join(".");return r[n]||(r[n]=function(r){for(var n=0;n<e.length;n++){var i=kt[e[n]];if(i&&i(r,e))return}for(var o=arguments.length,a=new Array(o>1?o-1:0),s=1;s<o;s++)a[s-1]=arguments[s];return t.apply(void 0,[r].concat(a))})},St=(0,l.X$)({patchProp:pt},y);function Ct(){return xt||(xt=(0,c.K9)(St))}var Ot=function(){var t,e=(t=Ct()).createApp.apply(t,arguments);var r=e.mount;return e.mount=function(t){var n=Rt(t);if(n){var i=e._component;(0,l.Tn)(i)||i.render||i.template||(i.template=n.innerHTML),1===n.nodeType&&(n.textContent="");var o=r(n,!1,Tt(n));return n instanceof Element&&(n.removeAttribute("v-cloak"),n.setAttribute("data-v-app","")),o}},e};function Tt(t){return t instanceof SVGElement?"svg":"function"===typeof MathMLElement&&t instanceof MathMLElement?"mathml":void 0}function Rt(t){if((0,l.Kg)(t)){var e=document.querySelector(t);return e}return t}},45439:(t,e,r)=>{"use strict";r(62062),r(44114),r(18111),r(61701),r(26099),r(42762),t.exports=function(t,e){var r,n,i,o,a,s,u=e+1,c=t.md.block.ruler.getRules("paragraph"),l=t.lineMax;for(s=t.parentType,t.parentType="paragraph";u<l&&!t.isEmpty(u);u++)if(!(t.sCount[u]-t.blkIndent>3)&&!(t.sCount[u]<0)){for(n=!1,i=0,o=c.length;i<o;i++)if(c[i](t,u,l,!0)){n=!0;break}if(n)break}return r=t.getLines(e,u,t.blkIndent,!1).trim(),t.line=u,a=t.push("paragraph_open","p",1),a.map=[e,t.line],a=t.push("inline","",0),a.content=r,a.map=[e,t.line],a.children=[],a=t.push("paragraph_close","p",-1),t.parentType=s,!0}},45700:(t,e,r)=>{"use strict";var n=r(70511),i=r(58242);n("toPrimitive"),i()},45806:(t,e,r)=>{"use strict";r(47764);var n,i=r(46518),o=r(43724),a=r(67416),s=r(22195),u=r(76080),c=r(79504),l=r(36840),f=r(62106),p=r(90679),h=r(39297),d=r(44213),v=r(97916),g=r(67680),m=r(68183).codeAt,y=r(3717),b=r(655),w=r(10687),_=r(22812),x=r(98406),A=r(91181),k=A.set,E=A.getterFor("URL"),S=x.URLSearchParams,C=x.getState,O=s.URL,T=s.TypeError,R=s.parseInt,D=Math.floor,I=Math.pow,L=c("".charAt),P=c(/./.exec),j=c([].join),F=c(1..toString),M=c([].pop),N=c([].push),q=c("".replace),U=c([].shift),B=c("".split),z=c("".slice),V=c("".toLowerCase),$=c([].unshift),G="Invalid authority",H="Invalid scheme",W="Invalid host",Z="Invalid port",K=/[a-z]/i,Y=/[\d+-.a-z]/i,J=/\d/,X=/^0x/i,Q=/^[0-7]+$/,tt=/^\d+$/,et=/^[\da-f]+$/i,rt=/[\0\t\n\r #%/:<>?@[\\\]^|]/,nt=/[\0\t\n\r #/:<>?@[\\\]^|]/,it=/^[\u0000-\u0020]+/,ot=/(^|[^\u0000-\u0020])[\u0000-\u0020]+$/,at=/[\t\n\r]/g,st=function(t){var e,r,n,i,o,a,s,u=B(t,".");if(u.length&&""===u[u.length-1]&&u.length--,e=u.length,e>4)return t;for(r=[],n=0;n<e;n++){if(i=u[n],""===i)return t;if(o=10,i.length>1&&"0"===L(i,0)&&(o=P(X,i)?16:8,i=z(i,8===o?1:2)),""===i)a=0;else{if(!P(10===o?tt:8===o?Q:et,i))return t;a=R(i,o)}N(r,a)}for(n=0;n<e;n++)if(a=r[n],n===e-1){if(a>=I(256,5-e))return null}else if(a>255)return null;for(s=M(r),n=0;n<r.length;n++)s+=r[n]*I(256,3-n);return s},ut=function(t){var e,r,n,i,o,a,s,u=[0,0,0,0,0,0,0,0],c=0,l=null,f=0,p=function(){return L(t,f)};if(":"===p()){if(":"!==L(t,1))return;f+=2,c++,l=c}while(p()){if(8===c)return;if(":"!==p()){e=r=0;while(r<4&&P(et,p()))e=16*e+R(p(),16),f++,r++;if("."===p()){if(0===r)return;if(f-=r,c>6)return;n=0;while(p()){if(i=null,n>0){if(!("."===p()&&n<4))return;f++}if(!P(J,p()))return;while(P(J,p())){if(o=R(p(),10),null===i)i=o;else{if(0===i)return;i=10*i+o}if(i>255)return;f++}u[c]=256*u[c]+i,n++,2!==n&&4!==n||c++}if(4!==n)return;break}if(":"===p()){if(f++,!p())return}else if(p())return;u[c++]=e}else{if(null!==l)return;f++,c++,l=c}}if(null!==l){a=c-l,c=7;while(0!==c&&a>0)s=u[c],u[c--]=u[l+a-1],u[l+--a]=s}else if(8!==c)return;return u},ct=function(t){for(var e=null,r=1,n=null,i=0,o=0;o<8;o++)0!==t[o]?(i>r&&(e=n,r=i),n=null,i=0):(null===n&&(n=o),++i);return i>r?n:e},lt=function(t){var e,r,n,i;if("number"==typeof t){for(e=[],r=0;r<4;r++)$(e,t%256),t=D(t/256);return j(e,".")}if("object"==typeof t){for(e="",n=ct(t),r=0;r<8;r++)i&&0===t[r]||(i&&(i=!1),n===r?(e+=r?":":"::",i=!0):(e+=F(t[r],16),r<7&&(e+=":")));return"["+e+"]"}return t},ft={},pt=d({},ft,{" ":1,'"':1,"<":1,">":1,"`":1}),ht=d({},pt,{"#":1,"?":1,"{":1,"}":1}),dt=d({},ht,{"/":1,":":1,";":1,"=":1,"@":1,"[":1,"\\":1,"]":1,"^":1,"|":1}),vt=function(t,e){var r=m(t,0);return r>32&&r<127&&!h(e,t)?t:encodeURIComponent(t)},gt={ftp:21,file:null,http:80,https:443,ws:80,wss:443},mt=function(t,e){var r;return 2===t.length&&P(K,L(t,0))&&(":"===(r=L(t,1))||!e&&"|"===r)},yt=function(t){var e;return t.length>1&&mt(z(t,0,2))&&(2===t.length||"/"===(e=L(t,2))||"\\"===e||"?"===e||"#"===e)},bt=function(t){return"."===t||"%2e"===V(t)},wt=function(t){return t=V(t),".."===t||"%2e."===t||".%2e"===t||"%2e%2e"===t},_t={},xt={},At={},kt={},Et={},St={},Ct={},Ot={},Tt={},Rt={},Dt={},It={},Lt={},Pt={},jt={},Ft={},Mt={},Nt={},qt={},Ut={},Bt={},zt=function(t,e,r){var n,i,o,a=b(t);if(e){if(i=this.parse(a),i)throw new T(i);this.searchParams=null}else{if(void 0!==r&&(n=new zt(r,!0)),i=this.parse(a,null,n),i)throw new T(i);o=C(new S),o.bindURL(this),this.searchParams=o}};zt.prototype={type:"URL",parse:function(t,e,r){var i,o,a,s,u=this,c=e||_t,l=0,f="",p=!1,d=!1,m=!1;t=b(t),e||(u.scheme="",u.username="",u.password="",u.host=null,u.port=null,u.path=[],u.query=null,u.fragment=null,u.cannotBeABaseURL=!1,t=q(t,it,""),t=q(t,ot,"$1")),t=q(t,at,""),i=v(t);while(l<=i.length){switch(o=i[l],c){case _t:if(!o||!P(K,o)){if(e)return H;c=At;continue}f+=V(o),c=xt;break;case xt:if(o&&(P(Y,o)||"+"===o||"-"===o||"."===o))f+=V(o);else{if(":"!==o){if(e)return H;f="",c=At,l=0;continue}if(e&&(u.isSpecial()!==h(gt,f)||"file"===f&&(u.includesCredentials()||null!==u.port)||"file"===u.scheme&&!u.host))return;if(u.scheme=f,e)return void(u.isSpecial()&&gt[u.scheme]===u.port&&(u.port=null));f="","file"===u.scheme?c=Pt:u.isSpecial()&&r&&r.scheme===u.scheme?c=kt:u.isSpecial()?c=Ot:"/"===i[l+1]?(c=Et,l++):(u.cannotBeABaseURL=!0,N(u.path,""),c=qt)}break;case At:if(!r||r.cannotBeABaseURL&&"#"!==o)return H;if(r.cannotBeABaseURL&&"#"===o){u.scheme=r.scheme,u.path=g(r.path),u.query=r.query,u.fragment="",u.cannotBeABaseURL=!0,c=Bt;break}c="file"===r.scheme?Pt:St;continue;case kt:if("/"!==o||"/"!==i[l+1]){c=St;continue}c=Tt,l++;break;case Et:if("/"===o){c=Rt;break}c=Nt;continue;case St:if(u.scheme=r.scheme,o===n)u.username=r.username,u.password=r.password,u.host=r.host,u.port=r.port,u.path=g(r.path),u.query=r.query;else if("/"===o||"\\"===o&&u.isSpecial())c=Ct;else if("?"===o)u.username=r.username,u.password=r.password,u.host=r.host,u.port=r.port,u.path=g(r.path),u.query="",c=Ut;else{if("#"!==o){u.username=r.username,u.password=r.password,u.host=r.host,u.port=r.port,u.path=g(r.path),u.path.length--,c=Nt;continue}u.username=r.username,u.password=r.password,u.host=r.host,u.port=r.port,u.path=g(r.path),u.query=r.query,u.fragment="",c=Bt}break;case Ct:if(!u.isSpecial()||"/"!==o&&"\\"!==o){if("/"!==o){u.username=r.username,u.password=r.password,u.host=r.host,u.port=r.port,c=Nt;continue}c=Rt}else c=Tt;break;case Ot:if(c=Tt,"/"!==o||"/"!==L(f,l+1))continue;l++;break;case Tt:if("/"!==o&&"\\"!==o){c=Rt;continue}break;case Rt:if("@"===o){p&&(f="%40"+f),p=!0,a=v(f);for(var y=0;y<a.length;y++){var w=a[y];if(":"!==w||m){var _=vt(w,dt);m?u.password+=_:u.username+=_}else m=!0}f=""}else if(o===n||"/"===o||"?"===o||"#"===o||"\\"===o&&u.isSpecial()){if(p&&""===f)return G;l-=v(f).length+1,f="",c=Dt}else f+=o;break;case Dt:case It:if(e&&"file"===u.scheme){c=Ft;continue}if(":"!==o||d){if(o===n||"/"===o||"?"===o||"#"===o||"\\"===o&&u.isSpecial()){if(u.isSpecial()&&""===f)return W;if(e&&""===f&&(u.includesCredentials()||null!==u.port))return;if(s=u.parseHost(f),s)return s;if(f="",c=Mt,e)return;continue}"["===o?d=!0:"]"===o&&(d=!1),f+=o}else{if(""===f)return W;if(s=u.parseHost(f),s)return s;if(f="",c=Lt,e===It)return}break;case Lt:if(!P(J,o)){if(o===n||"/"===o||"?"===o||"#"===o||"\\"===o&&u.isSpecial()||e){if(""!==f){var x=R(f,10);if(x>65535)return Z;u.port=u.isSpecial()&&x===gt[u.scheme]?null:x,f=""}if(e)return;c=Mt;continue}return Z}f+=o;break;case Pt:if(u.scheme="file","/"===o||"\\"===o)c=jt;else{if(!r||"file"!==r.scheme){c=Nt;continue}switch(o){case n:u.host=r.host,u.path=g(r.path),u.query=r.query;break;case"?":u.host=r.host,u.path=g(r.path),u.query="",c=Ut;break;case"#":u.host=r.host,u.path=g(r.path),u.query=r.query,u.fragment="",c=Bt;break;default:yt(j(g(i,l),""))||(u.host=r.host,u.path=g(r.path),u.shortenPath()),c=Nt;continue}}break;case jt:if("/"===o||"\\"===o){c=Ft;break}r&&"file"===r.scheme&&!yt(j(g(i,l),""))&&(mt(r.path[0],!0)?N(u.path,r.path[0]):u.host=r.host),c=Nt;continue;case Ft:if(o===n||"/"===o||"\\"===o||"?"===o||"#"===o){if(!e&&mt(f))c=Nt;else if(""===f){if(u.host="",e)return;c=Mt}else{if(s=u.parseHost(f),s)return s;if("localhost"===u.host&&(u.host=""),e)return;f="",c=Mt}continue}f+=o;break;case Mt:if(u.isSpecial()){if(c=Nt,"/"!==o&&"\\"!==o)continue}else if(e||"?"!==o)if(e||"#"!==o){if(o!==n&&(c=Nt,"/"!==o))continue}else u.fragment="",c=Bt;else u.query="",c=Ut;break;case Nt:if(o===n||"/"===o||"\\"===o&&u.isSpecial()||!e&&("?"===o||"#"===o)){if(wt(f)?(u.shortenPath(),"/"===o||"\\"===o&&u.isSpecial()||N(u.path,"")):bt(f)?"/"===o||"\\"===o&&u.isSpecial()||N(u.path,""):("file"===u.scheme&&!u.path.length&&mt(f)&&(u.host&&(u.host=""),f=L(f,0)+":"),N(u.path,f)),f="","file"===u.scheme&&(o===n||"?"===o||"#"===o))while(u.path.length>1&&""===u.path[0])U(u.path);"?"===o?(u.query="",c=Ut):"#"===o&&(u.fragment="",c=Bt)}else f+=vt(o,ht);break;case qt:"?"===o?(u.query="",c=Ut):"#"===o?(u.fragment="",c=Bt):o!==n&&(u.path[0]+=vt(o,ft));break;case Ut:e||"#"!==o?o!==n&&("'"===o&&u.isSpecial()?u.query+="%27":u.query+="#"===o?"%23":vt(o,ft)):(u.fragment="",c=Bt);break;case Bt:o!==n&&(u.fragment+=vt(o,pt));break}l++}},parseHost:function(t){var e,r,n;if("["===L(t,0)){if("]"!==L(t,t.length-1))return W;if(e=ut(z(t,1,-1)),!e)return W;this.host=e}else if(this.isSpecial()){if(t=y(t),P(rt,t))return W;if(e=st(t),null===e)return W;this.host=e}else{if(P(nt,t))return W;for(e="",r=v(t),n=0;n<r.length;n++)e+=vt(r[n],ft);this.host=e}},cannotHaveUsernamePasswordPort:function(){return!this.host||this.cannotBeABaseURL||"file"===this.scheme},includesCredentials:function(){return""!==this.username||""!==this.password},isSpecial:function(){return h(gt,this.scheme)},shortenPath:function(){var t=this.path,e=t.length;!e||"file"===this.scheme&&1===e&&mt(t[0],!0)||t.length--},serialize:function(){var t=this,e=t.scheme,r=t.username,n=t.password,i=t.host,o=t.port,a=t.path,s=t.query,u=t.fragment,c=e+":";return null!==i?(c+="//",t.includesCredentials()&&(c+=r+(n?":"+n:"")+"@"),c+=lt(i),null!==o&&(c+=":"+o)):"file"===e&&(c+="//"),c+=t.cannotBeABaseURL?a[0]:a.length?"/"+j(a,"/"):"",null!==s&&(c+="?"+s),null!==u&&(c+="#"+u),c},setHref:function(t){var e=this.parse(t);if(e)throw new T(e);this.searchParams.update()},getOrigin:function(){var t=this.scheme,e=this.port;if("blob"===t)try{return new Vt(t.path[0]).origin}catch(r){return"null"}return"file"!==t&&this.isSpecial()?t+"://"+lt(this.host)+(null!==e?":"+e:""):"null"},getProtocol:function(){return this.scheme+":"},setProtocol:function(t){this.parse(b(t)+":",_t)},getUsername:function(){return this.username},setUsername:function(t){var e=v(b(t));if(!this.cannotHaveUsernamePasswordPort()){this.username="";for(var r=0;r<e.length;r++)this.username+=vt(e[r],dt)}},getPassword:function(){return this.password},setPassword:function(t){var e=v(b(t));if(!this.cannotHaveUsernamePasswordPort()){this.password="";for(var r=0;r<e.length;r++)this.password+=vt(e[r],dt)}},getHost:function(){var t=this.host,e=this.port;return null===t?"":null===e?lt(t):lt(t)+":"+e},setHost:function(t){this.cannotBeABaseURL||this.parse(t,Dt)},getHostname:function(){var t=this.host;return null===t?"":lt(t)},setHostname:function(t){this.cannotBeABaseURL||this.parse(t,It)},getPort:function(){var t=this.port;return null===t?"":b(t)},setPort:function(t){this.cannotHaveUsernamePasswordPort()||(t=b(t),""===t?this.port=null:this.parse(t,Lt))},getPathname:function(){var t=this.path;return this.cannotBeABaseURL?t[0]:t.length?"/"+j(t,"/"):""},setPathname:function(t){this.cannotBeABaseURL||(this.path=[],this.parse(t,Mt))},getSearch:function(){var t=this.query;return t?"?"+t:""},setSearch:function(t){t=b(t),""===t?this.query=null:("?"===L(t,0)&&(t=z(t,1)),this.query="",this.parse(t,Ut)),this.searchParams.update()},getSearchParams:function(){return this.searchParams.facade},getHash:function(){var t=this.fragment;return t?"#"+t:""},setHash:function(t){t=b(t),""!==t?("#"===L(t,0)&&(t=z(t,1)),this.fragment="",this.parse(t,Bt)):this.fragment=null},update:function(){this.query=this.searchParams.serialize()||null}};var Vt=function(t){var e=p(this,$t),r=_(arguments.length,1)>1?arguments[1]:void 0,n=k(e,new zt(t,!1,r));o||(e.href=n.serialize(),e.origin=n.getOrigin(),e.protocol=n.getProtocol(),e.username=n.getUsername(),e.password=n.getPassword(),e.host=n.getHost(),e.hostname=n.getHostname(),e.port=n.getPort(),e.pathname=n.getPathname(),e.search=n.getSearch(),e.searchParams=n.getSearchParams(),e.hash=n.getHash())},$t=Vt.prototype,Gt=function(t,e){return{get:function(){return E(this)[t]()},set:e&&function(t){return E(this)[e](t)},configurable:!0,enumerable:!0}};if(o&&(f($t,"href",Gt("serialize","setHref")),f($t,"origin",Gt("getOrigin")),f($t,"protocol",Gt("getProtocol","setProtocol")),f($t,"username",Gt("getUsername","setUsername")),f($t,"password",Gt("getPassword","setPassword")),f($t,"host",Gt("getHost","setHost")),f($t,"hostname",Gt("getHostname","setHostname")),f($t,"port",Gt("getPort","setPort")),f($t,"pathname",Gt("getPathname","setPathname")),f($t,"search",Gt("getSearch","setSearch")),f($t,"searchParams",Gt("getSearchParams")),f($t,"hash",Gt("getHash","setHash"))),l($t,"toJSON",(function(){return E(this).serialize()}),{enumerable:!0}),l($t,"toString",(function(){return E(this).serialize()}),{enumerable:!0}),O){var Ht=O.createObjectURL,Wt=O.revokeObjectURL;Ht&&l(Vt,"createObjectURL",u(Ht,O)),Wt&&l(Vt,"revokeObjectURL",u(Wt,O))}w(Vt,"URL"),i({global:!0,constructor:!0,forced:!a,sham:!o},{URL:Vt})},45876:(t,e,r)=>{"use strict";var n=r(46518),i=r(53838),o=r(84916),a=!o("isSubsetOf",(function(t){return t}));n({target:"Set",proto:!0,real:!0,forced:a},{isSubsetOf:i})},46518:(t,e,r)=>{"use strict";var n=r(22195),i=r(77347).f,o=r(66699),a=r(36840),s=r(39433),u=r(77740),c=r(92796);t.exports=function(t,e){var r,l,f,p,h,d,v=t.target,g=t.global,m=t.stat;if(l=g?n:m?n[v]||s(v,{}):n[v]&&n[v].prototype,l)for(f in e){if(h=e[f],t.dontCallGetSet?(d=i(l,f),p=d&&d.value):p=l[f],r=c(g?f:v+(m?".":"#")+f,t.forced),!r&&void 0!==p){if(typeof h==typeof p)continue;u(h,p)}(t.sham||p&&p.sham)&&o(h,"sham",!0),a(l,f,h,t)}}},46706:(t,e,r)=>{"use strict";var n=r(79504),i=r(79306);t.exports=function(t,e,r){try{return n(i(Object.getOwnPropertyDescriptor(t,e)[r]))}catch(o){}}},46761:(t,e,r)=>{"use strict";var n=r(46518),i=r(94644),o=i.NATIVE_ARRAY_BUFFER_VIEWS;n({target:"ArrayBuffer",stat:!0,forced:!o},{isView:i.isView})},47045:(t,e,r)=>{function n(e){return t.exports=n="function"==typeof Symbol&&"symbol"==typeof Symbol.iterator?function(t){return typeof t}:function(t){return t&&"function"==typeof Symbol&&t.constructor===Symbol&&t!==Symbol.prototype?"symbol":typeof t},t.exports.__esModule=!0,t.exports["default"]=t.exports,n(e)}r(52675),r(89463),r(2259),r(26099),r(47764),r(62953),t.exports=n,t.exports.__esModule=!0,t.exports["default"]=t.exports},47055:(t,e,r)=>{"use strict";var n=r(79504),i=r(79039),o=r(44576),a=Object,s=n("".split);t.exports=i((function(){return!a("z").propertyIsEnumerable(0)}))?function(t){return"String"===o(t)?s(t,""):a(t)}:a},47452:t=>{"use strict";t.exports="\t\n\v\f\r                　\u2028\u2029\ufeff"},47566:(t,e,r)=>{"use strict";var n=r(36840),i=r(79504),o=r(655),a=r(22812),s=URLSearchParams,u=s.prototype,c=i(u.getAll),l=i(u.has),f=new s("a=1");!f.has("a",2)&&f.has("a",void 0)||n(u,"has",(function(t){var e=arguments.length,r=e<2?void 0:arguments[1];if(e&&void 0===r)return l(this,t);var n=c(this,t);a(e,1);var i=o(r),s=0;while(s<n.length)if(n[s++]===i)return!0;return!1}),{enumerable:!0,unsafe:!0})},47764:(t,e,r)=>{"use strict";var n=r(68183).charAt,i=r(655),o=r(91181),a=r(51088),s=r(62529),u="String Iterator",c=o.set,l=o.getterFor(u);a(String,"String",(function(t){c(this,{type:u,string:i(t),index:0})}),(function(){var t,e=l(this),r=e.string,i=e.index;return i>=r.length?s(void 0,!0):(t=n(r,i),e.index+=t.length,s(t,!1))}))},47830:(t,e,r)=>{"use strict";r(34782),r(58940),r(27495),r(71761);var n=r(10198),i=r(8700).has,o=r(8700).isValidEntityCode,a=r(8700).fromCodePoint,s=/^&#((?:x[a-f0-9]{1,6}|[0-9]{1,7}));/i,u=/^&([a-z][a-z0-9]{1,31});/i;t.exports=function(t,e){var r,c,l,f=t.pos,p=t.posMax;if(38!==t.src.charCodeAt(f))return!1;if(f+1<p)if(r=t.src.charCodeAt(f+1),35===r){if(l=t.src.slice(f).match(s),l)return e||(c="x"===l[1][0].toLowerCase()?parseInt(l[1].slice(1),16):parseInt(l[1],10),t.pending+=o(c)?a(c):a(65533)),t.pos+=l[0].length,!0}else if(l=t.src.slice(f).match(u),l&&i(n,l[1]))return e||(t.pending+=n[l[1]]),t.pos+=l[0].length,!0;return e||(t.pending+="&"),t.pos++,!0}},47914:(t,e,r)=>{"use strict";r.d(e,{A:()=>n});r(64346);function n(t){if(Array.isArray(t))return t}},48140:(t,e,r)=>{"use strict";var n=r(94644),i=r(26198),o=r(91291),a=n.aTypedArray,s=n.exportTypedArrayMethod;s("at",(function(t){var e=a(this),r=i(e),n=o(t),s=n>=0?n:r+n;return s<0||s>=r?void 0:e[s]}))},48408:(t,e,r)=>{"use strict";r(98406)},48523:(t,e,r)=>{"use strict";var n=r(16468),i=r(86938);n("Map",(function(t){return function(){return t(this,arguments.length?arguments[0]:void 0)}}),i)},48598:(t,e,r)=>{"use strict";var n=r(46518),i=r(79504),o=r(47055),a=r(25397),s=r(34598),u=i([].join),c=o!==Object,l=c||!s("join",",");n({target:"Array",proto:!0,forced:l},{join:function(t){return u(a(this),void 0===t?",":t)}})},48686:(t,e,r)=>{"use strict";var n=r(43724),i=r(79039);t.exports=n&&i((function(){return 42!==Object.defineProperty((function(){}),"prototype",{value:42,writable:!1}).prototype}))},48718:(t,e,r)=>{"use strict";var n=r(46518),i=r(77240),o=r(23061);n({target:"String",proto:!0,forced:o("sub")},{sub:function(){return i(this,"sub","","")}})},48773:(t,e)=>{"use strict";var r={}.propertyIsEnumerable,n=Object.getOwnPropertyDescriptor,i=n&&!r.call({1:2},1);e.f=i?function(t){var e=n(this,t);return!!e&&e.enumerable}:r},48980:(t,e,r)=>{"use strict";var n=r(46518),i=r(59213).findIndex,o=r(6469),a="findIndex",s=!0;a in[]&&Array(1)[a]((function(){s=!1})),n({target:"Array",proto:!0,forced:s},{findIndex:function(t){return i(this,t,arguments.length>1?arguments[1]:void 0)}}),o(a)},48981:(t,e,r)=>{"use strict";var n=r(67750),i=Object;t.exports=function(t){return i(n(t))}},49069:(t,e,r)=>{"use strict";r(25276),r(27495),r(5746),t.exports=function(t){var e="";return e+=t.protocol||"",e+=t.slashes?"//":"",e+=t.auth?t.auth+"@":"",t.hostname&&-1!==t.hostname.indexOf(":")?e+="["+t.hostname+"]":e+=t.hostname||"",e+=t.port?":"+t.port:"",e+=t.pathname||"",e+=t.search||"",e+=t.hash||"",e}},49108:(t,e,r)=>{"use strict";r(76918),r(44114),r(34782),r(23288),r(26099),r(27495),r(90906),r(38781);var n={};function i(t){var e,r,i=n[t];if(i)return i;for(i=n[t]=[],e=0;e<128;e++)r=String.fromCharCode(e),/^[0-9a-z]$/i.test(r)?i.push(r):i.push("%"+("0"+e.toString(16).toUpperCase()).slice(-2));for(e=0;e<t.length;e++)i[t.charCodeAt(e)]=t[e];return i}function o(t,e,r){var n,a,s,u,c,l="";for("string"!==typeof e&&(r=e,e=o.defaultChars),"undefined"===typeof r&&(r=!0),c=i(e),n=0,a=t.length;n<a;n++)if(s=t.charCodeAt(n),r&&37===s&&n+2<a&&/^[0-9a-f]{2}$/i.test(t.slice(n+1,n+3)))l+=t.slice(n,n+3),n+=2;else if(s<128)l+=c[s];else if(s>=55296&&s<=57343){if(s>=55296&&s<=56319&&n+1<a&&(u=t.charCodeAt(n+1),u>=56320&&u<=57343)){l+=encodeURIComponent(t[n]+t[n+1]),n++;continue}l+="%EF%BF%BD"}else l+=encodeURIComponent(t[n]);return l}o.defaultChars=";/?:@&=+$,-_.!~*'()#",o.componentChars="-_.!~*'()",t.exports=o},49773:(t,e,r)=>{"use strict";var n=r(46518),i=r(4495),o=r(79039),a=r(33717),s=r(48981),u=!i||o((function(){a.f(1)}));n({target:"Object",stat:!0,forced:u},{getOwnPropertySymbols:function(t){var e=a.f;return e?e(s(t)):[]}})},50113:(t,e,r)=>{"use strict";var n=r(46518),i=r(59213).find,o=r(6469),a="find",s=!0;a in[]&&Array(1)[a]((function(){s=!1})),n({target:"Array",proto:!0,forced:s},{find:function(t){return i(this,t,arguments.length>1?arguments[1]:void 0)}}),o(a)},50283:(t,e,r)=>{"use strict";var n=r(79504),i=r(79039),o=r(94901),a=r(39297),s=r(43724),u=r(10350).CONFIGURABLE,c=r(33706),l=r(91181),f=l.enforce,p=l.get,h=String,d=Object.defineProperty,v=n("".slice),g=n("".replace),m=n([].join),y=s&&!i((function(){return 8!==d((function(){}),"length",{value:8}).length})),b=String(String).split("String"),w=t.exports=function(t,e,r){"Symbol("===v(h(e),0,7)&&(e="["+g(h(e),/^Symbol\(([^)]*)\).*$/,"$1")+"]"),r&&r.getter&&(e="get "+e),r&&r.setter&&(e="set "+e),(!a(t,"name")||u&&t.name!==e)&&(s?d(t,"name",{value:e,configurable:!0}):t.name=e),y&&r&&a(r,"arity")&&t.length!==r.arity&&d(t,"length",{value:r.arity});try{r&&a(r,"constructor")&&r.constructor?s&&d(t,"prototype",{writable:!1}):t.prototype&&(t.prototype=void 0)}catch(i){}var n=f(t);return a(n,"source")||(n.source=m(b,"string"==typeof e?e:"")),t};Function.prototype.toString=w((function(){return o(this)&&p(this).source||c(this)}),"toString")},50360:(t,e,r)=>{"use strict";var n=r(22195),i=n.isFinite;t.exports=Number.isFinite||function(t){return"number"==typeof t&&i(t)}},50375:(t,e,r)=>{"use strict";var n=r(46518),i=r(79504),o=r(67750),a=r(91291),s=r(655),u=i("".slice),c=Math.max,l=Math.min,f=!"".substr||"b"!=="ab".substr(-1);n({target:"String",proto:!0,forced:f},{substr:function(t,e){var r,n,i=s(o(this)),f=i.length,p=a(t);return p===1/0&&(p=0),p<0&&(p=c(f+p,0)),r=void 0===e?f:a(e),r<=0||r===1/0?"":(n=l(p+r,f),p>=n?"":u(i,p,n))}})},50851:(t,e,r)=>{"use strict";var n=r(36955),i=r(55966),o=r(64117),a=r(26269),s=r(78227),u=s("iterator");t.exports=function(t){if(!o(t))return i(t,u)||i(t,"@@iterator")||a[n(t)]}},51003:(t,e,r)=>{"use strict";r.d(e,{A:()=>qr});var n={};r.r(n),r.d(n,{hasBrowserEnv:()=>Gt,hasStandardBrowserEnv:()=>Wt,hasStandardBrowserWebWorkerEnv:()=>Zt,navigator:()=>Ht,origin:()=>Kt});r(26099),r(47764),r(62953);var i=r(81587),o=r(54119);r(2259),r(78125),r(16280),r(76918),r(51629),r(25276),r(64346),r(62062),r(44114),r(34782),r(54743),r(46761),r(11745),r(38309),r(16573),r(78100),r(77936),r(60739),r(23288),r(94170),r(55081),r(18111),r(7588),r(4731),r(60479),r(25428),r(59904),r(67945),r(84185),r(81278),r(1480),r(40875),r(79432),r(27495),r(38781),r(25440),r(90744),r(42762),r(21489),r(48140),r(81630),r(72170),r(75044),r(69539),r(31694),r(89955),r(21903),r(91134),r(33206),r(44496),r(66651),r(12887),r(19369),r(66812),r(8995),r(31575),r(36072),r(88747),r(28845),r(29423),r(57301),r(373),r(86614),r(41405),r(37467),r(44732),r(33684),r(79577),r(23500),r(59848),r(122),r(13611),r(76031);function a(t,e){return function(){return t.apply(e,arguments)}}var s=Object.prototype.toString,u=Object.getPrototypeOf,c=function(t){return function(e){var r=s.call(e);return t[r]||(t[r]=r.slice(8,-1).toLowerCase())}}(Object.create(null)),l=function(t){return t=t.toLowerCase(),function(e){return c(e)===t}},f=function(t){return function(e){return(0,o.A)(e)===t}},p=Array.isArray,h=f("undefined");function d(t){return null!==t&&!h(t)&&null!==t.constructor&&!h(t.constructor)&&y(t.constructor.isBuffer)&&t.constructor.isBuffer(t)}var v=l("ArrayBuffer");function g(t){var e;return e="undefined"!==typeof ArrayBuffer&&ArrayBuffer.isView?ArrayBuffer.isView(t):t&&t.buffer&&v(t.buffer),e}var m=f("string"),y=f("function"),b=f("number"),w=function(t){return null!==t&&"object"===(0,o.A)(t)},_=function(t){return!0===t||!1===t},x=function(t){if("object"!==c(t))return!1;var e=u(t);return(null===e||e===Object.prototype||null===Object.getPrototypeOf(e))&&!(Symbol.toStringTag in t)&&!(Symbol.iterator in t)},A=l("Date"),k=l("File"),E=l("Blob"),S=l("FileList"),C=function(t){return w(t)&&y(t.pipe)},O=function(t){var e;return t&&("function"===typeof FormData&&t instanceof FormData||y(t.append)&&("formdata"===(e=c(t))||"object"===e&&y(t.toString)&&"[object FormData]"===t.toString()))},T=l("URLSearchParams"),R=["ReadableStream","Request","Response","Headers"].map(l),D=(0,i.A)(R,4),I=D[0],L=D[1],P=D[2],j=D[3],F=function(t){return t.trim?t.trim():t.replace(/^[\s\uFEFF\xA0]+|[\s\uFEFF\xA0]+$/g,"")};function M(t,e){var r,n,i=arguments.length>2&&void 0!==arguments[2]?arguments[2]:{},a=i.allOwnKeys,s=void 0!==a&&a;if(null!==t&&"undefined"!==typeof t)if("object"!==(0,o.A)(t)&&(t=[t]),p(t))for(r=0,n=t.length;r<n;r++)e.call(null,t[r],r,t);else{var u,c=s?Object.getOwnPropertyNames(t):Object.keys(t),l=c.length;for(r=0;r<l;r++)u=c[r],e.call(null,t[u],u,t)}}function N(t,e){e=e.toLowerCase();var r,n=Object.keys(t),i=n.length;while(i-- >0)if(r=n[i],e===r.toLowerCase())return r;return null}var q=function(){return"undefined"!==typeof globalThis?globalThis:"undefined"!==typeof self?self:"undefined"!==typeof window?window:global}(),U=function(t){return!h(t)&&t!==q};function B(){for(var t=U(this)&&this||{},e=t.caseless,r={},n=function(t,n){var i=e&&N(r,n)||n;x(r[i])&&x(t)?r[i]=B(r[i],t):x(t)?r[i]=B({},t):p(t)?r[i]=t.slice():r[i]=t},i=0,o=arguments.length;i<o;i++)arguments[i]&&M(arguments[i],n);return r}var z=function(t,e,r){var n=arguments.length>3&&void 0!==arguments[3]?arguments[3]:{},i=n.allOwnKeys;return M(e,(function(e,n){r&&y(e)?t[n]=a(e,r):t[n]=e}),{allOwnKeys:i}),t},V=function(t){return 65279===t.charCodeAt(0)&&(t=t.slice(1)),t},$=function(t,e,r,n){t.prototype=Object.create(e.prototype,n),t.prototype.constructor=t,Object.defineProperty(t,"super",{value:e.prototype}),r&&Object.assign(t.prototype,r)},G=function(t,e,r,n){var i,o,a,s={};if(e=e||{},null==t)return e;do{i=Object.getOwnPropertyNames(t),o=i.length;while(o-- >0)a=i[o],n&&!n(a,t,e)||s[a]||(e[a]=t[a],s[a]=!0);t=!1!==r&&u(t)}while(t&&(!r||r(t,e))&&t!==Object.prototype);return e},H=function(t,e,r){t=String(t),(void 0===r||r>t.length)&&(r=t.length),r-=e.length;var n=t.indexOf(e,r);return-1!==n&&n===r},W=function(t){if(!t)return null;if(p(t))return t;var e=t.length;if(!b(e))return null;var r=new Array(e);while(e-- >0)r[e]=t[e];return r},Z=function(t){return function(e){return t&&e instanceof t}}("undefined"!==typeof Uint8Array&&u(Uint8Array)),K=function(t,e){var r,n=t&&t[Symbol.iterator],i=n.call(t);while((r=i.next())&&!r.done){var o=r.value;e.call(t,o[0],o[1])}},Y=function(t,e){var r,n=[];while(null!==(r=t.exec(e)))n.push(r);return n},J=l("HTMLFormElement"),X=function(t){return t.toLowerCase().replace(/[-_\s]([a-z\d])(\w*)/g,(function(t,e,r){return e.toUpperCase()+r}))},Q=function(t){var e=t.hasOwnProperty;return function(t,r){return e.call(t,r)}}(Object.prototype),tt=l("RegExp"),et=function(t,e){var r=Object.getOwnPropertyDescriptors(t),n={};M(r,(function(r,i){var o;!1!==(o=e(r,i,t))&&(n[i]=o||r)})),Object.defineProperties(t,n)},rt=function(t){et(t,(function(e,r){if(y(t)&&-1!==["arguments","caller","callee"].indexOf(r))return!1;var n=t[r];y(n)&&(e.enumerable=!1,"writable"in e?e.writable=!1:e.set||(e.set=function(){throw Error("Can not rewrite read-only method '"+r+"'")}))}))},nt=function(t,e){var r={},n=function(t){t.forEach((function(t){r[t]=!0}))};return p(t)?n(t):n(String(t).split(e)),r},it=function(){},ot=function(t,e){return null!=t&&Number.isFinite(t=+t)?t:e};function at(t){return!!(t&&y(t.append)&&"FormData"===t[Symbol.toStringTag]&&t[Symbol.iterator])}var st=function(t){var e=new Array(10),r=function(t,n){if(w(t)){if(e.indexOf(t)>=0)return;if(!("toJSON"in t)){e[n]=t;var i=p(t)?[]:{};return M(t,(function(t,e){var o=r(t,n+1);!h(o)&&(i[e]=o)})),e[n]=void 0,i}}return t};return r(t,0)},ut=l("AsyncFunction"),ct=function(t){return t&&(w(t)||y(t))&&y(t.then)&&y(t["catch"])},lt=function(t,e){return t?setImmediate:e?function(t,e){return q.addEventListener("message",(function(r){var n=r.source,i=r.data;n===q&&i===t&&e.length&&e.shift()()}),!1),function(r){e.push(r),q.postMessage(t,"*")}}("axios@".concat(Math.random()),[]):function(t){return setTimeout(t)}}("function"===typeof setImmediate,y(q.postMessage)),ft="undefined"!==typeof queueMicrotask?queueMicrotask.bind(q):"undefined"!==typeof process&&process.nextTick||lt;const pt={isArray:p,isArrayBuffer:v,isBuffer:d,isFormData:O,isArrayBufferView:g,isString:m,isNumber:b,isBoolean:_,isObject:w,isPlainObject:x,isReadableStream:I,isRequest:L,isResponse:P,isHeaders:j,isUndefined:h,isDate:A,isFile:k,isBlob:E,isRegExp:tt,isFunction:y,isStream:C,isURLSearchParams:T,isTypedArray:Z,isFileList:S,forEach:M,merge:B,extend:z,trim:F,stripBOM:V,inherits:$,toFlatObject:G,kindOf:c,kindOfTest:l,endsWith:H,toArray:W,forEachEntry:K,matchAll:Y,isHTMLForm:J,hasOwnProperty:Q,hasOwnProp:Q,reduceDescriptors:et,freezeMethods:rt,toObjectSet:nt,toCamelCase:X,noop:it,toFiniteNumber:ot,findKey:N,global:q,isContextDefined:U,isSpecCompliantForm:at,toJSONObject:st,isAsyncFn:ut,isThenable:ct,setImmediate:lt,asap:ft};var ht=r(14048),dt=r(30388),vt=r(25026),gt=r(69492);r(28706),r(13609),r(99449),r(48598),r(61701),r(15086),r(1688),r(13579),r(61806),r(33110),r(90906),r(52675),r(89463),r(62010);function mt(t,e,r,n,i){Error.call(this),Error.captureStackTrace?Error.captureStackTrace(this,this.constructor):this.stack=(new Error).stack,this.message=t,this.name="AxiosError",e&&(this.code=e),r&&(this.config=r),n&&(this.request=n),i&&(this.response=i,this.status=i.status?i.status:null)}pt.inherits(mt,Error,{toJSON:function(){return{message:this.message,name:this.name,description:this.description,number:this.number,fileName:this.fileName,lineNumber:this.lineNumber,columnNumber:this.columnNumber,stack:this.stack,config:pt.toJSONObject(this.config),code:this.code,status:this.status}}});var yt=mt.prototype,bt={};["ERR_BAD_OPTION_VALUE","ERR_BAD_OPTION","ECONNABORTED","ETIMEDOUT","ERR_NETWORK","ERR_FR_TOO_MANY_REDIRECTS","ERR_DEPRECATED","ERR_BAD_RESPONSE","ERR_BAD_REQUEST","ERR_CANCELED","ERR_NOT_SUPPORT","ERR_INVALID_URL"].forEach((function(t){bt[t]={value:t}})),Object.defineProperties(mt,bt),Object.defineProperty(yt,"isAxiosError",{value:!0}),mt.from=function(t,e,r,n,i,o){var a=Object.create(yt);return pt.toFlatObject(t,a,(function(t){return t!==Error.prototype}),(function(t){return"isAxiosError"!==t})),mt.call(a,t.message,e,r,n,i),a.cause=t,a.name=t.name,o&&Object.assign(a,o),a};const wt=mt,_t=null;function xt(t){return pt.isPlainObject(t)||pt.isArray(t)}function At(t){return pt.endsWith(t,"[]")?t.slice(0,-2):t}function kt(t,e,r){return t?t.concat(e).map((function(t,e){return t=At(t),!r&&e?"["+t+"]":t})).join(r?".":""):e}function Et(t){return pt.isArray(t)&&!t.some(xt)}var St=pt.toFlatObject(pt,{},null,(function(t){return/^is[A-Z]/.test(t)}));function Ct(t,e,r){if(!pt.isObject(t))throw new TypeError("target must be an object");e=e||new(_t||FormData),r=pt.toFlatObject(r,{metaTokens:!0,dots:!1,indexes:!1},!1,(function(t,e){return!pt.isUndefined(e[t])}));var n=r.metaTokens,i=r.visitor||f,a=r.dots,s=r.indexes,u=r.Blob||"undefined"!==typeof Blob&&Blob,c=u&&pt.isSpecCompliantForm(e);if(!pt.isFunction(i))throw new TypeError("visitor must be a function");function l(t){if(null===t)return"";if(pt.isDate(t))return t.toISOString();if(!c&&pt.isBlob(t))throw new wt("Blob is not supported. Use a Buffer instead.");return pt.isArrayBuffer(t)||pt.isTypedArray(t)?c&&"function"===typeof Blob?new Blob([t]):Buffer.from(t):t}function f(t,r,i){var u=t;if(t&&!i&&"object"===(0,o.A)(t))if(pt.endsWith(r,"{}"))r=n?r:r.slice(0,-2),t=JSON.stringify(t);else if(pt.isArray(t)&&Et(t)||(pt.isFileList(t)||pt.endsWith(r,"[]"))&&(u=pt.toArray(t)))return r=At(r),u.forEach((function(t,n){!pt.isUndefined(t)&&null!==t&&e.append(!0===s?kt([r],n,a):null===s?r:r+"[]",l(t))})),!1;return!!xt(t)||(e.append(kt(i,r,a),l(t)),!1)}var p=[],h=Object.assign(St,{defaultVisitor:f,convertValue:l,isVisitable:xt});function d(t,r){if(!pt.isUndefined(t)){if(-1!==p.indexOf(t))throw Error("Circular reference detected in "+r.join("."));p.push(t),pt.forEach(t,(function(t,n){var o=!(pt.isUndefined(t)||null===t)&&i.call(e,t,pt.isString(n)?n.trim():n,r,h);!0===o&&d(t,r?r.concat(n):[n])})),p.pop()}}if(!pt.isObject(t))throw new TypeError("data must be an object");return d(t),e}const Ot=Ct;function Tt(t){var e={"!":"%21","'":"%27","(":"%28",")":"%29","~":"%7E","%20":"+","%00":"\0"};return encodeURIComponent(t).replace(/[!'()~]|%20|%00/g,(function(t){return e[t]}))}function Rt(t,e){this._pairs=[],t&&Ot(t,this,e)}var Dt=Rt.prototype;Dt.append=function(t,e){this._pairs.push([t,e])},Dt.toString=function(t){var e=t?function(e){return t.call(this,e,Tt)}:Tt;return this._pairs.map((function(t){return e(t[0])+"="+e(t[1])}),"").join("&")};const It=Rt;function Lt(t){return encodeURIComponent(t).replace(/%3A/gi,":").replace(/%24/g,"$").replace(/%2C/gi,",").replace(/%20/g,"+").replace(/%5B/gi,"[").replace(/%5D/gi,"]")}function Pt(t,e,r){if(!e)return t;var n=r&&r.encode||Lt;pt.isFunction(r)&&(r={serialize:r});var i,o=r&&r.serialize;if(i=o?o(e,r):pt.isURLSearchParams(e)?e.toString():new It(e,r).toString(n),i){var a=t.indexOf("#");-1!==a&&(t=t.slice(0,a)),t+=(-1===t.indexOf("?")?"?":"&")+i}return t}var jt=function(){function t(){(0,vt.A)(this,t),this.handlers=[]}return(0,gt.A)(t,[{key:"use",value:function(t,e,r){return this.handlers.push({fulfilled:t,rejected:e,synchronous:!!r&&r.synchronous,runWhen:r?r.runWhen:null}),this.handlers.length-1}},{key:"eject",value:function(t){this.handlers[t]&&(this.handlers[t]=null)}},{key:"clear",value:function(){this.handlers&&(this.handlers=[])}},{key:"forEach",value:function(t){pt.forEach(this.handlers,(function(e){null!==e&&t(e)}))}}])}();const Ft=jt,Mt={silentJSONParsing:!0,forcedJSONParsing:!0,clarifyTimeoutError:!1};r(2008),r(22489),r(83851);var Nt=r(23772);function qt(t,e){var r=Object.keys(t);if(Object.getOwnPropertySymbols){var n=Object.getOwnPropertySymbols(t);e&&(n=n.filter((function(e){return Object.getOwnPropertyDescriptor(t,e).enumerable}))),r.push.apply(r,n)}return r}function Ut(t){for(var e=1;e<arguments.length;e++){var r=null!=arguments[e]?arguments[e]:{};e%2?qt(Object(r),!0).forEach((function(e){(0,Nt.A)(t,e,r[e])})):Object.getOwnPropertyDescriptors?Object.defineProperties(t,Object.getOwnPropertyDescriptors(r)):qt(Object(r)).forEach((function(e){Object.defineProperty(t,e,Object.getOwnPropertyDescriptor(r,e))}))}return t}r(48408),r(14603),r(47566),r(98721);const Bt="undefined"!==typeof URLSearchParams?URLSearchParams:It,zt="undefined"!==typeof FormData?FormData:null,Vt="undefined"!==typeof Blob?Blob:null,$t={isBrowser:!0,classes:{URLSearchParams:Bt,FormData:zt,Blob:Vt},protocols:["http","https","file","blob","url","data"]};var Gt="undefined"!==typeof window&&"undefined"!==typeof document,Ht="object"===("undefined"===typeof navigator?"undefined":(0,o.A)(navigator))&&navigator||void 0,Wt=Gt&&(!Ht||["ReactNative","NativeScript","NS"].indexOf(Ht.product)<0),Zt=function(){return"undefined"!==typeof WorkerGlobalScope&&self instanceof WorkerGlobalScope&&"function"===typeof self.importScripts}(),Kt=Gt&&window.location.href||"http://localhost";const Yt=Ut(Ut({},n),$t);function Jt(t,e){return Ot(t,new Yt.classes.URLSearchParams,Object.assign({visitor:function(t,e,r,n){return Yt.isNode&&pt.isBuffer(t)?(this.append(e,t.toString("base64")),!1):n.defaultVisitor.apply(this,arguments)}},e))}r(28543);function Xt(t){return pt.matchAll(/\w+|\[(\w*)]/g,t).map((function(t){return"[]"===t[0]?"":t[1]||t[0]}))}function Qt(t){var e,r,n={},i=Object.keys(t),o=i.length;for(e=0;e<o;e++)r=i[e],n[r]=t[r];return n}function te(t){function e(t,r,n,i){var o=t[i++];if("__proto__"===o)return!0;var a=Number.isFinite(+o),s=i>=t.length;if(o=!o&&pt.isArray(n)?n.length:o,s)return pt.hasOwnProp(n,o)?n[o]=[n[o],r]:n[o]=r,!a;n[o]&&pt.isObject(n[o])||(n[o]=[]);var u=e(t,r,n[o],i);return u&&pt.isArray(n[o])&&(n[o]=Qt(n[o])),!a}if(pt.isFormData(t)&&pt.isFunction(t.entries)){var r={};return pt.forEachEntry(t,(function(t,n){e(Xt(t),n,r,0)})),r}return null}const ee=te;function re(t,e,r){if(pt.isString(t))try{return(e||JSON.parse)(t),pt.trim(t)}catch(n){if("SyntaxError"!==n.name)throw n}return(r||JSON.stringify)(t)}var ne={transitional:Mt,adapter:["xhr","http","fetch"],transformRequest:[function(t,e){var r=e.getContentType()||"",n=r.indexOf("application/json")>-1,i=pt.isObject(t);i&&pt.isHTMLForm(t)&&(t=new FormData(t));var o,a=pt.isFormData(t);if(a)return n?JSON.stringify(ee(t)):t;if(pt.isArrayBuffer(t)||pt.isBuffer(t)||pt.isStream(t)||pt.isFile(t)||pt.isBlob(t)||pt.isReadableStream(t))return t;if(pt.isArrayBufferView(t))return t.buffer;if(pt.isURLSearchParams(t))return e.setContentType("application/x-www-form-urlencoded;charset=utf-8",!1),t.toString();if(i){if(r.indexOf("application/x-www-form-urlencoded")>-1)return Jt(t,this.formSerializer).toString();if((o=pt.isFileList(t))||r.indexOf("multipart/form-data")>-1){var s=this.env&&this.env.FormData;return Ot(o?{"files[]":t}:t,s&&new s,this.formSerializer)}}return i||n?(e.setContentType("application/json",!1),re(t)):t}],transformResponse:[function(t){var e=this.transitional||ne.transitional,r=e&&e.forcedJSONParsing,n="json"===this.responseType;if(pt.isResponse(t)||pt.isReadableStream(t))return t;if(t&&pt.isString(t)&&(r&&!this.responseType||n)){var i=e&&e.silentJSONParsing,o=!i&&n;try{return JSON.parse(t)}catch(a){if(o){if("SyntaxError"===a.name)throw wt.from(a,wt.ERR_BAD_RESPONSE,this,null,this.response);throw a}}}return t}],timeout:0,xsrfCookieName:"XSRF-TOKEN",xsrfHeaderName:"X-XSRF-TOKEN",maxContentLength:-1,maxBodyLength:-1,env:{FormData:Yt.classes.FormData,Blob:Yt.classes.Blob},validateStatus:function(t){return t>=200&&t<300},headers:{common:{Accept:"application/json, text/plain, */*","Content-Type":void 0}}};pt.forEach(["delete","get","head","post","put","patch"],(function(t){ne.headers[t]={}}));const ie=ne;var oe=r(3604),ae=(r(5506),pt.toObjectSet(["age","authorization","content-length","content-type","etag","expires","from","host","if-modified-since","if-unmodified-since","last-modified","location","max-forwards","proxy-authorization","referer","retry-after","user-agent"]));const se=function(t){var e,r,n,i={};return t&&t.split("\n").forEach((function(t){n=t.indexOf(":"),e=t.substring(0,n).trim().toLowerCase(),r=t.substring(n+1).trim(),!e||i[e]&&ae[e]||("set-cookie"===e?i[e]?i[e].push(r):i[e]=[r]:i[e]=i[e]?i[e]+", "+r:r)})),i};var ue=Symbol("internals");function ce(t){return t&&String(t).trim().toLowerCase()}function le(t){return!1===t||null==t?t:pt.isArray(t)?t.map(le):String(t)}function fe(t){var e,r=Object.create(null),n=/([^\s,;=]+)\s*(?:=\s*([^,;]+))?/g;while(e=n.exec(t))r[e[1]]=e[2];return r}var pe=function(t){return/^[-_a-zA-Z0-9^`|~,!#$%&'*+.]+$/.test(t.trim())};function he(t,e,r,n,i){return pt.isFunction(n)?n.call(this,e,r):(i&&(e=r),pt.isString(e)?pt.isString(n)?-1!==e.indexOf(n):pt.isRegExp(n)?n.test(e):void 0:void 0)}function de(t){return t.trim().toLowerCase().replace(/([a-z\d])(\w*)/g,(function(t,e,r){return e.toUpperCase()+r}))}function ve(t,e){var r=pt.toCamelCase(" "+e);["get","set","has"].forEach((function(n){Object.defineProperty(t,n+r,{value:function(t,r,i){return this[n].call(this,e,t,r,i)},configurable:!0})}))}var ge=function(){function t(e){(0,vt.A)(this,t),e&&this.set(e)}return(0,gt.A)(t,[{key:"set",value:function(t,e,r){var n=this;function o(t,e,r){var i=ce(e);if(!i)throw new Error("header name must be a non-empty string");var o=pt.findKey(n,i);(!o||void 0===n[o]||!0===r||void 0===r&&!1!==n[o])&&(n[o||e]=le(t))}var a=function(t,e){return pt.forEach(t,(function(t,r){return o(t,r,e)}))};if(pt.isPlainObject(t)||t instanceof this.constructor)a(t,e);else if(pt.isString(t)&&(t=t.trim())&&!pe(t))a(se(t),e);else if(pt.isHeaders(t)){var s,u=(0,oe.A)(t.entries());try{for(u.s();!(s=u.n()).done;){var c=(0,i.A)(s.value,2),l=c[0],f=c[1];o(f,l,r)}}catch(p){u.e(p)}finally{u.f()}}else null!=t&&o(e,t,r);return this}},{key:"get",value:function(t,e){if(t=ce(t),t){var r=pt.findKey(this,t);if(r){var n=this[r];if(!e)return n;if(!0===e)return fe(n);if(pt.isFunction(e))return e.call(this,n,r);if(pt.isRegExp(e))return e.exec(n);throw new TypeError("parser must be boolean|regexp|function")}}}},{key:"has",value:function(t,e){if(t=ce(t),t){var r=pt.findKey(this,t);return!(!r||void 0===this[r]||e&&!he(this,this[r],r,e))}return!1}},{key:"delete",value:function(t,e){var r=this,n=!1;function i(t){if(t=ce(t),t){var i=pt.findKey(r,t);!i||e&&!he(r,r[i],i,e)||(delete r[i],n=!0)}}return pt.isArray(t)?t.forEach(i):i(t),n}},{key:"clear",value:function(t){var e=Object.keys(this),r=e.length,n=!1;while(r--){var i=e[r];t&&!he(this,this[i],i,t,!0)||(delete this[i],n=!0)}return n}},{key:"normalize",value:function(t){var e=this,r={};return pt.forEach(this,(function(n,i){var o=pt.findKey(r,i);if(o)return e[o]=le(n),void delete e[i];var a=t?de(i):String(i).trim();a!==i&&delete e[i],e[a]=le(n),r[a]=!0})),this}},{key:"concat",value:function(){for(var t,e=arguments.length,r=new Array(e),n=0;n<e;n++)r[n]=arguments[n];return(t=this.constructor).concat.apply(t,[this].concat(r))}},{key:"toJSON",value:function(t){var e=Object.create(null);return pt.forEach(this,(function(r,n){null!=r&&!1!==r&&(e[n]=t&&pt.isArray(r)?r.join(", "):r)})),e}},{key:Symbol.iterator,value:function(){return Object.entries(this.toJSON())[Symbol.iterator]()}},{key:"toString",value:function(){return Object.entries(this.toJSON()).map((function(t){var e=(0,i.A)(t,2),r=e[0],n=e[1];return r+": "+n})).join("\n")}},{key:Symbol.toStringTag,get:function(){return"AxiosHeaders"}}],[{key:"from",value:function(t){return t instanceof this?t:new this(t)}},{key:"concat",value:function(t){for(var e=new this(t),r=arguments.length,n=new Array(r>1?r-1:0),i=1;i<r;i++)n[i-1]=arguments[i];return n.forEach((function(t){return e.set(t)})),e}},{key:"accessor",value:function(t){var e=this[ue]=this[ue]={accessors:{}},r=e.accessors,n=this.prototype;function i(t){var e=ce(t);r[e]||(ve(n,t),r[e]=!0)}return pt.isArray(t)?t.forEach(i):i(t),this}}])}();ge.accessor(["Content-Type","Content-Length","Accept","Accept-Encoding","User-Agent","Authorization"]),pt.reduceDescriptors(ge.prototype,(function(t,e){var r=t.value,n=e[0].toUpperCase()+e.slice(1);return{get:function(){return r},set:function(t){this[n]=t}}})),pt.freezeMethods(ge);const me=ge;function ye(t,e){var r=this||ie,n=e||r,i=me.from(n.headers),o=n.data;return pt.forEach(t,(function(t){o=t.call(r,o,i.normalize(),e?e.status:void 0)})),i.normalize(),o}function be(t){return!(!t||!t.__CANCEL__)}function we(t,e,r){wt.call(this,null==t?"canceled":t,wt.ERR_CANCELED,e,r),this.name="CanceledError"}pt.inherits(we,wt,{__CANCEL__:!0});const _e=we;function xe(t,e,r){var n=r.config.validateStatus;r.status&&n&&!n(r.status)?e(new wt("Request failed with status code "+r.status,[wt.ERR_BAD_REQUEST,wt.ERR_BAD_RESPONSE][Math.floor(r.status/100)-4],r.config,r.request,r)):t(r)}function Ae(t){var e=/^([-+\w]{1,25})(:?\/\/|:)/.exec(t);return e&&e[1]||""}r(59089);function ke(t,e){t=t||10;var r,n=new Array(t),i=new Array(t),o=0,a=0;return e=void 0!==e?e:1e3,function(s){var u=Date.now(),c=i[a];r||(r=u),n[o]=s,i[o]=u;var l=a,f=0;while(l!==o)f+=n[l++],l%=t;if(o=(o+1)%t,o===a&&(a=(a+1)%t),!(u-r<e)){var p=c&&u-c;return p?Math.round(1e3*f/p):void 0}}}const Ee=ke;function Se(t,e){var r,n,i=0,o=1e3/e,a=function(e){var o=arguments.length>1&&void 0!==arguments[1]?arguments[1]:Date.now();i=o,r=null,n&&(clearTimeout(n),n=null),t.apply(null,e)},s=function(){for(var t=Date.now(),e=t-i,s=arguments.length,u=new Array(s),c=0;c<s;c++)u[c]=arguments[c];e>=o?a(u,t):(r=u,n||(n=setTimeout((function(){n=null,a(r)}),o-e)))},u=function(){return r&&a(r)};return[s,u]}const Ce=Se;var Oe=function(t,e){var r=arguments.length>2&&void 0!==arguments[2]?arguments[2]:3,n=0,i=Ee(50,250);return Ce((function(r){var o=r.loaded,a=r.lengthComputable?r.total:void 0,s=o-n,u=i(s),c=o<=a;n=o;var l=(0,Nt.A)({loaded:o,total:a,progress:a?o/a:void 0,bytes:s,rate:u||void 0,estimated:u&&a&&c?(a-o)/u:void 0,event:r,lengthComputable:null!=a},e?"download":"upload",!0);t(l)}),r)},Te=function(t,e){var r=null!=t;return[function(n){return e[0]({lengthComputable:r,total:t,loaded:n})},e[1]]},Re=function(t){return function(){for(var e=arguments.length,r=new Array(e),n=0;n<e;n++)r[n]=arguments[n];return pt.asap((function(){return t.apply(void 0,r)}))}},De=r(74721),Ie=r(47914),Le=r(22036),Pe=r(12635),je=r(3531);function Fe(t){return(0,Ie.A)(t)||(0,Le.A)(t)||(0,Pe.A)(t)||(0,je.A)()}r(88267),r(42207),r(55815),r(64979),r(79739),r(3296),r(27208);const Me=Yt.hasStandardBrowserEnv?function(t,e){return function(r){return r=new URL(r,Yt.origin),t.protocol===r.protocol&&t.host===r.host&&(e||t.port===r.port)}}(new URL(Yt.origin),Yt.navigator&&/(msie|trident)/i.test(Yt.navigator.userAgent)):function(){return!0};r(93515),r(84864),r(57465),r(87745),r(71761);const Ne=Yt.hasStandardBrowserEnv?{write:function(t,e,r,n,i,o){var a=[t+"="+encodeURIComponent(e)];pt.isNumber(r)&&a.push("expires="+new Date(r).toGMTString()),pt.isString(n)&&a.push("path="+n),pt.isString(i)&&a.push("domain="+i),!0===o&&a.push("secure"),document.cookie=a.join("; ")},read:function(t){var e=document.cookie.match(new RegExp("(^|;\\s*)("+t+")=([^;]*)"));return e?decodeURIComponent(e[3]):null},remove:function(t){this.write(t,"",Date.now()-864e5)}}:{write:function(){},read:function(){return null},remove:function(){}};function qe(t){return/^([a-z][a-z\d+\-.]*:)?\/\//i.test(t)}function Ue(t,e){return e?t.replace(/\/?\/$/,"")+"/"+e.replace(/^\/+/,""):t}function Be(t,e,r){var n=!qe(e);return t&&n||0==r?Ue(t,e):e}var ze=function(t){return t instanceof me?Ut({},t):t};function Ve(t,e){e=e||{};var r={};function n(t,e,r,n){return pt.isPlainObject(t)&&pt.isPlainObject(e)?pt.merge.call({caseless:n},t,e):pt.isPlainObject(e)?pt.merge({},e):pt.isArray(e)?e.slice():e}function i(t,e,r,i){return pt.isUndefined(e)?pt.isUndefined(t)?void 0:n(void 0,t,r,i):n(t,e,r,i)}function o(t,e){if(!pt.isUndefined(e))return n(void 0,e)}function a(t,e){return pt.isUndefined(e)?pt.isUndefined(t)?void 0:n(void 0,t):n(void 0,e)}function s(r,i,o){return o in e?n(r,i):o in t?n(void 0,r):void 0}var u={url:o,method:o,data:o,baseURL:a,transformRequest:a,transformResponse:a,paramsSerializer:a,timeout:a,timeoutMessage:a,withCredentials:a,withXSRFToken:a,adapter:a,responseType:a,xsrfCookieName:a,xsrfHeaderName:a,onUploadProgress:a,onDownloadProgress:a,decompress:a,maxContentLength:a,maxBodyLength:a,beforeRedirect:a,transport:a,httpAgent:a,httpsAgent:a,cancelToken:a,socketPath:a,responseEncoding:a,validateStatus:s,headers:function(t,e,r){return i(ze(t),ze(e),r,!0)}};return pt.forEach(Object.keys(Object.assign({},t,e)),(function(n){var o=u[n]||i,a=o(t[n],e[n],n);pt.isUndefined(a)&&o!==s||(r[n]=a)})),r}const $e=function(t){var e,r=Ve({},t),n=r.data,i=r.withXSRFToken,o=r.xsrfHeaderName,a=r.xsrfCookieName,s=r.headers,u=r.auth;if(r.headers=s=me.from(s),r.url=Pt(Be(r.baseURL,r.url),t.params,t.paramsSerializer),u&&s.set("Authorization","Basic "+btoa((u.username||"")+":"+(u.password?unescape(encodeURIComponent(u.password)):""))),pt.isFormData(n))if(Yt.hasStandardBrowserEnv||Yt.hasStandardBrowserWebWorkerEnv)s.setContentType(void 0);else if(!1!==(e=s.getContentType())){var c=e?e.split(";").map((function(t){return t.trim()})).filter(Boolean):[],l=Fe(c),f=l[0],p=l.slice(1);s.setContentType([f||"multipart/form-data"].concat((0,De.A)(p)).join("; "))}if(Yt.hasStandardBrowserEnv&&(i&&pt.isFunction(i)&&(i=i(r)),i||!1!==i&&Me(r.url))){var h=o&&a&&Ne.read(a);h&&s.set(o,h)}return r};var Ge="undefined"!==typeof XMLHttpRequest;const He=Ge&&function(t){return new Promise((function(e,r){var n,o,a,s,u,c=$e(t),l=c.data,f=me.from(c.headers).normalize(),p=c.responseType,h=c.onUploadProgress,d=c.onDownloadProgress;function v(){s&&s(),u&&u(),c.cancelToken&&c.cancelToken.unsubscribe(n),c.signal&&c.signal.removeEventListener("abort",n)}var g=new XMLHttpRequest;function m(){if(g){var n=me.from("getAllResponseHeaders"in g&&g.getAllResponseHeaders()),i=p&&"text"!==p&&"json"!==p?g.response:g.responseText,o={data:i,status:g.status,statusText:g.statusText,headers:n,config:t,request:g};xe((function(t){e(t),v()}),(function(t){r(t),v()}),o),g=null}}if(g.open(c.method.toUpperCase(),c.url,!0),g.timeout=c.timeout,"onloadend"in g?g.onloadend=m:g.onreadystatechange=function(){g&&4===g.readyState&&(0!==g.status||g.responseURL&&0===g.responseURL.indexOf("file:"))&&setTimeout(m)},g.onabort=function(){g&&(r(new wt("Request aborted",wt.ECONNABORTED,t,g)),g=null)},g.onerror=function(){r(new wt("Network Error",wt.ERR_NETWORK,t,g)),g=null},g.ontimeout=function(){var e=c.timeout?"timeout of "+c.timeout+"ms exceeded":"timeout exceeded",n=c.transitional||Mt;c.timeoutErrorMessage&&(e=c.timeoutErrorMessage),r(new wt(e,n.clarifyTimeoutError?wt.ETIMEDOUT:wt.ECONNABORTED,t,g)),g=null},void 0===l&&f.setContentType(null),"setRequestHeader"in g&&pt.forEach(f.toJSON(),(function(t,e){g.setRequestHeader(e,t)})),pt.isUndefined(c.withCredentials)||(g.withCredentials=!!c.withCredentials),p&&"json"!==p&&(g.responseType=c.responseType),d){var y=Oe(d,!0),b=(0,i.A)(y,2);a=b[0],u=b[1],g.addEventListener("progress",a)}if(h&&g.upload){var w=Oe(h),_=(0,i.A)(w,2);o=_[0],s=_[1],g.upload.addEventListener("progress",o),g.upload.addEventListener("loadend",s)}(c.cancelToken||c.signal)&&(n=function(e){g&&(r(!e||e.type?new _e(null,t,g):e),g.abort(),g=null)},c.cancelToken&&c.cancelToken.subscribe(n),c.signal&&(c.signal.aborted?n():c.signal.addEventListener("abort",n)));var x=Ae(c.url);x&&-1===Yt.protocols.indexOf(x)?r(new wt("Unsupported protocol "+x+":",wt.ERR_BAD_REQUEST,t)):g.send(l||null)}))};var We=function(t,e){var r=t=t?t.filter(Boolean):[],n=r.length;if(e||n){var i,o=new AbortController,a=function(t){if(!i){i=!0,u();var e=t instanceof Error?t:this.reason;o.abort(e instanceof wt?e:new _e(e instanceof Error?e.message:e))}},s=e&&setTimeout((function(){s=null,a(new wt("timeout ".concat(e," of ms exceeded"),wt.ETIMEDOUT))}),e),u=function(){t&&(s&&clearTimeout(s),s=null,t.forEach((function(t){t.unsubscribe?t.unsubscribe(a):t.removeEventListener("abort",a)})),t=null)};t.forEach((function(t){return t.addEventListener("abort",a)}));var c=o.signal;return c.unsubscribe=function(){return pt.asap(u)},c}};const Ze=We;r(66412);function Ke(t,e){this.v=t,this.k=e}function Ye(t){return function(){return new Je(t.apply(this,arguments))}}function Je(t){var e,r;function n(e,r){try{var o=t[e](r),a=o.value,s=a instanceof Ke;Promise.resolve(s?a.v:a).then((function(r){if(s){var u="return"===e?"return":"next";if(!a.k||r.done)return n(u,r);r=t[u](r).value}i(o.done?"return":"normal",r)}),(function(t){n("throw",t)}))}catch(t){i("throw",t)}}function i(t,i){switch(t){case"return":e.resolve({value:i,done:!0});break;case"throw":e.reject(i);break;default:e.resolve({value:i,done:!1})}(e=e.next)?n(e.key,e.arg):r=null}this._invoke=function(t,i){return new Promise((function(o,a){var s={key:t,arg:i,resolve:o,reject:a,next:null};r?r=r.next=s:(e=r=s,n(t,i))}))},"function"!=typeof t["return"]&&(this["return"]=void 0)}function Xe(t){return new Ke(t,0)}function Qe(t){var e={},r=!1;function n(e,n){return r=!0,n=new Promise((function(r){r(t[e](n))})),{done:!1,value:new Ke(n,1)}}return e["undefined"!=typeof Symbol&&Symbol.iterator||"@@iterator"]=function(){return this},e.next=function(t){return r?(r=!1,t):n("next",t)},"function"==typeof t["throw"]&&(e["throw"]=function(t){if(r)throw r=!1,t;return n("throw",t)}),"function"==typeof t["return"]&&(e["return"]=function(t){return r?(r=!1,t):n("return",t)}),e}function tr(t){var e,r,n,i=2;for("undefined"!=typeof Symbol&&(r=Symbol.asyncIterator,n=Symbol.iterator);i--;){if(r&&null!=(e=t[r]))return e.call(t);if(n&&null!=(e=t[n]))return new er(e.call(t));r="@@asyncIterator",n="@@iterator"}throw new TypeError("Object is not async iterable")}function er(t){function e(t){if(Object(t)!==t)return Promise.reject(new TypeError(t+" is not an object."));var e=t.done;return Promise.resolve(t.value).then((function(t){return{value:t,done:e}}))}return er=function(t){this.s=t,this.n=t.next},er.prototype={s:null,n:null,next:function(){return e(this.n.apply(this.s,arguments))},return:function(t){var r=this.s["return"];return void 0===r?Promise.resolve({value:t,done:!0}):e(r.apply(this.s,arguments))},throw:function(t){var r=this.s["return"];return void 0===r?Promise.reject(t):e(r.apply(this.s,arguments))}},new er(t)}Je.prototype["function"==typeof Symbol&&Symbol.asyncIterator||"@@asyncIterator"]=function(){return this},Je.prototype.next=function(t){return this._invoke("next",t)},Je.prototype["throw"]=function(t){return this._invoke("throw",t)},Je.prototype["return"]=function(t){return this._invoke("return",t)};var rr=(0,ht.A)().mark((function t(e,r){var n,i,o;return(0,ht.A)().wrap((function(t){while(1)switch(t.prev=t.next){case 0:if(n=e.byteLength,r&&!(n<r)){t.next=5;break}return t.next=4,e;case 4:return t.abrupt("return");case 5:i=0;case 6:if(!(i<n)){t.next=13;break}return o=i+r,t.next=10,e.slice(i,o);case 10:i=o,t.next=6;break;case 13:case"end":return t.stop()}}),t)})),nr=function(){var t=Ye((0,ht.A)().mark((function t(e,r){var n,i,o,a,s,u;return(0,ht.A)().wrap((function(t){while(1)switch(t.prev=t.next){case 0:n=!1,i=!1,t.prev=2,a=tr(ir(e));case 4:return t.next=6,Xe(a.next());case 6:if(!(n=!(s=t.sent).done)){t.next=12;break}return u=s.value,t.delegateYield(Qe(tr(rr(u,r)),Xe),"t0",9);case 9:n=!1,t.next=4;break;case 12:t.next=18;break;case 14:t.prev=14,t.t1=t["catch"](2),i=!0,o=t.t1;case 18:if(t.prev=18,t.prev=19,!n||null==a["return"]){t.next=23;break}return t.next=23,Xe(a["return"]());case 23:if(t.prev=23,!i){t.next=26;break}throw o;case 26:return t.finish(23);case 27:return t.finish(18);case 28:case"end":return t.stop()}}),t,null,[[2,14,18,28],[19,,23,27]])})));return function(e,r){return t.apply(this,arguments)}}(),ir=function(){var t=Ye((0,ht.A)().mark((function t(e){var r,n,i,o;return(0,ht.A)().wrap((function(t){while(1)switch(t.prev=t.next){case 0:if(!e[Symbol.asyncIterator]){t.next=3;break}return t.delegateYield(Qe(tr(e),Xe),"t0",2);case 2:return t.abrupt("return");case 3:r=e.getReader(),t.prev=4;case 5:return t.next=7,Xe(r.read());case 7:if(n=t.sent,i=n.done,o=n.value,!i){t.next=12;break}return t.abrupt("break",16);case 12:return t.next=14,o;case 14:t.next=5;break;case 16:return t.prev=16,t.next=19,Xe(r.cancel());case 19:return t.finish(16);case 20:case"end":return t.stop()}}),t,null,[[4,,16,20]])})));return function(e){return t.apply(this,arguments)}}(),or=function(t,e,r,n){var i,o=nr(t,e),a=0,s=function(t){i||(i=!0,n&&n(t))};return new ReadableStream({pull:function(t){return(0,dt.A)((0,ht.A)().mark((function e(){var n,i,u,c,l;return(0,ht.A)().wrap((function(e){while(1)switch(e.prev=e.next){case 0:return e.prev=0,e.next=3,o.next();case 3:if(n=e.sent,i=n.done,u=n.value,!i){e.next=10;break}return s(),t.close(),e.abrupt("return");case 10:c=u.byteLength,r&&(l=a+=c,r(l)),t.enqueue(new Uint8Array(u)),e.next=19;break;case 15:throw e.prev=15,e.t0=e["catch"](0),s(e.t0),e.t0;case 19:case"end":return e.stop()}}),e,null,[[0,15]])})))()},cancel:function(t){return s(t),o["return"]()}},{highWaterMark:2})},ar="function"===typeof fetch&&"function"===typeof Request&&"function"===typeof Response,sr=ar&&"function"===typeof ReadableStream,ur=ar&&("function"===typeof TextEncoder?function(t){return function(e){return t.encode(e)}}(new TextEncoder):function(){var t=(0,dt.A)((0,ht.A)().mark((function t(e){return(0,ht.A)().wrap((function(t){while(1)switch(t.prev=t.next){case 0:return t.t0=Uint8Array,t.next=3,new Response(e).arrayBuffer();case 3:return t.t1=t.sent,t.abrupt("return",new t.t0(t.t1));case 5:case"end":return t.stop()}}),t)})));return function(e){return t.apply(this,arguments)}}()),cr=function(t){try{for(var e=arguments.length,r=new Array(e>1?e-1:0),n=1;n<e;n++)r[n-1]=arguments[n];return!!t.apply(void 0,r)}catch(i){return!1}},lr=sr&&cr((function(){var t=!1,e=new Request(Yt.origin,{body:new ReadableStream,method:"POST",get duplex(){return t=!0,"half"}}).headers.has("Content-Type");return t&&!e})),fr=65536,pr=sr&&cr((function(){return pt.isReadableStream(new Response("").body)})),hr={stream:pr&&function(t){return t.body}};ar&&function(t){["text","arrayBuffer","blob","formData","stream"].forEach((function(e){!hr[e]&&(hr[e]=pt.isFunction(t[e])?function(t){return t[e]()}:function(t,r){throw new wt("Response type '".concat(e,"' is not supported"),wt.ERR_NOT_SUPPORT,r)})}))}(new Response);var dr=function(){var t=(0,dt.A)((0,ht.A)().mark((function t(e){var r;return(0,ht.A)().wrap((function(t){while(1)switch(t.prev=t.next){case 0:if(null!=e){t.next=2;break}return t.abrupt("return",0);case 2:if(!pt.isBlob(e)){t.next=4;break}return t.abrupt("return",e.size);case 4:if(!pt.isSpecCompliantForm(e)){t.next=9;break}return r=new Request(Yt.origin,{method:"POST",body:e}),t.next=8,r.arrayBuffer();case 8:return t.abrupt("return",t.sent.byteLength);case 9:if(!pt.isArrayBufferView(e)&&!pt.isArrayBuffer(e)){t.next=11;break}return t.abrupt("return",e.byteLength);case 11:if(pt.isURLSearchParams(e)&&(e+=""),!pt.isString(e)){t.next=16;break}return t.next=15,ur(e);case 15:return t.abrupt("return",t.sent.byteLength);case 16:case"end":return t.stop()}}),t)})));return function(e){return t.apply(this,arguments)}}(),vr=function(){var t=(0,dt.A)((0,ht.A)().mark((function t(e,r){var n;return(0,ht.A)().wrap((function(t){while(1)switch(t.prev=t.next){case 0:return n=pt.toFiniteNumber(e.getContentLength()),t.abrupt("return",null==n?dr(r):n);case 2:case"end":return t.stop()}}),t)})));return function(e,r){return t.apply(this,arguments)}}();const gr=ar&&function(){var t=(0,dt.A)((0,ht.A)().mark((function t(e){var r,n,o,a,s,u,c,l,f,p,h,d,v,g,m,y,b,w,_,x,A,k,E,S,C,O,T,R,D,I,L,P,j,F;return(0,ht.A)().wrap((function(t){while(1)switch(t.prev=t.next){case 0:if(r=$e(e),n=r.url,o=r.method,a=r.data,s=r.signal,u=r.cancelToken,c=r.timeout,l=r.onDownloadProgress,f=r.onUploadProgress,p=r.responseType,h=r.headers,d=r.withCredentials,v=void 0===d?"same-origin":d,g=r.fetchOptions,p=p?(p+"").toLowerCase():"text",m=Ze([s,u&&u.toAbortSignal()],c),b=m&&m.unsubscribe&&function(){m.unsubscribe()},t.prev=4,t.t0=f&&lr&&"get"!==o&&"head"!==o,!t.t0){t.next=11;break}return t.next=9,vr(h,a);case 9:t.t1=w=t.sent,t.t0=0!==t.t1;case 11:if(!t.t0){t.next=15;break}_=new Request(n,{method:"POST",body:a,duplex:"half"}),pt.isFormData(a)&&(x=_.headers.get("content-type"))&&h.setContentType(x),_.body&&(A=Te(w,Oe(Re(f))),k=(0,i.A)(A,2),E=k[0],S=k[1],a=or(_.body,fr,E,S));case 15:return pt.isString(v)||(v=v?"include":"omit"),C="credentials"in Request.prototype,y=new Request(n,Ut(Ut({},g),{},{signal:m,method:o.toUpperCase(),headers:h.normalize().toJSON(),body:a,duplex:"half",credentials:C?v:void 0})),t.next=20,fetch(y);case 20:return O=t.sent,T=pr&&("stream"===p||"response"===p),pr&&(l||T&&b)&&(R={},["status","statusText","headers"].forEach((function(t){R[t]=O[t]})),D=pt.toFiniteNumber(O.headers.get("content-length")),I=l&&Te(D,Oe(Re(l),!0))||[],L=(0,i.A)(I,2),P=L[0],j=L[1],O=new Response(or(O.body,fr,P,(function(){j&&j(),b&&b()})),R)),p=p||"text",t.next=26,hr[pt.findKey(hr,p)||"text"](O,e);case 26:return F=t.sent,!T&&b&&b(),t.next=30,new Promise((function(t,r){xe(t,r,{data:F,headers:me.from(O.headers),status:O.status,statusText:O.statusText,config:e,request:y})}));case 30:return t.abrupt("return",t.sent);case 33:if(t.prev=33,t.t2=t["catch"](4),b&&b(),!t.t2||"TypeError"!==t.t2.name||!/fetch/i.test(t.t2.message)){t.next=38;break}throw Object.assign(new wt("Network Error",wt.ERR_NETWORK,e,y),{cause:t.t2.cause||t.t2});case 38:throw wt.from(t.t2,t.t2&&t.t2.code,e,y);case 39:case"end":return t.stop()}}),t,null,[[4,33]])})));return function(e){return t.apply(this,arguments)}}();var mr={http:_t,xhr:He,fetch:gr};pt.forEach(mr,(function(t,e){if(t){try{Object.defineProperty(t,"name",{value:e})}catch(r){}Object.defineProperty(t,"adapterName",{value:e})}}));var yr=function(t){return"- ".concat(t)},br=function(t){return pt.isFunction(t)||null===t||!1===t};const wr={getAdapter:function(t){t=pt.isArray(t)?t:[t];for(var e,r,n=t,o=n.length,a={},s=0;s<o;s++){e=t[s];var u=void 0;if(r=e,!br(e)&&(r=mr[(u=String(e)).toLowerCase()],void 0===r))throw new wt("Unknown adapter '".concat(u,"'"));if(r)break;a[u||"#"+s]=r}if(!r){var c=Object.entries(a).map((function(t){var e=(0,i.A)(t,2),r=e[0],n=e[1];return"adapter ".concat(r," ")+(!1===n?"is not supported by the environment":"is not available in the build")})),l=o?c.length>1?"since :\n"+c.map(yr).join("\n"):" "+yr(c[0]):"as no adapter specified";throw new wt("There is no suitable adapter to dispatch the request "+l,"ERR_NOT_SUPPORT")}return r},adapters:mr};function _r(t){if(t.cancelToken&&t.cancelToken.throwIfRequested(),t.signal&&t.signal.aborted)throw new _e(null,t)}function xr(t){_r(t),t.headers=me.from(t.headers),t.data=ye.call(t,t.transformRequest),-1!==["post","put","patch"].indexOf(t.method)&&t.headers.setContentType("application/x-www-form-urlencoded",!1);var e=wr.getAdapter(t.adapter||ie.adapter);return e(t).then((function(e){return _r(t),e.data=ye.call(t,t.transformResponse,e),e.headers=me.from(e.headers),e}),(function(e){return be(e)||(_r(t),e&&e.response&&(e.response.data=ye.call(t,t.transformResponse,e.response),e.response.headers=me.from(e.response.headers))),Promise.reject(e)}))}var Ar="1.8.1",kr={};["object","boolean","number","function","string","symbol"].forEach((function(t,e){kr[t]=function(r){return(0,o.A)(r)===t||"a"+(e<1?"n ":" ")+t}}));var Er={};function Sr(t,e,r){if("object"!==(0,o.A)(t))throw new wt("options must be an object",wt.ERR_BAD_OPTION_VALUE);var n=Object.keys(t),i=n.length;while(i-- >0){var a=n[i],s=e[a];if(s){var u=t[a],c=void 0===u||s(u,a,t);if(!0!==c)throw new wt("option "+a+" must be "+c,wt.ERR_BAD_OPTION_VALUE)}else if(!0!==r)throw new wt("Unknown option "+a,wt.ERR_BAD_OPTION)}}kr.transitional=function(t,e,r){function n(t,e){return"[Axios v"+Ar+"] Transitional option '"+t+"'"+e+(r?". "+r:"")}return function(r,i,o){if(!1===t)throw new wt(n(i," has been removed"+(e?" in "+e:"")),wt.ERR_DEPRECATED);return e&&!Er[i]&&(Er[i]=!0,console.warn(n(i," has been deprecated since v"+e+" and will be removed in the near future"))),!t||t(r,i,o)}},kr.spelling=function(t){return function(e,r){return console.warn("".concat(r," is likely a misspelling of ").concat(t)),!0}};const Cr={assertOptions:Sr,validators:kr};var Or=Cr.validators,Tr=function(){function t(e){(0,vt.A)(this,t),this.defaults=e,this.interceptors={request:new Ft,response:new Ft}}return(0,gt.A)(t,[{key:"request",value:function(){var t=(0,dt.A)((0,ht.A)().mark((function t(e,r){var n,i;return(0,ht.A)().wrap((function(t){while(1)switch(t.prev=t.next){case 0:return t.prev=0,t.next=3,this._request(e,r);case 3:return t.abrupt("return",t.sent);case 6:if(t.prev=6,t.t0=t["catch"](0),t.t0 instanceof Error){n={},Error.captureStackTrace?Error.captureStackTrace(n):n=new Error,i=n.stack?n.stack.replace(/^.+\n/,""):"";try{t.t0.stack?i&&!String(t.t0.stack).endsWith(i.replace(/^.+\n.+\n/,""))&&(t.t0.stack+="\n"+i):t.t0.stack=i}catch(o){}}throw t.t0;case 10:case"end":return t.stop()}}),t,this,[[0,6]])})));function e(e,r){return t.apply(this,arguments)}return e}()},{key:"_request",value:function(t,e){"string"===typeof t?(e=e||{},e.url=t):e=t||{},e=Ve(this.defaults,e);var r=e,n=r.transitional,i=r.paramsSerializer,o=r.headers;void 0!==n&&Cr.assertOptions(n,{silentJSONParsing:Or.transitional(Or["boolean"]),forcedJSONParsing:Or.transitional(Or["boolean"]),clarifyTimeoutError:Or.transitional(Or["boolean"])},!1),null!=i&&(pt.isFunction(i)?e.paramsSerializer={serialize:i}:Cr.assertOptions(i,{encode:Or["function"],serialize:Or["function"]},!0)),void 0!==e.allowAbsoluteUrls||(void 0!==this.defaults.allowAbsoluteUrls?e.allowAbsoluteUrls=this.defaults.allowAbsoluteUrls:e.allowAbsoluteUrls=!0),Cr.assertOptions(e,{baseUrl:Or.spelling("baseURL"),withXsrfToken:Or.spelling("withXSRFToken")},!0),e.method=(e.method||this.defaults.method||"get").toLowerCase();var a=o&&pt.merge(o.common,o[e.method]);o&&pt.forEach(["delete","get","head","post","put","patch","common"],(function(t){delete o[t]})),e.headers=me.concat(a,o);var s=[],u=!0;this.interceptors.request.forEach((function(t){"function"===typeof t.runWhen&&!1===t.runWhen(e)||(u=u&&t.synchronous,s.unshift(t.fulfilled,t.rejected))}));var c,l=[];this.interceptors.response.forEach((function(t){l.push(t.fulfilled,t.rejected)}));var f,p=0;if(!u){var h=[xr.bind(this),void 0];h.unshift.apply(h,s),h.push.apply(h,l),f=h.length,c=Promise.resolve(e);while(p<f)c=c.then(h[p++],h[p++]);return c}f=s.length;var d=e;p=0;while(p<f){var v=s[p++],g=s[p++];try{d=v(d)}catch(m){g.call(this,m);break}}try{c=xr.call(this,d)}catch(m){return Promise.reject(m)}p=0,f=l.length;while(p<f)c=c.then(l[p++],l[p++]);return c}},{key:"getUri",value:function(t){t=Ve(this.defaults,t);var e=Be(t.baseURL,t.url,t.allowAbsoluteUrls);return Pt(e,t.params,t.paramsSerializer)}}])}();pt.forEach(["delete","get","head","options"],(function(t){Tr.prototype[t]=function(e,r){return this.request(Ve(r||{},{method:t,url:e,data:(r||{}).data}))}})),pt.forEach(["post","put","patch"],(function(t){function e(e){return function(r,n,i){return this.request(Ve(i||{},{method:t,headers:e?{"Content-Type":"multipart/form-data"}:{},url:r,data:n}))}}Tr.prototype[t]=e(),Tr.prototype[t+"Form"]=e(!0)}));const Rr=Tr;r(54554);var Dr=function(){function t(e){if((0,vt.A)(this,t),"function"!==typeof e)throw new TypeError("executor must be a function.");var r;this.promise=new Promise((function(t){r=t}));var n=this;this.promise.then((function(t){if(n._listeners){var e=n._listeners.length;while(e-- >0)n._listeners[e](t);n._listeners=null}})),this.promise.then=function(t){var e,r=new Promise((function(t){n.subscribe(t),e=t})).then(t);return r.cancel=function(){n.unsubscribe(e)},r},e((function(t,e,i){n.reason||(n.reason=new _e(t,e,i),r(n.reason))}))}return(0,gt.A)(t,[{key:"throwIfRequested",value:function(){if(this.reason)throw this.reason}},{key:"subscribe",value:function(t){this.reason?t(this.reason):this._listeners?this._listeners.push(t):this._listeners=[t]}},{key:"unsubscribe",value:function(t){if(this._listeners){var e=this._listeners.indexOf(t);-1!==e&&this._listeners.splice(e,1)}}},{key:"toAbortSignal",value:function(){var t=this,e=new AbortController,r=function(t){e.abort(t)};return this.subscribe(r),e.signal.unsubscribe=function(){return t.unsubscribe(r)},e.signal}}],[{key:"source",value:function(){var e,r=new t((function(t){e=t}));return{token:r,cancel:e}}}])}();const Ir=Dr;function Lr(t){return function(e){return t.apply(null,e)}}function Pr(t){return pt.isObject(t)&&!0===t.isAxiosError}var jr={Continue:100,SwitchingProtocols:101,Processing:102,EarlyHints:103,Ok:200,Created:201,Accepted:202,NonAuthoritativeInformation:203,NoContent:204,ResetContent:205,PartialContent:206,MultiStatus:207,AlreadyReported:208,ImUsed:226,MultipleChoices:300,MovedPermanently:301,Found:302,SeeOther:303,NotModified:304,UseProxy:305,Unused:306,TemporaryRedirect:307,PermanentRedirect:308,BadRequest:400,Unauthorized:401,PaymentRequired:402,Forbidden:403,NotFound:404,MethodNotAllowed:405,NotAcceptable:406,ProxyAuthenticationRequired:407,RequestTimeout:408,Conflict:409,Gone:410,LengthRequired:411,PreconditionFailed:412,PayloadTooLarge:413,UriTooLong:414,UnsupportedMediaType:415,RangeNotSatisfiable:416,ExpectationFailed:417,ImATeapot:418,MisdirectedRequest:421,UnprocessableEntity:422,Locked:423,FailedDependency:424,TooEarly:425,UpgradeRequired:426,PreconditionRequired:428,TooManyRequests:429,RequestHeaderFieldsTooLarge:431,UnavailableForLegalReasons:451,InternalServerError:500,NotImplemented:501,BadGateway:502,ServiceUnavailable:503,GatewayTimeout:504,HttpVersionNotSupported:505,VariantAlsoNegotiates:506,InsufficientStorage:507,LoopDetected:508,NotExtended:510,NetworkAuthenticationRequired:511};Object.entries(jr).forEach((function(t){var e=(0,i.A)(t,2),r=e[0],n=e[1];jr[n]=r}));const Fr=jr;function Mr(t){var e=new Rr(t),r=a(Rr.prototype.request,e);return pt.extend(r,Rr.prototype,e,{allOwnKeys:!0}),pt.extend(r,e,null,{allOwnKeys:!0}),r.create=function(e){return Mr(Ve(t,e))},r}var Nr=Mr(ie);Nr.Axios=Rr,Nr.CanceledError=_e,Nr.CancelToken=Ir,Nr.isCancel=be,Nr.VERSION=Ar,Nr.toFormData=Ot,Nr.AxiosError=wt,Nr.Cancel=Nr.CanceledError,Nr.all=function(t){return Promise.all(t)},Nr.spread=Lr,Nr.isAxiosError=Pr,Nr.mergeConfig=Ve,Nr.AxiosHeaders=me,Nr.formToJSON=function(t){return ee(pt.isHTMLForm(t)?new FormData(t):t)},Nr.getAdapter=wr.getAdapter,Nr.HttpStatusCode=Fr,Nr["default"]=Nr;const qr=Nr},51088:(t,e,r)=>{"use strict";var n=r(46518),i=r(69565),o=r(96395),a=r(10350),s=r(94901),u=r(33994),c=r(42787),l=r(52967),f=r(10687),p=r(66699),h=r(36840),d=r(78227),v=r(26269),g=r(57657),m=a.PROPER,y=a.CONFIGURABLE,b=g.IteratorPrototype,w=g.BUGGY_SAFARI_ITERATORS,_=d("iterator"),x="keys",A="values",k="entries",E=function(){return this};t.exports=function(t,e,r,a,d,g,S){u(r,e,a);var C,O,T,R=function(t){if(t===d&&j)return j;if(!w&&t&&t in L)return L[t];switch(t){case x:return function(){return new r(this,t)};case A:return function(){return new r(this,t)};case k:return function(){return new r(this,t)}}return function(){return new r(this)}},D=e+" Iterator",I=!1,L=t.prototype,P=L[_]||L["@@iterator"]||d&&L[d],j=!w&&P||R(d),F="Array"===e&&L.entries||P;if(F&&(C=c(F.call(new t)),C!==Object.prototype&&C.next&&(o||c(C)===b||(l?l(C,b):s(C[_])||h(C,_,E)),f(C,D,!0,!0),o&&(v[D]=E))),m&&d===A&&P&&P.name!==A&&(!o&&y?p(L,"name",A):(I=!0,j=function(){return i(P,this)})),d)if(O={values:R(A),keys:g?j:R(x),entries:R(k)},S)for(T in O)(w||I||!(T in L))&&h(L,T,O[T]);else n({target:e,proto:!0,forced:w||I},O);return o&&!S||L[_]===j||h(L,_,j,{name:d}),v[e]=j,O}},51481:(t,e,r)=>{"use strict";var n=r(46518),i=r(36043),o=r(10916).CONSTRUCTOR;n({target:"Promise",stat:!0,forced:o},{reject:function(t){var e=i.f(this),r=e.reject;return r(t),e.promise}})},51629:(t,e,r)=>{"use strict";var n=r(46518),i=r(90235);n({target:"Array",proto:!0,forced:[].forEach!==i},{forEach:i})},52675:(t,e,r)=>{"use strict";r(6761),r(81510),r(97812),r(33110),r(49773)},52703:(t,e,r)=>{"use strict";var n=r(22195),i=r(79039),o=r(79504),a=r(655),s=r(43802).trim,u=r(47452),c=n.parseInt,l=n.Symbol,f=l&&l.iterator,p=/^[+-]?0x/i,h=o(p.exec),d=8!==c(u+"08")||22!==c(u+"0x16")||f&&!i((function(){c(Object(f))}));t.exports=d?function(t,e){var r=s(a(t));return c(r,e>>>0||(h(p,r)?16:10))}:c},52811:(t,e,r)=>{"use strict";var n=r(46518),i=r(92744),o=r(79039),a=r(20034),s=r(3451).onFreeze,u=Object.freeze,c=o((function(){u(1)}));n({target:"Object",stat:!0,forced:c,sham:!i},{freeze:function(t){return u&&a(t)?u(s(t)):t}})},52967:(t,e,r)=>{"use strict";var n=r(46706),i=r(20034),o=r(67750),a=r(73506);t.exports=Object.setPrototypeOf||("__proto__"in{}?function(){var t,e=!1,r={};try{t=n(Object.prototype,"__proto__","set"),t(r,[]),e=r instanceof Array}catch(s){}return function(r,n){return o(r),a(n),i(r)?(e?t(r,n):r.__proto__=n,r):r}}():void 0)},53069:(t,e,r)=>{"use strict";r(48598),t.exports=function(t){var e={};e.src_Any=r(87746).source,e.src_Cc=r(4919).source,e.src_Z=r(64535).source,e.src_P=r(44557).source,e.src_ZPCc=[e.src_Z,e.src_P,e.src_Cc].join("|"),e.src_ZCc=[e.src_Z,e.src_Cc].join("|");var n="[><｜]";return e.src_pseudo_letter="(?:(?!"+n+"|"+e.src_ZPCc+")"+e.src_Any+")",e.src_ip4="(?:(25[0-5]|2[0-4][0-9]|[01]?[0-9][0-9]?)\\.){3}(25[0-5]|2[0-4][0-9]|[01]?[0-9][0-9]?)",e.src_auth="(?:(?:(?!"+e.src_ZCc+"|[@/\\[\\]()]).)+@)?",e.src_port="(?::(?:6(?:[0-4]\\d{3}|5(?:[0-4]\\d{2}|5(?:[0-2]\\d|3[0-5])))|[1-5]?\\d{1,4}))?",e.src_host_terminator="(?=$|"+n+"|"+e.src_ZPCc+")(?!-|_|:\\d|\\.-|\\.(?!$|"+e.src_ZPCc+"))",e.src_path="(?:[/?#](?:(?!"+e.src_ZCc+"|"+n+"|[()[\\]{}.,\"'?!\\-;]).|\\[(?:(?!"+e.src_ZCc+"|\\]).)*\\]|\\((?:(?!"+e.src_ZCc+"|[)]).)*\\)|\\{(?:(?!"+e.src_ZCc+'|[}]).)*\\}|\\"(?:(?!'+e.src_ZCc+'|["]).)+\\"|\\\'(?:(?!'+e.src_ZCc+"|[']).)+\\'|\\'(?="+e.src_pseudo_letter+"|[-]).|\\.{2,}[a-zA-Z0-9%/&]|\\.(?!"+e.src_ZCc+"|[.]).|"+(t&&t["---"]?"\\-(?!--(?:[^-]|$))(?:-*)|":"\\-+|")+",(?!"+e.src_ZCc+").|;(?!"+e.src_ZCc+").|\\!+(?!"+e.src_ZCc+"|[!]).|\\?(?!"+e.src_ZCc+"|[?]).)+|\\/)?",e.src_email_name='[\\-;:&=\\+\\$,\\.a-zA-Z0-9_][\\-;:&=\\+\\$,\\"\\.a-zA-Z0-9_]*',e.src_xn="xn--[a-z0-9\\-]{1,59}",e.src_domain_root="(?:"+e.src_xn+"|"+e.src_pseudo_letter+"{1,63})",e.src_domain="(?:"+e.src_xn+"|(?:"+e.src_pseudo_letter+")|(?:"+e.src_pseudo_letter+"(?:-|"+e.src_pseudo_letter+"){0,61}"+e.src_pseudo_letter+"))",e.src_host="(?:(?:(?:(?:"+e.src_domain+")\\.)*"+e.src_domain+"))",e.tpl_host_fuzzy="(?:"+e.src_ip4+"|(?:(?:(?:"+e.src_domain+")\\.)+(?:%TLDS%)))",e.tpl_host_no_ip_fuzzy="(?:(?:(?:"+e.src_domain+")\\.)+(?:%TLDS%))",e.src_host_strict=e.src_host+e.src_host_terminator,e.tpl_host_fuzzy_strict=e.tpl_host_fuzzy+e.src_host_terminator,e.src_host_port_strict=e.src_host+e.src_port+e.src_host_terminator,e.tpl_host_port_fuzzy_strict=e.tpl_host_fuzzy+e.src_port+e.src_host_terminator,e.tpl_host_port_no_ip_fuzzy_strict=e.tpl_host_no_ip_fuzzy+e.src_port+e.src_host_terminator,e.tpl_host_fuzzy_test="localhost|www\\.|\\.\\d{1,3}\\.|(?:\\.(?:%TLDS%)(?:"+e.src_ZPCc+"|>|$))",e.tpl_email_fuzzy="(^|"+n+'|"|\\(|'+e.src_ZCc+")("+e.src_email_name+"@"+e.tpl_host_fuzzy_strict+")",e.tpl_link_fuzzy="(^|(?![.:/\\-_@])(?:[$+<=>^`|｜]|"+e.src_ZPCc+"))((?![$+<=>^`|｜])"+e.tpl_host_port_fuzzy_strict+e.src_path+")",e.tpl_link_no_ip_fuzzy="(^|(?![.:/\\-_@])(?:[$+<=>^`|｜]|"+e.src_ZPCc+"))((?![$+<=>^`|｜])"+e.tpl_host_port_no_ip_fuzzy_strict+e.src_path+")",e}},53087:(t,e,r)=>{"use strict";r(44114),r(27495),r(90906),r(50375);var n=r(8700).isWhiteSpace,i=r(8700).isPunctChar,o=r(8700).isMdAsciiPunct,a=/['"]/,s=/['"]/g,u="’";function c(t,e,r){return t.substr(0,e)+r+t.substr(e+1)}function l(t,e){var r,a,l,f,p,h,d,v,g,m,y,b,w,_,x,A,k,E,S,C,O;for(S=[],r=0;r<t.length;r++){for(a=t[r],d=t[r].level,k=S.length-1;k>=0;k--)if(S[k].level<=d)break;if(S.length=k+1,"text"===a.type){l=a.content,p=0,h=l.length;t:while(p<h){if(s.lastIndex=p,f=s.exec(l),!f)break;if(x=A=!0,p=f.index+1,E="'"===f[0],g=32,f.index-1>=0)g=l.charCodeAt(f.index-1);else for(k=r-1;k>=0;k--){if("softbreak"===t[k].type||"hardbreak"===t[k].type)break;if(t[k].content){g=t[k].content.charCodeAt(t[k].content.length-1);break}}if(m=32,p<h)m=l.charCodeAt(p);else for(k=r+1;k<t.length;k++){if("softbreak"===t[k].type||"hardbreak"===t[k].type)break;if(t[k].content){m=t[k].content.charCodeAt(0);break}}if(y=o(g)||i(String.fromCharCode(g)),b=o(m)||i(String.fromCharCode(m)),w=n(g),_=n(m),_?x=!1:b&&(w||y||(x=!1)),w?A=!1:y&&(_||b||(A=!1)),34===m&&'"'===f[0]&&g>=48&&g<=57&&(A=x=!1),x&&A&&(x=y,A=b),x||A){if(A)for(k=S.length-1;k>=0;k--){if(v=S[k],S[k].level<d)break;if(v.single===E&&S[k].level===d){v=S[k],E?(C=e.md.options.quotes[2],O=e.md.options.quotes[3]):(C=e.md.options.quotes[0],O=e.md.options.quotes[1]),a.content=c(a.content,f.index,O),t[v.token].content=c(t[v.token].content,v.pos,C),p+=O.length-1,v.token===r&&(p+=C.length-1),l=a.content,h=l.length,S.length=k;continue t}}x?S.push({token:r,pos:f.index,single:E,level:d}):A&&E&&(a.content=c(a.content,f.index,u))}else E&&(a.content=c(a.content,f.index,u))}}}}t.exports=function(t){var e;if(t.md.options.typographer)for(e=t.tokens.length-1;e>=0;e--)"inline"===t.tokens[e].type&&a.test(t.tokens[e].content)&&l(t.tokens[e].children,t)}},53179:(t,e,r)=>{"use strict";var n=r(92140),i=r(36955);t.exports=n?{}.toString:function(){return"[object "+i(this)+"]"}},53602:t=>{"use strict";var e=2220446049250313e-31,r=1/e;t.exports=function(t){return t+r-r}},53640:(t,e,r)=>{"use strict";var n=r(28551),i=r(84270),o=TypeError;t.exports=function(t){if(n(this),"string"===t||"default"===t)t="string";else if("number"!==t)throw new o("Incorrect hint");return i(this,t)}},53838:(t,e,r)=>{"use strict";var n=r(97080),i=r(25170),o=r(38469),a=r(83789);t.exports=function(t){var e=n(this),r=a(t);return!(i(e)>r.size)&&!1!==o(e,(function(t){if(!r.includes(t))return!1}),!0)}},54119:(t,e,r)=>{"use strict";r.d(e,{A:()=>n});r(52675),r(89463),r(2259),r(26099),r(47764),r(62953);function n(t){return n="function"==typeof Symbol&&"symbol"==typeof Symbol.iterator?function(t){return typeof t}:function(t){return t&&"function"==typeof Symbol&&t.constructor===Symbol&&t!==Symbol.prototype?"symbol":typeof t},n(t)}},54475:(t,e,r)=>{"use strict";r(48598),r(62062),r(44114),r(18111),r(61701),r(26099);var n=r(8700).isSpace;t.exports=function(t,e,r,i){var o,a,s,u,c=t.bMarks[e]+t.tShift[e],l=t.eMarks[e];if(t.sCount[e]-t.blkIndent>=4)return!1;if(o=t.src.charCodeAt(c++),42!==o&&45!==o&&95!==o)return!1;a=1;while(c<l){if(s=t.src.charCodeAt(c++),s!==o&&!n(s))return!1;s===o&&a++}return!(a<3)&&(i||(t.line=e+1,u=t.push("hr","hr",0),u.map=[e,t.line],u.markup=Array(a+1).join(String.fromCharCode(o))),!0)}},54554:(t,e,r)=>{"use strict";var n=r(46518),i=r(48981),o=r(35610),a=r(91291),s=r(26198),u=r(34527),c=r(96837),l=r(1469),f=r(97040),p=r(84606),h=r(70597),d=h("splice"),v=Math.max,g=Math.min;n({target:"Array",proto:!0,forced:!d},{splice:function(t,e){var r,n,h,d,m,y,b=i(this),w=s(b),_=o(t,w),x=arguments.length;for(0===x?r=n=0:1===x?(r=0,n=w-_):(r=x-2,n=g(v(a(e),0),w-_)),c(w+r-n),h=l(b,n),d=0;d<n;d++)m=_+d,m in b&&f(h,d,b[m]);if(h.length=n,r<n){for(d=_;d<w-n;d++)m=d+n,y=d+r,m in b?b[y]=b[m]:p(b,y);for(d=w;d>w-n+r;d--)p(b,d-1)}else if(r>n)for(d=w-n;d>_;d--)m=d+n-1,y=d+r-1,m in b?b[y]=b[m]:p(b,y);for(d=0;d<r;d++)b[d+_]=arguments[d+2];return u(b,w-n+r),h}})},54743:(t,e,r)=>{"use strict";var n=r(46518),i=r(22195),o=r(66346),a=r(87633),s="ArrayBuffer",u=o[s],c=i[s];n({global:!0,constructor:!0,forced:c!==u},{ArrayBuffer:u}),a(s)},55002:t=>{"use strict";t.exports={IndexSizeError:{s:"INDEX_SIZE_ERR",c:1,m:1},DOMStringSizeError:{s:"DOMSTRING_SIZE_ERR",c:2,m:0},HierarchyRequestError:{s:"HIERARCHY_REQUEST_ERR",c:3,m:1},WrongDocumentError:{s:"WRONG_DOCUMENT_ERR",c:4,m:1},InvalidCharacterError:{s:"INVALID_CHARACTER_ERR",c:5,m:1},NoDataAllowedError:{s:"NO_DATA_ALLOWED_ERR",c:6,m:0},NoModificationAllowedError:{s:"NO_MODIFICATION_ALLOWED_ERR",c:7,m:1},NotFoundError:{s:"NOT_FOUND_ERR",c:8,m:1},NotSupportedError:{s:"NOT_SUPPORTED_ERR",c:9,m:1},InUseAttributeError:{s:"INUSE_ATTRIBUTE_ERR",c:10,m:1},InvalidStateError:{s:"INVALID_STATE_ERR",c:11,m:1},SyntaxError:{s:"SYNTAX_ERR",c:12,m:1},InvalidModificationError:{s:"INVALID_MODIFICATION_ERR",c:13,m:1},NamespaceError:{s:"NAMESPACE_ERR",c:14,m:1},InvalidAccessError:{s:"INVALID_ACCESS_ERR",c:15,m:1},ValidationError:{s:"VALIDATION_ERR",c:16,m:0},TypeMismatchError:{s:"TYPE_MISMATCH_ERR",c:17,m:1},SecurityError:{s:"SECURITY_ERR",c:18,m:1},NetworkError:{s:"NETWORK_ERR",c:19,m:1},AbortError:{s:"ABORT_ERR",c:20,m:1},URLMismatchError:{s:"URL_MISMATCH_ERR",c:21,m:1},QuotaExceededError:{s:"QUOTA_EXCEEDED_ERR",c:22,m:1},TimeoutError:{s:"TIMEOUT_ERR",c:23,m:1},InvalidNodeTypeError:{s:"INVALID_NODE_TYPE_ERR",c:24,m:1},DataCloneError:{s:"DATA_CLONE_ERR",c:25,m:1}}},55081:(t,e,r)=>{"use strict";var n=r(46518),i=r(22195);n({global:!0,forced:i.globalThis!==i},{globalThis:i})},55169:(t,e,r)=>{"use strict";var n=r(3238),i=TypeError;t.exports=function(t){if(n(t))throw new i("ArrayBuffer is detached");return t}},55672:(t,e,r)=>{"use strict";t.exports.encode=r(49108),t.exports.decode=r(21212),t.exports.format=r(49069),t.exports.parse=r(56011)},55815:(t,e,r)=>{"use strict";var n=r(46518),i=r(97751),o=r(89429),a=r(79039),s=r(2360),u=r(6980),c=r(24913).f,l=r(36840),f=r(62106),p=r(39297),h=r(90679),d=r(28551),v=r(77536),g=r(32603),m=r(55002),y=r(38574),b=r(91181),w=r(43724),_=r(96395),x="DOMException",A="DATA_CLONE_ERR",k=i("Error"),E=i(x)||function(){try{var t=i("MessageChannel")||o("worker_threads").MessageChannel;(new t).port1.postMessage(new WeakMap)}catch(e){if(e.name===A&&25===e.code)return e.constructor}}(),S=E&&E.prototype,C=k.prototype,O=b.set,T=b.getterFor(x),R="stack"in new k(x),D=function(t){return p(m,t)&&m[t].m?m[t].c:0},I=function(){h(this,L);var t=arguments.length,e=g(t<1?void 0:arguments[0]),r=g(t<2?void 0:arguments[1],"Error"),n=D(r);if(O(this,{type:x,name:r,message:e,code:n}),w||(this.name=r,this.message=e,this.code=n),R){var i=new k(e);i.name=x,c(this,"stack",u(1,y(i.stack,1)))}},L=I.prototype=s(C),P=function(t){return{enumerable:!0,configurable:!0,get:t}},j=function(t){return P((function(){return T(this)[t]}))};w&&(f(L,"code",j("code")),f(L,"message",j("message")),f(L,"name",j("name"))),c(L,"constructor",u(1,I));var F=a((function(){return!(new E instanceof k)})),M=F||a((function(){return C.toString!==v||"2: 1"!==String(new E(1,2))})),N=F||a((function(){return 25!==new E(1,"DataCloneError").code})),q=F||25!==E[A]||25!==S[A],U=_?M||N||q:F;n({global:!0,constructor:!0,forced:U},{DOMException:U?I:E});var B=i(x),z=B.prototype;for(var V in M&&(_||E===B)&&l(z,"toString",v),N&&w&&E===B&&f(z,"code",P((function(){return D(d(this).name)}))),m)if(p(m,V)){var $=m[V],G=$.s,H=u(6,$.c);p(B,G)||c(B,G,H),p(z,G)||c(z,G,H)}},55966:(t,e,r)=>{"use strict";var n=r(79306),i=r(64117);t.exports=function(t,e){var r=t[e];return i(r)?void 0:n(r)}},56011:(t,e,r)=>{"use strict";function n(){this.protocol=null,this.slashes=null,this.auth=null,this.port=null,this.hostname=null,this.hash=null,this.search=null,this.pathname=null}r(28706),r(25276),r(48598),r(8921),r(44114),r(34782),r(13609),r(27495),r(71761),r(5746),r(90744),r(50375),r(42762);var i=/^([a-z0-9.+-]+:)/i,o=/:[0-9]*$/,a=/^(\/\/?(?!\/)[^\?\s]*)(\?[^\s]*)?$/,s=["<",">",'"',"`"," ","\r","\n","\t"],u=["{","}","|","\\","^","`"].concat(s),c=["'"].concat(u),l=["%","/","?",";","#"].concat(c),f=["/","?","#"],p=255,h=/^[+a-z0-9A-Z_-]{0,63}$/,d=/^([+a-z0-9A-Z_-]{0,63})(.*)$/,v={javascript:!0,"javascript:":!0},g={http:!0,https:!0,ftp:!0,gopher:!0,file:!0,"http:":!0,"https:":!0,"ftp:":!0,"gopher:":!0,"file:":!0};function m(t,e){if(t&&t instanceof n)return t;var r=new n;return r.parse(t,e),r}n.prototype.parse=function(t,e){var r,n,o,s,u,c=t;if(c=c.trim(),!e&&1===t.split("#").length){var m=a.exec(c);if(m)return this.pathname=m[1],m[2]&&(this.search=m[2]),this}var y=i.exec(c);if(y&&(y=y[0],o=y.toLowerCase(),this.protocol=y,c=c.substr(y.length)),(e||y||c.match(/^\/\/[^@\/]+@[^@\/]+/))&&(u="//"===c.substr(0,2),!u||y&&v[y]||(c=c.substr(2),this.slashes=!0)),!v[y]&&(u||y&&!g[y])){var b,w,_=-1;for(r=0;r<f.length;r++)s=c.indexOf(f[r]),-1!==s&&(-1===_||s<_)&&(_=s);for(w=-1===_?c.lastIndexOf("@"):c.lastIndexOf("@",_),-1!==w&&(b=c.slice(0,w),c=c.slice(w+1),this.auth=b),_=-1,r=0;r<l.length;r++)s=c.indexOf(l[r]),-1!==s&&(-1===_||s<_)&&(_=s);-1===_&&(_=c.length),":"===c[_-1]&&_--;var x=c.slice(0,_);c=c.slice(_),this.parseHost(x),this.hostname=this.hostname||"";var A="["===this.hostname[0]&&"]"===this.hostname[this.hostname.length-1];if(!A){var k=this.hostname.split(/\./);for(r=0,n=k.length;r<n;r++){var E=k[r];if(E&&!E.match(h)){for(var S="",C=0,O=E.length;C<O;C++)E.charCodeAt(C)>127?S+="x":S+=E[C];if(!S.match(h)){var T=k.slice(0,r),R=k.slice(r+1),D=E.match(d);D&&(T.push(D[1]),R.unshift(D[2])),R.length&&(c=R.join(".")+c),this.hostname=T.join(".");break}}}}this.hostname.length>p&&(this.hostname=""),A&&(this.hostname=this.hostname.substr(1,this.hostname.length-2))}var I=c.indexOf("#");-1!==I&&(this.hash=c.substr(I),c=c.slice(0,I));var L=c.indexOf("?");return-1!==L&&(this.search=c.substr(L),c=c.slice(0,L)),c&&(this.pathname=c),g[o]&&this.hostname&&!this.pathname&&(this.pathname=""),this},n.prototype.parseHost=function(t){var e=o.exec(t);e&&(e=e[0],":"!==e&&(this.port=e.substr(1)),t=t.substr(0,t.length-e.length)),t&&(this.hostname=t)},t.exports=m},56279:(t,e,r)=>{"use strict";var n=r(36840);t.exports=function(t,e,r){for(var i in e)n(t,i,e[i],r);return t}},56682:(t,e,r)=>{"use strict";var n=r(69565),i=r(28551),o=r(94901),a=r(44576),s=r(57323),u=TypeError;t.exports=function(t,e){var r=t.exec;if(o(r)){var c=n(r,t,e);return null!==c&&i(c),c}if("RegExp"===a(t))return n(s,t,e);throw new u("RegExp#exec called on incompatible receiver")}},56768:(t,e,r)=>{"use strict";r.d(e,{$u:()=>Ct,CE:()=>gr,Df:()=>ct,EW:()=>an,FK:()=>or,Fv:()=>Or,Gt:()=>le,Gy:()=>J,K9:()=>Ie,Lk:()=>xr,MZ:()=>ut,OW:()=>ot,Q3:()=>Tr,QP:()=>Q,WQ:()=>fe,Wv:()=>mr,bF:()=>Ar,bo:()=>j,dY:()=>x,eW:()=>Cr,g2:()=>jt,h:()=>sn,k6:()=>P,nI:()=>Br,pI:()=>qt,pM:()=>lt,pR:()=>nt,qL:()=>p,sV:()=>Et,uX:()=>fr,wB:()=>Ve});var n,i=r(3604),o=r(81587),a=r(54119),s=r(23772),u=r(74721),c=(r(52675),r(89463),r(2259),r(78125),r(20326),r(16280),r(76918),r(28706),r(88431),r(2008),r(51629),r(23418),r(74423),r(25276),r(48598),r(62062),r(44114),r(72712),r(34782),r(15086),r(26910),r(54554),r(13609),r(59089),r(60739),r(23288),r(94170),r(62010),r(18111),r(81148),r(22489),r(7588),r(61701),r(18237),r(13579),r(33110),r(4731),r(36033),r(60479),r(2892),r(32637),r(59904),r(84185),r(52811),r(83851),r(40875),r(79432),r(26099),r(58940),r(87411),r(40888),r(71137),r(84864),r(57465),r(27495),r(69479),r(87745),r(90906),r(38781),r(31415),r(17642),r(58004),r(33853),r(45876),r(32475),r(15024),r(31698),r(99449),r(21699),r(47764),r(71761),r(25440),r(90744),r(11392),r(42762),r(89907),r(73772),r(30958),r(23500),r(62953),r(76031),r(90144)),l=r(24232);n={},(0,s.A)((0,s.A)((0,s.A)((0,s.A)((0,s.A)((0,s.A)((0,s.A)((0,s.A)((0,s.A)((0,s.A)(n,"sp","serverPrefetch hook"),"bc","beforeCreate hook"),"c","created hook"),"bm","beforeMount hook"),"m","mounted hook"),"bu","beforeUpdate hook"),"u","updated"),"bum","beforeUnmount hook"),"um","unmounted hook"),"a","activated hook"),(0,s.A)((0,s.A)((0,s.A)((0,s.A)((0,s.A)((0,s.A)((0,s.A)((0,s.A)((0,s.A)((0,s.A)(n,"da","deactivated hook"),"ec","errorCaptured hook"),"rtc","renderTracked hook"),"rtg","renderTriggered hook"),0,"setup function"),1,"render function"),2,"watcher getter"),3,"watcher callback"),4,"watcher cleanup function"),5,"native event handler"),(0,s.A)((0,s.A)((0,s.A)((0,s.A)((0,s.A)((0,s.A)((0,s.A)((0,s.A)((0,s.A)((0,s.A)(n,6,"component event handler"),7,"vnode hook"),8,"directive hook"),9,"transition hook"),10,"app errorHandler"),11,"app warnHandler"),12,"ref function"),13,"async component loader"),14,"scheduler flush"),15,"component update"),(0,s.A)(n,16,"app unmount cleanup function");function f(t,e,r,n){try{return n?t.apply(void 0,(0,u.A)(n)):t()}catch(i){h(i,e,r)}}function p(t,e,r,n){if((0,l.Tn)(t)){var i=f(t,e,r,n);return i&&(0,l.yL)(i)&&i["catch"]((function(t){h(t,e,r)})),i}if((0,l.cy)(t)){for(var o=[],a=0;a<t.length;a++)o.push(p(t[a],e,r,n));return o}}function h(t,e,r){var n=!(arguments.length>3&&void 0!==arguments[3])||arguments[3],i=e?e.vnode:null,o=e&&e.appContext.config||l.MZ,a=o.errorHandler,s=o.throwUnhandledErrorInProduction;if(e){var u=e.parent,p=e.proxy,h="https://vuejs.org/error-reference/#runtime-".concat(r);while(u){var v=u.ec;if(v)for(var g=0;g<v.length;g++)if(!1===v[g](t,p,h))return;u=u.parent}if(a)return(0,c.C4)(),f(a,null,10,[t,p,h]),void(0,c.bl)()}d(t,r,i,n,s)}function d(t,e,r){var n=arguments.length>4&&void 0!==arguments[4]&&arguments[4];if(n)throw t;console.error(t)}var v=[],g=-1,m=[],y=null,b=0,w=Promise.resolve(),_=null;function x(t){var e=_||w;return t?e.then(this?t.bind(this):t):e}function A(t){var e=g+1,r=v.length;while(e<r){var n=e+r>>>1,i=v[n],o=T(i);o<t||o===t&&2&i.flags?e=n+1:r=n}return e}function k(t){if(!(1&t.flags)){var e=T(t),r=v[v.length-1];!r||!(2&t.flags)&&e>=T(r)?v.push(t):v.splice(A(e),0,t),t.flags|=1,E()}}function E(){_||(_=w.then(R))}function S(t){(0,l.cy)(t)?m.push.apply(m,(0,u.A)(t)):y&&-1===t.id?y.splice(b+1,0,t):1&t.flags||(m.push(t),t.flags|=1),E()}function C(t,e){var r=arguments.length>2&&void 0!==arguments[2]?arguments[2]:g+1;for(0;r<v.length;r++){var n=v[r];if(n&&2&n.flags){if(t&&n.id!==t.uid)continue;0,v.splice(r,1),r--,4&n.flags&&(n.flags&=-2),n(),4&n.flags||(n.flags&=-2)}}}function O(t){if(m.length){var e,r=(0,u.A)(new Set(m)).sort((function(t,e){return T(t)-T(e)}));if(m.length=0,y)return void(e=y).push.apply(e,(0,u.A)(r));for(y=r,b=0;b<y.length;b++){var n=y[b];0,4&n.flags&&(n.flags&=-2),8&n.flags||n(),n.flags&=-2}y=null,b=0}}var T=function(t){return null==t.id?2&t.flags?-1:1/0:t.id};function R(t){l.tE;try{for(g=0;g<v.length;g++){var e=v[g];!e||8&e.flags||(4&e.flags&&(e.flags&=-2),f(e,e.i,e.i?15:14),4&e.flags||(e.flags&=-2))}}finally{for(;g<v.length;g++){var r=v[g];r&&(r.flags&=-2)}g=-1,v.length=0,O(t),_=null,(v.length||m.length)&&R(t)}}var D=null,I=null;function L(t){var e=D;return D=t,I=t&&t.type.__scopeId||null,e}function P(t){var e=arguments.length>1&&void 0!==arguments[1]?arguments[1]:D;if(!e)return t;if(t._n)return t;var r=function(){r._d&&dr(-1);var n,i=L(e);try{n=t.apply(void 0,arguments)}finally{L(i),r._d&&dr(1)}return n};return r._n=!0,r._c=!0,r._d=!0,r}function j(t,e){if(null===D)return t;for(var r=rn(D),n=t.dirs||(t.dirs=[]),i=0;i<e.length;i++){var a=(0,o.A)(e[i],4),s=a[0],u=a[1],f=a[2],p=a[3],h=void 0===p?l.MZ:p;s&&((0,l.Tn)(s)&&(s={mounted:s,updated:s}),s.deep&&(0,c.hV)(u),n.push({dir:s,instance:r,value:u,oldValue:void 0,arg:f,modifiers:h}))}return t}function F(t,e,r,n){for(var i=t.dirs,o=e&&e.dirs,a=0;a<i.length;a++){var s=i[a];o&&(s.oldValue=o[a].value);var u=s.dir[n];u&&((0,c.C4)(),p(u,r,8,[t.el,s,t,e]),(0,c.bl)())}}var M=Symbol("_vte"),N=function(t){return t.__isTeleport},q=function(t){return t&&(t.disabled||""===t.disabled)},U=function(t){return t&&(t.defer||""===t.defer)},B=function(t){return"undefined"!==typeof SVGElement&&t instanceof SVGElement},z=function(t){return"function"===typeof MathMLElement&&t instanceof MathMLElement},V=function(t,e){var r=t&&t.to;if((0,l.Kg)(r)){if(e){var n=e(r);return n}return null}return r},$={name:"Teleport",__isTeleport:!0,process:function(t){function e(e,r,n,i,o,a,s,u,c,l){return t.apply(this,arguments)}return e.toString=function(){return t.toString()},e}((function(t,e,r,n,i,o,a,s,u,c){var l=c.mc,f=c.pc,p=c.pbc,h=c.o,d=h.insert,v=h.querySelector,g=h.createText,m=(h.createComment,q(e.props)),y=e.shapeFlag,b=e.children,w=e.dynamicChildren;if(null==t){var _=e.el=g(""),x=e.anchor=g("");d(_,r,n),d(x,r,n);var A=function(t,e){16&y&&(i&&i.isCE&&(i.ce._teleportTarget=t),l(b,t,e,i,o,a,s,u))},k=function(){var t=e.target=V(e.props,v),r=Z(t,e,g,d);t&&("svg"!==a&&B(t)?a="svg":"mathml"!==a&&z(t)&&(a="mathml"),m||(A(t,r),W(e,!1)))};m&&(A(r,x),W(e,!0)),U(e.props)?De((function(){k(),e.el.__isMounted=!0}),o):k()}else{if(U(e.props)&&!t.el.__isMounted)return void De((function(){$.process(t,e,r,n,i,o,a,s,u,c),delete t.el.__isMounted}),o);e.el=t.el,e.targetStart=t.targetStart;var E=e.anchor=t.anchor,S=e.target=t.target,C=e.targetAnchor=t.targetAnchor,O=q(t.props),T=O?r:S,R=O?E:C;if("svg"===a||B(S)?a="svg":("mathml"===a||z(S))&&(a="mathml"),w?(p(t.dynamicChildren,w,T,i,o,a,s),Me(t,e,!0)):u||f(t,e,T,R,i,o,a,s,!1),m)O?e.props&&t.props&&e.props.to!==t.props.to&&(e.props.to=t.props.to):G(e,r,E,c,1);else if((e.props&&e.props.to)!==(t.props&&t.props.to)){var D=e.target=V(e.props,v);D&&G(e,D,null,c,0)}else O&&G(e,S,C,c,1);W(e,m)}})),remove:function(t,e,r,n,i){var o=n.um,a=n.o.remove,s=t.shapeFlag,u=t.children,c=t.anchor,l=t.targetStart,f=t.targetAnchor,p=t.target,h=t.props;if(p&&(a(l),a(f)),i&&a(c),16&s)for(var d=i||!q(h),v=0;v<u.length;v++){var g=u[v];o(g,e,r,d,!!g.dynamicChildren)}},move:G,hydrate:H};function G(t,e,r,n){var i=n.o.insert,o=n.m,a=arguments.length>4&&void 0!==arguments[4]?arguments[4]:2;0===a&&i(t.targetAnchor,e,r);var s=t.el,u=t.anchor,c=t.shapeFlag,l=t.children,f=t.props,p=2===a;if(p&&i(s,e,r),(!p||q(f))&&16&c)for(var h=0;h<l.length;h++)o(l[h],e,r,2);p&&i(u,e,r)}function H(t,e,r,n,i,o,a,s){var u=a.o,c=u.nextSibling,l=u.parentNode,f=u.querySelector,p=u.insert,h=u.createText,d=e.target=V(e.props,f);if(d){var v=q(e.props),g=d._lpa||d.firstChild;if(16&e.shapeFlag)if(v)e.anchor=s(c(t),e,l(t),r,n,i,o),e.targetStart=g,e.targetAnchor=g&&c(g);else{e.anchor=c(t);var m=g;while(m){if(m&&8===m.nodeType)if("teleport start anchor"===m.data)e.targetStart=m;else if("teleport anchor"===m.data){e.targetAnchor=m,d._lpa=e.targetAnchor&&c(e.targetAnchor);break}m=c(m)}e.targetAnchor||Z(d,e,h,p),s(g&&c(g),e,d,r,n,i,o)}W(e,v)}return e.anchor&&c(e.anchor)}function W(t,e){var r=t.ctx;if(r&&r.ut){var n,i;e?(n=t.el,i=t.anchor):(n=t.targetStart,i=t.targetAnchor);while(n&&n!==i)1===n.nodeType&&n.setAttribute("data-v-owner",r.uid),n=n.nextSibling;r.ut()}}function Z(t,e,r,n){var i=e.targetStart=r(""),o=e.targetAnchor=r("");return i[M]=o,t&&(n(i,t),n(o,t)),o}var K=Symbol("_leaveCb"),Y=Symbol("_enterCb");function J(){var t={isMounted:!1,isLeaving:!1,isUnmounting:!1,leavingVNodes:new Map};return Et((function(){t.isMounted=!0})),Ot((function(){t.isUnmounting=!0})),t}var X=[Function,Array],Q={mode:String,appear:Boolean,persisted:Boolean,onBeforeEnter:X,onEnter:X,onAfterEnter:X,onEnterCancelled:X,onBeforeLeave:X,onLeave:X,onAfterLeave:X,onLeaveCancelled:X,onBeforeAppear:X,onAppear:X,onAfterAppear:X,onAppearCancelled:X},tt=function(t){var e=t.subTree;return e.component?tt(e.component):e},et={name:"BaseTransition",props:Q,setup:function(t,e){var r=e.slots,n=Br(),i=J();return function(){var e=r["default"]&&ct(r["default"](),!0);if(e&&e.length){var o=rt(e),a=(0,c.ux)(t),s=a.mode;if(i.isLeaving)return at(o);var u=st(o);if(!u)return at(o);var l=ot(u,a,i,n,(function(t){return l=t}));u.type!==sr&&ut(u,l);var f=n.subTree&&st(n.subTree);if(f&&f.type!==sr&&!br(u,f)&&tt(n).type!==sr){var p=ot(f,a,i,n);if(ut(f,p),"out-in"===s&&u.type!==sr)return i.isLeaving=!0,p.afterLeave=function(){i.isLeaving=!1,8&n.job.flags||n.update(),delete p.afterLeave,f=void 0},at(o);"in-out"===s&&u.type!==sr?p.delayLeave=function(t,e,r){var n=it(i,f);n[String(f.key)]=f,t[K]=function(){e(),t[K]=void 0,delete l.delayedLeave,f=void 0},l.delayedLeave=function(){r(),delete l.delayedLeave,f=void 0}}:f=void 0}else f&&(f=void 0);return o}}}};function rt(t){var e=t[0];if(t.length>1){var r,n=(0,i.A)(t);try{for(n.s();!(r=n.n()).done;){var o=r.value;if(o.type!==sr){0,e=o;break}}}catch(a){n.e(a)}finally{n.f()}}return e}var nt=et;function it(t,e){var r=t.leavingVNodes,n=r.get(e.type);return n||(n=Object.create(null),r.set(e.type,n)),n}function ot(t,e,r,n,i){var o=e.appear,a=e.mode,s=e.persisted,u=void 0!==s&&s,c=e.onBeforeEnter,f=e.onEnter,h=e.onAfterEnter,d=e.onEnterCancelled,v=e.onBeforeLeave,g=e.onLeave,m=e.onAfterLeave,y=e.onLeaveCancelled,b=e.onBeforeAppear,w=e.onAppear,_=e.onAfterAppear,x=e.onAppearCancelled,A=String(t.key),k=it(r,t),E=function(t,e){t&&p(t,n,9,e)},S=function(t,e){var r=e[1];E(t,e),(0,l.cy)(t)?t.every((function(t){return t.length<=1}))&&r():t.length<=1&&r()},C={mode:a,persisted:u,beforeEnter:function(e){var n=c;if(!r.isMounted){if(!o)return;n=b||c}e[K]&&e[K](!0);var i=k[A];i&&br(t,i)&&i.el[K]&&i.el[K](),E(n,[e])},enter:function(t){var e=f,n=h,i=d;if(!r.isMounted){if(!o)return;e=w||f,n=_||h,i=x||d}var a=!1,s=t[Y]=function(e){a||(a=!0,E(e?i:n,[t]),C.delayedLeave&&C.delayedLeave(),t[Y]=void 0)};e?S(e,[t,s]):s()},leave:function(e,n){var i=String(t.key);if(e[Y]&&e[Y](!0),r.isUnmounting)return n();E(v,[e]);var o=!1,a=e[K]=function(r){o||(o=!0,n(),E(r?y:m,[e]),e[K]=void 0,k[i]===t&&delete k[i])};k[i]=t,g?S(g,[e,a]):a()},clone:function(t){var o=ot(t,e,r,n,i);return i&&i(o),o}};return C}function at(t){if(dt(t))return t=Sr(t),t.children=null,t}function st(t){if(!dt(t))return N(t.type)&&t.children?rt(t.children):t;var e=t.shapeFlag,r=t.children;if(r){if(16&e)return r[0];if(32&e&&(0,l.Tn)(r["default"]))return r["default"]()}}function ut(t,e){6&t.shapeFlag&&t.component?(t.transition=e,ut(t.component.subTree,e)):128&t.shapeFlag?(t.ssContent.transition=e.clone(t.ssContent),t.ssFallback.transition=e.clone(t.ssFallback)):t.transition=e}function ct(t){for(var e=arguments.length>1&&void 0!==arguments[1]&&arguments[1],r=arguments.length>2?arguments[2]:void 0,n=[],i=0,o=0;o<t.length;o++){var a=t[o],s=null==r?a.key:String(r)+String(null!=a.key?a.key:o);a.type===or?(128&a.patchFlag&&i++,n=n.concat(ct(a.children,e,s))):(e||a.type!==sr)&&n.push(null!=s?Sr(a,{key:s}):a)}if(i>1)for(var u=0;u<n.length;u++)n[u].patchFlag=-2;return n}
-/*! #__NO_SIDE_EFFECTS__ */function lt(t,e){return(0,l.Tn)(t)?function(){return(0,l.X$)({name:t.name},e,{setup:t})}():t}function ft(t){t.ids=[t.ids[0]+t.ids[2]+++"-",0,0]}function pt(t,e,r,n){var i=arguments.length>4&&void 0!==arguments[4]&&arguments[4];if((0,l.cy)(t))t.forEach((function(t,o){return pt(t,e&&((0,l.cy)(e)?e[o]:e),r,n,i)}));else if(!ht(n)||i){var o=4&n.shapeFlag?rn(n.component):n.el,a=i?null:o,s=t.i,u=t.r;0;var p=e&&e.r,h=s.refs===l.MZ?s.refs={}:s.refs,d=s.setupState,v=(0,c.ux)(d),g=d===l.MZ?function(){return!1}:function(t){return(0,l.$3)(v,t)};if(null!=p&&p!==u&&((0,l.Kg)(p)?(h[p]=null,g(p)&&(d[p]=null)):(0,c.i9)(p)&&(p.value=null)),(0,l.Tn)(u))f(u,s,12,[a,h]);else{var m=(0,l.Kg)(u),y=(0,c.i9)(u);if(m||y){var b=function(){if(t.f){var e=m?g(u)?d[u]:h[u]:u.value;i?(0,l.cy)(e)&&(0,l.TF)(e,o):(0,l.cy)(e)?e.includes(o)||e.push(o):m?(h[u]=[o],g(u)&&(d[u]=h[u])):(u.value=[o],t.k&&(h[t.k]=u.value))}else m?(h[u]=a,g(u)&&(d[u]=a)):y&&(u.value=a,t.k&&(h[t.k]=a))};a?(b.id=-1,De(b,r)):b()}else 0}}else 512&n.shapeFlag&&n.type.__asyncResolved&&n.component.subTree.component&&pt(t,e,r,n.component.subTree)}(0,s.A)((0,s.A)((0,s.A)((0,s.A)((0,s.A)({},0,"text"),1,"children"),2,"class"),3,"style"),4,"attribute");(0,l.We)().requestIdleCallback,(0,l.We)().cancelIdleCallback;var ht=function(t){return!!t.type.__asyncLoader};
-/*! #__NO_SIDE_EFFECTS__ */var dt=function(t){return t.type.__isKeepAlive};RegExp,RegExp;function vt(t,e){return(0,l.cy)(t)?t.some((function(t){return vt(t,e)})):(0,l.Kg)(t)?t.split(",").includes(e):!!(0,l.gd)(t)&&(t.lastIndex=0,t.test(e))}function gt(t,e){yt(t,"a",e)}function mt(t,e){yt(t,"da",e)}function yt(t,e){var r=arguments.length>2&&void 0!==arguments[2]?arguments[2]:Ur,n=t.__wdc||(t.__wdc=function(){var e=r;while(e){if(e.isDeactivated)return;e=e.parent}return t()});if(xt(e,n,r),r){var i=r.parent;while(i&&i.parent)dt(i.parent.vnode)&&bt(n,e,r,i),i=i.parent}}function bt(t,e,r,n){var i=xt(e,t,n,!0);Tt((function(){(0,l.TF)(n[e],i)}),r)}function wt(t){t.shapeFlag&=-257,t.shapeFlag&=-513}function _t(t){return 128&t.shapeFlag?t.ssContent:t}function xt(t,e){var r=arguments.length>2&&void 0!==arguments[2]?arguments[2]:Ur,n=arguments.length>3&&void 0!==arguments[3]&&arguments[3];if(r){var i=r[t]||(r[t]=[]),o=e.__weh||(e.__weh=function(){(0,c.C4)();for(var n=$r(r),i=arguments.length,o=new Array(i),a=0;a<i;a++)o[a]=arguments[a];var s=p(e,r,t,o);return n(),(0,c.bl)(),s});return n?i.unshift(o):i.push(o),o}}var At=function(t){return function(e){var r=arguments.length>1&&void 0!==arguments[1]?arguments[1]:Ur;Kr&&"sp"!==t||xt(t,(function(){return e.apply(void 0,arguments)}),r)}},kt=At("bm"),Et=At("m"),St=At("bu"),Ct=At("u"),Ot=At("bum"),Tt=At("um"),Rt=At("sp"),Dt=At("rtg"),It=At("rtc");function Lt(t){var e=arguments.length>1&&void 0!==arguments[1]?arguments[1]:Ur;xt("ec",t,e)}var Pt="components";function jt(t,e){return Mt(Pt,t,!0,e)||t}var Ft=Symbol["for"]("v-ndc");function Mt(t,e){var r=arguments.length>3&&void 0!==arguments[3]&&arguments[3],n=D||Ur;if(n){var i=n.type;if(t===Pt){var o=nn(i,!1);if(o&&(o===e||o===(0,l.PT)(e)||o===(0,l.ZH)((0,l.PT)(e))))return i}var a=Nt(n[t]||i[t],e)||Nt(n.appContext[t],e);return!a&&r?i:a}}function Nt(t,e){return t&&(t[e]||t[(0,l.PT)(e)]||t[(0,l.ZH)((0,l.PT)(e))])}function qt(t,e,r,n){var i,o=r&&r[n],a=(0,l.cy)(t);if(a||(0,l.Kg)(t)){var s=a&&(0,c.g8)(t),u=!1;s&&(u=!(0,c.fE)(t),t=(0,c.qA)(t)),i=new Array(t.length);for(var f=0,p=t.length;f<p;f++)i[f]=e(u?(0,c.lJ)(t[f]):t[f],f,void 0,o&&o[f])}else if("number"===typeof t){0,i=new Array(t);for(var h=0;h<t;h++)i[h]=e(h+1,h,void 0,o&&o[h])}else if((0,l.Gv)(t))if(t[Symbol.iterator])i=Array.from(t,(function(t,r){return e(t,r,void 0,o&&o[r])}));else{var d=Object.keys(t);i=new Array(d.length);for(var v=0,g=d.length;v<g;v++){var m=d[v];i[v]=e(t[m],m,v,o&&o[v])}}else i=[];return r&&(r[n]=i),i}var Ut=function(t){return t?Hr(t)?rn(t):Ut(t.parent):null},Bt=(0,l.X$)(Object.create(null),{$:function(t){return t},$el:function(t){return t.vnode.el},$data:function(t){return t.data},$props:function(t){return t.props},$attrs:function(t){return t.attrs},$slots:function(t){return t.slots},$refs:function(t){return t.refs},$parent:function(t){return Ut(t.parent)},$root:function(t){return Ut(t.root)},$host:function(t){return t.ce},$emit:function(t){return t.emit},$options:function(t){return Yt(t)},$forceUpdate:function(t){return t.f||(t.f=function(){k(t.update)})},$nextTick:function(t){return t.n||(t.n=x.bind(t.proxy))},$watch:function(t){return Ge.bind(t)}}),zt=function(t,e){return t!==l.MZ&&!t.__isScriptSetup&&(0,l.$3)(t,e)},Vt={get:function(t,e){var r=t._;if("__v_skip"===e)return!0;var n,i=r.ctx,o=r.setupState,a=r.data,s=r.props,u=r.accessCache,f=r.type,p=r.appContext;if("$"!==e[0]){var h=u[e];if(void 0!==h)switch(h){case 1:return o[e];case 2:return a[e];case 4:return i[e];case 3:return s[e]}else{if(zt(o,e))return u[e]=1,o[e];if(a!==l.MZ&&(0,l.$3)(a,e))return u[e]=2,a[e];if((n=r.propsOptions[0])&&(0,l.$3)(n,e))return u[e]=3,s[e];if(i!==l.MZ&&(0,l.$3)(i,e))return u[e]=4,i[e];Gt&&(u[e]=0)}}var d,v,g=Bt[e];return g?("$attrs"===e&&(0,c.u4)(r.attrs,"get",""),g(r)):(d=f.__cssModules)&&(d=d[e])?d:i!==l.MZ&&(0,l.$3)(i,e)?(u[e]=4,i[e]):(v=p.config.globalProperties,(0,l.$3)(v,e)?v[e]:void 0)},set:function(t,e,r){var n=t._,i=n.data,o=n.setupState,a=n.ctx;return zt(o,e)?(o[e]=r,!0):i!==l.MZ&&(0,l.$3)(i,e)?(i[e]=r,!0):!(0,l.$3)(n.props,e)&&(("$"!==e[0]||!(e.slice(1)in n))&&(a[e]=r,!0))},has:function(t,e){var r,n=t._,i=n.data,o=n.setupState,a=n.accessCache,s=n.ctx,u=n.appContext,c=n.propsOptions;return!!a[e]||i!==l.MZ&&(0,l.$3)(i,e)||zt(o,e)||(r=c[0])&&(0,l.$3)(r,e)||(0,l.$3)(s,e)||(0,l.$3)(Bt,e)||(0,l.$3)(u.config.globalProperties,e)},defineProperty:function(t,e,r){return null!=r.get?t._.accessCache[e]=0:(0,l.$3)(r,"value")&&this.set(t,e,r.value,null),Reflect.defineProperty(t,e,r)}};function $t(t){return(0,l.cy)(t)?t.reduce((function(t,e){return t[e]=null,t}),{}):t}var Gt=!0;function Ht(t){var e=Yt(t),r=t.proxy,n=t.ctx;Gt=!1,e.beforeCreate&&Zt(e.beforeCreate,t,"bc");var i=e.data,o=e.computed,a=e.methods,s=e.watch,u=e.provide,f=e.inject,p=e.created,h=e.beforeMount,d=e.mounted,v=e.beforeUpdate,g=e.updated,m=e.activated,y=e.deactivated,b=(e.beforeDestroy,e.beforeUnmount),w=(e.destroyed,e.unmounted),_=e.render,x=e.renderTracked,A=e.renderTriggered,k=e.errorCaptured,E=e.serverPrefetch,S=e.expose,C=e.inheritAttrs,O=e.components,T=e.directives,R=(e.filters,null);if(f&&Wt(f,n,R),a)for(var D in a){var I=a[D];(0,l.Tn)(I)&&(n[D]=I.bind(r))}if(i){0;var L=i.call(r,r);if((0,l.Gv)(L))t.data=(0,c.Kh)(L);else;}if(Gt=!0,o){var P=function(t){var e=o[t],i=(0,l.Tn)(e)?e.bind(r,r):(0,l.Tn)(e.get)?e.get.bind(r,r):l.tE;var a=!(0,l.Tn)(e)&&(0,l.Tn)(e.set)?e.set.bind(r):l.tE,s=an({get:i,set:a});Object.defineProperty(n,t,{enumerable:!0,configurable:!0,get:function(){return s.value},set:function(t){return s.value=t}})};for(var j in o)P(j)}if(s)for(var F in s)Kt(s[F],n,r,F);if(u){var M=(0,l.Tn)(u)?u.call(r):u;Reflect.ownKeys(M).forEach((function(t){le(t,M[t])}))}function N(t,e){(0,l.cy)(e)?e.forEach((function(e){return t(e.bind(r))})):e&&t(e.bind(r))}if(p&&Zt(p,t,"c"),N(kt,h),N(Et,d),N(St,v),N(Ct,g),N(gt,m),N(mt,y),N(Lt,k),N(It,x),N(Dt,A),N(Ot,b),N(Tt,w),N(Rt,E),(0,l.cy)(S))if(S.length){var q=t.exposed||(t.exposed={});S.forEach((function(t){Object.defineProperty(q,t,{get:function(){return r[t]},set:function(e){return r[t]=e}})}))}else t.exposed||(t.exposed={});_&&t.render===l.tE&&(t.render=_),null!=C&&(t.inheritAttrs=C),O&&(t.components=O),T&&(t.directives=T),E&&ft(t)}function Wt(t,e){arguments.length>2&&void 0!==arguments[2]||l.tE;(0,l.cy)(t)&&(t=ee(t));var r=function(){var r,i=t[n];r=(0,l.Gv)(i)?"default"in i?fe(i.from||n,i["default"],!0):fe(i.from||n):fe(i),(0,c.i9)(r)?Object.defineProperty(e,n,{enumerable:!0,configurable:!0,get:function(){return r.value},set:function(t){return r.value=t}}):e[n]=r};for(var n in t)r()}function Zt(t,e,r){p((0,l.cy)(t)?t.map((function(t){return t.bind(e.proxy)})):t.bind(e.proxy),e,r)}function Kt(t,e,r,n){var i=n.includes(".")?He(r,n):function(){return r[n]};if((0,l.Kg)(t)){var o=e[t];(0,l.Tn)(o)&&Ve(i,o)}else if((0,l.Tn)(t))Ve(i,t.bind(r));else if((0,l.Gv)(t))if((0,l.cy)(t))t.forEach((function(t){return Kt(t,e,r,n)}));else{var a=(0,l.Tn)(t.handler)?t.handler.bind(r):e[t.handler];(0,l.Tn)(a)&&Ve(i,a,t)}else 0}function Yt(t){var e,r=t.type,n=r.mixins,i=r["extends"],o=t.appContext,a=o.mixins,s=o.optionsCache,u=o.config.optionMergeStrategies,c=s.get(r);return c?e=c:a.length||n||i?(e={},a.length&&a.forEach((function(t){return Jt(e,t,u,!0)})),Jt(e,r,u)):e=r,(0,l.Gv)(r)&&s.set(r,e),e}function Jt(t,e,r){var n=arguments.length>3&&void 0!==arguments[3]&&arguments[3],i=e.mixins,o=e["extends"];for(var a in o&&Jt(t,o,r,!0),i&&i.forEach((function(e){return Jt(t,e,r,!0)})),e)if(n&&"expose"===a);else{var s=Xt[a]||r&&r[a];t[a]=s?s(t[a],e[a]):e[a]}return t}var Xt={data:Qt,props:ie,emits:ie,methods:ne,computed:ne,beforeCreate:re,created:re,beforeMount:re,mounted:re,beforeUpdate:re,updated:re,beforeDestroy:re,beforeUnmount:re,destroyed:re,unmounted:re,activated:re,deactivated:re,errorCaptured:re,serverPrefetch:re,components:ne,directives:ne,watch:oe,provide:Qt,inject:te};function Qt(t,e){return e?t?function(){return(0,l.X$)((0,l.Tn)(t)?t.call(this,this):t,(0,l.Tn)(e)?e.call(this,this):e)}:e:t}function te(t,e){return ne(ee(t),ee(e))}function ee(t){if((0,l.cy)(t)){for(var e={},r=0;r<t.length;r++)e[t[r]]=t[r];return e}return t}function re(t,e){return t?(0,u.A)(new Set([].concat(t,e))):e}function ne(t,e){return t?(0,l.X$)(Object.create(null),t,e):e}function ie(t,e){return t?(0,l.cy)(t)&&(0,l.cy)(e)?(0,u.A)(new Set([].concat((0,u.A)(t),(0,u.A)(e)))):(0,l.X$)(Object.create(null),$t(t),$t(null!=e?e:{})):e}function oe(t,e){if(!t)return e;if(!e)return t;var r=(0,l.X$)(Object.create(null),t);for(var n in e)r[n]=re(t[n],e[n]);return r}function ae(){return{app:null,config:{isNativeTag:l.NO,performance:!1,globalProperties:{},optionMergeStrategies:{},errorHandler:void 0,warnHandler:void 0,compilerOptions:{}},mixins:[],components:{},directives:{},provides:Object.create(null),optionsCache:new WeakMap,propsCache:new WeakMap,emitsCache:new WeakMap}}var se=0;function ue(t,e){return function(r){var n=arguments.length>1&&void 0!==arguments[1]?arguments[1]:null;(0,l.Tn)(r)||(r=(0,l.X$)({},r)),null==n||(0,l.Gv)(n)||(n=null);var i=ae(),o=new WeakSet,a=[],s=!1,u=i.app={_uid:se++,_component:r,_props:n,_container:null,_context:i,_instance:null,version:un,get config(){return i.config},set config(t){0},use:function(t){for(var e=arguments.length,r=new Array(e>1?e-1:0),n=1;n<e;n++)r[n-1]=arguments[n];return o.has(t)||(t&&(0,l.Tn)(t.install)?(o.add(t),t.install.apply(t,[u].concat(r))):(0,l.Tn)(t)&&(o.add(t),t.apply(void 0,[u].concat(r)))),u},mixin:function(t){return i.mixins.includes(t)||i.mixins.push(t),u},component:function(t,e){return e?(i.components[t]=e,u):i.components[t]},directive:function(t,e){return e?(i.directives[t]=e,u):i.directives[t]},mount:function(o,a,c){if(!s){0;var l=u._ceVNode||Ar(r,n);return l.appContext=i,!0===c?c="svg":!1===c&&(c=void 0),a&&e?e(l,o):t(l,o,c),s=!0,u._container=o,o.__vue_app__=u,rn(l.component)}},onUnmount:function(t){a.push(t)},unmount:function(){s&&(p(a,u._instance,16),t(null,u._container),delete u._container.__vue_app__)},provide:function(t,e){return i.provides[t]=e,u},runWithContext:function(t){var e=ce;ce=u;try{return t()}finally{ce=e}}};return u}}var ce=null;function le(t,e){if(Ur){var r=Ur.provides,n=Ur.parent&&Ur.parent.provides;n===r&&(r=Ur.provides=Object.create(n)),r[t]=e}else 0}function fe(t,e){var r=arguments.length>2&&void 0!==arguments[2]&&arguments[2],n=Ur||D;if(n||ce){var i=ce?ce._context.provides:n?null==n.parent?n.vnode.appContext&&n.vnode.appContext.provides:n.parent.provides:void 0;if(i&&t in i)return i[t];if(arguments.length>1)return r&&(0,l.Tn)(e)?e.call(n&&n.proxy):e}else 0}var pe={},he=function(){return Object.create(pe)},de=function(t){return Object.getPrototypeOf(t)===pe};function ve(t,e,r){var n=arguments.length>3&&void 0!==arguments[3]&&arguments[3],i={},o=he();for(var a in t.propsDefaults=Object.create(null),me(t,e,i,o),t.propsOptions[0])a in i||(i[a]=void 0);r?t.props=n?i:(0,c.Gc)(i):t.type.props?t.props=i:t.props=o,t.attrs=o}function ge(t,e,r,n){var i=t.props,a=t.attrs,s=t.vnode.patchFlag,u=(0,c.ux)(i),f=(0,o.A)(t.propsOptions,1),p=f[0],h=!1;if(!(n||s>0)||16&s){var d;for(var v in me(t,e,i,a)&&(h=!0),u)e&&((0,l.$3)(e,v)||(d=(0,l.Tg)(v))!==v&&(0,l.$3)(e,d))||(p?!r||void 0===r[v]&&void 0===r[d]||(i[v]=ye(p,u,v,void 0,t,!0)):delete i[v]);if(a!==u)for(var g in a)e&&(0,l.$3)(e,g)||(delete a[g],h=!0)}else if(8&s)for(var m=t.vnode.dynamicProps,y=0;y<m.length;y++){var b=m[y];if(!Ye(t.emitsOptions,b)){var w=e[b];if(p)if((0,l.$3)(a,b))w!==a[b]&&(a[b]=w,h=!0);else{var _=(0,l.PT)(b);i[_]=ye(p,u,_,w,t,!1)}else w!==a[b]&&(a[b]=w,h=!0)}}h&&(0,c.hZ)(t.attrs,"set","")}function me(t,e,r,n){var i,a=(0,o.A)(t.propsOptions,2),s=a[0],u=a[1],f=!1;if(e)for(var p in e)if(!(0,l.SU)(p)){var h=e[p],d=void 0;s&&(0,l.$3)(s,d=(0,l.PT)(p))?u&&u.includes(d)?(i||(i={}))[d]=h:r[d]=h:Ye(t.emitsOptions,p)||p in n&&h===n[p]||(n[p]=h,f=!0)}if(u)for(var v=(0,c.ux)(r),g=i||l.MZ,m=0;m<u.length;m++){var y=u[m];r[y]=ye(s,v,y,g[y],t,!(0,l.$3)(g,y))}return f}function ye(t,e,r,n,i,o){var a=t[r];if(null!=a){var s=(0,l.$3)(a,"default");if(s&&void 0===n){var u=a["default"];if(a.type!==Function&&!a.skipFactory&&(0,l.Tn)(u)){var c=i.propsDefaults;if(r in c)n=c[r];else{var f=$r(i);n=c[r]=u.call(null,e),f()}}else n=u;i.ce&&i.ce._setProp(r,n)}a[0]&&(o&&!s?n=!1:!a[1]||""!==n&&n!==(0,l.Tg)(r)||(n=!0))}return n}var be=new WeakMap;function we(t,e){var r=arguments.length>2&&void 0!==arguments[2]&&arguments[2],n=r?be:e.propsCache,i=n.get(t);if(i)return i;var a=t.props,s={},c=[],f=!1;if(!(0,l.Tn)(t)){var p=function(t){f=!0;var r=we(t,e,!0),n=(0,o.A)(r,2),i=n[0],a=n[1];(0,l.X$)(s,i),a&&c.push.apply(c,(0,u.A)(a))};!r&&e.mixins.length&&e.mixins.forEach(p),t["extends"]&&p(t["extends"]),t.mixins&&t.mixins.forEach(p)}if(!a&&!f)return(0,l.Gv)(t)&&n.set(t,l.Oj),l.Oj;if((0,l.cy)(a))for(var h=0;h<a.length;h++){0;var d=(0,l.PT)(a[h]);_e(d)&&(s[d]=l.MZ)}else if(a)for(var v in a){var g=(0,l.PT)(v);if(_e(g)){var m=a[v],y=s[g]=(0,l.cy)(m)||(0,l.Tn)(m)?{type:m}:(0,l.X$)({},m),b=y.type,w=!1,_=!0;if((0,l.cy)(b))for(var x=0;x<b.length;++x){var A=b[x],k=(0,l.Tn)(A)&&A.name;if("Boolean"===k){w=!0;break}"String"===k&&(_=!1)}else w=(0,l.Tn)(b)&&"Boolean"===b.name;y[0]=w,y[1]=_,(w||(0,l.$3)(y,"default"))&&c.push(g)}}var E=[s,c];return(0,l.Gv)(t)&&n.set(t,E),E}function _e(t){return"$"!==t[0]&&!(0,l.SU)(t)}var xe=function(t){return"_"===t[0]||"$stable"===t},Ae=function(t){return(0,l.cy)(t)?t.map(Rr):[Rr(t)]},ke=function(t,e,r){if(e._n)return e;var n=P((function(){return Ae(e.apply(void 0,arguments))}),r);return n._c=!1,n},Ee=function(t,e,r){var n=t._ctx,i=function(){if(xe(o))return 1;var r=t[o];if((0,l.Tn)(r))e[o]=ke(o,r,n);else if(null!=r){0;var i=Ae(r);e[o]=function(){return i}}};for(var o in t)i()},Se=function(t,e){var r=Ae(e);t.slots["default"]=function(){return r}},Ce=function(t,e,r){for(var n in e)(r||"_"!==n)&&(t[n]=e[n])},Oe=function(t,e,r){var n=t.slots=he();if(32&t.vnode.shapeFlag){var i=e._;i?(Ce(n,e,r),r&&(0,l.yQ)(n,"_",i,!0)):Ee(e,n)}else e&&Se(t,e)},Te=function(t,e,r){var n=t.vnode,i=t.slots,o=!0,a=l.MZ;if(32&n.shapeFlag){var s=e._;s?r&&1===s?o=!1:Ce(i,e,r):(o=!e.$stable,Ee(e,i)),a=e}else e&&(Se(t,e),a={default:1});if(o)for(var u in i)xe(u)||null!=a[u]||delete i[u]};function Re(){"boolean"!==typeof __VUE_PROD_HYDRATION_MISMATCH_DETAILS__&&((0,l.We)().__VUE_PROD_HYDRATION_MISMATCH_DETAILS__=!1)}var De=ir;function Ie(t){return Le(t)}function Le(t,e){Re();var r=(0,l.We)();r.__VUE__=!0;var n,i,a=t.insert,s=t.remove,u=t.patchProp,f=t.createElement,p=t.createText,h=t.createComment,d=t.setText,v=t.setElementText,g=t.parentNode,m=t.nextSibling,y=t.setScopeId,b=void 0===y?l.tE:y,w=t.insertStaticContent,_=function(t,e,r){var n=arguments.length>3&&void 0!==arguments[3]?arguments[3]:null,i=arguments.length>4&&void 0!==arguments[4]?arguments[4]:null,o=arguments.length>5&&void 0!==arguments[5]?arguments[5]:null,a=arguments.length>6&&void 0!==arguments[6]?arguments[6]:void 0,s=arguments.length>7&&void 0!==arguments[7]?arguments[7]:null,u=arguments.length>8&&void 0!==arguments[8]?arguments[8]:!!e.dynamicChildren;if(t!==e){t&&!br(t,e)&&(n=tt(t),K(t,i,o,!0),t=null),-2===e.patchFlag&&(u=!1,e.dynamicChildren=null);var c=e.type,l=e.ref,f=e.shapeFlag;switch(c){case ar:x(t,e,r,n);break;case sr:A(t,e,r,n);break;case ur:null==t&&E(e,r,n,a);break;case or:q(t,e,r,n,i,o,a,s,u);break;default:1&f?R(t,e,r,n,i,o,a,s,u):6&f?U(t,e,r,n,i,o,a,s,u):(64&f||128&f)&&c.process(t,e,r,n,i,o,a,s,u,nt)}null!=l&&i&&pt(l,t&&t.ref,o,e||t,!e)}},x=function(t,e,r,n){if(null==t)a(e.el=p(e.children),r,n);else{var i=e.el=t.el;e.children!==t.children&&d(i,e.children)}},A=function(t,e,r,n){null==t?a(e.el=h(e.children||""),r,n):e.el=t.el},E=function(t,e,r,n){var i=w(t.children,e,r,n,t.el,t.anchor),a=(0,o.A)(i,2);t.el=a[0],t.anchor=a[1]},S=function(t,e,r){var n,i=t.el,o=t.anchor;while(i&&i!==o)n=m(i),a(i,e,r),i=n;a(o,e,r)},T=function(t){var e,r=t.el,n=t.anchor;while(r&&r!==n)e=m(r),s(r),r=e;s(n)},R=function(t,e,r,n,i,o,a,s,u){"svg"===e.type?a="svg":"math"===e.type&&(a="mathml"),null==t?D(e,r,n,i,o,a,s,u):P(t,e,i,o,a,s,u)},D=function(t,e,r,n,i,o,s,c){var p,h,d=t.props,g=t.shapeFlag,m=t.transition,y=t.dirs;if(p=t.el=f(t.type,o,d&&d.is,d),8&g?v(p,t.children):16&g&&L(t.children,p,null,n,i,Pe(t,o),s,c),y&&F(t,null,n,"created"),I(p,t,t.scopeId,s,n),d){for(var b in d)"value"===b||(0,l.SU)(b)||u(p,b,null,d[b],o,n);"value"in d&&u(p,"value",null,d.value,o),(h=d.onVnodeBeforeMount)&&Pr(h,n,t)}y&&F(t,null,n,"beforeMount");var w=Fe(i,m);w&&m.beforeEnter(p),a(p,e,r),((h=d&&d.onVnodeMounted)||w||y)&&De((function(){h&&Pr(h,n,t),w&&m.enter(p),y&&F(t,null,n,"mounted")}),i)},I=function(t,e,r,n,i){if(r&&b(t,r),n)for(var o=0;o<n.length;o++)b(t,n[o]);if(i){var a=i.subTree;if(e===a||nr(a.type)&&(a.ssContent===e||a.ssFallback===e)){var s=i.vnode;I(t,s,s.scopeId,s.slotScopeIds,i.parent)}}},L=function(t,e,r,n,i,o,a,s){for(var u=arguments.length>8&&void 0!==arguments[8]?arguments[8]:0,c=u;c<t.length;c++){var l=t[c]=s?Dr(t[c]):Rr(t[c]);_(null,l,e,r,n,i,o,a,s)}},P=function(t,e,r,n,i,o,a){var s=e.el=t.el;var c=e.patchFlag,f=e.dynamicChildren,p=e.dirs;c|=16&t.patchFlag;var h,d=t.props||l.MZ,g=e.props||l.MZ;if(r&&je(r,!1),(h=g.onVnodeBeforeUpdate)&&Pr(h,r,e,t),p&&F(e,t,r,"beforeUpdate"),r&&je(r,!0),(d.innerHTML&&null==g.innerHTML||d.textContent&&null==g.textContent)&&v(s,""),f?j(t.dynamicChildren,f,s,r,n,Pe(e,i),o):a||G(t,e,s,null,r,n,Pe(e,i),o,!1),c>0){if(16&c)N(s,d,g,r,i);else if(2&c&&d["class"]!==g["class"]&&u(s,"class",null,g["class"],i),4&c&&u(s,"style",d.style,g.style,i),8&c)for(var m=e.dynamicProps,y=0;y<m.length;y++){var b=m[y],w=d[b],_=g[b];_===w&&"value"!==b||u(s,b,w,_,i,r)}1&c&&t.children!==e.children&&v(s,e.children)}else a||null!=f||N(s,d,g,r,i);((h=g.onVnodeUpdated)||p)&&De((function(){h&&Pr(h,r,e,t),p&&F(e,t,r,"updated")}),n)},j=function(t,e,r,n,i,o,a){for(var s=0;s<e.length;s++){var u=t[s],c=e[s],l=u.el&&(u.type===or||!br(u,c)||70&u.shapeFlag)?g(u.el):r;_(u,c,l,null,n,i,o,a,!0)}},N=function(t,e,r,n,i){if(e!==r){if(e!==l.MZ)for(var o in e)(0,l.SU)(o)||o in r||u(t,o,e[o],null,i,n);for(var a in r)if(!(0,l.SU)(a)){var s=r[a],c=e[a];s!==c&&"value"!==a&&u(t,a,c,s,i,n)}"value"in r&&u(t,"value",e.value,r.value,i)}},q=function(t,e,r,n,i,o,s,u,c){var l=e.el=t?t.el:p(""),f=e.anchor=t?t.anchor:p(""),h=e.patchFlag,d=e.dynamicChildren,v=e.slotScopeIds;v&&(u=u?u.concat(v):v),null==t?(a(l,r,n),a(f,r,n),L(e.children||[],r,f,i,o,s,u,c)):h>0&&64&h&&d&&t.dynamicChildren?(j(t.dynamicChildren,d,r,i,o,s,u),(null!=e.key||i&&e===i.subTree)&&Me(t,e,!0)):G(t,e,r,f,i,o,s,u,c)},U=function(t,e,r,n,i,o,a,s,u){e.slotScopeIds=s,null==t?512&e.shapeFlag?i.ctx.activate(e,r,n,a,u):B(e,r,n,i,o,a,u):z(t,e,u)},B=function(t,e,r,n,i,o,a){var s=t.component=Mr(t,n,i);if(dt(t)&&(s.ctx.renderer=nt),Yr(s,!1,a),s.asyncDep){if(i&&i.registerDep(s,V,a),!t.el){var u=s.subTree=Ar(sr);A(null,u,e,r)}}else V(s,t,e,r,i,o,a)},z=function(t,e,r){var n=e.component=t.component;if(tr(t,e,r)){if(n.asyncDep&&!n.asyncResolved)return void $(n,e,r);n.next=e,n.update()}else e.el=t.el,n.vnode=e},V=function(t,e,r,n,o,a,s){var u=function(){if(t.isMounted){var c=t.next,f=t.bu,p=t.u,h=t.parent,d=t.vnode,v=qe(t);if(v)return c&&(c.el=d.el,$(t,c,s)),void v.asyncDep.then((function(){t.isUnmounted||u()}));var m,y=c;0,je(t,!1),c?(c.el=d.el,$(t,c,s)):c=d,f&&(0,l.DY)(f),(m=c.props&&c.props.onVnodeBeforeUpdate)&&Pr(m,h,c,d),je(t,!0);var b=Je(t);0;var w=t.subTree;t.subTree=b,_(w,b,g(w.el),tt(w),t,o,a),c.el=b.el,null===y&&rr(t,b.el),p&&De(p,o),(m=c.props&&c.props.onVnodeUpdated)&&De((function(){return Pr(m,h,c,d)}),o)}else{var x,A=e,k=A.el,E=A.props,S=t.bm,C=t.m,O=t.parent,T=t.root,R=t.type,D=ht(e);if(je(t,!1),S&&(0,l.DY)(S),!D&&(x=E&&E.onVnodeBeforeMount)&&Pr(x,O,e),je(t,!0),k&&i){var I=function(){t.subTree=Je(t),i(k,t.subTree,t,o,null)};D&&R.__asyncHydrate?R.__asyncHydrate(k,t,I):I()}else{T.ce&&T.ce._injectChildStyle(R);var L=t.subTree=Je(t);0,_(null,L,r,n,t,o,a),e.el=L.el}if(C&&De(C,o),!D&&(x=E&&E.onVnodeMounted)){var P=e;De((function(){return Pr(x,O,P)}),o)}(256&e.shapeFlag||O&&ht(O.vnode)&&256&O.vnode.shapeFlag)&&t.a&&De(t.a,o),t.isMounted=!0,e=r=n=null}};t.scope.on();var f=t.effect=new c.X2(u);t.scope.off();var p=t.update=f.run.bind(f),h=t.job=f.runIfDirty.bind(f);h.i=t,h.id=t.uid,f.scheduler=function(){return k(h)},je(t,!0),p()},$=function(t,e,r){e.component=t;var n=t.vnode.props;t.vnode=e,t.next=null,ge(t,e.props,n,r),Te(t,e.children,r),(0,c.C4)(),C(t),(0,c.bl)()},G=function(t,e,r,n,i,o,a,s){var u=arguments.length>8&&void 0!==arguments[8]&&arguments[8],c=t&&t.children,l=t?t.shapeFlag:0,f=e.children,p=e.patchFlag,h=e.shapeFlag;if(p>0){if(128&p)return void W(c,f,r,n,i,o,a,s,u);if(256&p)return void H(c,f,r,n,i,o,a,s,u)}8&h?(16&l&&Q(c,i,o),f!==c&&v(r,f)):16&l?16&h?W(c,f,r,n,i,o,a,s,u):Q(c,i,o,!0):(8&l&&v(r,""),16&h&&L(f,r,n,i,o,a,s,u))},H=function(t,e,r,n,i,o,a,s,u){t=t||l.Oj,e=e||l.Oj;var c,f=t.length,p=e.length,h=Math.min(f,p);for(c=0;c<h;c++){var d=e[c]=u?Dr(e[c]):Rr(e[c]);_(t[c],d,r,null,i,o,a,s,u)}f>p?Q(t,i,o,!0,!1,h):L(e,r,n,i,o,a,s,u,h)},W=function(t,e,r,n,i,o,a,s,u){var c=0,f=e.length,p=t.length-1,h=f-1;while(c<=p&&c<=h){var d=t[c],v=e[c]=u?Dr(e[c]):Rr(e[c]);if(!br(d,v))break;_(d,v,r,null,i,o,a,s,u),c++}while(c<=p&&c<=h){var g=t[p],m=e[h]=u?Dr(e[h]):Rr(e[h]);if(!br(g,m))break;_(g,m,r,null,i,o,a,s,u),p--,h--}if(c>p){if(c<=h){var y=h+1,b=y<f?e[y].el:n;while(c<=h)_(null,e[c]=u?Dr(e[c]):Rr(e[c]),r,b,i,o,a,s,u),c++}}else if(c>h)while(c<=p)K(t[c],i,o,!0),c++;else{var w,x=c,A=c,k=new Map;for(c=A;c<=h;c++){var E=e[c]=u?Dr(e[c]):Rr(e[c]);null!=E.key&&k.set(E.key,c)}var S=0,C=h-A+1,O=!1,T=0,R=new Array(C);for(c=0;c<C;c++)R[c]=0;for(c=x;c<=p;c++){var D=t[c];if(S>=C)K(D,i,o,!0);else{var I=void 0;if(null!=D.key)I=k.get(D.key);else for(w=A;w<=h;w++)if(0===R[w-A]&&br(D,e[w])){I=w;break}void 0===I?K(D,i,o,!0):(R[I-A]=c+1,I>=T?T=I:O=!0,_(D,e[I],r,null,i,o,a,s,u),S++)}}var L=O?Ne(R):l.Oj;for(w=L.length-1,c=C-1;c>=0;c--){var P=A+c,j=e[P],F=P+1<f?e[P+1].el:n;0===R[c]?_(null,j,r,F,i,o,a,s,u):O&&(w<0||c!==L[w]?Z(j,r,F,2):w--)}}},Z=function(t,e,r,n){var i=arguments.length>4&&void 0!==arguments[4]?arguments[4]:null,o=t.el,s=t.type,u=t.transition,c=t.children,l=t.shapeFlag;if(6&l)Z(t.component.subTree,e,r,n);else if(128&l)t.suspense.move(e,r,n);else if(64&l)s.move(t,e,r,nt);else if(s!==or)if(s!==ur){var f=2!==n&&1&l&&u;if(f)if(0===n)u.beforeEnter(o),a(o,e,r),De((function(){return u.enter(o)}),i);else{var p=u.leave,h=u.delayLeave,d=u.afterLeave,v=function(){return a(o,e,r)},g=function(){p(o,(function(){v(),d&&d()}))};h?h(o,v,g):g()}else a(o,e,r)}else S(t,e,r);else{a(o,e,r);for(var m=0;m<c.length;m++)Z(c[m],e,r,n);a(t.anchor,e,r)}},K=function(t,e,r){var n=arguments.length>3&&void 0!==arguments[3]&&arguments[3],i=arguments.length>4&&void 0!==arguments[4]&&arguments[4],o=t.type,a=t.props,s=t.ref,u=t.children,c=t.dynamicChildren,l=t.shapeFlag,f=t.patchFlag,p=t.dirs,h=t.cacheIndex;if(-2===f&&(i=!1),null!=s&&pt(s,null,r,t,!0),null!=h&&(e.renderCache[h]=void 0),256&l)e.ctx.deactivate(t);else{var d,v=1&l&&p,g=!ht(t);if(g&&(d=a&&a.onVnodeBeforeUnmount)&&Pr(d,e,t),6&l)X(t.component,r,n);else{if(128&l)return void t.suspense.unmount(r,n);v&&F(t,null,e,"beforeUnmount"),64&l?t.type.remove(t,e,r,nt,n):c&&!c.hasOnce&&(o!==or||f>0&&64&f)?Q(c,e,r,!1,!0):(o===or&&384&f||!i&&16&l)&&Q(u,e,r),n&&Y(t)}(g&&(d=a&&a.onVnodeUnmounted)||v)&&De((function(){d&&Pr(d,e,t),v&&F(t,null,e,"unmounted")}),r)}},Y=function(t){var e=t.type,r=t.el,n=t.anchor,i=t.transition;if(e!==or)if(e!==ur){var o=function(){s(r),i&&!i.persisted&&i.afterLeave&&i.afterLeave()};if(1&t.shapeFlag&&i&&!i.persisted){var a=i.leave,u=i.delayLeave,c=function(){return a(r,o)};u?u(t.el,o,c):c()}else o()}else T(t);else J(r,n)},J=function(t,e){var r;while(t!==e)r=m(t),s(t),t=r;s(e)},X=function(t,e,r){var n=t.bum,i=t.scope,o=t.job,a=t.subTree,s=t.um,u=t.m,c=t.a;Ue(u),Ue(c),n&&(0,l.DY)(n),i.stop(),o&&(o.flags|=8,K(a,t,e,r)),s&&De(s,e),De((function(){t.isUnmounted=!0}),e),e&&e.pendingBranch&&!e.isUnmounted&&t.asyncDep&&!t.asyncResolved&&t.suspenseId===e.pendingId&&(e.deps--,0===e.deps&&e.resolve())},Q=function(t,e,r){for(var n=arguments.length>3&&void 0!==arguments[3]&&arguments[3],i=arguments.length>4&&void 0!==arguments[4]&&arguments[4],o=arguments.length>5&&void 0!==arguments[5]?arguments[5]:0,a=o;a<t.length;a++)K(t[a],e,r,n,i)},tt=function(t){if(6&t.shapeFlag)return tt(t.component.subTree);if(128&t.shapeFlag)return t.suspense.next();var e=m(t.anchor||t.el),r=e&&e[M];return r?m(r):e},et=!1,rt=function(t,e,r){null==t?e._vnode&&K(e._vnode,null,null,!0):_(e._vnode||null,t,e,null,null,null,r),e._vnode=t,et||(et=!0,C(),O(),et=!1)},nt={p:_,um:K,m:Z,r:Y,mt:B,mc:L,pc:G,pbc:j,n:tt,o:t};if(e){var it=e(nt),ot=(0,o.A)(it,2);n=ot[0],i=ot[1]}return{render:rt,hydrate:n,createApp:ue(rt,n)}}function Pe(t,e){var r=t.type,n=t.props;return"svg"===e&&"foreignObject"===r||"mathml"===e&&"annotation-xml"===r&&n&&n.encoding&&n.encoding.includes("html")?void 0:e}function je(t,e){var r=t.effect,n=t.job;e?(r.flags|=32,n.flags|=4):(r.flags&=-33,n.flags&=-5)}function Fe(t,e){return(!t||t&&!t.pendingBranch)&&e&&!e.persisted}function Me(t,e){var r=arguments.length>2&&void 0!==arguments[2]&&arguments[2],n=t.children,i=e.children;if((0,l.cy)(n)&&(0,l.cy)(i))for(var o=0;o<n.length;o++){var a=n[o],s=i[o];1&s.shapeFlag&&!s.dynamicChildren&&((s.patchFlag<=0||32===s.patchFlag)&&(s=i[o]=Dr(i[o]),s.el=a.el),r||-2===s.patchFlag||Me(a,s)),s.type===ar&&(s.el=a.el)}}function Ne(t){var e,r,n,i,o,a=t.slice(),s=[0],u=t.length;for(e=0;e<u;e++){var c=t[e];if(0!==c){if(r=s[s.length-1],t[r]<c){a[e]=r,s.push(e);continue}n=0,i=s.length-1;while(n<i)o=n+i>>1,t[s[o]]<c?n=o+1:i=o;c<t[s[n]]&&(n>0&&(a[e]=s[n-1]),s[n]=e)}}n=s.length,i=s[n-1];while(n-- >0)s[n]=i,i=a[i];return s}function qe(t){var e=t.subTree.component;if(e)return e.asyncDep&&!e.asyncResolved?e:qe(e)}function Ue(t){if(t)for(var e=0;e<t.length;e++)t[e].flags|=8}var Be=Symbol["for"]("v-scx"),ze=function(){var t=fe(Be);return t};function Ve(t,e,r){return $e(t,e,r)}function $e(t,e){var r=arguments.length>2&&void 0!==arguments[2]?arguments[2]:l.MZ,n=r.immediate,i=(r.deep,r.flush);r.once;var o=(0,l.X$)({},r);var a,s=e&&n||!e&&"post"!==i;if(Kr)if("sync"===i){var u=ze();a=u.__watcherHandles||(u.__watcherHandles=[])}else if(!s){var f=function(){};return f.stop=l.tE,f.resume=l.tE,f.pause=l.tE,f}var h=Ur;o.call=function(t,e,r){return p(t,h,e,r)};var d=!1;"post"===i?o.scheduler=function(t){De(t,h&&h.suspense)}:"sync"!==i&&(d=!0,o.scheduler=function(t,e){e?t():k(t)}),o.augmentJob=function(t){e&&(t.flags|=4),d&&(t.flags|=2,h&&(t.id=h.uid,t.i=h))};var v=(0,c.wB)(t,e,o);return Kr&&(a?a.push(v):s&&v()),v}function Ge(t,e,r){var n,i=this.proxy,o=(0,l.Kg)(t)?t.includes(".")?He(i,t):function(){return i[t]}:t.bind(i,i);(0,l.Tn)(e)?n=e:(n=e.handler,r=e);var a=$r(this),s=$e(o,n.bind(i),r);return a(),s}function He(t,e){var r=e.split(".");return function(){for(var e=t,n=0;n<r.length&&e;n++)e=e[r[n]];return e}}var We=function(t,e){return"modelValue"===e||"model-value"===e?t.modelModifiers:t["".concat(e,"Modifiers")]||t["".concat((0,l.PT)(e),"Modifiers")]||t["".concat((0,l.Tg)(e),"Modifiers")]};function Ze(t,e){if(!t.isUnmounted){for(var r=t.vnode.props||l.MZ,n=arguments.length,i=new Array(n>2?n-2:0),o=2;o<n;o++)i[o-2]=arguments[o];var a,s=i,u=e.startsWith("update:"),c=u&&We(r,e.slice(7));c&&(c.trim&&(s=i.map((function(t){return(0,l.Kg)(t)?t.trim():t}))),c.number&&(s=i.map(l.bB)));var f=r[a=(0,l.rU)(e)]||r[a=(0,l.rU)((0,l.PT)(e))];!f&&u&&(f=r[a=(0,l.rU)((0,l.Tg)(e))]),f&&p(f,t,6,s);var h=r[a+"Once"];if(h){if(t.emitted){if(t.emitted[a])return}else t.emitted={};t.emitted[a]=!0,p(h,t,6,s)}}}function Ke(t,e){var r=arguments.length>2&&void 0!==arguments[2]&&arguments[2],n=e.emitsCache,i=n.get(t);if(void 0!==i)return i;var o=t.emits,a={},s=!1;if(!(0,l.Tn)(t)){var u=function(t){var r=Ke(t,e,!0);r&&(s=!0,(0,l.X$)(a,r))};!r&&e.mixins.length&&e.mixins.forEach(u),t["extends"]&&u(t["extends"]),t.mixins&&t.mixins.forEach(u)}return o||s?((0,l.cy)(o)?o.forEach((function(t){return a[t]=null})):(0,l.X$)(a,o),(0,l.Gv)(t)&&n.set(t,a),a):((0,l.Gv)(t)&&n.set(t,null),null)}function Ye(t,e){return!(!t||!(0,l.Mp)(e))&&(e=e.slice(2).replace(/Once$/,""),(0,l.$3)(t,e[0].toLowerCase()+e.slice(1))||(0,l.$3)(t,(0,l.Tg)(e))||(0,l.$3)(t,e))}function Je(t){var e,r,n=t.type,i=t.vnode,a=t.proxy,s=t.withProxy,u=(0,o.A)(t.propsOptions,1),c=u[0],f=t.slots,p=t.attrs,d=t.emit,v=t.render,g=t.renderCache,m=t.props,y=t.data,b=t.setupState,w=t.ctx,_=t.inheritAttrs,x=L(t);try{if(4&i.shapeFlag){var A=s||a,k=A;e=Rr(v.call(k,A,g,m,b,y,w)),r=p}else{var E=n;0,e=Rr(E.length>1?E(m,{attrs:p,slots:f,emit:d}):E(m,null)),r=n.props?p:Xe(p)}}catch(R){cr.length=0,h(R,t,1),e=Ar(sr)}var S=e;if(r&&!1!==_){var C=Object.keys(r),O=S,T=O.shapeFlag;if(C.length)if(7&T)c&&C.some(l.CP)&&(r=Qe(r,c)),S=Sr(S,r,!1,!0);else;}return i.dirs&&(S=Sr(S,null,!1,!0),S.dirs=S.dirs?S.dirs.concat(i.dirs):i.dirs),i.transition&&ut(S,i.transition),e=S,L(x),e}var Xe=function(t){var e;for(var r in t)("class"===r||"style"===r||(0,l.Mp)(r))&&((e||(e={}))[r]=t[r]);return e},Qe=function(t,e){var r={};for(var n in t)(0,l.CP)(n)&&n.slice(9)in e||(r[n]=t[n]);return r};function tr(t,e,r){var n=t.props,i=t.children,o=t.component,a=e.props,s=e.children,u=e.patchFlag,c=o.emitsOptions;if(e.dirs||e.transition)return!0;if(!(r&&u>=0))return!(!i&&!s||s&&s.$stable)||n!==a&&(n?!a||er(n,a,c):!!a);if(1024&u)return!0;if(16&u)return n?er(n,a,c):!!a;if(8&u)for(var l=e.dynamicProps,f=0;f<l.length;f++){var p=l[f];if(a[p]!==n[p]&&!Ye(c,p))return!0}return!1}function er(t,e,r){var n=Object.keys(e);if(n.length!==Object.keys(t).length)return!0;for(var i=0;i<n.length;i++){var o=n[i];if(e[o]!==t[o]&&!Ye(r,o))return!0}return!1}function rr(t,e){var r=t.vnode,n=t.parent;while(n){var i=n.subTree;if(i.suspense&&i.suspense.activeBranch===r&&(i.el=r.el),i!==r)break;(r=n.vnode).el=e,n=n.parent}}var nr=function(t){return t.__isSuspense};function ir(t,e){var r;e&&e.pendingBranch?(0,l.cy)(t)?(r=e.effects).push.apply(r,(0,u.A)(t)):e.effects.push(t):S(t)}var or=Symbol["for"]("v-fgt"),ar=Symbol["for"]("v-txt"),sr=Symbol["for"]("v-cmt"),ur=Symbol["for"]("v-stc"),cr=[],lr=null;function fr(){var t=arguments.length>0&&void 0!==arguments[0]&&arguments[0];cr.push(lr=t?null:[])}function pr(){cr.pop(),lr=cr[cr.length-1]||null}var hr=1;function dr(t){var e=arguments.length>1&&void 0!==arguments[1]&&arguments[1];hr+=t,t<0&&lr&&e&&(lr.hasOnce=!0)}function vr(t){return t.dynamicChildren=hr>0?lr||l.Oj:null,pr(),hr>0&&lr&&lr.push(t),t}function gr(t,e,r,n,i,o){return vr(xr(t,e,r,n,i,o,!0))}function mr(t,e,r,n,i){return vr(Ar(t,e,r,n,i,!0))}function yr(t){return!!t&&!0===t.__v_isVNode}function br(t,e){return t.type===e.type&&t.key===e.key}var wr=function(t){var e=t.key;return null!=e?e:null},_r=function(t){var e=t.ref,r=t.ref_key,n=t.ref_for;return"number"===typeof e&&(e=""+e),null!=e?(0,l.Kg)(e)||(0,c.i9)(e)||(0,l.Tn)(e)?{i:D,r:e,k:r,f:!!n}:e:null};function xr(t){var e=arguments.length>1&&void 0!==arguments[1]?arguments[1]:null,r=arguments.length>2&&void 0!==arguments[2]?arguments[2]:null,n=arguments.length>3&&void 0!==arguments[3]?arguments[3]:0,i=arguments.length>4&&void 0!==arguments[4]?arguments[4]:null,o=arguments.length>5&&void 0!==arguments[5]?arguments[5]:t===or?0:1,a=arguments.length>6&&void 0!==arguments[6]&&arguments[6],s=arguments.length>7&&void 0!==arguments[7]&&arguments[7],u={__v_isVNode:!0,__v_skip:!0,type:t,props:e,key:e&&wr(e),ref:e&&_r(e),scopeId:I,slotScopeIds:null,children:r,component:null,suspense:null,ssContent:null,ssFallback:null,dirs:null,transition:null,el:null,anchor:null,target:null,targetStart:null,targetAnchor:null,staticCount:0,shapeFlag:o,patchFlag:n,dynamicProps:i,dynamicChildren:null,appContext:null,ctx:D};return s?(Ir(u,r),128&o&&t.normalize(u)):r&&(u.shapeFlag|=(0,l.Kg)(r)?8:16),hr>0&&!a&&lr&&(u.patchFlag>0||6&o)&&32!==u.patchFlag&&lr.push(u),u}var Ar=kr;function kr(t){var e=arguments.length>1&&void 0!==arguments[1]?arguments[1]:null,r=arguments.length>2&&void 0!==arguments[2]?arguments[2]:null,n=arguments.length>3&&void 0!==arguments[3]?arguments[3]:0,i=arguments.length>4&&void 0!==arguments[4]?arguments[4]:null,o=arguments.length>5&&void 0!==arguments[5]&&arguments[5];if(t&&t!==Ft||(t=sr),yr(t)){var a=Sr(t,e,!0);return r&&Ir(a,r),hr>0&&!o&&lr&&(6&a.shapeFlag?lr[lr.indexOf(t)]=a:lr.push(a)),a.patchFlag=-2,a}if(on(t)&&(t=t.__vccOpts),e){e=Er(e);var s=e,u=s["class"],f=s.style;u&&!(0,l.Kg)(u)&&(e["class"]=(0,l.C4)(u)),(0,l.Gv)(f)&&((0,c.ju)(f)&&!(0,l.cy)(f)&&(f=(0,l.X$)({},f)),e.style=(0,l.Tr)(f))}var p=(0,l.Kg)(t)?1:nr(t)?128:N(t)?64:(0,l.Gv)(t)?4:(0,l.Tn)(t)?2:0;return xr(t,e,r,n,i,p,o,!0)}function Er(t){return t?(0,c.ju)(t)||de(t)?(0,l.X$)({},t):t:null}function Sr(t,e){var r=arguments.length>2&&void 0!==arguments[2]&&arguments[2],n=arguments.length>3&&void 0!==arguments[3]&&arguments[3],i=t.props,o=t.ref,a=t.patchFlag,s=t.children,u=t.transition,c=e?Lr(i||{},e):i,f={__v_isVNode:!0,__v_skip:!0,type:t.type,props:c,key:c&&wr(c),ref:e&&e.ref?r&&o?(0,l.cy)(o)?o.concat(_r(e)):[o,_r(e)]:_r(e):o,scopeId:t.scopeId,slotScopeIds:t.slotScopeIds,children:s,target:t.target,targetStart:t.targetStart,targetAnchor:t.targetAnchor,staticCount:t.staticCount,shapeFlag:t.shapeFlag,patchFlag:e&&t.type!==or?-1===a?16:16|a:a,dynamicProps:t.dynamicProps,dynamicChildren:t.dynamicChildren,appContext:t.appContext,dirs:t.dirs,transition:u,component:t.component,suspense:t.suspense,ssContent:t.ssContent&&Sr(t.ssContent),ssFallback:t.ssFallback&&Sr(t.ssFallback),el:t.el,anchor:t.anchor,ctx:t.ctx,ce:t.ce};return u&&n&&ut(f,u.clone(f)),f}function Cr(){var t=arguments.length>0&&void 0!==arguments[0]?arguments[0]:" ",e=arguments.length>1&&void 0!==arguments[1]?arguments[1]:0;return Ar(ar,null,t,e)}function Or(t,e){var r=Ar(ur,null,t);return r.staticCount=e,r}function Tr(){var t=arguments.length>0&&void 0!==arguments[0]?arguments[0]:"",e=arguments.length>1&&void 0!==arguments[1]&&arguments[1];return e?(fr(),mr(sr,null,t)):Ar(sr,null,t)}function Rr(t){return null==t||"boolean"===typeof t?Ar(sr):(0,l.cy)(t)?Ar(or,null,t.slice()):yr(t)?Dr(t):Ar(ar,null,String(t))}function Dr(t){return null===t.el&&-1!==t.patchFlag||t.memo?t:Sr(t)}function Ir(t,e){var r=0,n=t.shapeFlag;if(null==e)e=null;else if((0,l.cy)(e))r=16;else if("object"===(0,a.A)(e)){if(65&n){var i=e["default"];return void(i&&(i._c&&(i._d=!1),Ir(t,i()),i._c&&(i._d=!0)))}r=32;var o=e._;o||de(e)?3===o&&D&&(1===D.slots._?e._=1:(e._=2,t.patchFlag|=1024)):e._ctx=D}else(0,l.Tn)(e)?(e={default:e,_ctx:D},r=32):(e=String(e),64&n?(r=16,e=[Cr(e)]):r=8);t.children=e,t.shapeFlag|=r}function Lr(){for(var t={},e=0;e<arguments.length;e++){var r=e<0||arguments.length<=e?void 0:arguments[e];for(var n in r)if("class"===n)t["class"]!==r["class"]&&(t["class"]=(0,l.C4)([t["class"],r["class"]]));else if("style"===n)t.style=(0,l.Tr)([t.style,r.style]);else if((0,l.Mp)(n)){var i=t[n],o=r[n];!o||i===o||(0,l.cy)(i)&&i.includes(o)||(t[n]=i?[].concat(i,o):o)}else""!==n&&(t[n]=r[n])}return t}function Pr(t,e,r){var n=arguments.length>3&&void 0!==arguments[3]?arguments[3]:null;p(t,e,7,[r,n])}var jr=ae(),Fr=0;function Mr(t,e,r){var n=t.type,i=(e?e.appContext:t.appContext)||jr,o={uid:Fr++,vnode:t,type:n,parent:e,appContext:i,root:null,next:null,subTree:null,effect:null,update:null,job:null,scope:new c.yC(!0),render:null,proxy:null,exposed:null,exposeProxy:null,withProxy:null,provides:e?e.provides:Object.create(i.provides),ids:e?e.ids:["",0,0],accessCache:null,renderCache:[],components:null,directives:null,propsOptions:we(n,i),emitsOptions:Ke(n,i),emit:null,emitted:null,propsDefaults:l.MZ,inheritAttrs:n.inheritAttrs,ctx:l.MZ,data:l.MZ,props:l.MZ,attrs:l.MZ,slots:l.MZ,refs:l.MZ,setupState:l.MZ,setupContext:null,suspense:r,suspenseId:r?r.pendingId:0,asyncDep:null,asyncResolved:!1,isMounted:!1,isUnmounted:!1,isDeactivated:!1,bc:null,c:null,bm:null,m:null,bu:null,u:null,um:null,bum:null,da:null,a:null,rtg:null,rtc:null,ec:null,sp:null};return o.ctx={_:o},o.root=e?e.root:o,o.emit=Ze.bind(null,o),t.ce&&t.ce(o),o}var Nr,qr,Ur=null,Br=function(){return Ur||D},zr=(0,l.We)(),Vr=function(t,e){var r;return(r=zr[t])||(r=zr[t]=[]),r.push(e),function(t){r.length>1?r.forEach((function(e){return e(t)})):r[0](t)}};Nr=Vr("__VUE_INSTANCE_SETTERS__",(function(t){return Ur=t})),qr=Vr("__VUE_SSR_SETTERS__",(function(t){return Kr=t}));var $r=function(t){var e=Ur;return Nr(t),t.scope.on(),function(){t.scope.off(),Nr(e)}},Gr=function(){Ur&&Ur.scope.off(),Nr(null)};function Hr(t){return 4&t.vnode.shapeFlag}var Wr,Zr,Kr=!1;function Yr(t){var e=arguments.length>1&&void 0!==arguments[1]&&arguments[1],r=arguments.length>2&&void 0!==arguments[2]&&arguments[2];e&&qr(e);var n=t.vnode,i=n.props,o=n.children,a=Hr(t);ve(t,i,a,e),Oe(t,o,r);var s=a?Jr(t,e):void 0;return e&&qr(!1),s}function Jr(t,e){var r=t.type;t.accessCache=Object.create(null),t.proxy=new Proxy(t.ctx,Vt);var n=r.setup;if(n){(0,c.C4)();var i=t.setupContext=n.length>1?en(t):null,o=$r(t),a=f(n,t,0,[t.props,i]),s=(0,l.yL)(a);if((0,c.bl)(),o(),!s&&!t.sp||ht(t)||ft(t),s){if(a.then(Gr,Gr),e)return a.then((function(r){Xr(t,r,e)}))["catch"]((function(e){h(e,t,0)}));t.asyncDep=a}else Xr(t,a,e)}else Qr(t,e)}function Xr(t,e,r){(0,l.Tn)(e)?t.type.__ssrInlineRender?t.ssrRender=e:t.render=e:(0,l.Gv)(e)&&(t.setupState=(0,c.Pr)(e)),Qr(t,r)}function Qr(t,e,r){var n=t.type;if(!t.render){if(!e&&Wr&&!n.render){var i=n.template||Yt(t).template;if(i){0;var o=t.appContext.config,a=o.isCustomElement,s=o.compilerOptions,u=n.delimiters,f=n.compilerOptions,p=(0,l.X$)((0,l.X$)({isCustomElement:a,delimiters:u},s),f);n.render=Wr(i,p)}}t.render=n.render||l.tE,Zr&&Zr(t)}var h=$r(t);(0,c.C4)();try{Ht(t)}finally{(0,c.bl)(),h()}}var tn={get:function(t,e){return(0,c.u4)(t,"get",""),t[e]}};function en(t){var e=function(e){t.exposed=e||{}};return{attrs:new Proxy(t.attrs,tn),slots:t.slots,emit:t.emit,expose:e}}function rn(t){return t.exposed?t.exposeProxy||(t.exposeProxy=new Proxy((0,c.Pr)((0,c.IG)(t.exposed)),{get:function(e,r){return r in e?e[r]:r in Bt?Bt[r](t):void 0},has:function(t,e){return e in t||e in Bt}})):t.proxy}function nn(t){var e=!(arguments.length>1&&void 0!==arguments[1])||arguments[1];return(0,l.Tn)(t)?t.displayName||t.name:t.name||e&&t.__name}function on(t){return(0,l.Tn)(t)&&"__vccOpts"in t}var an=function(t,e){var r=(0,c.EW)(t,e,Kr);return r};function sn(t,e,r){var n=arguments.length;return 2===n?(0,l.Gv)(e)&&!(0,l.cy)(e)?yr(e)?Ar(t,null,[e]):Ar(t,e):Ar(t,null,e):(n>3?r=Array.prototype.slice.call(arguments,2):3===n&&yr(r)&&(r=[r]),Ar(t,e,r))}var un="3.5.13"},56969:(t,e,r)=>{"use strict";var n=r(72777),i=r(10757);t.exports=function(t){var e=n(t,"string");return i(e)?e:e+""}},57029:(t,e,r)=>{"use strict";var n=r(48981),i=r(35610),o=r(26198),a=r(84606),s=Math.min;t.exports=[].copyWithin||function(t,e){var r=n(this),u=o(r),c=i(t,u),l=i(e,u),f=arguments.length>2?arguments[2]:void 0,p=s((void 0===f?u:i(f,u))-l,u-c),h=1;l<c&&c<l+p&&(h=-1,l+=p-1,c+=p-1);while(p-- >0)l in r?r[c]=r[l]:a(r,c),c+=h,l+=h;return r}},57145:(t,e,r)=>{"use strict";var n=r(46518),i=r(79504),o=r(79306),a=r(25397),s=r(35370),u=r(44124),c=r(6469),l=Array,f=i(u("Array","sort"));n({target:"Array",proto:!0},{toSorted:function(t){void 0!==t&&o(t);var e=a(this),r=s(l,e);return f(r,t)}}),c("toSorted")},57301:(t,e,r)=>{"use strict";var n=r(94644),i=r(59213).some,o=n.aTypedArray,a=n.exportTypedArrayMethod;a("some",(function(t){return i(o(this),t,arguments.length>1?arguments[1]:void 0)}))},57323:(t,e,r)=>{"use strict";var n=r(69565),i=r(79504),o=r(655),a=r(67979),s=r(58429),u=r(25745),c=r(2360),l=r(91181).get,f=r(83635),p=r(18814),h=u("native-string-replace",String.prototype.replace),d=RegExp.prototype.exec,v=d,g=i("".charAt),m=i("".indexOf),y=i("".replace),b=i("".slice),w=function(){var t=/a/,e=/b*/g;return n(d,t,"a"),n(d,e,"a"),0!==t.lastIndex||0!==e.lastIndex}(),_=s.BROKEN_CARET,x=void 0!==/()??/.exec("")[1],A=w||x||_||f||p;A&&(v=function(t){var e,r,i,s,u,f,p,A=this,k=l(A),E=o(t),S=k.raw;if(S)return S.lastIndex=A.lastIndex,e=n(v,S,E),A.lastIndex=S.lastIndex,e;var C=k.groups,O=_&&A.sticky,T=n(a,A),R=A.source,D=0,I=E;if(O&&(T=y(T,"y",""),-1===m(T,"g")&&(T+="g"),I=b(E,A.lastIndex),A.lastIndex>0&&(!A.multiline||A.multiline&&"\n"!==g(E,A.lastIndex-1))&&(R="(?: "+R+")",I=" "+I,D++),r=new RegExp("^(?:"+R+")",T)),x&&(r=new RegExp("^"+R+"$(?!\\s)",T)),w&&(i=A.lastIndex),s=n(d,O?r:A,I),O?s?(s.input=b(s.input,D),s[0]=b(s[0],D),s.index=A.lastIndex,A.lastIndex+=s[0].length):A.lastIndex=0:w&&s&&(A.lastIndex=A.global?s.index+s[0].length:i),x&&s&&s.length>1&&n(h,s[0],r,(function(){for(u=1;u<arguments.length-2;u++)void 0===arguments[u]&&(s[u]=void 0)})),s&&C)for(s.groups=f=c(null),u=0;u<C.length;u++)p=C[u],f[p[0]]=s[p[1]];return s}),t.exports=v},57465:(t,e,r)=>{"use strict";var n=r(43724),i=r(83635),o=r(44576),a=r(62106),s=r(91181).get,u=RegExp.prototype,c=TypeError;n&&i&&a(u,"dotAll",{configurable:!0,get:function(){if(this!==u){if("RegExp"===o(this))return!!s(this).dotAll;throw new c("Incompatible receiver, RegExp required")}}})},57657:(t,e,r)=>{"use strict";var n,i,o,a=r(79039),s=r(94901),u=r(20034),c=r(2360),l=r(42787),f=r(36840),p=r(78227),h=r(96395),d=p("iterator"),v=!1;[].keys&&(o=[].keys(),"next"in o?(i=l(l(o)),i!==Object.prototype&&(n=i)):v=!0);var g=!u(n)||a((function(){var t={};return n[d].call(t)!==t}));g?n={}:h&&(n=c(n)),s(n[d])||f(n,d,(function(){return this})),t.exports={IteratorPrototype:n,BUGGY_SAFARI_ITERATORS:v}},57696:(t,e,r)=>{"use strict";var n=r(91291),i=r(18014),o=RangeError;t.exports=function(t){if(void 0===t)return 0;var e=n(t),r=i(e);if(e!==r)throw new o("Wrong length or index");return r}},57829:(t,e,r)=>{"use strict";var n=r(68183).charAt;t.exports=function(t,e,r){return e+(r?n(t,e).length:1)}},58004:(t,e,r)=>{"use strict";var n=r(46518),i=r(79039),o=r(68750),a=r(84916),s=!a("intersection",(function(t){return 2===t.size&&t.has(1)&&t.has(2)}))||i((function(){return"3,2"!==String(Array.from(new Set([1,2,3]).intersection(new Set([3,2]))))}));n({target:"Set",proto:!0,real:!0,forced:s},{intersection:o})},58229:(t,e,r)=>{"use strict";var n=r(99590),i=RangeError;t.exports=function(t,e){var r=n(t);if(r%e)throw new i("Wrong offset");return r}},58242:(t,e,r)=>{"use strict";var n=r(69565),i=r(97751),o=r(78227),a=r(36840);t.exports=function(){var t=i("Symbol"),e=t&&t.prototype,r=e&&e.valueOf,s=o("toPrimitive");e&&!e[s]&&a(e,s,(function(t){return n(r,this)}),{arity:1})}},58319:t=>{"use strict";var e=Math.round;t.exports=function(t){var r=e(t);return r<0?0:r>255?255:255&r}},58429:(t,e,r)=>{"use strict";var n=r(79039),i=r(22195),o=i.RegExp,a=n((function(){var t=o("a","y");return t.lastIndex=2,null!==t.exec("abcd")})),s=a||n((function(){return!o("a","y").sticky})),u=a||n((function(){var t=o("^r","gy");return t.lastIndex=2,null!==t.exec("str")}));t.exports={BROKEN_CARET:u,MISSED_STICKY:s,UNSUPPORTED_Y:a}},58622:(t,e,r)=>{"use strict";var n=r(22195),i=r(94901),o=n.WeakMap;t.exports=i(o)&&/native code/.test(String(o))},58940:(t,e,r)=>{"use strict";var n=r(46518),i=r(52703);n({global:!0,forced:parseInt!==i},{parseInt:i})},59089:(t,e,r)=>{"use strict";var n=r(46518),i=r(79504),o=Date,a=i(o.prototype.getTime);n({target:"Date",stat:!0},{now:function(){return a(new o)}})},59213:(t,e,r)=>{"use strict";var n=r(76080),i=r(79504),o=r(47055),a=r(48981),s=r(26198),u=r(1469),c=i([].push),l=function(t){var e=1===t,r=2===t,i=3===t,l=4===t,f=6===t,p=7===t,h=5===t||f;return function(d,v,g,m){for(var y,b,w=a(d),_=o(w),x=s(_),A=n(v,g),k=0,E=m||u,S=e?E(d,x):r||p?E(d,0):void 0;x>k;k++)if((h||k in _)&&(y=_[k],b=A(y,k,w),t))if(e)S[k]=b;else if(b)switch(t){case 3:return!0;case 5:return y;case 6:return k;case 2:c(S,y)}else switch(t){case 4:return!1;case 7:c(S,y)}return f?-1:i||l?l:S}};t.exports={forEach:l(0),map:l(1),filter:l(2),some:l(3),every:l(4),find:l(5),findIndex:l(6),filterReject:l(7)}},59225:(t,e,r)=>{"use strict";var n,i,o,a,s=r(22195),u=r(18745),c=r(76080),l=r(94901),f=r(39297),p=r(79039),h=r(20397),d=r(67680),v=r(4055),g=r(22812),m=r(89544),y=r(16193),b=s.setImmediate,w=s.clearImmediate,_=s.process,x=s.Dispatch,A=s.Function,k=s.MessageChannel,E=s.String,S=0,C={},O="onreadystatechange";p((function(){n=s.location}));var T=function(t){if(f(C,t)){var e=C[t];delete C[t],e()}},R=function(t){return function(){T(t)}},D=function(t){T(t.data)},I=function(t){s.postMessage(E(t),n.protocol+"//"+n.host)};b&&w||(b=function(t){g(arguments.length,1);var e=l(t)?t:A(t),r=d(arguments,1);return C[++S]=function(){u(e,void 0,r)},i(S),S},w=function(t){delete C[t]},y?i=function(t){_.nextTick(R(t))}:x&&x.now?i=function(t){x.now(R(t))}:k&&!m?(o=new k,a=o.port2,o.port1.onmessage=D,i=c(a.postMessage,a)):s.addEventListener&&l(s.postMessage)&&!s.importScripts&&n&&"file:"!==n.protocol&&!p(I)?(i=I,s.addEventListener("message",D,!1)):i=O in v("script")?function(t){h.appendChild(v("script"))[O]=function(){h.removeChild(this),T(t)}}:function(t){setTimeout(R(t),0)}),t.exports={set:b,clear:w}},59848:(t,e,r)=>{"use strict";r(86368),r(29309)},59904:(t,e,r)=>{"use strict";var n=r(46518),i=r(43724),o=r(2360);n({target:"Object",stat:!0,sham:!i},{create:o})},60479:(t,e,r)=>{"use strict";var n=r(10687);n(Math,"Math",!0)},60511:(t,e,r)=>{"use strict";var n=r(60788),i=TypeError;t.exports=function(t){if(n(t))throw new i("The method doesn't accept regular expressions");return t}},60533:(t,e,r)=>{"use strict";var n=r(79504),i=r(18014),o=r(655),a=r(72333),s=r(67750),u=n(a),c=n("".slice),l=Math.ceil,f=function(t){return function(e,r,n){var a,f,p=o(s(e)),h=i(r),d=p.length,v=void 0===n?" ":o(n);return h<=d||""===v?p:(a=h-d,f=u(v,l(a/v.length)),f.length>a&&(f=c(f,0,a)),t?p+f:f+p)}};t.exports={start:f(!1),end:f(!0)}},60706:(t,e,r)=>{"use strict";var n=r(10350).PROPER,i=r(79039),o=r(47452),a="​᠎";t.exports=function(t){return i((function(){return!!o[t]()||a[t]()!==a||n&&o[t].name!==t}))}},60739:(t,e,r)=>{"use strict";var n=r(46518),i=r(79039),o=r(48981),a=r(72777),s=i((function(){return null!==new Date(NaN).toJSON()||1!==Date.prototype.toJSON.call({toISOString:function(){return 1}})}));n({target:"Date",proto:!0,arity:1,forced:s},{toJSON:function(t){var e=o(this),r=a(e,"number");return"number"!=typeof r||isFinite(r)?e.toISOString():null}})},60782:(t,e,r)=>{"use strict";r.d(e,{y$:()=>ut,Pj:()=>k});var n=r(54119),i=(r(16280),r(76918),r(28706),r(2008),r(51629),r(74423),r(25276),r(64346),r(48598),r(62062),r(44114),r(72712),r(34782),r(54554),r(13609),r(59089),r(60739),r(23288),r(94170),r(18111),r(22489),r(7588),r(61701),r(18237),r(33110),r(59904),r(67945),r(84185),r(79432),r(26099),r(38781),r(99449),r(21699),r(47764),r(23500),r(62953),r(56768)),o=r(90144);r(55081);function a(){return s().__VUE_DEVTOOLS_GLOBAL_HOOK__}function s(){return"undefined"!==typeof navigator&&"undefined"!==typeof window?window:"undefined"!==typeof globalThis?globalThis:{}}var u,c,l="function"===typeof Proxy,f="devtools-plugin:setup",p="plugin:settings:set",h=r(14048),d=r(74721),v=r(3604),g=r(30388),m=r(25026),y=r(69492);function b(){var t;return void 0!==u||("undefined"!==typeof window&&window.performance?(u=!0,c=window.performance):"undefined"!==typeof globalThis&&(null===(t=globalThis.perf_hooks)||void 0===t?void 0:t.performance)?(u=!0,c=globalThis.perf_hooks.performance):u=!1),u}function w(){return b()?c.now():Date.now()}var _=function(){function t(e,r){var n=this;(0,m.A)(this,t),this.target=null,this.targetQueue=[],this.onQueue=[],this.plugin=e,this.hook=r;var i={};if(e.settings)for(var o in e.settings){var a=e.settings[o];i[o]=a.defaultValue}var s="__vue-devtools-plugin-settings__".concat(e.id),u=Object.assign({},i);try{var c=localStorage.getItem(s),l=JSON.parse(c);Object.assign(u,l)}catch(f){}this.fallbacks={getSettings:function(){return u},setSettings:function(t){try{localStorage.setItem(s,JSON.stringify(t))}catch(f){}u=t},now:function(){return w()}},r&&r.on(p,(function(t,e){t===n.plugin.id&&n.fallbacks.setSettings(e)})),this.proxiedOn=new Proxy({},{get:function(t,e){return n.target?n.target.on[e]:function(){for(var t=arguments.length,r=new Array(t),i=0;i<t;i++)r[i]=arguments[i];n.onQueue.push({method:e,args:r})}}}),this.proxiedTarget=new Proxy({},{get:function(t,e){return n.target?n.target[e]:"on"===e?n.proxiedOn:Object.keys(n.fallbacks).includes(e)?function(){for(var t,r=arguments.length,i=new Array(r),o=0;o<r;o++)i[o]=arguments[o];return n.targetQueue.push({method:e,args:i,resolve:function(){}}),(t=n.fallbacks)[e].apply(t,i)}:function(){for(var t=arguments.length,r=new Array(t),i=0;i<t;i++)r[i]=arguments[i];return new Promise((function(t){n.targetQueue.push({method:e,args:r,resolve:t})}))}}})}return(0,y.A)(t,[{key:"setRealTarget",value:function(){var t=(0,g.A)((0,h.A)().mark((function t(e){var r,n,i,o,a,s,u,c;return(0,h.A)().wrap((function(t){while(1)switch(t.prev=t.next){case 0:this.target=e,r=(0,v.A)(this.onQueue);try{for(r.s();!(n=r.n()).done;)o=n.value,(i=this.target.on)[o.method].apply(i,(0,d.A)(o.args))}catch(l){r.e(l)}finally{r.f()}a=(0,v.A)(this.targetQueue),t.prev=4,a.s();case 6:if((s=a.n()).done){t.next=15;break}return c=s.value,t.t0=c,t.next=11,(u=this.target)[c.method].apply(u,(0,d.A)(c.args));case 11:t.t1=t.sent,t.t0.resolve.call(t.t0,t.t1);case 13:t.next=6;break;case 15:t.next=20;break;case 17:t.prev=17,t.t2=t["catch"](4),a.e(t.t2);case 20:return t.prev=20,a.f(),t.finish(20);case 23:case"end":return t.stop()}}),t,this,[[4,17,20,23]])})));function e(e){return t.apply(this,arguments)}return e}()}])}();function x(t,e){var r=t,n=s(),i=a(),o=l&&r.enableEarlyProxy;if(!i||!n.__VUE_DEVTOOLS_PLUGIN_API_AVAILABLE__&&o){var u=o?new _(r,i):null,c=n.__VUE_DEVTOOLS_PLUGINS__=n.__VUE_DEVTOOLS_PLUGINS__||[];c.push({pluginDescriptor:r,setupFn:e,proxy:u}),u&&e(u.proxiedTarget)}else i.emit(f,t,e)}
-/*!
- * vuex v4.1.0
- * (c) 2022 Evan You
- * @license MIT
- */
-var A="store";function k(t){return void 0===t&&(t=null),(0,i.WQ)(null!==t?t:A)}function E(t,e){Object.keys(t).forEach((function(r){return e(t[r],r)}))}function S(t){return null!==t&&"object"===(0,n.A)(t)}function C(t){return t&&"function"===typeof t.then}function O(t,e){return function(){return t(e)}}function T(t,e,r){return e.indexOf(t)<0&&(r&&r.prepend?e.unshift(t):e.push(t)),function(){var r=e.indexOf(t);r>-1&&e.splice(r,1)}}function R(t,e){t._actions=Object.create(null),t._mutations=Object.create(null),t._wrappedGetters=Object.create(null),t._modulesNamespaceMap=Object.create(null);var r=t.state;I(t,r,[],t._modules.root,!0),D(t,r,e)}function D(t,e,r){var n=t._state,a=t._scope;t.getters={},t._makeLocalGettersCache=Object.create(null);var s=t._wrappedGetters,u={},c={},l=(0,o.uY)(!0);l.run((function(){E(s,(function(e,r){u[r]=O(e,t),c[r]=(0,i.EW)((function(){return u[r]()})),Object.defineProperty(t.getters,r,{get:function(){return c[r].value},enumerable:!0})}))})),t._state=(0,o.Kh)({data:e}),t._scope=l,t.strict&&N(t),n&&r&&t._withCommit((function(){n.data=null})),a&&a.stop()}function I(t,e,r,n,i){var o=!r.length,a=t._modules.getNamespace(r);if(n.namespaced&&(t._modulesNamespaceMap[a],t._modulesNamespaceMap[a]=n),!o&&!i){var s=q(e,r.slice(0,-1)),u=r[r.length-1];t._withCommit((function(){s[u]=n.state}))}var c=n.context=L(t,a,r);n.forEachMutation((function(e,r){var n=a+r;j(t,n,e,c)})),n.forEachAction((function(e,r){var n=e.root?r:a+r,i=e.handler||e;F(t,n,i,c)})),n.forEachGetter((function(e,r){var n=a+r;M(t,n,e,c)})),n.forEachChild((function(n,o){I(t,e,r.concat(o),n,i)}))}function L(t,e,r){var n=""===e,i={dispatch:n?t.dispatch:function(r,n,i){var o=U(r,n,i),a=o.payload,s=o.options,u=o.type;return s&&s.root||(u=e+u),t.dispatch(u,a)},commit:n?t.commit:function(r,n,i){var o=U(r,n,i),a=o.payload,s=o.options,u=o.type;s&&s.root||(u=e+u),t.commit(u,a,s)}};return Object.defineProperties(i,{getters:{get:n?function(){return t.getters}:function(){return P(t,e)}},state:{get:function(){return q(t.state,r)}}}),i}function P(t,e){if(!t._makeLocalGettersCache[e]){var r={},n=e.length;Object.keys(t.getters).forEach((function(i){if(i.slice(0,n)===e){var o=i.slice(n);Object.defineProperty(r,o,{get:function(){return t.getters[i]},enumerable:!0})}})),t._makeLocalGettersCache[e]=r}return t._makeLocalGettersCache[e]}function j(t,e,r,n){var i=t._mutations[e]||(t._mutations[e]=[]);i.push((function(e){r.call(t,n.state,e)}))}function F(t,e,r,n){var i=t._actions[e]||(t._actions[e]=[]);i.push((function(e){var i=r.call(t,{dispatch:n.dispatch,commit:n.commit,getters:n.getters,state:n.state,rootGetters:t.getters,rootState:t.state},e);return C(i)||(i=Promise.resolve(i)),t._devtoolHook?i["catch"]((function(e){throw t._devtoolHook.emit("vuex:error",e),e})):i}))}function M(t,e,r,n){t._wrappedGetters[e]||(t._wrappedGetters[e]=function(t){return r(n.state,n.getters,t.state,t.getters)})}function N(t){(0,i.wB)((function(){return t._state.data}),(function(){0}),{deep:!0,flush:"sync"})}function q(t,e){return e.reduce((function(t,e){return t[e]}),t)}function U(t,e,r){return S(t)&&t.type&&(r=e,e=t,t=t.type),{type:t,payload:e,options:r}}var B="vuex bindings",z="vuex:mutations",V="vuex:actions",$="vuex",G=0;function H(t,e){x({id:"org.vuejs.vuex",app:t,label:"Vuex",homepage:"https://next.vuex.vuejs.org/",logo:"https://vuejs.org/images/icons/favicon-96x96.png",packageName:"vuex",componentStateTypes:[B]},(function(r){r.addTimelineLayer({id:z,label:"Vuex Mutations",color:W}),r.addTimelineLayer({id:V,label:"Vuex Actions",color:W}),r.addInspector({id:$,label:"Vuex",icon:"storage",treeFilterPlaceholder:"Filter stores..."}),r.on.getInspectorTree((function(r){if(r.app===t&&r.inspectorId===$)if(r.filter){var n=[];Q(n,e._modules.root,r.filter,""),r.rootNodes=n}else r.rootNodes=[X(e._modules.root,"")]})),r.on.getInspectorState((function(r){if(r.app===t&&r.inspectorId===$){var n=r.nodeId;P(e,n),r.state=tt(rt(e._modules,n),"root"===n?e.getters:e._makeLocalGettersCache,n)}})),r.on.editInspectorState((function(r){if(r.app===t&&r.inspectorId===$){var n=r.nodeId,i=r.path;"root"!==n&&(i=n.split("/").filter(Boolean).concat(i)),e._withCommit((function(){r.set(e._state.data,i,r.state.value)}))}})),e.subscribe((function(t,e){var n={};t.payload&&(n.payload=t.payload),n.state=e,r.notifyComponentUpdate(),r.sendInspectorTree($),r.sendInspectorState($),r.addTimelineEvent({layerId:z,event:{time:Date.now(),title:t.type,data:n}})})),e.subscribeAction({before:function(t,e){var n={};t.payload&&(n.payload=t.payload),t._id=G++,t._time=Date.now(),n.state=e,r.addTimelineEvent({layerId:V,event:{time:t._time,title:t.type,groupId:t._id,subtitle:"start",data:n}})},after:function(t,e){var n={},i=Date.now()-t._time;n.duration={_custom:{type:"duration",display:i+"ms",tooltip:"Action duration",value:i}},t.payload&&(n.payload=t.payload),n.state=e,r.addTimelineEvent({layerId:V,event:{time:Date.now(),title:t.type,groupId:t._id,subtitle:"end",data:n}})}})}))}var W=8702998,Z=6710886,K=16777215,Y={label:"namespaced",textColor:K,backgroundColor:Z};function J(t){return t&&"root"!==t?t.split("/").slice(-2,-1)[0]:"Root"}function X(t,e){return{id:e||"root",label:J(e),tags:t.namespaced?[Y]:[],children:Object.keys(t._children).map((function(r){return X(t._children[r],e+r+"/")}))}}function Q(t,e,r,n){n.includes(r)&&t.push({id:n||"root",label:n.endsWith("/")?n.slice(0,n.length-1):n||"Root",tags:e.namespaced?[Y]:[]}),Object.keys(e._children).forEach((function(i){Q(t,e._children[i],r,n+i+"/")}))}function tt(t,e,r){e="root"===r?e:e[r];var n=Object.keys(e),i={state:Object.keys(t.state).map((function(e){return{key:e,editable:!0,value:t.state[e]}}))};if(n.length){var o=et(e);i.getters=Object.keys(o).map((function(t){return{key:t.endsWith("/")?J(t):t,editable:!1,value:nt((function(){return o[t]}))}}))}return i}function et(t){var e={};return Object.keys(t).forEach((function(r){var n=r.split("/");if(n.length>1){var i=e,o=n.pop();n.forEach((function(t){i[t]||(i[t]={_custom:{value:{},display:t,tooltip:"Module",abstract:!0}}),i=i[t]._custom.value})),i[o]=nt((function(){return t[r]}))}else e[r]=nt((function(){return t[r]}))})),e}function rt(t,e){var r=e.split("/").filter((function(t){return t}));return r.reduce((function(t,n,i){var o=t[n];if(!o)throw new Error('Missing module "'+n+'" for path "'+e+'".');return i===r.length-1?o:o._children}),"root"===e?t:t.root._children)}function nt(t){try{return t()}catch(e){return e}}var it=function(t,e){this.runtime=e,this._children=Object.create(null),this._rawModule=t;var r=t.state;this.state=("function"===typeof r?r():r)||{}},ot={namespaced:{configurable:!0}};ot.namespaced.get=function(){return!!this._rawModule.namespaced},it.prototype.addChild=function(t,e){this._children[t]=e},it.prototype.removeChild=function(t){delete this._children[t]},it.prototype.getChild=function(t){return this._children[t]},it.prototype.hasChild=function(t){return t in this._children},it.prototype.update=function(t){this._rawModule.namespaced=t.namespaced,t.actions&&(this._rawModule.actions=t.actions),t.mutations&&(this._rawModule.mutations=t.mutations),t.getters&&(this._rawModule.getters=t.getters)},it.prototype.forEachChild=function(t){E(this._children,t)},it.prototype.forEachGetter=function(t){this._rawModule.getters&&E(this._rawModule.getters,t)},it.prototype.forEachAction=function(t){this._rawModule.actions&&E(this._rawModule.actions,t)},it.prototype.forEachMutation=function(t){this._rawModule.mutations&&E(this._rawModule.mutations,t)},Object.defineProperties(it.prototype,ot);var at=function(t){this.register([],t,!1)};function st(t,e,r){if(e.update(r),r.modules)for(var n in r.modules){if(!e.getChild(n))return void 0;st(t.concat(n),e.getChild(n),r.modules[n])}}at.prototype.get=function(t){return t.reduce((function(t,e){return t.getChild(e)}),this.root)},at.prototype.getNamespace=function(t){var e=this.root;return t.reduce((function(t,r){return e=e.getChild(r),t+(e.namespaced?r+"/":"")}),"")},at.prototype.update=function(t){st([],this.root,t)},at.prototype.register=function(t,e,r){var n=this;void 0===r&&(r=!0);var i=new it(e,r);if(0===t.length)this.root=i;else{var o=this.get(t.slice(0,-1));o.addChild(t[t.length-1],i)}e.modules&&E(e.modules,(function(e,i){n.register(t.concat(i),e,r)}))},at.prototype.unregister=function(t){var e=this.get(t.slice(0,-1)),r=t[t.length-1],n=e.getChild(r);n&&n.runtime&&e.removeChild(r)},at.prototype.isRegistered=function(t){var e=this.get(t.slice(0,-1)),r=t[t.length-1];return!!e&&e.hasChild(r)};function ut(t){return new ct(t)}var ct=function(t){var e=this;void 0===t&&(t={});var r=t.plugins;void 0===r&&(r=[]);var n=t.strict;void 0===n&&(n=!1);var i=t.devtools;this._committing=!1,this._actions=Object.create(null),this._actionSubscribers=[],this._mutations=Object.create(null),this._wrappedGetters=Object.create(null),this._modules=new at(t),this._modulesNamespaceMap=Object.create(null),this._subscribers=[],this._makeLocalGettersCache=Object.create(null),this._scope=null,this._devtools=i;var o=this,a=this,s=a.dispatch,u=a.commit;this.dispatch=function(t,e){return s.call(o,t,e)},this.commit=function(t,e,r){return u.call(o,t,e,r)},this.strict=n;var c=this._modules.root.state;I(this,c,[],this._modules.root),D(this,c),r.forEach((function(t){return t(e)}))},lt={state:{configurable:!0}};ct.prototype.install=function(t,e){t.provide(e||A,this),t.config.globalProperties.$store=this;var r=void 0!==this._devtools&&this._devtools;r&&H(t,this)},lt.state.get=function(){return this._state.data},lt.state.set=function(t){0},ct.prototype.commit=function(t,e,r){var n=this,i=U(t,e,r),o=i.type,a=i.payload,s=(i.options,{type:o,payload:a}),u=this._mutations[o];u&&(this._withCommit((function(){u.forEach((function(t){t(a)}))})),this._subscribers.slice().forEach((function(t){return t(s,n.state)})))},ct.prototype.dispatch=function(t,e){var r=this,n=U(t,e),i=n.type,o=n.payload,a={type:i,payload:o},s=this._actions[i];if(s){try{this._actionSubscribers.slice().filter((function(t){return t.before})).forEach((function(t){return t.before(a,r.state)}))}catch(c){0}var u=s.length>1?Promise.all(s.map((function(t){return t(o)}))):s[0](o);return new Promise((function(t,e){u.then((function(e){try{r._actionSubscribers.filter((function(t){return t.after})).forEach((function(t){return t.after(a,r.state)}))}catch(c){0}t(e)}),(function(t){try{r._actionSubscribers.filter((function(t){return t.error})).forEach((function(e){return e.error(a,r.state,t)}))}catch(c){0}e(t)}))}))}},ct.prototype.subscribe=function(t,e){return T(t,this._subscribers,e)},ct.prototype.subscribeAction=function(t,e){var r="function"===typeof t?{before:t}:t;return T(r,this._actionSubscribers,e)},ct.prototype.watch=function(t,e,r){var n=this;return(0,i.wB)((function(){return t(n.state,n.getters)}),e,Object.assign({},r))},ct.prototype.replaceState=function(t){var e=this;this._withCommit((function(){e._state.data=t}))},ct.prototype.registerModule=function(t,e,r){void 0===r&&(r={}),"string"===typeof t&&(t=[t]),this._modules.register(t,e),I(this,this.state,t,this._modules.get(t),r.preserveState),D(this,this.state)},ct.prototype.unregisterModule=function(t){var e=this;"string"===typeof t&&(t=[t]),this._modules.unregister(t),this._withCommit((function(){var r=q(e.state,t.slice(0,-1));delete r[t[t.length-1]]})),R(this)},ct.prototype.hasModule=function(t){return"string"===typeof t&&(t=[t]),this._modules.isRegistered(t)},ct.prototype.hotUpdate=function(t){this._modules.update(t),R(this,!0)},ct.prototype._withCommit=function(t){var e=this._committing;this._committing=!0,t(),this._committing=e},Object.defineProperties(ct.prototype,lt);ht((function(t,e){var r={};return ft(e).forEach((function(e){var n=e.key,i=e.val;r[n]=function(){var e=this.$store.state,r=this.$store.getters;if(t){var n=dt(this.$store,"mapState",t);if(!n)return;e=n.context.state,r=n.context.getters}return"function"===typeof i?i.call(this,e,r):e[i]},r[n].vuex=!0})),r})),ht((function(t,e){var r={};return ft(e).forEach((function(e){var n=e.key,i=e.val;r[n]=function(){var e=[],r=arguments.length;while(r--)e[r]=arguments[r];var n=this.$store.commit;if(t){var o=dt(this.$store,"mapMutations",t);if(!o)return;n=o.context.commit}return"function"===typeof i?i.apply(this,[n].concat(e)):n.apply(this.$store,[i].concat(e))}})),r})),ht((function(t,e){var r={};return ft(e).forEach((function(e){var n=e.key,i=e.val;i=t+i,r[n]=function(){if(!t||dt(this.$store,"mapGetters",t))return this.$store.getters[i]},r[n].vuex=!0})),r})),ht((function(t,e){var r={};return ft(e).forEach((function(e){var n=e.key,i=e.val;r[n]=function(){var e=[],r=arguments.length;while(r--)e[r]=arguments[r];var n=this.$store.dispatch;if(t){var o=dt(this.$store,"mapActions",t);if(!o)return;n=o.context.dispatch}return"function"===typeof i?i.apply(this,[n].concat(e)):n.apply(this.$store,[i].concat(e))}})),r}));function ft(t){return pt(t)?Array.isArray(t)?t.map((function(t){return{key:t,val:t}})):Object.keys(t).map((function(e){return{key:e,val:t[e]}})):[]}function pt(t){return Array.isArray(t)||S(t)}function ht(t){return function(e,r){return"string"!==typeof e?(r=e,e=""):"/"!==e.charAt(e.length-1)&&(e+="/"),t(e,r)}}function dt(t,e,r){var n=t._modulesNamespaceMap[r];return n}},60788:(t,e,r)=>{"use strict";var n=r(20034),i=r(44576),o=r(78227),a=o("match");t.exports=function(t){var e;return n(t)&&(void 0!==(e=t[a])?!!e:"RegExp"===i(t))}},60825:(t,e,r)=>{"use strict";var n=r(46518),i=r(97751),o=r(18745),a=r(30566),s=r(35548),u=r(28551),c=r(20034),l=r(2360),f=r(79039),p=i("Reflect","construct"),h=Object.prototype,d=[].push,v=f((function(){function t(){}return!(p((function(){}),[],t)instanceof t)})),g=!f((function(){p((function(){}))})),m=v||g;n({target:"Reflect",stat:!0,forced:m,sham:m},{construct:function(t,e){s(t),u(e);var r=arguments.length<3?t:s(arguments[2]);if(g&&!v)return p(t,e,r);if(t===r){switch(e.length){case 0:return new t;case 1:return new t(e[0]);case 2:return new t(e[0],e[1]);case 3:return new t(e[0],e[1],e[2]);case 4:return new t(e[0],e[1],e[2],e[3])}var n=[null];return o(d,n,e),new(o(a,t,n))}var i=r.prototype,f=l(c(i)?i:h),m=o(t,f,e);return c(m)?m:f}})},60922:(t,e,r)=>{"use strict";r(44114),r(34782),r(27495),r(71761);var n=r(81890).l;function i(t){var e=32|t;return e>=97&&e<=122}t.exports=function(t,e){var r,o,a,s,u=t.pos;return!!t.md.options.html&&(a=t.posMax,!(60!==t.src.charCodeAt(u)||u+2>=a)&&(r=t.src.charCodeAt(u+1),!(33!==r&&63!==r&&47!==r&&!i(r))&&(o=t.src.slice(u).match(n),!!o&&(e||(s=t.push("html_inline","",0),s.content=t.src.slice(u,u+o[0].length)),t.pos+=o[0].length,!0))))}},61010:(t,e,r)=>{"use strict";r(34782);var n=r(8700).unescapeAll;t.exports=function(t,e,r){var i,o,a=0,s=e,u={ok:!1,pos:0,lines:0,str:""};if(60===t.charCodeAt(e)){e++;while(e<r){if(i=t.charCodeAt(e),10===i)return u;if(60===i)return u;if(62===i)return u.pos=e+1,u.str=n(t.slice(s+1,e)),u.ok=!0,u;92===i&&e+1<r?e+=2:e++}return u}o=0;while(e<r){if(i=t.charCodeAt(e),32===i)break;if(i<32||127===i)break;if(92===i&&e+1<r){if(32===t.charCodeAt(e+1))break;e+=2}else{if(40===i&&(o++,o>32))return u;if(41===i){if(0===o)break;o--}e++}}return s===e||0!==o||(u.str=n(t.slice(s,e)),u.lines=a,u.pos=e,u.ok=!0),u}},61034:(t,e,r)=>{"use strict";var n=r(69565),i=r(39297),o=r(1625),a=r(67979),s=RegExp.prototype;t.exports=function(t){var e=t.flags;return void 0!==e||"flags"in s||i(t,"flags")||!o(s,t)?e:n(a,t)}},61504:(t,e,r)=>{"use strict";r(25276),r(62062),r(44114),r(34782),r(18111),r(61701),r(26099),t.exports=function(t,e,r,n){var i,o,a,s,u,c,l,f=!1,p=t.bMarks[e]+t.tShift[e],h=t.eMarks[e];if(t.sCount[e]-t.blkIndent>=4)return!1;if(p+3>h)return!1;if(i=t.src.charCodeAt(p),126!==i&&96!==i)return!1;if(u=p,p=t.skipChars(p,i),o=p-u,o<3)return!1;if(l=t.src.slice(u,p),a=t.src.slice(p,h),96===i&&a.indexOf(String.fromCharCode(i))>=0)return!1;if(n)return!0;for(s=e;;){if(s++,s>=r)break;if(p=u=t.bMarks[s]+t.tShift[s],h=t.eMarks[s],p<h&&t.sCount[s]<t.blkIndent)break;if(t.src.charCodeAt(p)===i&&(!(t.sCount[s]-t.blkIndent>=4)&&(p=t.skipChars(p,i),!(p-u<o)&&(p=t.skipSpaces(p),!(p<h))))){f=!0;break}}return o=t.sCount[e],t.line=s+(f?1:0),c=t.push("fence","code",0),c.info=a,c.content=t.getLines(e+1,s,o,!0),c.markup=l,c.map=[e,t.line],!0}},61701:(t,e,r)=>{"use strict";var n=r(46518),i=r(20713),o=r(96395);n({target:"Iterator",proto:!0,real:!0,forced:o},{map:i})},61806:(t,e,r)=>{"use strict";var n=r(46518),i=r(28551),o=r(72652),a=r(1767),s=[].push;n({target:"Iterator",proto:!0,real:!0},{toArray:function(){var t=[];return o(a(i(this)),s,{that:t,IS_RECORD:!0}),t}})},61828:(t,e,r)=>{"use strict";var n=r(79504),i=r(39297),o=r(25397),a=r(19617).indexOf,s=r(30421),u=n([].push);t.exports=function(t,e){var r,n=o(t),c=0,l=[];for(r in n)!i(s,r)&&i(n,r)&&u(l,r);while(e.length>c)i(n,r=e[c++])&&(~a(l,r)||u(l,r));return l}},62010:(t,e,r)=>{"use strict";var n=r(43724),i=r(10350).EXISTS,o=r(79504),a=r(62106),s=Function.prototype,u=o(s.toString),c=/function\b(?:\s|\/\*[\S\s]*?\*\/|\/\/[^\n\r]*[\n\r]+)*([^\s(/]*)/,l=o(c.exec),f="name";n&&!i&&a(s,f,{configurable:!0,get:function(){try{return l(c,u(this))[1]}catch(t){return""}}})},62062:(t,e,r)=>{"use strict";var n=r(46518),i=r(59213).map,o=r(70597),a=o("map");n({target:"Array",proto:!0,forced:!a},{map:function(t){return i(this,t,arguments.length>1?arguments[1]:void 0)}})},62106:(t,e,r)=>{"use strict";var n=r(50283),i=r(24913);t.exports=function(t,e,r){return r.get&&n(r.get,e,{getter:!0}),r.set&&n(r.set,e,{setter:!0}),i.f(t,e,r)}},62529:t=>{"use strict";t.exports=function(t,e){return{value:t,done:e}}},62953:(t,e,r)=>{"use strict";var n=r(22195),i=r(67400),o=r(79296),a=r(23792),s=r(66699),u=r(10687),c=r(78227),l=c("iterator"),f=a.values,p=function(t,e){if(t){if(t[l]!==f)try{s(t,l,f)}catch(n){t[l]=f}if(u(t,e,!0),i[e])for(var r in a)if(t[r]!==a[r])try{s(t,r,a[r])}catch(n){t[r]=a[r]}}};for(var h in i)p(n[h]&&n[h].prototype,h);p(o,"DOMTokenList")},63548:(t,e,r)=>{"use strict";var n=r(43724),i=r(62106),o=r(20034),a=r(13925),s=r(48981),u=r(67750),c=Object.getPrototypeOf,l=Object.setPrototypeOf,f=Object.prototype,p="__proto__";if(n&&c&&l&&!(p in f))try{i(f,p,{configurable:!0,get:function(){return c(s(this))},set:function(t){var e=u(this);a(t)&&o(e)&&l(e,t)}})}catch(h){}},63686:(t,e,r)=>{var n=r(7196),i=r(34311),o=r(82229),a=r(22729);function s(t,e){return n(t)||i(t,e)||o(t,e)||a()}t.exports=s,t.exports.__esModule=!0,t.exports["default"]=t.exports},63988:t=>{"use strict";t.exports={options:{html:!1,xhtmlOut:!1,breaks:!1,langPrefix:"language-",linkify:!1,typographer:!1,quotes:"“”‘’",highlight:null,maxNesting:20},components:{core:{rules:["normalize","block","inline"]},block:{rules:["paragraph"]},inline:{rules:["text"],rules2:["balance_pairs","text_collapse"]}}}},64117:t=>{"use strict";t.exports=function(t){return null===t||void 0===t}},64346:(t,e,r)=>{"use strict";var n=r(46518),i=r(34376);n({target:"Array",stat:!0},{isArray:i})},64449:(t,e,r)=>{"use strict";var n=r(97080),i=r(94402).has,o=r(25170),a=r(83789),s=r(38469),u=r(40507),c=r(9539);t.exports=function(t){var e=n(this),r=a(t);if(o(e)<=r.size)return!1!==s(e,(function(t){if(r.includes(t))return!1}),!0);var l=r.getIterator();return!1!==u(l,(function(t){if(i(e,t))return c(l,"normal",!1)}))}},64535:t=>{t.exports=/[ \xA0\u1680\u2000-\u200A\u2028\u2029\u202F\u205F\u3000]/},64979:(t,e,r)=>{"use strict";var n=r(46518),i=r(22195),o=r(97751),a=r(6980),s=r(24913).f,u=r(39297),c=r(90679),l=r(23167),f=r(32603),p=r(55002),h=r(38574),d=r(43724),v=r(96395),g="DOMException",m=o("Error"),y=o(g),b=function(){c(this,w);var t=arguments.length,e=f(t<1?void 0:arguments[0]),r=f(t<2?void 0:arguments[1],"Error"),n=new y(e,r),i=new m(e);return i.name=g,s(n,"stack",a(1,h(i.stack,1))),l(n,this,b),n},w=b.prototype=y.prototype,_="stack"in new m(g),x="stack"in new y(1,2),A=y&&d&&Object.getOwnPropertyDescriptor(i,g),k=!!A&&!(A.writable&&A.configurable),E=_&&!k&&!x;n({global:!0,constructor:!0,forced:v||E},{DOMException:E?b:y});var S=o(g),C=S.prototype;if(C.constructor!==S)for(var O in v||s(C,"constructor",a(1,S)),p)if(u(p,O)){var T=p[O],R=T.s;u(S,R)||s(S,R,a(6,T.c))}},65676:(t,e,r)=>{"use strict";r(34782),r(42762);var n=r(8700).normalizeReference,i=r(8700).isSpace;t.exports=function(t,e,r,o){var a,s,u,c,l,f,p,h,d,v,g,m,y,b,w,_,x=0,A=t.bMarks[e]+t.tShift[e],k=t.eMarks[e],E=e+1;if(t.sCount[e]-t.blkIndent>=4)return!1;if(91!==t.src.charCodeAt(A))return!1;while(++A<k)if(93===t.src.charCodeAt(A)&&92!==t.src.charCodeAt(A-1)){if(A+1===k)return!1;if(58!==t.src.charCodeAt(A+1))return!1;break}for(c=t.lineMax,w=t.md.block.ruler.getRules("reference"),v=t.parentType,t.parentType="reference";E<c&&!t.isEmpty(E);E++)if(!(t.sCount[E]-t.blkIndent>3)&&!(t.sCount[E]<0)){for(b=!1,f=0,p=w.length;f<p;f++)if(w[f](t,E,c,!0)){b=!0;break}if(b)break}for(y=t.getLines(e,E,t.blkIndent,!1).trim(),k=y.length,A=1;A<k;A++){if(a=y.charCodeAt(A),91===a)return!1;if(93===a){d=A;break}10===a?x++:92===a&&(A++,A<k&&10===y.charCodeAt(A)&&x++)}if(d<0||58!==y.charCodeAt(d+1))return!1;for(A=d+2;A<k;A++)if(a=y.charCodeAt(A),10===a)x++;else if(!i(a))break;if(g=t.md.helpers.parseLinkDestination(y,A,k),!g.ok)return!1;if(l=t.md.normalizeLink(g.str),!t.md.validateLink(l))return!1;for(A=g.pos,x+=g.lines,s=A,u=x,m=A;A<k;A++)if(a=y.charCodeAt(A),10===a)x++;else if(!i(a))break;g=t.md.helpers.parseLinkTitle(y,A,k),A<k&&m!==A&&g.ok?(_=g.str,A=g.pos,x+=g.lines):(_="",A=s,x=u);while(A<k){if(a=y.charCodeAt(A),!i(a))break;A++}if(A<k&&10!==y.charCodeAt(A)&&_){_="",A=s,x=u;while(A<k){if(a=y.charCodeAt(A),!i(a))break;A++}}return!(A<k&&10!==y.charCodeAt(A))&&(h=n(y.slice(1,d)),!!h&&(o||("undefined"===typeof t.env.references&&(t.env.references={}),"undefined"===typeof t.env.references[h]&&(t.env.references[h]={title:_,href:l}),t.parentType=v,t.line=e+x+1),!0))}},65746:(t,e,r)=>{"use strict";var n,i=r(92744),o=r(22195),a=r(79504),s=r(56279),u=r(3451),c=r(16468),l=r(91625),f=r(20034),p=r(91181).enforce,h=r(79039),d=r(58622),v=Object,g=Array.isArray,m=v.isExtensible,y=v.isFrozen,b=v.isSealed,w=v.freeze,_=v.seal,x=!o.ActiveXObject&&"ActiveXObject"in o,A=function(t){return function(){return t(this,arguments.length?arguments[0]:void 0)}},k=c("WeakMap",A,l),E=k.prototype,S=a(E.set),C=function(){return i&&h((function(){var t=w([]);return S(new k,t,1),!y(t)}))};if(d)if(x){n=l.getConstructor(A,"WeakMap",!0),u.enable();var O=a(E["delete"]),T=a(E.has),R=a(E.get);s(E,{delete:function(t){if(f(t)&&!m(t)){var e=p(this);return e.frozen||(e.frozen=new n),O(this,t)||e.frozen["delete"](t)}return O(this,t)},has:function(t){if(f(t)&&!m(t)){var e=p(this);return e.frozen||(e.frozen=new n),T(this,t)||e.frozen.has(t)}return T(this,t)},get:function(t){if(f(t)&&!m(t)){var e=p(this);return e.frozen||(e.frozen=new n),T(this,t)?R(this,t):e.frozen.get(t)}return R(this,t)},set:function(t,e){if(f(t)&&!m(t)){var r=p(this);r.frozen||(r.frozen=new n),T(this,t)?S(this,t,e):r.frozen.set(t,e)}else S(this,t,e);return this}})}else C()&&s(E,{set:function(t,e){var r;return g(t)&&(y(t)?r=w:b(t)&&(r=_)),S(this,t,e),r&&r(t),this}})},66119:(t,e,r)=>{"use strict";var n=r(25745),i=r(33392),o=n("keys");t.exports=function(t){return o[t]||(o[t]=i(t))}},66346:(t,e,r)=>{"use strict";var n=r(22195),i=r(79504),o=r(43724),a=r(77811),s=r(10350),u=r(66699),c=r(62106),l=r(56279),f=r(79039),p=r(90679),h=r(91291),d=r(18014),v=r(57696),g=r(15617),m=r(88490),y=r(42787),b=r(52967),w=r(84373),_=r(67680),x=r(23167),A=r(77740),k=r(10687),E=r(91181),S=s.PROPER,C=s.CONFIGURABLE,O="ArrayBuffer",T="DataView",R="prototype",D="Wrong length",I="Wrong index",L=E.getterFor(O),P=E.getterFor(T),j=E.set,F=n[O],M=F,N=M&&M[R],q=n[T],U=q&&q[R],B=Object.prototype,z=n.Array,V=n.RangeError,$=i(w),G=i([].reverse),H=m.pack,W=m.unpack,Z=function(t){return[255&t]},K=function(t){return[255&t,t>>8&255]},Y=function(t){return[255&t,t>>8&255,t>>16&255,t>>24&255]},J=function(t){return t[3]<<24|t[2]<<16|t[1]<<8|t[0]},X=function(t){return H(g(t),23,4)},Q=function(t){return H(t,52,8)},tt=function(t,e,r){c(t[R],e,{configurable:!0,get:function(){return r(this)[e]}})},et=function(t,e,r,n){var i=P(t),o=v(r),a=!!n;if(o+e>i.byteLength)throw new V(I);var s=i.bytes,u=o+i.byteOffset,c=_(s,u,u+e);return a?c:G(c)},rt=function(t,e,r,n,i,o){var a=P(t),s=v(r),u=n(+i),c=!!o;if(s+e>a.byteLength)throw new V(I);for(var l=a.bytes,f=s+a.byteOffset,p=0;p<e;p++)l[f+p]=u[c?p:e-p-1]};if(a){var nt=S&&F.name!==O;f((function(){F(1)}))&&f((function(){new F(-1)}))&&!f((function(){return new F,new F(1.5),new F(NaN),1!==F.length||nt&&!C}))?nt&&C&&u(F,"name",O):(M=function(t){return p(this,N),x(new F(v(t)),this,M)},M[R]=N,N.constructor=M,A(M,F)),b&&y(U)!==B&&b(U,B);var it=new q(new M(2)),ot=i(U.setInt8);it.setInt8(0,2147483648),it.setInt8(1,2147483649),!it.getInt8(0)&&it.getInt8(1)||l(U,{setInt8:function(t,e){ot(this,t,e<<24>>24)},setUint8:function(t,e){ot(this,t,e<<24>>24)}},{unsafe:!0})}else M=function(t){p(this,N);var e=v(t);j(this,{type:O,bytes:$(z(e),0),byteLength:e}),o||(this.byteLength=e,this.detached=!1)},N=M[R],q=function(t,e,r){p(this,U),p(t,N);var n=L(t),i=n.byteLength,a=h(e);if(a<0||a>i)throw new V("Wrong offset");if(r=void 0===r?i-a:d(r),a+r>i)throw new V(D);j(this,{type:T,buffer:t,byteLength:r,byteOffset:a,bytes:n.bytes}),o||(this.buffer=t,this.byteLength=r,this.byteOffset=a)},U=q[R],o&&(tt(M,"byteLength",L),tt(q,"buffer",P),tt(q,"byteLength",P),tt(q,"byteOffset",P)),l(U,{getInt8:function(t){return et(this,1,t)[0]<<24>>24},getUint8:function(t){return et(this,1,t)[0]},getInt16:function(t){var e=et(this,2,t,arguments.length>1&&arguments[1]);return(e[1]<<8|e[0])<<16>>16},getUint16:function(t){var e=et(this,2,t,arguments.length>1&&arguments[1]);return e[1]<<8|e[0]},getInt32:function(t){return J(et(this,4,t,arguments.length>1&&arguments[1]))},getUint32:function(t){return J(et(this,4,t,arguments.length>1&&arguments[1]))>>>0},getFloat32:function(t){return W(et(this,4,t,arguments.length>1&&arguments[1]),23)},getFloat64:function(t){return W(et(this,8,t,arguments.length>1&&arguments[1]),52)},setInt8:function(t,e){rt(this,1,t,Z,e)},setUint8:function(t,e){rt(this,1,t,Z,e)},setInt16:function(t,e){rt(this,2,t,K,e,arguments.length>2&&arguments[2])},setUint16:function(t,e){rt(this,2,t,K,e,arguments.length>2&&arguments[2])},setInt32:function(t,e){rt(this,4,t,Y,e,arguments.length>2&&arguments[2])},setUint32:function(t,e){rt(this,4,t,Y,e,arguments.length>2&&arguments[2])},setFloat32:function(t,e){rt(this,4,t,X,e,arguments.length>2&&arguments[2])},setFloat64:function(t,e){rt(this,8,t,Q,e,arguments.length>2&&arguments[2])}});k(M,O),k(q,T),t.exports={ArrayBuffer:M,DataView:q}},66412:(t,e,r)=>{"use strict";var n=r(70511);n("asyncIterator")},66651:(t,e,r)=>{"use strict";var n=r(94644),i=r(19617).indexOf,o=n.aTypedArray,a=n.exportTypedArrayMethod;a("indexOf",(function(t){return i(o(this),t,arguments.length>1?arguments[1]:void 0)}))},66699:(t,e,r)=>{"use strict";var n=r(43724),i=r(24913),o=r(6980);t.exports=n?function(t,e,r){return i.f(t,e,o(1,r))}:function(t,e,r){return t[e]=r,t}},66812:(t,e,r)=>{"use strict";var n=r(94644),i=r(18745),o=r(8379),a=n.aTypedArray,s=n.exportTypedArrayMethod;s("lastIndexOf",(function(t){var e=arguments.length;return i(o,a(this),e>1?[t,arguments[1]]:[t])}))},66933:(t,e,r)=>{"use strict";var n=r(79504),i=r(34376),o=r(94901),a=r(44576),s=r(655),u=n([].push);t.exports=function(t){if(o(t))return t;if(i(t)){for(var e=t.length,r=[],n=0;n<e;n++){var c=t[n];"string"==typeof c?u(r,c):"number"!=typeof c&&"Number"!==a(c)&&"String"!==a(c)||u(r,s(c))}var l=r.length,f=!0;return function(t,e){if(f)return f=!1,e;if(i(this))return e;for(var n=0;n<l;n++)if(r[n]===t)return e}}}},67357:(t,e,r)=>{"use strict";var n=r(46518),i=r(79504),o=r(67750),a=r(91291),s=r(655),u=r(79039),c=i("".charAt),l=u((function(){return"\ud842"!=="𠮷".at(-2)}));n({target:"String",proto:!0,forced:l},{at:function(t){var e=s(o(this)),r=e.length,n=a(t),i=n>=0?n:r+n;return i<0||i>=r?void 0:c(e,i)}})},67394:(t,e,r)=>{"use strict";var n=r(22195),i=r(46706),o=r(44576),a=n.ArrayBuffer,s=n.TypeError;t.exports=a&&i(a.prototype,"byteLength","get")||function(t){if("ArrayBuffer"!==o(t))throw new s("ArrayBuffer expected");return t.byteLength}},67400:t=>{"use strict";t.exports={CSSRuleList:0,CSSStyleDeclaration:0,CSSValueList:0,ClientRectList:0,DOMRectList:0,DOMStringList:0,DOMTokenList:1,DataTransferItemList:0,FileList:0,HTMLAllCollection:0,HTMLCollection:0,HTMLFormElement:0,HTMLSelectElement:0,MediaList:0,MimeTypeArray:0,NamedNodeMap:0,NodeList:1,PaintRequestList:0,Plugin:0,PluginArray:0,SVGLengthList:0,SVGNumberList:0,SVGPathSegList:0,SVGPointList:0,SVGStringList:0,SVGTransformList:0,SourceBufferList:0,StyleSheetList:0,TextTrackCueList:0,TextTrackList:0,TouchList:0}},67416:(t,e,r)=>{"use strict";var n=r(79039),i=r(78227),o=r(43724),a=r(96395),s=i("iterator");t.exports=!n((function(){var t=new URL("b?a=1&b=2&c=3","https://a"),e=t.searchParams,r=new URLSearchParams("a=1&a=2&b=3"),n="";return t.pathname="c%20d",e.forEach((function(t,r){e["delete"]("b"),n+=r+t})),r["delete"]("a",2),r["delete"]("b",void 0),a&&(!t.toJSON||!r.has("a",1)||r.has("a",2)||!r.has("a",void 0)||r.has("b"))||!e.size&&(a||!o)||!e.sort||"https://a/c%20d?a=1&c=3"!==t.href||"3"!==e.get("c")||"a=1"!==String(new URLSearchParams("?a=1"))||!e[s]||"a"!==new URL("https://a@b").username||"b"!==new URLSearchParams(new URLSearchParams("a=b")).get("a")||"xn--e1aybc"!==new URL("https://тест").host||"#%D0%B1"!==new URL("https://a#б").hash||"a1c3"!==n||"x"!==new URL("https://x",void 0).host}))},67680:(t,e,r)=>{"use strict";var n=r(79504);t.exports=n([].slice)},67750:(t,e,r)=>{"use strict";var n=r(64117),i=TypeError;t.exports=function(t){if(n(t))throw new i("Can't call method on "+t);return t}},67945:(t,e,r)=>{"use strict";var n=r(46518),i=r(43724),o=r(96801).f;n({target:"Object",stat:!0,forced:Object.defineProperties!==o,sham:!i},{defineProperties:o})},67979:(t,e,r)=>{"use strict";var n=r(28551);t.exports=function(){var t=n(this),e="";return t.hasIndices&&(e+="d"),t.global&&(e+="g"),t.ignoreCase&&(e+="i"),t.multiline&&(e+="m"),t.dotAll&&(e+="s"),t.unicode&&(e+="u"),t.unicodeSets&&(e+="v"),t.sticky&&(e+="y"),e}},68183:(t,e,r)=>{"use strict";var n=r(79504),i=r(91291),o=r(655),a=r(67750),s=n("".charAt),u=n("".charCodeAt),c=n("".slice),l=function(t){return function(e,r){var n,l,f=o(a(e)),p=i(r),h=f.length;return p<0||p>=h?t?"":void 0:(n=u(f,p),n<55296||n>56319||p+1===h||(l=u(f,p+1))<56320||l>57343?t?s(f,p):n:t?c(f,p,p+2):l-56320+(n-55296<<10)+65536)}};t.exports={codeAt:l(!1),charAt:l(!0)}},68750:(t,e,r)=>{"use strict";var n=r(97080),i=r(94402),o=r(25170),a=r(83789),s=r(38469),u=r(40507),c=i.Set,l=i.add,f=i.has;t.exports=function(t){var e=n(this),r=a(t),i=new c;return o(e)>r.size?u(r.getIterator(),(function(t){f(e,t)&&l(i,t)})):s(e,(function(t){r.includes(t)&&l(i,t)})),i}},68854:(t,e,r)=>{"use strict";r(44114);var n=r(43011),i=[["text",r(97726)],["newline",r(28565)],["escape",r(83250)],["backticks",r(15654)],["strikethrough",r(38950).q],["emphasis",r(72675).q],["link",r(10201)],["image",r(34336)],["autolink",r(85926)],["html_inline",r(60922)],["entity",r(47830)]],o=[["balance_pairs",r(96019)],["strikethrough",r(38950).g],["emphasis",r(72675).g],["text_collapse",r(80002)]];function a(){var t;for(this.ruler=new n,t=0;t<i.length;t++)this.ruler.push(i[t][0],i[t][1]);for(this.ruler2=new n,t=0;t<o.length;t++)this.ruler2.push(o[t][0],o[t][1])}a.prototype.skipToken=function(t){var e,r,n=t.pos,i=this.ruler.getRules(""),o=i.length,a=t.md.options.maxNesting,s=t.cache;if("undefined"===typeof s[n]){if(t.level<a){for(r=0;r<o;r++)if(t.level++,e=i[r](t,!0),t.level--,e)break}else t.pos=t.posMax;e||t.pos++,s[n]=t.pos}else t.pos=s[n]},a.prototype.tokenize=function(t){var e,r,n=this.ruler.getRules(""),i=n.length,o=t.posMax,a=t.md.options.maxNesting;while(t.pos<o){if(t.level<a)for(r=0;r<i;r++)if(e=n[r](t,!1),e)break;if(e){if(t.pos>=o)break}else t.pending+=t.src[t.pos++]}t.pending&&t.pushPending()},a.prototype.parse=function(t,e,r,n){var i,o,a,s=new this.State(t,e,r,n);for(this.tokenize(s),o=this.ruler2.getRules(""),a=o.length,i=0;i<a;i++)o[i](s)},a.prototype.State=r(10834),t.exports=a},69085:(t,e,r)=>{"use strict";var n=r(46518),i=r(44213);n({target:"Object",stat:!0,arity:2,forced:Object.assign!==i},{assign:i})},69479:(t,e,r)=>{"use strict";var n=r(22195),i=r(43724),o=r(62106),a=r(67979),s=r(79039),u=n.RegExp,c=u.prototype,l=i&&s((function(){var t=!0;try{u(".","d")}catch(l){t=!1}var e={},r="",n=t?"dgimsy":"gimsy",i=function(t,n){Object.defineProperty(e,t,{get:function(){return r+=n,!0}})},o={dotAll:"s",global:"g",ignoreCase:"i",multiline:"m",sticky:"y"};for(var a in t&&(o.hasIndices="d"),o)i(a,o[a]);var s=Object.getOwnPropertyDescriptor(c,"flags").get.call(e);return s!==n||r!==n}));l&&o(c,"flags",{configurable:!0,get:a})},69492:(t,e,r)=>{"use strict";r.d(e,{A:()=>o});r(84185);var n=r(33051);function i(t,e){for(var r=0;r<e.length;r++){var i=e[r];i.enumerable=i.enumerable||!1,i.configurable=!0,"value"in i&&(i.writable=!0),Object.defineProperty(t,(0,n.A)(i.key),i)}}function o(t,e,r){return e&&i(t.prototype,e),r&&i(t,r),Object.defineProperty(t,"prototype",{writable:!1}),t}},69539:(t,e,r)=>{"use strict";var n=r(94644),i=r(59213).filter,o=r(29948),a=n.aTypedArray,s=n.exportTypedArrayMethod;s("filter",(function(t){var e=i(a(this),t,arguments.length>1?arguments[1]:void 0);return o(this,e)}))},69565:(t,e,r)=>{"use strict";var n=r(40616),i=Function.prototype.call;t.exports=n?i.bind(i):function(){return i.apply(i,arguments)}},70081:(t,e,r)=>{"use strict";var n=r(69565),i=r(79306),o=r(28551),a=r(16823),s=r(50851),u=TypeError;t.exports=function(t,e){var r=arguments.length<2?s(t):e;if(i(r))return o(n(r,t));throw new u(a(t)+" is not iterable")}},70380:(t,e,r)=>{"use strict";var n=r(79504),i=r(79039),o=r(60533).start,a=RangeError,s=isFinite,u=Math.abs,c=Date.prototype,l=c.toISOString,f=n(c.getTime),p=n(c.getUTCDate),h=n(c.getUTCFullYear),d=n(c.getUTCHours),v=n(c.getUTCMilliseconds),g=n(c.getUTCMinutes),m=n(c.getUTCMonth),y=n(c.getUTCSeconds);t.exports=i((function(){return"0385-07-25T07:06:39.999Z"!==l.call(new Date(-50000000000001))}))||!i((function(){l.call(new Date(NaN))}))?function(){if(!s(f(this)))throw new a("Invalid time value");var t=this,e=h(t),r=v(t),n=e<0?"-":e>9999?"+":"";return n+o(u(e),n?6:4,0)+"-"+o(m(t)+1,2,0)+"-"+o(p(t),2,0)+"T"+o(d(t),2,0)+":"+o(g(t),2,0)+":"+o(y(t),2,0)+"."+o(r,3,0)+"Z"}:l},70446:(t,e,r)=>{"use strict";function n(t){var e=Array.prototype.slice.call(arguments,1);return e.forEach((function(e){e&&Object.keys(e).forEach((function(r){t[r]=e[r]}))})),t}function i(t){return Object.prototype.toString.call(t)}function o(t){return"[object String]"===i(t)}function a(t){return"[object Object]"===i(t)}function s(t){return"[object RegExp]"===i(t)}function u(t){return"[object Function]"===i(t)}function c(t){return t.replace(/[.?*+^$[\]\\(){}|-]/g,"\\$&")}r(16280),r(76918),r(28706),r(2008),r(51629),r(25276),r(64346),r(48598),r(62062),r(44114),r(72712),r(94490),r(34782),r(26910),r(23288),r(18111),r(22489),r(7588),r(61701),r(18237),r(79432),r(26099),r(84864),r(57465),r(27495),r(87745),r(90906),r(38781),r(71761),r(25440),r(5746),r(23500);var l={fuzzyLink:!0,fuzzyEmail:!0,fuzzyIP:!1};function f(t){return Object.keys(t||{}).reduce((function(t,e){return t||l.hasOwnProperty(e)}),!1)}var p={"http:":{validate:function(t,e,r){var n=t.slice(e);return r.re.http||(r.re.http=new RegExp("^\\/\\/"+r.re.src_auth+r.re.src_host_port_strict+r.re.src_path,"i")),r.re.http.test(n)?n.match(r.re.http)[0].length:0}},"https:":"http:","ftp:":"http:","//":{validate:function(t,e,r){var n=t.slice(e);return r.re.no_http||(r.re.no_http=new RegExp("^"+r.re.src_auth+"(?:localhost|(?:(?:"+r.re.src_domain+")\\.)+"+r.re.src_domain_root+")"+r.re.src_port+r.re.src_host_terminator+r.re.src_path,"i")),r.re.no_http.test(n)?e>=3&&":"===t[e-3]||e>=3&&"/"===t[e-3]?0:n.match(r.re.no_http)[0].length:0}},"mailto:":{validate:function(t,e,r){var n=t.slice(e);return r.re.mailto||(r.re.mailto=new RegExp("^"+r.re.src_email_name+"@"+r.re.src_host_strict,"i")),r.re.mailto.test(n)?n.match(r.re.mailto)[0].length:0}}},h="a[cdefgilmnoqrstuwxz]|b[abdefghijmnorstvwyz]|c[acdfghiklmnoruvwxyz]|d[ejkmoz]|e[cegrstu]|f[ijkmor]|g[abdefghilmnpqrstuwy]|h[kmnrtu]|i[delmnoqrst]|j[emop]|k[eghimnprwyz]|l[abcikrstuvy]|m[acdeghklmnopqrstuvwxyz]|n[acefgilopruz]|om|p[aefghklmnrstwy]|qa|r[eosuw]|s[abcdeghijklmnortuvxyz]|t[cdfghjklmnortvwz]|u[agksyz]|v[aceginu]|w[fs]|y[et]|z[amw]",d="biz|com|edu|gov|net|org|pro|web|xxx|aero|asia|coop|info|museum|name|shop|рф".split("|");function v(t){t.__index__=-1,t.__text_cache__=""}function g(t){return function(e,r){var n=e.slice(r);return t.test(n)?n.match(t)[0].length:0}}function m(){return function(t,e){e.normalize(t)}}function y(t){var e=t.re=r(53069)(t.__opts__),n=t.__tlds__.slice();function i(t){return t.replace("%TLDS%",e.src_tlds)}t.onCompile(),t.__tlds_replaced__||n.push(h),n.push(e.src_xn),e.src_tlds=n.join("|"),e.email_fuzzy=RegExp(i(e.tpl_email_fuzzy),"i"),e.link_fuzzy=RegExp(i(e.tpl_link_fuzzy),"i"),e.link_no_ip_fuzzy=RegExp(i(e.tpl_link_no_ip_fuzzy),"i"),e.host_fuzzy_test=RegExp(i(e.tpl_host_fuzzy_test),"i");var l=[];function f(t,e){throw new Error('(LinkifyIt) Invalid schema "'+t+'": '+e)}t.__compiled__={},Object.keys(t.__schemas__).forEach((function(e){var r=t.__schemas__[e];if(null!==r){var n={validate:null,link:null};if(t.__compiled__[e]=n,a(r))return s(r.validate)?n.validate=g(r.validate):u(r.validate)?n.validate=r.validate:f(e,r),void(u(r.normalize)?n.normalize=r.normalize:r.normalize?f(e,r):n.normalize=m());o(r)?l.push(e):f(e,r)}})),l.forEach((function(e){t.__compiled__[t.__schemas__[e]]&&(t.__compiled__[e].validate=t.__compiled__[t.__schemas__[e]].validate,t.__compiled__[e].normalize=t.__compiled__[t.__schemas__[e]].normalize)})),t.__compiled__[""]={validate:null,normalize:m()};var p=Object.keys(t.__compiled__).filter((function(e){return e.length>0&&t.__compiled__[e]})).map(c).join("|");t.re.schema_test=RegExp("(^|(?!_)(?:[><｜]|"+e.src_ZPCc+"))("+p+")","i"),t.re.schema_search=RegExp("(^|(?!_)(?:[><｜]|"+e.src_ZPCc+"))("+p+")","ig"),t.re.pretest=RegExp("("+t.re.schema_test.source+")|("+t.re.host_fuzzy_test.source+")|@","i"),v(t)}function b(t,e){var r=t.__index__,n=t.__last_index__,i=t.__text_cache__.slice(r,n);this.schema=t.__schema__.toLowerCase(),this.index=r+e,this.lastIndex=n+e,this.raw=i,this.text=i,this.url=i}function w(t,e){var r=new b(t,e);return t.__compiled__[r.schema].normalize(r,t),r}function _(t,e){if(!(this instanceof _))return new _(t,e);e||f(t)&&(e=t,t={}),this.__opts__=n({},l,e),this.__index__=-1,this.__last_index__=-1,this.__schema__="",this.__text_cache__="",this.__schemas__=n({},p,t),this.__compiled__={},this.__tlds__=d,this.__tlds_replaced__=!1,this.re={},y(this)}_.prototype.add=function(t,e){return this.__schemas__[t]=e,y(this),this},_.prototype.set=function(t){return this.__opts__=n(this.__opts__,t),this},_.prototype.test=function(t){if(this.__text_cache__=t,this.__index__=-1,!t.length)return!1;var e,r,n,i,o,a,s,u,c;if(this.re.schema_test.test(t)){s=this.re.schema_search,s.lastIndex=0;while(null!==(e=s.exec(t)))if(i=this.testSchemaAt(t,e[2],s.lastIndex),i){this.__schema__=e[2],this.__index__=e.index+e[1].length,this.__last_index__=e.index+e[0].length+i;break}}return this.__opts__.fuzzyLink&&this.__compiled__["http:"]&&(u=t.search(this.re.host_fuzzy_test),u>=0&&(this.__index__<0||u<this.__index__)&&null!==(r=t.match(this.__opts__.fuzzyIP?this.re.link_fuzzy:this.re.link_no_ip_fuzzy))&&(o=r.index+r[1].length,(this.__index__<0||o<this.__index__)&&(this.__schema__="",this.__index__=o,this.__last_index__=r.index+r[0].length))),this.__opts__.fuzzyEmail&&this.__compiled__["mailto:"]&&(c=t.indexOf("@"),c>=0&&null!==(n=t.match(this.re.email_fuzzy))&&(o=n.index+n[1].length,a=n.index+n[0].length,(this.__index__<0||o<this.__index__||o===this.__index__&&a>this.__last_index__)&&(this.__schema__="mailto:",this.__index__=o,this.__last_index__=a))),this.__index__>=0},_.prototype.pretest=function(t){return this.re.pretest.test(t)},_.prototype.testSchemaAt=function(t,e,r){return this.__compiled__[e.toLowerCase()]?this.__compiled__[e.toLowerCase()].validate(t,r,this):0},_.prototype.match=function(t){var e=0,r=[];this.__index__>=0&&this.__text_cache__===t&&(r.push(w(this,e)),e=this.__last_index__);var n=e?t.slice(e):t;while(this.test(n))r.push(w(this,e)),n=n.slice(this.__last_index__),e+=this.__last_index__;return r.length?r:null},_.prototype.tlds=function(t,e){return t=Array.isArray(t)?t:[t],e?(this.__tlds__=this.__tlds__.concat(t).sort().filter((function(t,e,r){return t!==r[e-1]})).reverse(),y(this),this):(this.__tlds__=t.slice(),this.__tlds_replaced__=!0,y(this),this)},_.prototype.normalize=function(t){t.schema||(t.url="http://"+t.url),"mailto:"!==t.schema||/^mailto:/i.test(t.url)||(t.url="mailto:"+t.url)},_.prototype.onCompile=function(){},t.exports=_},70511:(t,e,r)=>{"use strict";var n=r(19167),i=r(39297),o=r(1951),a=r(24913).f;t.exports=function(t){var e=n.Symbol||(n.Symbol={});i(e,t)||a(e,t,{value:o.f(t)})}},70597:(t,e,r)=>{"use strict";var n=r(79039),i=r(78227),o=r(39519),a=i("species");t.exports=function(t){return o>=51||!n((function(){var e=[],r=e.constructor={};return r[a]=function(){return{foo:1}},1!==e[t](Boolean).foo}))}},71072:(t,e,r)=>{"use strict";var n=r(61828),i=r(88727);t.exports=Object.keys||function(t){return n(t,i)}},71137:(t,e,r)=>{"use strict";var n=r(46518),i=r(35031);n({target:"Reflect",stat:!0},{ownKeys:i})},71241:(t,e,r)=>{"use strict";var n=r(63686)["default"],i=r(93794)["default"];r(84185),e.A=function(t,e){var r,o=t.__vccOpts||t,a=i(e);try{for(a.s();!(r=a.n()).done;){var s=n(r.value,2),u=s[0],c=s[1];o[u]=c}}catch(l){a.e(l)}finally{a.f()}return o}},71658:(t,e,r)=>{"use strict";var n=r(46518),i=r(6469),o=r(96837),a=r(26198),s=r(35610),u=r(25397),c=r(91291),l=Array,f=Math.max,p=Math.min;n({target:"Array",proto:!0},{toSpliced:function(t,e){var r,n,i,h,d=u(this),v=a(d),g=s(t,v),m=arguments.length,y=0;for(0===m?r=n=0:1===m?(r=0,n=v-g):(r=m-2,n=p(f(c(e),0),v-g)),i=o(v+r-n),h=l(i);y<g;y++)h[y]=d[y];for(;y<g+r;y++)h[y]=arguments[y-g+2];for(;y<i;y++)h[y]=d[y+n-r];return h}}),i("toSpliced")},71761:(t,e,r)=>{"use strict";var n=r(69565),i=r(89228),o=r(28551),a=r(64117),s=r(18014),u=r(655),c=r(67750),l=r(55966),f=r(57829),p=r(56682);i("match",(function(t,e,r){return[function(e){var r=c(this),i=a(e)?void 0:l(e,t);return i?n(i,e,r):new RegExp(e)[t](u(r))},function(t){var n=o(this),i=u(t),a=r(e,n,i);if(a.done)return a.value;if(!n.global)return p(n,i);var c=n.unicode;n.lastIndex=0;var l,h=[],d=0;while(null!==(l=p(n,i))){var v=u(l[0]);h[d]=v,""===v&&(n.lastIndex=f(i,s(n.lastIndex),c)),d++}return 0===d?null:h}]}))},72170:(t,e,r)=>{"use strict";var n=r(94644),i=r(59213).every,o=n.aTypedArray,a=n.exportTypedArrayMethod;a("every",(function(t){return i(o(this),t,arguments.length>1?arguments[1]:void 0)}))},72333:(t,e,r)=>{"use strict";var n=r(91291),i=r(655),o=r(67750),a=RangeError;t.exports=function(t){var e=i(o(this)),r="",s=n(t);if(s<0||s===1/0)throw new a("Wrong number of repetitions");for(;s>0;(s>>>=1)&&(e+=e))1&s&&(r+=e);return r}},72652:(t,e,r)=>{"use strict";var n=r(76080),i=r(69565),o=r(28551),a=r(16823),s=r(44209),u=r(26198),c=r(1625),l=r(70081),f=r(50851),p=r(9539),h=TypeError,d=function(t,e){this.stopped=t,this.result=e},v=d.prototype;t.exports=function(t,e,r){var g,m,y,b,w,_,x,A=r&&r.that,k=!(!r||!r.AS_ENTRIES),E=!(!r||!r.IS_RECORD),S=!(!r||!r.IS_ITERATOR),C=!(!r||!r.INTERRUPTED),O=n(e,A),T=function(t){return g&&p(g,"normal",t),new d(!0,t)},R=function(t){return k?(o(t),C?O(t[0],t[1],T):O(t[0],t[1])):C?O(t,T):O(t)};if(E)g=t.iterator;else if(S)g=t;else{if(m=f(t),!m)throw new h(a(t)+" is not iterable");if(s(m)){for(y=0,b=u(t);b>y;y++)if(w=R(t[y]),w&&c(v,w))return w;return new d(!1)}g=l(t,m)}_=E?t.next:g.next;while(!(x=i(_,g)).done){try{w=R(x.value)}catch(D){p(g,"throw",D)}if("object"==typeof w&&w&&c(v,w))return w}return new d(!1)}},72675:(t,e,r)=>{"use strict";function n(t,e){var r,n,i,o,a,s,u=e.length;for(r=u-1;r>=0;r--)n=e[r],95!==n.marker&&42!==n.marker||-1!==n.end&&(i=e[n.end],s=r>0&&e[r-1].end===n.end+1&&e[r-1].marker===n.marker&&e[r-1].token===n.token-1&&e[n.end+1].token===i.token+1,a=String.fromCharCode(n.marker),o=t.tokens[n.token],o.type=s?"strong_open":"em_open",o.tag=s?"strong":"em",o.nesting=1,o.markup=s?a+a:a,o.content="",o=t.tokens[i.token],o.type=s?"strong_close":"em_close",o.tag=s?"strong":"em",o.nesting=-1,o.markup=s?a+a:a,o.content="",s&&(t.tokens[e[r-1].token].content="",t.tokens[e[n.end+1].token].content="",r--))}r(44114),t.exports.q=function(t,e){var r,n,i,o=t.pos,a=t.src.charCodeAt(o);if(e)return!1;if(95!==a&&42!==a)return!1;for(n=t.scanDelims(t.pos,42===a),r=0;r<n.length;r++)i=t.push("text","",0),i.content=String.fromCharCode(a),t.delimiters.push({marker:a,length:n.length,token:t.tokens.length-1,end:-1,open:n.can_open,close:n.can_close});return t.pos+=n.length,!0},t.exports.g=function(t){var e,r=t.tokens_meta,i=t.tokens_meta.length;for(n(t,t.delimiters),e=0;e<i;e++)r[e]&&r[e].delimiters&&n(t,r[e].delimiters)}},72712:(t,e,r)=>{"use strict";var n=r(46518),i=r(80926).left,o=r(34598),a=r(39519),s=r(16193),u=!s&&a>79&&a<83,c=u||!o("reduce");n({target:"Array",proto:!0,forced:c},{reduce:function(t){var e=arguments.length;return i(this,t,e,e>1?arguments[1]:void 0)}})},72777:(t,e,r)=>{"use strict";var n=r(69565),i=r(20034),o=r(10757),a=r(55966),s=r(84270),u=r(78227),c=TypeError,l=u("toPrimitive");t.exports=function(t,e){if(!i(t)||o(t))return t;var r,u=a(t,l);if(u){if(void 0===e&&(e="default"),r=n(u,t,e),!i(r)||o(r))return r;throw new c("Can't convert object to primitive value")}return void 0===e&&(e="number"),s(t,e)}},72805:(t,e,r)=>{"use strict";var n=r(22195),i=r(79039),o=r(84428),a=r(94644).NATIVE_ARRAY_BUFFER_VIEWS,s=n.ArrayBuffer,u=n.Int8Array;t.exports=!a||!i((function(){u(1)}))||!i((function(){new u(-1)}))||!o((function(t){new u,new u(null),new u(1.5),new u(t)}),!0)||i((function(){return 1!==new u(new s(2),1,void 0).length}))},73322:(t,e,r)=>{"use strict";r(62062),r(44114),r(18111),r(61701),r(26099);var n=r(8700).isSpace;t.exports=function(t,e,r,i){var o,a,s,u,c,l,f,p,h,d,v,g,m,y,b,w,_,x,A,k,E=t.lineMax,S=t.bMarks[e]+t.tShift[e],C=t.eMarks[e];if(t.sCount[e]-t.blkIndent>=4)return!1;if(62!==t.src.charCodeAt(S++))return!1;if(i)return!0;u=h=t.sCount[e]+1,32===t.src.charCodeAt(S)?(S++,u++,h++,o=!1,w=!0):9===t.src.charCodeAt(S)?(w=!0,(t.bsCount[e]+h)%4===3?(S++,u++,h++,o=!1):o=!0):w=!1,d=[t.bMarks[e]],t.bMarks[e]=S;while(S<C){if(a=t.src.charCodeAt(S),!n(a))break;9===a?h+=4-(h+t.bsCount[e]+(o?1:0))%4:h++,S++}for(v=[t.bsCount[e]],t.bsCount[e]=t.sCount[e]+1+(w?1:0),l=S>=C,y=[t.sCount[e]],t.sCount[e]=h-u,b=[t.tShift[e]],t.tShift[e]=S-t.bMarks[e],x=t.md.block.ruler.getRules("blockquote"),m=t.parentType,t.parentType="blockquote",p=e+1;p<r;p++){if(k=t.sCount[p]<t.blkIndent,S=t.bMarks[p]+t.tShift[p],C=t.eMarks[p],S>=C)break;if(62!==t.src.charCodeAt(S++)||k){if(l)break;for(_=!1,s=0,c=x.length;s<c;s++)if(x[s](t,p,r,!0)){_=!0;break}if(_){t.lineMax=p,0!==t.blkIndent&&(d.push(t.bMarks[p]),v.push(t.bsCount[p]),b.push(t.tShift[p]),y.push(t.sCount[p]),t.sCount[p]-=t.blkIndent);break}d.push(t.bMarks[p]),v.push(t.bsCount[p]),b.push(t.tShift[p]),y.push(t.sCount[p]),t.sCount[p]=-1}else{u=h=t.sCount[p]+1,32===t.src.charCodeAt(S)?(S++,u++,h++,o=!1,w=!0):9===t.src.charCodeAt(S)?(w=!0,(t.bsCount[p]+h)%4===3?(S++,u++,h++,o=!1):o=!0):w=!1,d.push(t.bMarks[p]),t.bMarks[p]=S;while(S<C){if(a=t.src.charCodeAt(S),!n(a))break;9===a?h+=4-(h+t.bsCount[p]+(o?1:0))%4:h++,S++}l=S>=C,v.push(t.bsCount[p]),t.bsCount[p]=t.sCount[p]+1+(w?1:0),y.push(t.sCount[p]),t.sCount[p]=h-u,b.push(t.tShift[p]),t.tShift[p]=S-t.bMarks[p]}}for(g=t.blkIndent,t.blkIndent=0,A=t.push("blockquote_open","blockquote",1),A.markup=">",A.map=f=[e,0],t.md.block.tokenize(t,e,p),A=t.push("blockquote_close","blockquote",-1),A.markup=">",t.lineMax=E,t.parentType=m,f[1]=t.line,s=0;s<b.length;s++)t.bMarks[s+e]=d[s],t.tShift[s+e]=b[s],t.sCount[s+e]=y[s],t.bsCount[s+e]=v[s];return t.blkIndent=g,!0}},73506:(t,e,r)=>{"use strict";var n=r(13925),i=String,o=TypeError;t.exports=function(t){if(n(t))return t;throw new o("Can't set "+i(t)+" as a prototype")}},73772:(t,e,r)=>{"use strict";r(65746)},74423:(t,e,r)=>{"use strict";var n=r(46518),i=r(19617).includes,o=r(79039),a=r(6469),s=o((function(){return!Array(1).includes()}));n({target:"Array",proto:!0,forced:s},{includes:function(t){return i(this,t,arguments.length>1?arguments[1]:void 0)}}),a("includes")},74488:(t,e,r)=>{"use strict";var n=r(67680),i=Math.floor,o=function(t,e){var r=t.length;if(r<8){var a,s,u=1;while(u<r){s=u,a=t[u];while(s&&e(t[s-1],a)>0)t[s]=t[--s];s!==u++&&(t[s]=a)}}else{var c=i(r/2),l=o(n(t,0,c),e),f=o(n(t,c),e),p=l.length,h=f.length,d=0,v=0;while(d<p||v<h)t[d+v]=d<p&&v<h?e(l[d],f[v])<=0?l[d++]:f[v++]:d<p?l[d++]:f[v++]}return t};t.exports=o},74721:(t,e,r)=>{"use strict";r.d(e,{A:()=>u});r(64346);var n=r(6562);function i(t){if(Array.isArray(t))return(0,n.A)(t)}var o=r(22036),a=r(12635);r(16280),r(76918);function s(){throw new TypeError("Invalid attempt to spread non-iterable instance.\nIn order to be iterable, non-array objects must have a [Symbol.iterator]() method.")}function u(t){return i(t)||(0,o.A)(t)||(0,a.A)(t)||s()}},74904:t=>{function e(t,e){(null==e||e>t.length)&&(e=t.length);for(var r=0,n=Array(e);r<e;r++)n[r]=t[r];return n}t.exports=e,t.exports.__esModule=!0,t.exports["default"]=t.exports},75044:(t,e,r)=>{"use strict";var n=r(94644),i=r(84373),o=r(75854),a=r(36955),s=r(69565),u=r(79504),c=r(79039),l=n.aTypedArray,f=n.exportTypedArrayMethod,p=u("".slice),h=c((function(){var t=0;return new Int8Array(2).fill({valueOf:function(){return t++}}),1!==t}));f("fill",(function(t){var e=arguments.length;l(this);var r="Big"===p(a(this),0,3)?o(t):+t;return s(i,this,r,e>1?arguments[1]:void 0,e>2?arguments[2]:void 0)}),h)},75854:(t,e,r)=>{"use strict";var n=r(72777),i=TypeError;t.exports=function(t){var e=n(t,"number");if("number"==typeof e)throw new i("Can't convert number to bigint");return BigInt(e)}},76031:(t,e,r)=>{"use strict";r(15575),r(24599)},76080:(t,e,r)=>{"use strict";var n=r(27476),i=r(79306),o=r(40616),a=n(n.bind);t.exports=function(t,e){return i(t),void 0===e?t:o?a(t,e):function(){return t.apply(e,arguments)}}},76918:(t,e,r)=>{"use strict";var n=r(36840),i=r(77536),o=Error.prototype;o.toString!==i&&n(o,"toString",i)},77240:(t,e,r)=>{"use strict";var n=r(79504),i=r(67750),o=r(655),a=/"/g,s=n("".replace);t.exports=function(t,e,r,n){var u=o(i(t)),c="<"+e;return""!==r&&(c+=" "+r+'="'+s(o(n),a,"&quot;")+'"'),c+">"+u+"</"+e+">"}},77347:(t,e,r)=>{"use strict";var n=r(43724),i=r(69565),o=r(48773),a=r(6980),s=r(25397),u=r(56969),c=r(39297),l=r(35917),f=Object.getOwnPropertyDescriptor;e.f=n?f:function(t,e){if(t=s(t),e=u(e),l)try{return f(t,e)}catch(r){}if(c(t,e))return a(!i(o.f,t,e),t[e])}},77536:(t,e,r)=>{"use strict";var n=r(43724),i=r(79039),o=r(28551),a=r(32603),s=Error.prototype.toString,u=i((function(){if(n){var t=Object.create(Object.defineProperty({},"name",{get:function(){return this===t}}));if("true"!==s.call(t))return!0}return"2: 1"!==s.call({message:1,name:2})||"Error"!==s.call({})}));t.exports=u?function(){var t=o(this),e=a(t.name,"Error"),r=a(t.message);return e?r?e+": "+r:e:r}:s},77584:(t,e,r)=>{"use strict";var n=r(20034),i=r(66699);t.exports=function(t,e){n(e)&&"cause"in e&&i(t,"cause",e.cause)}},77629:(t,e,r)=>{"use strict";var n=r(96395),i=r(22195),o=r(39433),a="__core-js_shared__",s=t.exports=i[a]||o(a,{});(s.versions||(s.versions=[])).push({version:"3.40.0",mode:n?"pure":"global",copyright:"© 2014-2025 Denis Pushkarev (zloirock.ru)",license:"https://github.com/zloirock/core-js/blob/v3.40.0/LICENSE",source:"https://github.com/zloirock/core-js"})},77740:(t,e,r)=>{"use strict";var n=r(39297),i=r(35031),o=r(77347),a=r(24913);t.exports=function(t,e,r){for(var s=i(e),u=a.f,c=o.f,l=0;l<s.length;l++){var f=s[l];n(t,f)||r&&n(r,f)||u(t,f,c(e,f))}}},77782:t=>{"use strict";t.exports=Math.sign||function(t){var e=+t;return 0===e||e!==e?e:e<0?-1:1}},77811:t=>{"use strict";t.exports="undefined"!=typeof ArrayBuffer&&"undefined"!=typeof DataView},77936:(t,e,r)=>{"use strict";var n=r(46518),i=r(95636);i&&n({target:"ArrayBuffer",proto:!0},{transferToFixedLength:function(){return i(this,arguments.length?arguments[0]:void 0,!1)}})},78100:(t,e,r)=>{"use strict";var n=r(46518),i=r(95636);i&&n({target:"ArrayBuffer",proto:!0},{transfer:function(){return i(this,arguments.length?arguments[0]:void 0,!0)}})},78125:(t,e,r)=>{"use strict";var n=r(97751),i=r(70511),o=r(10687);i("toStringTag"),o(n("Symbol"),"Symbol")},78227:(t,e,r)=>{"use strict";var n=r(22195),i=r(25745),o=r(39297),a=r(33392),s=r(4495),u=r(7040),c=n.Symbol,l=i("wks"),f=u?c["for"]||c:c&&c.withoutSetter||a;t.exports=function(t){return o(l,t)||(l[t]=s&&o(c,t)?c[t]:f("Symbol."+t)),l[t]}},78459:(t,e,r)=>{"use strict";var n=r(46518),i=r(33904);n({global:!0,forced:parseFloat!==i},{parseFloat:i})},79039:t=>{"use strict";t.exports=function(t){try{return!!t()}catch(e){return!0}}},79296:(t,e,r)=>{"use strict";var n=r(4055),i=n("span").classList,o=i&&i.constructor&&i.constructor.prototype;t.exports=o===Object.prototype?void 0:o},79306:(t,e,r)=>{"use strict";var n=r(94901),i=r(16823),o=TypeError;t.exports=function(t){if(n(t))return t;throw new o(i(t)+" is not a function")}},79432:(t,e,r)=>{"use strict";var n=r(46518),i=r(48981),o=r(71072),a=r(79039),s=a((function(){o(1)}));n({target:"Object",stat:!0,forced:s},{keys:function(t){return o(i(t))}})},79472:(t,e,r)=>{"use strict";var n=r(22195),i=r(18745),o=r(94901),a=r(84215),s=r(82839),u=r(67680),c=r(22812),l=n.Function,f=/MSIE .\./.test(s)||"BUN"===a&&function(){var t=n.Bun.version.split(".");return t.length<3||"0"===t[0]&&(t[1]<3||"3"===t[1]&&"0"===t[2])}();t.exports=function(t,e){var r=e?2:1;return f?function(n,a){var s=c(arguments.length,1)>r,f=o(n)?n:l(n),p=s?u(arguments,r):[],h=s?function(){i(f,this,p)}:f;return e?t(h,a):t(h)}:t}},79504:(t,e,r)=>{"use strict";var n=r(40616),i=Function.prototype,o=i.call,a=n&&i.bind.bind(o,o);t.exports=n?a:function(t){return function(){return o.apply(t,arguments)}}},79577:(t,e,r)=>{"use strict";var n=r(39928),i=r(94644),o=r(18727),a=r(91291),s=r(75854),u=i.aTypedArray,c=i.getTypedArrayConstructor,l=i.exportTypedArrayMethod,f=!!function(){try{new Int8Array(1)["with"](2,{valueOf:function(){throw 8}})}catch(t){return 8===t}}();l("with",{with:function(t,e){var r=u(this),i=a(t),l=o(r)?s(e):+e;return n(r,c(r),i,l)}}["with"],!f)},79739:(t,e,r)=>{"use strict";var n=r(97751),i=r(10687),o="DOMException";i(n(o),o)},80002:t=>{"use strict";t.exports=function(t){var e,r,n=0,i=t.tokens,o=t.tokens.length;for(e=r=0;e<o;e++)i[e].nesting<0&&n--,i[e].level=n,i[e].nesting>0&&n++,"text"===i[e].type&&e+1<o&&"text"===i[e+1].type?i[e+1].content=i[e].content+i[e+1].content:(e!==r&&(i[r]=i[e]),r++);e!==r&&(i.length=r)}},80259:(t,e,r)=>{"use strict";r(62062),r(44114),r(18111),r(61701),r(26099),r(42762),t.exports=function(t,e,r){var n,i,o,a,s,u,c,l,f,p,h=e+1,d=t.md.block.ruler.getRules("paragraph");if(t.sCount[e]-t.blkIndent>=4)return!1;for(p=t.parentType,t.parentType="paragraph";h<r&&!t.isEmpty(h);h++)if(!(t.sCount[h]-t.blkIndent>3)){if(t.sCount[h]>=t.blkIndent&&(u=t.bMarks[h]+t.tShift[h],c=t.eMarks[h],u<c&&(f=t.src.charCodeAt(u),(45===f||61===f)&&(u=t.skipChars(u,f),u=t.skipSpaces(u),u>=c)))){l=61===f?1:2;break}if(!(t.sCount[h]<0)){for(i=!1,o=0,a=d.length;o<a;o++)if(d[o](t,h,r,!0)){i=!0;break}if(i)break}}return!!l&&(n=t.getLines(e,h,t.blkIndent,!1).trim(),t.line=h+1,s=t.push("heading_open","h"+String(l),1),s.markup=String.fromCharCode(f),s.map=[e,t.line],s=t.push("inline","",0),s.content=n,s.map=[e,t.line-1],s.children=[],s=t.push("heading_close","h"+String(l),-1),s.markup=String.fromCharCode(f),t.parentType=p,!0)}},80550:(t,e,r)=>{"use strict";var n=r(22195);t.exports=n.Promise},80741:t=>{"use strict";var e=Math.ceil,r=Math.floor;t.exports=Math.trunc||function(t){var n=+t;return(n>0?r:e)(n)}},80747:(t,e,r)=>{"use strict";var n=r(66699),i=r(38574),o=r(24659),a=Error.captureStackTrace;t.exports=function(t,e,r,s){o&&(a?a(t,e):n(t,"stack",i(r,s)))}},80926:(t,e,r)=>{"use strict";var n=r(79306),i=r(48981),o=r(47055),a=r(26198),s=TypeError,u="Reduce of empty array with no initial value",c=function(t){return function(e,r,c,l){var f=i(e),p=o(f),h=a(f);if(n(r),0===h&&c<2)throw new s(u);var d=t?h-1:0,v=t?-1:1;if(c<2)while(1){if(d in p){l=p[d],d+=v;break}if(d+=v,t?d<0:h<=d)throw new s(u)}for(;t?d>=0:h>d;d+=v)d in p&&(l=r(l,p[d],d,f));return l}};t.exports={left:c(!1),right:c(!0)}},81134:t=>{"use strict";t.exports=function(t){var e,r,n,i=t.tokens;for(r=0,n=i.length;r<n;r++)e=i[r],"inline"===e.type&&t.md.inline.parse(e.content,t.md,t.env,e.children)}},81148:(t,e,r)=>{"use strict";var n=r(46518),i=r(72652),o=r(79306),a=r(28551),s=r(1767);n({target:"Iterator",proto:!0,real:!0},{every:function(t){a(this),o(t);var e=s(this),r=0;return!i(e,(function(e,n){if(!t(e,r++))return n()}),{IS_RECORD:!0,INTERRUPTED:!0}).stopped}})},81278:(t,e,r)=>{"use strict";var n=r(46518),i=r(43724),o=r(35031),a=r(25397),s=r(77347),u=r(97040);n({target:"Object",stat:!0,sham:!i},{getOwnPropertyDescriptors:function(t){var e,r,n=a(t),i=s.f,c=o(n),l={},f=0;while(c.length>f)r=i(n,e=c[f++]),void 0!==r&&u(l,e,r);return l}})},81387:(t,e,r)=>{"use strict";r.d(e,{LA:()=>pt,aE:()=>le});var n=r(81587),i=r(23772),o=r(3604),a=r(54119),s=(r(52675),r(89463),r(78125),r(16280),r(76918),r(28706),r(88431),r(2008),r(50113),r(48980),r(51629),r(23418),r(74423),r(25276),r(64346),r(48598),r(8921),r(62062),r(44114),r(72712),r(94490),r(34782),r(15086),r(54554),r(13609),r(60739),r(23288),r(94170),r(62010),r(18111),r(81148),r(22489),r(20116),r(7588),r(61701),r(18237),r(13579),r(33110),r(4731),r(36033),r(60479),r(84185),r(79432),r(26099),r(84864),r(57465),r(27495),r(87745),r(90906),r(38781),r(31415),r(17642),r(58004),r(33853),r(45876),r(32475),r(15024),r(31698),r(99449),r(21699),r(47764),r(71761),r(25440),r(5746),r(11392),r(23500),r(62953),r(56768)),u=r(90144),c="undefined"!==typeof document;function l(t){return"object"===(0,a.A)(t)||"displayName"in t||"props"in t||"__vccOpts"in t}function f(t){return t.__esModule||"Module"===t[Symbol.toStringTag]||t["default"]&&l(t["default"])}var p=Object.assign;function h(t,e){var r={};for(var n in e){var i=e[n];r[n]=v(i)?i.map(t):t(i)}return r}var d=function(){},v=Array.isArray;var g=/#/g,m=/&/g,y=/\//g,b=/=/g,w=/\?/g,_=/\+/g,x=/%5B/g,A=/%5D/g,k=/%5E/g,E=/%60/g,S=/%7B/g,C=/%7C/g,O=/%7D/g,T=/%20/g;function R(t){return encodeURI(""+t).replace(C,"|").replace(x,"[").replace(A,"]")}function D(t){return R(t).replace(S,"{").replace(O,"}").replace(k,"^")}function I(t){return R(t).replace(_,"%2B").replace(T,"+").replace(g,"%23").replace(m,"%26").replace(E,"`").replace(S,"{").replace(O,"}").replace(k,"^")}function L(t){return I(t).replace(b,"%3D")}function P(t){return R(t).replace(g,"%23").replace(w,"%3F")}function j(t){return null==t?"":P(t).replace(y,"%2F")}function F(t){try{return decodeURIComponent(""+t)}catch(e){}return""+t}var M=/\/$/,N=function(t){return t.replace(M,"")};function q(t,e){var r,n=arguments.length>2&&void 0!==arguments[2]?arguments[2]:"/",i={},o="",a="",s=e.indexOf("#"),u=e.indexOf("?");return s<u&&s>=0&&(u=-1),u>-1&&(r=e.slice(0,u),o=e.slice(u+1,s>-1?s:e.length),i=t(o)),s>-1&&(r=r||e.slice(0,s),a=e.slice(s,e.length)),r=W(null!=r?r:e,n),{fullPath:r+(o&&"?")+o+a,path:r,query:i,hash:F(a)}}function U(t,e){var r=e.query?t(e.query):"";return e.path+(r&&"?")+r+(e.hash||"")}function B(t,e){return e&&t.toLowerCase().startsWith(e.toLowerCase())?t.slice(e.length)||"/":t}function z(t,e,r){var n=e.matched.length-1,i=r.matched.length-1;return n>-1&&n===i&&V(e.matched[n],r.matched[i])&&$(e.params,r.params)&&t(e.query)===t(r.query)&&e.hash===r.hash}function V(t,e){return(t.aliasOf||t)===(e.aliasOf||e)}function $(t,e){if(Object.keys(t).length!==Object.keys(e).length)return!1;for(var r in t)if(!G(t[r],e[r]))return!1;return!0}function G(t,e){return v(t)?H(t,e):v(e)?H(e,t):t===e}function H(t,e){return v(e)?t.length===e.length&&t.every((function(t,r){return t===e[r]})):1===t.length&&t[0]===e}function W(t,e){if(t.startsWith("/"))return t;if(!t)return e;var r=e.split("/"),n=t.split("/"),i=n[n.length-1];".."!==i&&"."!==i||n.push("");var o,a,s=r.length-1;for(o=0;o<n.length;o++)if(a=n[o],"."!==a){if(".."!==a)break;s>1&&s--}return r.slice(0,s).join("/")+"/"+n.slice(o).join("/")}var Z,K,Y={path:"/",name:void 0,params:{},query:{},hash:"",fullPath:"/",matched:[],meta:{},redirectedFrom:void 0};(function(t){t["pop"]="pop",t["push"]="push"})(Z||(Z={})),function(t){t["back"]="back",t["forward"]="forward",t["unknown"]=""}(K||(K={}));function J(t){if(!t)if(c){var e=document.querySelector("base");t=e&&e.getAttribute("href")||"/",t=t.replace(/^\w+:\/\/[^\/]+/,"")}else t="/";return"/"!==t[0]&&"#"!==t[0]&&(t="/"+t),N(t)}var X=/^[^#]+#/;function Q(t,e){return t.replace(X,"#")+e}function tt(t,e){var r=document.documentElement.getBoundingClientRect(),n=t.getBoundingClientRect();return{behavior:e.behavior,left:n.left-r.left-(e.left||0),top:n.top-r.top-(e.top||0)}}var et=function(){return{left:window.scrollX,top:window.scrollY}};function rt(t){var e;if("el"in t){var r=t.el,n="string"===typeof r&&r.startsWith("#"),i="string"===typeof r?n?document.getElementById(r.slice(1)):document.querySelector(r):r;if(!i)return;e=tt(i,t)}else e=t;"scrollBehavior"in document.documentElement.style?window.scrollTo(e):window.scrollTo(null!=e.left?e.left:window.scrollX,null!=e.top?e.top:window.scrollY)}function nt(t,e){var r=history.state?history.state.position-e:-1;return r+t}var it=new Map;function ot(t,e){it.set(t,e)}function at(t){var e=it.get(t);return it["delete"](t),e}var st=function(){return location.protocol+"//"+location.host};function ut(t,e){var r=e.pathname,n=e.search,i=e.hash,o=t.indexOf("#");if(o>-1){var a=i.includes(t.slice(o))?t.slice(o).length:1,s=i.slice(a);return"/"!==s[0]&&(s="/"+s),B(s,"")}var u=B(r,t);return u+n+i}function ct(t,e,r,n){var i=[],a=[],s=null,u=function(o){var a=o.state,u=ut(t,location),c=r.value,l=e.value,f=0;if(a){if(r.value=u,e.value=a,s&&s===c)return void(s=null);f=l?a.position-l.position:0}else n(u);i.forEach((function(t){t(r.value,c,{delta:f,type:Z.pop,direction:f?f>0?K.forward:K.back:K.unknown})}))};function c(){s=r.value}function l(t){i.push(t);var e=function(){var e=i.indexOf(t);e>-1&&i.splice(e,1)};return a.push(e),e}function f(){var t=window,e=t.history;e.state&&e.replaceState(p({},e.state,{scroll:et()}),"")}function h(){var t,e=(0,o.A)(a);try{for(e.s();!(t=e.n()).done;){var r=t.value;r()}}catch(n){e.e(n)}finally{e.f()}a=[],window.removeEventListener("popstate",u),window.removeEventListener("beforeunload",f)}return window.addEventListener("popstate",u),window.addEventListener("beforeunload",f,{passive:!0}),{pauseListeners:c,listen:l,destroy:h}}function lt(t,e,r){var n=arguments.length>3&&void 0!==arguments[3]&&arguments[3],i=arguments.length>4&&void 0!==arguments[4]&&arguments[4];return{back:t,current:e,forward:r,replaced:n,position:window.history.length,scroll:i?et():null}}function ft(t){var e=window,r=e.history,n=e.location,i={value:ut(t,n)},o={value:r.state};function a(e,i,a){var s=t.indexOf("#"),u=s>-1?(n.host&&document.querySelector("base")?t:t.slice(s))+e:st()+t+e;try{r[a?"replaceState":"pushState"](i,"",u),o.value=i}catch(c){console.error(c),n[a?"replace":"assign"](u)}}function s(t,e){var n=p({},r.state,lt(o.value.back,t,o.value.forward,!0),e,{position:o.value.position});a(t,n,!0),i.value=t}function u(t,e){var n=p({},o.value,r.state,{forward:t,scroll:et()});a(n.current,n,!0);var s=p({},lt(i.value,t,null),{position:n.position+1},e);a(t,s,!1),i.value=t}return o.value||a(i.value,{back:null,current:i.value,forward:null,position:r.length-1,replaced:!0,scroll:null},!0),{location:i,state:o,push:u,replace:s}}function pt(t){t=J(t);var e=ft(t),r=ct(t,e.state,e.location,e.replace);function n(t){var e=!(arguments.length>1&&void 0!==arguments[1])||arguments[1];e||r.pauseListeners(),history.go(t)}var i=p({location:"",base:t,go:n,createHref:Q.bind(null,t)},e,r);return Object.defineProperty(i,"location",{enumerable:!0,get:function(){return e.location.value}}),Object.defineProperty(i,"state",{enumerable:!0,get:function(){return e.state.value}}),i}function ht(t){return"string"===typeof t||t&&"object"===(0,a.A)(t)}function dt(t){return"string"===typeof t||"symbol"===(0,a.A)(t)}var vt,gt=Symbol("");(function(t){t[t["aborted"]=4]="aborted",t[t["cancelled"]=8]="cancelled",t[t["duplicated"]=16]="duplicated"})(vt||(vt={}));(0,i.A)((0,i.A)((0,i.A)((0,i.A)((0,i.A)({},1,(function(t){var e=t.location,r=t.currentLocation;return"No match for\n ".concat(JSON.stringify(e)).concat(r?"\nwhile being at\n"+JSON.stringify(r):"")})),2,(function(t){var e=t.from,r=t.to;return'Redirected from "'.concat(e.fullPath,'" to "').concat(wt(r),'" via a navigation guard.')})),4,(function(t){var e=t.from,r=t.to;return'Navigation aborted from "'.concat(e.fullPath,'" to "').concat(r.fullPath,'" via a navigation guard.')})),8,(function(t){var e=t.from,r=t.to;return'Navigation cancelled from "'.concat(e.fullPath,'" to "').concat(r.fullPath,'" with a new navigation.')})),16,(function(t){var e=t.from;t.to;return'Avoided redundant navigation to current location: "'.concat(e.fullPath,'".')}));function mt(t,e){return p(new Error,(0,i.A)({type:t},gt,!0),e)}function yt(t,e){return t instanceof Error&&gt in t&&(null==e||!!(t.type&e))}var bt=["params","query","hash"];function wt(t){if("string"===typeof t)return t;if(null!=t.path)return t.path;var e,r={},n=(0,o.A)(bt);try{for(n.s();!(e=n.n()).done;){var i=e.value;i in t&&(r[i]=t[i])}}catch(a){n.e(a)}finally{n.f()}return JSON.stringify(r,null,2)}var _t="[^/]+?",xt={sensitive:!1,strict:!1,start:!0,end:!0},At=/[.+*?^${}()[\]/\\]/g;function kt(t,e){var r,n=p({},xt,e),i=[],a=n.start?"^":"",s=[],u=(0,o.A)(t);try{for(u.s();!(r=u.n()).done;){var c=r.value,l=c.length?[]:[90];n.strict&&!c.length&&(a+="/");for(var f=0;f<c.length;f++){var h=c[f],d=40+(n.sensitive?.25:0);if(0===h.type)f||(a+="/"),a+=h.value.replace(At,"\\$&"),d+=40;else if(1===h.type){var g=h.value,m=h.repeatable,y=h.optional,b=h.regexp;s.push({name:g,repeatable:m,optional:y});var w=b||_t;if(w!==_t){d+=10;try{new RegExp("(".concat(w,")"))}catch(S){throw new Error('Invalid custom RegExp for param "'.concat(g,'" (').concat(w,"): ")+S.message)}}var _=m?"((?:".concat(w,")(?:/(?:").concat(w,"))*)"):"(".concat(w,")");f||(_=y&&c.length<2?"(?:/".concat(_,")"):"/"+_),y&&(_+="?"),a+=_,d+=20,y&&(d+=-8),m&&(d+=-20),".*"===w&&(d+=-50)}l.push(d)}i.push(l)}}catch(S){u.e(S)}finally{u.f()}if(n.strict&&n.end){var x=i.length-1;i[x][i[x].length-1]+=.7000000000000001}n.strict||(a+="/?"),n.end?a+="$":n.strict&&!a.endsWith("/")&&(a+="(?:/|$)");var A=new RegExp(a,n.sensitive?"":"i");function k(t){var e=t.match(A),r={};if(!e)return null;for(var n=1;n<e.length;n++){var i=e[n]||"",o=s[n-1];r[o.name]=i&&o.repeatable?i.split("/"):i}return r}function E(e){var r,n="",i=!1,a=(0,o.A)(t);try{for(a.s();!(r=a.n()).done;){var s=r.value;i&&n.endsWith("/")||(n+="/"),i=!1;var u,c=(0,o.A)(s);try{for(c.s();!(u=c.n()).done;){var l=u.value;if(0===l.type)n+=l.value;else if(1===l.type){var f=l.value,p=l.repeatable,h=l.optional,d=f in e?e[f]:"";if(v(d)&&!p)throw new Error('Provided param "'.concat(f,'" is an array but it is not repeatable (* or + modifiers)'));var g=v(d)?d.join("/"):d;if(!g){if(!h)throw new Error('Missing required param "'.concat(f,'"'));s.length<2&&(n.endsWith("/")?n=n.slice(0,-1):i=!0)}n+=g}}}catch(S){c.e(S)}finally{c.f()}}}catch(S){a.e(S)}finally{a.f()}return n||"/"}return{re:A,score:i,keys:s,parse:k,stringify:E}}function Et(t,e){var r=0;while(r<t.length&&r<e.length){var n=e[r]-t[r];if(n)return n;r++}return t.length<e.length?1===t.length&&80===t[0]?-1:1:t.length>e.length?1===e.length&&80===e[0]?1:-1:0}function St(t,e){var r=0,n=t.score,i=e.score;while(r<n.length&&r<i.length){var o=Et(n[r],i[r]);if(o)return o;r++}if(1===Math.abs(i.length-n.length)){if(Ct(n))return 1;if(Ct(i))return-1}return i.length-n.length}function Ct(t){var e=t[t.length-1];return t.length>0&&e[e.length-1]<0}var Ot={type:0,value:""},Tt=/[a-zA-Z0-9_]/;function Rt(t){if(!t)return[[]];if("/"===t)return[[Ot]];if(!t.startsWith("/"))throw new Error('Invalid path "'.concat(t,'"'));function e(t){throw new Error("ERR (".concat(n,')/"').concat(c,'": ').concat(t))}var r,n=0,i=n,o=[];function a(){r&&o.push(r),r=[]}var s,u=0,c="",l="";function f(){c&&(0===n?r.push({type:0,value:c}):1===n||2===n||3===n?(r.length>1&&("*"===s||"+"===s)&&e("A repeatable param (".concat(c,") must be alone in its segment. eg: '/:ids+.")),r.push({type:1,value:c,regexp:l,repeatable:"*"===s||"+"===s,optional:"*"===s||"?"===s})):e("Invalid state to consume buffer"),c="")}function p(){c+=s}while(u<t.length)if(s=t[u++],"\\"!==s||2===n)switch(n){case 0:"/"===s?(c&&f(),a()):":"===s?(f(),n=1):p();break;case 4:p(),n=i;break;case 1:"("===s?n=2:Tt.test(s)?p():(f(),n=0,"*"!==s&&"?"!==s&&"+"!==s&&u--);break;case 2:")"===s?"\\"==l[l.length-1]?l=l.slice(0,-1)+s:n=3:l+=s;break;case 3:f(),n=0,"*"!==s&&"?"!==s&&"+"!==s&&u--,l="";break;default:e("Unknown state");break}else i=n,n=4;return 2===n&&e('Unfinished custom RegExp for param "'.concat(c,'"')),f(),a(),o}function Dt(t,e,r){var n=kt(Rt(t.path),r),i=p(n,{record:t,parent:e,children:[],alias:[]});return e&&!i.record.aliasOf===!e.record.aliasOf&&e.children.push(i),i}function It(t,e){var r=[],n=new Map;function i(t){return n.get(t)}function a(t,r,n){var i=!n,u=Pt(t);u.aliasOf=n&&n.record;var l,f,h=Nt(e,t),v=[u];if("alias"in t){var g,m="string"===typeof t.alias?[t.alias]:t.alias,y=(0,o.A)(m);try{for(y.s();!(g=y.n()).done;){var b=g.value;v.push(Pt(p({},u,{components:n?n.record.components:u.components,path:b,aliasOf:n?n.record:u})))}}catch(O){y.e(O)}finally{y.f()}}for(var w=0,_=v;w<_.length;w++){var x=_[w],A=x.path;if(r&&"/"!==A[0]){var k=r.record.path,E="/"===k[k.length-1]?"":"/";x.path=r.record.path+(A&&E+A)}if(l=Dt(x,r,h),n?n.alias.push(l):(f=f||l,f!==l&&f.alias.push(l),i&&t.name&&!Ft(l)&&s(t.name)),Bt(l)&&c(l),u.children)for(var S=u.children,C=0;C<S.length;C++)a(S[C],l,n&&n.children[C]);n=n||l}return f?function(){s(f)}:d}function s(t){if(dt(t)){var e=n.get(t);e&&(n["delete"](t),r.splice(r.indexOf(e),1),e.children.forEach(s),e.alias.forEach(s))}else{var i=r.indexOf(t);i>-1&&(r.splice(i,1),t.record.name&&n["delete"](t.record.name),t.children.forEach(s),t.alias.forEach(s))}}function u(){return r}function c(t){var e=qt(t,r);r.splice(e,0,t),t.record.name&&!Ft(t)&&n.set(t.record.name,t)}function l(t,e){var i,o,a,s={};if("name"in t&&t.name){if(i=n.get(t.name),!i)throw mt(1,{location:t});a=i.record.name,s=p(Lt(e.params,i.keys.filter((function(t){return!t.optional})).concat(i.parent?i.parent.keys.filter((function(t){return t.optional})):[]).map((function(t){return t.name}))),t.params&&Lt(t.params,i.keys.map((function(t){return t.name})))),o=i.stringify(s)}else if(null!=t.path)o=t.path,i=r.find((function(t){return t.re.test(o)})),i&&(s=i.parse(o),a=i.record.name);else{if(i=e.name?n.get(e.name):r.find((function(t){return t.re.test(e.path)})),!i)throw mt(1,{location:t,currentLocation:e});a=i.record.name,s=p({},e.params,t.params),o=i.stringify(s)}var u=[],c=i;while(c)u.unshift(c.record),c=c.parent;return{name:a,path:o,params:s,matched:u,meta:Mt(u)}}function f(){r.length=0,n.clear()}return e=Nt({strict:!1,end:!0,sensitive:!1},e),t.forEach((function(t){return a(t)})),{addRoute:a,resolve:l,removeRoute:s,clearRoutes:f,getRoutes:u,getRecordMatcher:i}}function Lt(t,e){var r,n={},i=(0,o.A)(e);try{for(i.s();!(r=i.n()).done;){var a=r.value;a in t&&(n[a]=t[a])}}catch(s){i.e(s)}finally{i.f()}return n}function Pt(t){var e={path:t.path,redirect:t.redirect,name:t.name,meta:t.meta||{},aliasOf:t.aliasOf,beforeEnter:t.beforeEnter,props:jt(t),children:t.children||[],instances:{},leaveGuards:new Set,updateGuards:new Set,enterCallbacks:{},components:"components"in t?t.components||null:t.component&&{default:t.component}};return Object.defineProperty(e,"mods",{value:{}}),e}function jt(t){var e={},r=t.props||!1;if("component"in t)e["default"]=r;else for(var n in t.components)e[n]="object"===(0,a.A)(r)?r[n]:r;return e}function Ft(t){while(t){if(t.record.aliasOf)return!0;t=t.parent}return!1}function Mt(t){return t.reduce((function(t,e){return p(t,e.meta)}),{})}function Nt(t,e){var r={};for(var n in t)r[n]=n in e?e[n]:t[n];return r}function qt(t,e){var r=0,n=e.length;while(r!==n){var i=r+n>>1,o=St(t,e[i]);o<0?n=i:r=i+1}var a=Ut(t);return a&&(n=e.lastIndexOf(a,n-1)),n}function Ut(t){var e=t;while(e=e.parent)if(Bt(e)&&0===St(t,e))return e}function Bt(t){var e=t.record;return!!(e.name||e.components&&Object.keys(e.components).length||e.redirect)}function zt(t){var e={};if(""===t||"?"===t)return e;for(var r="?"===t[0],n=(r?t.slice(1):t).split("&"),i=0;i<n.length;++i){var o=n[i].replace(_," "),a=o.indexOf("="),s=F(a<0?o:o.slice(0,a)),u=a<0?null:F(o.slice(a+1));if(s in e){var c=e[s];v(c)||(c=e[s]=[c]),c.push(u)}else e[s]=u}return e}function Vt(t){var e="",r=function(r){var n=t[r];if(r=L(r),null==n)return void 0!==n&&(e+=(e.length?"&":"")+r),1;var i=v(n)?n.map((function(t){return t&&I(t)})):[n&&I(n)];i.forEach((function(t){void 0!==t&&(e+=(e.length?"&":"")+r,null!=t&&(e+="="+t))}))};for(var n in t)r(n);return e}function $t(t){var e={};for(var r in t){var n=t[r];void 0!==n&&(e[r]=v(n)?n.map((function(t){return null==t?null:""+t})):null==n?n:""+n)}return e}var Gt=Symbol(""),Ht=Symbol(""),Wt=Symbol(""),Zt=Symbol(""),Kt=Symbol("");function Yt(){var t=[];function e(e){return t.push(e),function(){var r=t.indexOf(e);r>-1&&t.splice(r,1)}}function r(){t=[]}return{add:e,list:function(){return t.slice()},reset:r}}function Jt(t,e,r,n,i){var o=arguments.length>5&&void 0!==arguments[5]?arguments[5]:function(t){return t()},a=n&&(n.enterCallbacks[i]=n.enterCallbacks[i]||[]);return function(){return new Promise((function(s,u){var c=function(t){!1===t?u(mt(4,{from:r,to:e})):t instanceof Error?u(t):ht(t)?u(mt(2,{from:e,to:t})):(a&&n.enterCallbacks[i]===a&&"function"===typeof t&&a.push(t),s())},l=o((function(){return t.call(n&&n.instances[i],e,r,c)})),f=Promise.resolve(l);t.length<3&&(f=f.then(c)),f["catch"]((function(t){return u(t)}))}))}}function Xt(t,e,r,n){var i,a=arguments.length>4&&void 0!==arguments[4]?arguments[4]:function(t){return t()},s=[],u=(0,o.A)(t);try{var c=function(){var t=i.value;var o=function(i){var o=t.components[i];if("beforeRouteEnter"!==e&&!t.instances[i])return 1;if(l(o)){var u=o.__vccOpts||o,c=u[e];c&&s.push(Jt(c,r,n,t,i,a))}else{var p=o();0,s.push((function(){return p.then((function(o){if(!o)throw new Error("Couldn't resolve component \"".concat(i,'" at "').concat(t.path,'"'));var s=f(o)?o["default"]:o;t.mods[i]=o,t.components[i]=s;var u=s.__vccOpts||s,c=u[e];return c&&Jt(c,r,n,t,i,a)()}))}))}};for(var u in t.components)o(u)};for(u.s();!(i=u.n()).done;)c()}catch(p){u.e(p)}finally{u.f()}return s}function Qt(t){var e=(0,s.WQ)(Wt),r=(0,s.WQ)(Zt),n=(0,s.EW)((function(){var r=(0,u.R1)(t.to);return e.resolve(r)})),i=(0,s.EW)((function(){var t=n.value.matched,e=t.length,i=t[e-1],o=r.matched;if(!i||!o.length)return-1;var a=o.findIndex(V.bind(null,i));if(a>-1)return a;var s=oe(t[e-2]);return e>1&&oe(i)===s&&o[o.length-1].path!==s?o.findIndex(V.bind(null,t[e-2])):a})),o=(0,s.EW)((function(){return i.value>-1&&ie(r.params,n.value.params)})),a=(0,s.EW)((function(){return i.value>-1&&i.value===r.matched.length-1&&$(r.params,n.value.params)}));function c(){var r=arguments.length>0&&void 0!==arguments[0]?arguments[0]:{};if(ne(r)){var n=e[(0,u.R1)(t.replace)?"replace":"push"]((0,u.R1)(t.to))["catch"](d);return t.viewTransition&&"undefined"!==typeof document&&"startViewTransition"in document&&document.startViewTransition((function(){return n})),n}return Promise.resolve()}return{route:n,href:(0,s.EW)((function(){return n.value.href})),isActive:o,isExactActive:a,navigate:c}}function te(t){return 1===t.length?t[0]:t}var ee=(0,s.pM)({name:"RouterLink",compatConfig:{MODE:3},props:{to:{type:[String,Object],required:!0},replace:Boolean,activeClass:String,exactActiveClass:String,custom:Boolean,ariaCurrentValue:{type:String,default:"page"}},useLink:Qt,setup:function(t,e){var r=e.slots,n=(0,u.Kh)(Qt(t)),o=(0,s.WQ)(Wt),a=o.options,c=(0,s.EW)((function(){return(0,i.A)((0,i.A)({},ae(t.activeClass,a.linkActiveClass,"router-link-active"),n.isActive),ae(t.exactActiveClass,a.linkExactActiveClass,"router-link-exact-active"),n.isExactActive)}));return function(){var e=r["default"]&&te(r["default"](n));return t.custom?e:(0,s.h)("a",{"aria-current":n.isExactActive?t.ariaCurrentValue:null,href:n.href,onClick:n.navigate,class:c.value},e)}}}),re=ee;function ne(t){if(!(t.metaKey||t.altKey||t.ctrlKey||t.shiftKey)&&!t.defaultPrevented&&(void 0===t.button||0===t.button)){if(t.currentTarget&&t.currentTarget.getAttribute){var e=t.currentTarget.getAttribute("target");if(/\b_blank\b/i.test(e))return}return t.preventDefault&&t.preventDefault(),!0}}function ie(t,e){var r,n=function(){var r=e[i],n=t[i];if("string"===typeof r){if(r!==n)return{v:!1}}else if(!v(n)||n.length!==r.length||r.some((function(t,e){return t!==n[e]})))return{v:!1}};for(var i in e)if(r=n(),r)return r.v;return!0}function oe(t){return t?t.aliasOf?t.aliasOf.path:t.path:""}var ae=function(t,e,r){return null!=t?t:null!=e?e:r},se=(0,s.pM)({name:"RouterView",inheritAttrs:!1,props:{name:{type:String,default:"default"},route:Object},compatConfig:{MODE:3},setup:function(t,e){var r=e.attrs,i=e.slots,o=(0,s.WQ)(Kt),a=(0,s.EW)((function(){return t.route||o.value})),c=(0,s.WQ)(Ht,0),l=(0,s.EW)((function(){var t,e=(0,u.R1)(c),r=a.value.matched;while((t=r[e])&&!t.components)e++;return e})),f=(0,s.EW)((function(){return a.value.matched[l.value]}));(0,s.Gt)(Ht,(0,s.EW)((function(){return l.value+1}))),(0,s.Gt)(Gt,f),(0,s.Gt)(Kt,a);var h=(0,u.KR)();return(0,s.wB)((function(){return[h.value,f.value,t.name]}),(function(t,e){var r=(0,n.A)(t,3),i=r[0],o=r[1],a=r[2],s=(0,n.A)(e,3),u=s[0],c=s[1];s[2];o&&(o.instances[a]=i,c&&c!==o&&i&&i===u&&(o.leaveGuards.size||(o.leaveGuards=c.leaveGuards),o.updateGuards.size||(o.updateGuards=c.updateGuards))),!i||!o||c&&V(o,c)&&u||(o.enterCallbacks[a]||[]).forEach((function(t){return t(i)}))}),{flush:"post"}),function(){var e=a.value,n=t.name,o=f.value,u=o&&o.components[n];if(!u)return ue(i["default"],{Component:u,route:e});var c=o.props[n],l=c?!0===c?e.params:"function"===typeof c?c(e):c:null,d=function(t){t.component.isUnmounted&&(o.instances[n]=null)},v=(0,s.h)(u,p({},l,r,{onVnodeUnmounted:d,ref:h}));return ue(i["default"],{Component:v,route:e})||v}}});function ue(t,e){if(!t)return null;var r=t(e);return 1===r.length?r[0]:r}var ce=se;function le(t){var e=It(t.routes,t),r=t.parseQuery||zt,i=t.stringifyQuery||Vt,l=t.history;var f=Yt(),g=Yt(),m=Yt(),y=(0,u.IJ)(Y),b=Y;c&&t.scrollBehavior&&"scrollRestoration"in history&&(history.scrollRestoration="manual");var w,_=h.bind(null,(function(t){return""+t})),x=h.bind(null,j),A=h.bind(null,F);function k(t,r){var n,i;return dt(t)?(n=e.getRecordMatcher(t),i=r):i=t,e.addRoute(i,n)}function E(t){var r=e.getRecordMatcher(t);r&&e.removeRoute(r)}function S(){return e.getRoutes().map((function(t){return t.record}))}function C(t){return!!e.getRecordMatcher(t)}function O(t,n){if(n=p({},n||y.value),"string"===typeof t){var o=q(r,t,n.path),a=e.resolve({path:o.path},n),s=l.createHref(o.fullPath);return p(o,a,{params:A(a.params),hash:F(o.hash),redirectedFrom:void 0,href:s})}var u;if(null!=t.path)u=p({},t,{path:q(r,t.path,n.path).path});else{var c=p({},t.params);for(var f in c)null==c[f]&&delete c[f];u=p({},t,{params:x(c)}),n.params=x(n.params)}var h=e.resolve(u,n),d=t.hash||"";h.params=_(A(h.params));var v=U(i,p({},t,{hash:D(d),path:h.path})),g=l.createHref(v);return p({fullPath:v,hash:d,query:i===Vt?$t(t.query):t.query||{}},h,{redirectedFrom:void 0,href:g})}function T(t){return"string"===typeof t?q(r,t,y.value.path):p({},t)}function R(t,e){if(b!==t)return mt(8,{from:e,to:t})}function I(t){return M(t)}function L(t){return I(p(T(t),{replace:!0}))}function P(t){var e=t.matched[t.matched.length-1];if(e&&e.redirect){var r=e.redirect,n="function"===typeof r?r(t):r;return"string"===typeof n&&(n=n.includes("?")||n.includes("#")?n=T(n):{path:n},n.params={}),p({query:t.query,hash:t.hash,params:null!=n.path?{}:t.params},n)}}function M(t,e){var r=b=O(t),n=y.value,o=t.state,s=t.force,u=!0===t.replace,c=P(r);if(c)return M(p(T(c),{state:"object"===(0,a.A)(c)?p({},o,c.state):o,force:s,replace:u}),e||r);var l,f=r;return f.redirectedFrom=e,!s&&z(i,n,r)&&(l=mt(16,{to:f,from:n}),it(n,n,!0,!1)),(l?Promise.resolve(l):V(f,n))["catch"]((function(t){return yt(t)?yt(t,2)?t:tt(t):X(t,f,n)})).then((function(t){if(t){if(yt(t,2))return M(p({replace:u},T(t.to),{state:"object"===(0,a.A)(t.to)?p({},o,t.to.state):o,force:s}),e||f)}else t=G(f,n,!0,u,o);return $(f,n,t),t}))}function N(t,e){var r=R(t,e);return r?Promise.reject(r):Promise.resolve()}function B(t){var e=ct.values().next().value;return e&&"function"===typeof e.runWithContext?e.runWithContext(t):t()}function V(t,e){var r,i=fe(t,e),a=(0,n.A)(i,3),s=a[0],u=a[1],c=a[2];r=Xt(s.reverse(),"beforeRouteLeave",t,e);var l,p=(0,o.A)(s);try{for(p.s();!(l=p.n()).done;){var h=l.value;h.leaveGuards.forEach((function(n){r.push(Jt(n,t,e))}))}}catch(m){p.e(m)}finally{p.f()}var d=N.bind(null,t,e);return r.push(d),ft(r).then((function(){r=[];var n,i=(0,o.A)(f.list());try{for(i.s();!(n=i.n()).done;){var a=n.value;r.push(Jt(a,t,e))}}catch(m){i.e(m)}finally{i.f()}return r.push(d),ft(r)})).then((function(){r=Xt(u,"beforeRouteUpdate",t,e);var n,i=(0,o.A)(u);try{for(i.s();!(n=i.n()).done;){var a=n.value;a.updateGuards.forEach((function(n){r.push(Jt(n,t,e))}))}}catch(m){i.e(m)}finally{i.f()}return r.push(d),ft(r)})).then((function(){r=[];var n,i=(0,o.A)(c);try{for(i.s();!(n=i.n()).done;){var a=n.value;if(a.beforeEnter)if(v(a.beforeEnter)){var s,u=(0,o.A)(a.beforeEnter);try{for(u.s();!(s=u.n()).done;){var l=s.value;r.push(Jt(l,t,e))}}catch(m){u.e(m)}finally{u.f()}}else r.push(Jt(a.beforeEnter,t,e))}}catch(m){i.e(m)}finally{i.f()}return r.push(d),ft(r)})).then((function(){return t.matched.forEach((function(t){return t.enterCallbacks={}})),r=Xt(c,"beforeRouteEnter",t,e,B),r.push(d),ft(r)})).then((function(){r=[];var n,i=(0,o.A)(g.list());try{for(i.s();!(n=i.n()).done;){var a=n.value;r.push(Jt(a,t,e))}}catch(m){i.e(m)}finally{i.f()}return r.push(d),ft(r)}))["catch"]((function(t){return yt(t,8)?t:Promise.reject(t)}))}function $(t,e,r){m.list().forEach((function(n){return B((function(){return n(t,e,r)}))}))}function G(t,e,r,n,i){var o=R(t,e);if(o)return o;var a=e===Y,s=c?history.state:{};r&&(n||a?l.replace(t.fullPath,p({scroll:a&&s&&s.scroll},i)):l.push(t.fullPath,i)),y.value=t,it(t,e,r,a),tt()}function H(){w||(w=l.listen((function(t,e,r){if(lt.listening){var n=O(t),i=P(n);if(i)M(p(i,{replace:!0,force:!0}),n)["catch"](d);else{b=n;var o=y.value;c&&ot(nt(o.fullPath,r.delta),et()),V(n,o)["catch"]((function(t){return yt(t,12)?t:yt(t,2)?(M(p(T(t.to),{force:!0}),n).then((function(t){yt(t,20)&&!r.delta&&r.type===Z.pop&&l.go(-1,!1)}))["catch"](d),Promise.reject()):(r.delta&&l.go(-r.delta,!1),X(t,n,o))})).then((function(t){t=t||G(n,o,!1),t&&(r.delta&&!yt(t,8)?l.go(-r.delta,!1):r.type===Z.pop&&yt(t,20)&&l.go(-1,!1)),$(n,o,t)}))["catch"](d)}}})))}var W,K=Yt(),J=Yt();function X(t,e,r){tt(t);var n=J.list();return n.length?n.forEach((function(n){return n(t,e,r)})):console.error(t),Promise.reject(t)}function Q(){return W&&y.value!==Y?Promise.resolve():new Promise((function(t,e){K.add([t,e])}))}function tt(t){return W||(W=!t,H(),K.list().forEach((function(e){var r=(0,n.A)(e,2),i=r[0],o=r[1];return t?o(t):i()})),K.reset()),t}function it(e,r,n,i){var o=t.scrollBehavior;if(!c||!o)return Promise.resolve();var a=!n&&at(nt(e.fullPath,0))||(i||!n)&&history.state&&history.state.scroll||null;return(0,s.dY)().then((function(){return o(e,r,a)})).then((function(t){return t&&rt(t)}))["catch"]((function(t){return X(t,e,r)}))}var st,ut=function(t){return l.go(t)},ct=new Set,lt={currentRoute:y,listening:!0,addRoute:k,removeRoute:E,clearRoutes:e.clearRoutes,hasRoute:C,getRoutes:S,resolve:O,options:t,push:I,replace:L,go:ut,back:function(){return ut(-1)},forward:function(){return ut(1)},beforeEach:f.add,beforeResolve:g.add,afterEach:m.add,onError:J.add,isReady:Q,install:function(t){var e=this;t.component("RouterLink",re),t.component("RouterView",ce),t.config.globalProperties.$router=e,Object.defineProperty(t.config.globalProperties,"$route",{enumerable:!0,get:function(){return(0,u.R1)(y)}}),c&&!st&&y.value===Y&&(st=!0,I(l.location)["catch"]((function(t){0})));var r={},n=function(t){Object.defineProperty(r,t,{get:function(){return y.value[t]},enumerable:!0})};for(var i in Y)n(i);t.provide(Wt,e),t.provide(Zt,(0,u.Gc)(r)),t.provide(Kt,y);var o=t.unmount;ct.add(t),t.unmount=function(){ct["delete"](t),ct.size<1&&(b=Y,w&&w(),w=null,y.value=Y,st=!1,W=!1),o()}}};function ft(t){return t.reduce((function(t,e){return t.then((function(){return B(e)}))}),Promise.resolve())}return lt}function fe(t,e){for(var r=[],n=[],i=[],o=Math.max(e.matched.length,t.matched.length),a=function(){var o=e.matched[s];o&&(t.matched.find((function(t){return V(t,o)}))?n.push(o):r.push(o));var a=t.matched[s];a&&(e.matched.find((function(t){return V(t,a)}))||i.push(a))},s=0;s<o;s++)a();return[r,n,i]}},81510:(t,e,r)=>{"use strict";var n=r(46518),i=r(97751),o=r(39297),a=r(655),s=r(25745),u=r(91296),c=s("string-to-symbol-registry"),l=s("symbol-to-string-registry");n({target:"Symbol",stat:!0,forced:!u},{for:function(t){var e=a(t);if(o(c,e))return c[e];var r=i("Symbol")(e);return c[e]=r,l[r]=e,r}})},81587:(t,e,r)=>{"use strict";r.d(e,{A:()=>s});var n=r(47914);r(52675),r(89463),r(2259),r(44114),r(26099),r(47764),r(62953);function i(t,e){var r=null==t?null:"undefined"!=typeof Symbol&&t[Symbol.iterator]||t["@@iterator"];if(null!=r){var n,i,o,a,s=[],u=!0,c=!1;try{if(o=(r=r.call(t)).next,0===e){if(Object(r)!==r)return;u=!1}else for(;!(u=(n=o.call(r)).done)&&(s.push(n.value),s.length!==e);u=!0);}catch(t){c=!0,i=t}finally{try{if(!u&&null!=r["return"]&&(a=r["return"](),Object(a)!==a))return}finally{if(c)throw i}}return s}}var o=r(12635),a=r(3531);function s(t,e){return(0,n.A)(t)||i(t,e)||(0,o.A)(t,e)||(0,a.A)()}},81630:(t,e,r)=>{"use strict";var n=r(79504),i=r(94644),o=r(57029),a=n(o),s=i.aTypedArray,u=i.exportTypedArrayMethod;u("copyWithin",(function(t,e){return a(s(this),t,e,arguments.length>2?arguments[2]:void 0)}))},81890:(t,e,r)=>{"use strict";r(84864),r(57465),r(27495),r(87745),r(38781);var n="[a-zA-Z_:][a-zA-Z0-9:._-]*",i="[^\"'=<>`\\x00-\\x20]+",o="'[^']*'",a='"[^"]*"',s="(?:"+i+"|"+o+"|"+a+")",u="(?:\\s+"+n+"(?:\\s*=\\s*"+s+")?)",c="<[A-Za-z][A-Za-z0-9\\-]*"+u+"*\\s*\\/?>",l="<\\/[A-Za-z][A-Za-z0-9\\-]*\\s*>",f="\x3c!----\x3e|\x3c!--(?:-?[^>-])(?:-?[^-])*--\x3e",p="<[?][\\s\\S]*?[?]>",h="<![A-Z]+\\s+[^>]*>",d="<!\\[CDATA\\[[\\s\\S]*?\\]\\]>",v=new RegExp("^(?:"+c+"|"+l+"|"+f+"|"+p+"|"+h+"|"+d+")"),g=new RegExp("^(?:"+c+"|"+l+")");t.exports.l=v,t.exports.p=g},82003:(t,e,r)=>{"use strict";var n=r(46518),i=r(96395),o=r(10916).CONSTRUCTOR,a=r(80550),s=r(97751),u=r(94901),c=r(36840),l=a&&a.prototype;if(n({target:"Promise",proto:!0,forced:o,real:!0},{catch:function(t){return this.then(void 0,t)}}),!i&&u(a)){var f=s("Promise").prototype["catch"];l["catch"]!==f&&c(l,"catch",f,{unsafe:!0})}},82229:(t,e,r)=>{r(76918),r(23418),r(34782),r(23288),r(62010),r(26099),r(27495),r(90906),r(38781),r(47764);var n=r(74904);function i(t,e){if(t){if("string"==typeof t)return n(t,e);var r={}.toString.call(t).slice(8,-1);return"Object"===r&&t.constructor&&(r=t.constructor.name),"Map"===r||"Set"===r?Array.from(t):"Arguments"===r||/^(?:Ui|I)nt(?:8|16|32)(?:Clamped)?Array$/.test(r)?n(t,e):void 0}}t.exports=i,t.exports.__esModule=!0,t.exports["default"]=t.exports},82540:(t,e,r)=>{"use strict";e.Any=r(87746),e.Cc=r(4919),e.Cf=r(87724),e.P=r(44557),e.Z=r(64535)},82839:(t,e,r)=>{"use strict";var n=r(22195),i=n.navigator,o=i&&i.userAgent;t.exports=o?String(o):""},83250:(t,e,r)=>{"use strict";r(51629),r(44114),r(18111),r(7588),r(26099),r(23500);for(var n=r(8700).isSpace,i=[],o=0;o<256;o++)i.push(0);"\\!\"#$%&'()*+,./:;<=>?@[]^_`{|}~-".split("").forEach((function(t){i[t.charCodeAt(0)]=1})),t.exports=function(t,e){var r,o=t.pos,a=t.posMax;if(92!==t.src.charCodeAt(o))return!1;if(o++,o<a){if(r=t.src.charCodeAt(o),r<256&&0!==i[r])return e||(t.pending+=t.src[o]),t.pos+=2,!0;if(10===r){e||t.push("hardbreak","br",0),o++;while(o<a){if(r=t.src.charCodeAt(o),!n(r))break;o++}return t.pos=o,!0}}return e||(t.pending+="\\"),t.pos++,!0}},83440:(t,e,r)=>{"use strict";var n=r(97080),i=r(94402),o=r(89286),a=r(25170),s=r(83789),u=r(38469),c=r(40507),l=i.has,f=i.remove;t.exports=function(t){var e=n(this),r=s(t),i=o(e);return a(e)<=r.size?u(e,(function(t){r.includes(t)&&f(i,t)})):c(r.getIterator(),(function(t){l(e,t)&&f(i,t)})),i}},83635:(t,e,r)=>{"use strict";var n=r(79039),i=r(22195),o=i.RegExp;t.exports=n((function(){var t=o(".","s");return!(t.dotAll&&t.test("\n")&&"s"===t.flags)}))},83650:(t,e,r)=>{"use strict";var n=r(97080),i=r(94402),o=r(89286),a=r(83789),s=r(40507),u=i.add,c=i.has,l=i.remove;t.exports=function(t){var e=n(this),r=a(t).getIterator(),i=o(e);return s(r,(function(t){c(e,t)?l(i,t):u(i,t)})),i}},83789:(t,e,r)=>{"use strict";var n=r(79306),i=r(28551),o=r(69565),a=r(91291),s=r(1767),u="Invalid size",c=RangeError,l=TypeError,f=Math.max,p=function(t,e){this.set=t,this.size=f(e,0),this.has=n(t.has),this.keys=n(t.keys)};p.prototype={getIterator:function(){return s(i(o(this.keys,this.set)))},includes:function(t){return o(this.has,this.set,t)}},t.exports=function(t){i(t);var e=+t.size;if(e!==e)throw new l(u);var r=a(e);if(r<0)throw new c(u);return new p(t,r)}},83851:(t,e,r)=>{"use strict";var n=r(46518),i=r(79039),o=r(25397),a=r(77347).f,s=r(43724),u=!s||i((function(){a(1)}));n({target:"Object",stat:!0,forced:u,sham:!s},{getOwnPropertyDescriptor:function(t,e){return a(o(t),e)}})},84185:(t,e,r)=>{"use strict";var n=r(46518),i=r(43724),o=r(24913).f;n({target:"Object",stat:!0,forced:Object.defineProperty!==o,sham:!i},{defineProperty:o})},84215:(t,e,r)=>{"use strict";var n=r(22195),i=r(82839),o=r(44576),a=function(t){return i.slice(0,t.length)===t};t.exports=function(){return a("Bun/")?"BUN":a("Cloudflare-Workers")?"CLOUDFLARE":a("Deno/")?"DENO":a("Node.js/")?"NODE":n.Bun&&"string"==typeof Bun.version?"BUN":n.Deno&&"object"==typeof Deno.version?"DENO":"process"===o(n.process)?"NODE":n.window&&n.document?"BROWSER":"REST"}()},84270:(t,e,r)=>{"use strict";var n=r(69565),i=r(94901),o=r(20034),a=TypeError;t.exports=function(t,e){var r,s;if("string"===e&&i(r=t.toString)&&!o(s=n(r,t)))return s;if(i(r=t.valueOf)&&!o(s=n(r,t)))return s;if("string"!==e&&i(r=t.toString)&&!o(s=n(r,t)))return s;throw new a("Can't convert object to primitive value")}},84373:(t,e,r)=>{"use strict";var n=r(48981),i=r(35610),o=r(26198);t.exports=function(t){var e=n(this),r=o(e),a=arguments.length,s=i(a>1?arguments[1]:void 0,r),u=a>2?arguments[2]:void 0,c=void 0===u?r:i(u,r);while(c>s)e[s++]=t;return e}},84428:(t,e,r)=>{"use strict";var n=r(78227),i=n("iterator"),o=!1;try{var a=0,s={next:function(){return{done:!!a++}},return:function(){o=!0}};s[i]=function(){return this},Array.from(s,(function(){throw 2}))}catch(u){}t.exports=function(t,e){try{if(!e&&!o)return!1}catch(u){return!1}var r=!1;try{var n={};n[i]=function(){return{next:function(){return{done:r=!0}}}},t(n)}catch(u){}return r}},84606:(t,e,r)=>{"use strict";var n=r(16823),i=TypeError;t.exports=function(t,e){if(!delete t[e])throw new i("Cannot delete property "+n(e)+" of "+n(t))}},84864:(t,e,r)=>{"use strict";var n=r(43724),i=r(22195),o=r(79504),a=r(92796),s=r(23167),u=r(66699),c=r(2360),l=r(38480).f,f=r(1625),p=r(60788),h=r(655),d=r(61034),v=r(58429),g=r(11056),m=r(36840),y=r(79039),b=r(39297),w=r(91181).enforce,_=r(87633),x=r(78227),A=r(83635),k=r(18814),E=x("match"),S=i.RegExp,C=S.prototype,O=i.SyntaxError,T=o(C.exec),R=o("".charAt),D=o("".replace),I=o("".indexOf),L=o("".slice),P=/^\?<[^\s\d!#%&*+<=>@^][^\s!#%&*+<=>@^]*>/,j=/a/g,F=/a/g,M=new S(j)!==j,N=v.MISSED_STICKY,q=v.UNSUPPORTED_Y,U=n&&(!M||N||A||k||y((function(){return F[E]=!1,S(j)!==j||S(F)===F||"/a/i"!==String(S(j,"i"))}))),B=function(t){for(var e,r=t.length,n=0,i="",o=!1;n<=r;n++)e=R(t,n),"\\"!==e?o||"."!==e?("["===e?o=!0:"]"===e&&(o=!1),i+=e):i+="[\\s\\S]":i+=e+R(t,++n);return i},z=function(t){for(var e,r=t.length,n=0,i="",o=[],a=c(null),s=!1,u=!1,l=0,f="";n<=r;n++){if(e=R(t,n),"\\"===e)e+=R(t,++n);else if("]"===e)s=!1;else if(!s)switch(!0){case"["===e:s=!0;break;case"("===e:if(i+=e,"?:"===L(t,n+1,n+3))continue;T(P,L(t,n+1))&&(n+=2,u=!0),l++;continue;case">"===e&&u:if(""===f||b(a,f))throw new O("Invalid capture group name");a[f]=!0,o[o.length]=[f,l],u=!1,f="";continue}u?f+=e:i+=e}return[i,o]};if(a("RegExp",U)){for(var V=function(t,e){var r,n,i,o,a,c,l=f(C,this),v=p(t),g=void 0===e,m=[],y=t;if(!l&&v&&g&&t.constructor===V)return t;if((v||f(C,t))&&(t=t.source,g&&(e=d(y))),t=void 0===t?"":h(t),e=void 0===e?"":h(e),y=t,A&&"dotAll"in j&&(n=!!e&&I(e,"s")>-1,n&&(e=D(e,/s/g,""))),r=e,N&&"sticky"in j&&(i=!!e&&I(e,"y")>-1,i&&q&&(e=D(e,/y/g,""))),k&&(o=z(t),t=o[0],m=o[1]),a=s(S(t,e),l?this:C,V),(n||i||m.length)&&(c=w(a),n&&(c.dotAll=!0,c.raw=V(B(t),r)),i&&(c.sticky=!0),m.length&&(c.groups=m)),t!==y)try{u(a,"source",""===y?"(?:)":y)}catch(b){}return a},$=l(S),G=0;$.length>G;)g(V,S,$[G++]);C.constructor=V,V.prototype=C,m(i,"RegExp",V,{constructor:!0})}_("RegExp")},84916:(t,e,r)=>{"use strict";var n=r(97751),i=function(t){return{size:t,has:function(){return!1},keys:function(){return{next:function(){return{done:!0}}}}}},o=function(t){return{size:t,has:function(){return!0},keys:function(){throw new Error("e")}}};t.exports=function(t,e){var r=n("Set");try{(new r)[t](i(0));try{return(new r)[t](i(-1)),!1}catch(s){if(!e)return!0;try{return(new r)[t](o(-1/0)),!1}catch(u){var a=new r;return a.add(1),a.add(2),e(a[t](o(1/0)))}}}catch(u){return!1}}},85220:(t,e,r)=>{"use strict";r(62062),r(44114),r(18111),r(61701),r(26099),t.exports=function(t,e,r){var n,i,o;if(t.sCount[e]-t.blkIndent<4)return!1;i=n=e+1;while(n<r)if(t.isEmpty(n))n++;else{if(!(t.sCount[n]-t.blkIndent>=4))break;n++,i=n}return t.line=i,o=t.push("code_block","code",0),o.content=t.getLines(e,i,4+t.blkIndent,!1)+"\n",o.map=[e,t.line],!0}},85926:(t,e,r)=>{"use strict";r(44114),r(34782),r(27495),r(90906);var n=/^([a-zA-Z0-9.!#$%&'*+\/=?^_`{|}~-]+@[a-zA-Z0-9](?:[a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?(?:\.[a-zA-Z0-9](?:[a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?)*)$/,i=/^([a-zA-Z][a-zA-Z0-9+.\-]{1,31}):([^<>\x00-\x20]*)$/;t.exports=function(t,e){var r,o,a,s,u,c,l=t.pos;if(60!==t.src.charCodeAt(l))return!1;for(u=t.pos,c=t.posMax;;){if(++l>=c)return!1;if(s=t.src.charCodeAt(l),60===s)return!1;if(62===s)break}return r=t.src.slice(u+1,l),i.test(r)?(o=t.md.normalizeLink(r),!!t.md.validateLink(o)&&(e||(a=t.push("link_open","a",1),a.attrs=[["href",o]],a.markup="autolink",a.info="auto",a=t.push("text","",0),a.content=t.md.normalizeLinkText(r),a=t.push("link_close","a",-1),a.markup="autolink",a.info="auto"),t.pos+=r.length+2,!0)):!!n.test(r)&&(o=t.md.normalizeLink("mailto:"+r),!!t.md.validateLink(o)&&(e||(a=t.push("link_open","a",1),a.attrs=[["href",o]],a.markup="autolink",a.info="auto",a=t.push("text","",0),a.content=t.md.normalizeLinkText(r),a=t.push("link_close","a",-1),a.markup="autolink",a.info="auto"),t.pos+=r.length+2,!0))}},86368:(t,e,r)=>{"use strict";var n=r(46518),i=r(22195),o=r(59225).clear;n({global:!0,bind:!0,enumerable:!0,forced:i.clearImmediate!==o},{clearImmediate:o})},86565:(t,e,r)=>{"use strict";var n=r(46518),i=r(28551),o=r(42787),a=r(12211);n({target:"Reflect",stat:!0,sham:!a},{getPrototypeOf:function(t){return o(i(t))}})},86614:(t,e,r)=>{"use strict";var n=r(94644),i=r(18014),o=r(35610),a=n.aTypedArray,s=n.getTypedArrayConstructor,u=n.exportTypedArrayMethod;u("subarray",(function(t,e){var r=a(this),n=r.length,u=o(t,n),c=s(r);return new c(r.buffer,r.byteOffset+u*r.BYTES_PER_ELEMENT,i((void 0===e?n:o(e,n))-u))}))},86938:(t,e,r)=>{"use strict";var n=r(2360),i=r(62106),o=r(56279),a=r(76080),s=r(90679),u=r(64117),c=r(72652),l=r(51088),f=r(62529),p=r(87633),h=r(43724),d=r(3451).fastKey,v=r(91181),g=v.set,m=v.getterFor;t.exports={getConstructor:function(t,e,r,l){var f=t((function(t,i){s(t,p),g(t,{type:e,index:n(null),first:null,last:null,size:0}),h||(t.size=0),u(i)||c(i,t[l],{that:t,AS_ENTRIES:r})})),p=f.prototype,v=m(e),y=function(t,e,r){var n,i,o=v(t),a=b(t,e);return a?a.value=r:(o.last=a={index:i=d(e,!0),key:e,value:r,previous:n=o.last,next:null,removed:!1},o.first||(o.first=a),n&&(n.next=a),h?o.size++:t.size++,"F"!==i&&(o.index[i]=a)),t},b=function(t,e){var r,n=v(t),i=d(e);if("F"!==i)return n.index[i];for(r=n.first;r;r=r.next)if(r.key===e)return r};return o(p,{clear:function(){var t=this,e=v(t),r=e.first;while(r)r.removed=!0,r.previous&&(r.previous=r.previous.next=null),r=r.next;e.first=e.last=null,e.index=n(null),h?e.size=0:t.size=0},delete:function(t){var e=this,r=v(e),n=b(e,t);if(n){var i=n.next,o=n.previous;delete r.index[n.index],n.removed=!0,o&&(o.next=i),i&&(i.previous=o),r.first===n&&(r.first=i),r.last===n&&(r.last=o),h?r.size--:e.size--}return!!n},forEach:function(t){var e,r=v(this),n=a(t,arguments.length>1?arguments[1]:void 0);while(e=e?e.next:r.first){n(e.value,e.key,this);while(e&&e.removed)e=e.previous}},has:function(t){return!!b(this,t)}}),o(p,r?{get:function(t){var e=b(this,t);return e&&e.value},set:function(t,e){return y(this,0===t?0:t,e)}}:{add:function(t){return y(this,t=0===t?0:t,t)}}),h&&i(p,"size",{configurable:!0,get:function(){return v(this).size}}),f},setStrong:function(t,e,r){var n=e+" Iterator",i=m(e),o=m(n);l(t,e,(function(t,e){g(this,{type:n,target:t,state:i(t),kind:e,last:null})}),(function(){var t=o(this),e=t.kind,r=t.last;while(r&&r.removed)r=r.previous;return t.target&&(t.last=r=r?r.next:t.state.first)?f("keys"===e?r.key:"values"===e?r.value:[r.key,r.value],!1):(t.target=null,f(void 0,!0))}),r?"entries":"values",!r,!0),p(e)}}},87411:(t,e,r)=>{"use strict";var n=r(46518),i=r(43724),o=r(28551),a=r(56969),s=r(24913),u=r(79039),c=u((function(){Reflect.defineProperty(s.f({},1,{value:1}),1,{value:2})}));n({target:"Reflect",stat:!0,forced:c,sham:!i},{defineProperty:function(t,e,r){o(t);var n=a(e);o(r);try{return s.f(t,n,r),!0}catch(i){return!1}}})},87433:(t,e,r)=>{"use strict";var n=r(34376),i=r(33517),o=r(20034),a=r(78227),s=a("species"),u=Array;t.exports=function(t){var e;return n(t)&&(e=t.constructor,i(e)&&(e===u||n(e.prototype))?e=void 0:o(e)&&(e=e[s],null===e&&(e=void 0))),void 0===e?u:e}},87633:(t,e,r)=>{"use strict";var n=r(97751),i=r(62106),o=r(78227),a=r(43724),s=o("species");t.exports=function(t){var e=n(t);a&&e&&!e[s]&&i(e,s,{configurable:!0,get:function(){return this}})}},87724:t=>{t.exports=/[\xAD\u0600-\u0605\u061C\u06DD\u070F\u08E2\u180E\u200B-\u200F\u202A-\u202E\u2060-\u2064\u2066-\u206F\uFEFF\uFFF9-\uFFFB]|\uD804[\uDCBD\uDCCD]|\uD82F[\uDCA0-\uDCA3]|\uD834[\uDD73-\uDD7A]|\uDB40[\uDC01\uDC20-\uDC7F]/},87745:(t,e,r)=>{"use strict";var n=r(43724),i=r(58429).MISSED_STICKY,o=r(44576),a=r(62106),s=r(91181).get,u=RegExp.prototype,c=TypeError;n&&i&&a(u,"sticky",{configurable:!0,get:function(){if(this!==u){if("RegExp"===o(this))return!!s(this).sticky;throw new c("Incompatible receiver, RegExp required")}}})},87746:t=>{t.exports=/[\0-\uD7FF\uE000-\uFFFF]|[\uD800-\uDBFF][\uDC00-\uDFFF]|[\uD800-\uDBFF](?![\uDC00-\uDFFF])|(?:[^\uD800-\uDBFF]|^)[\uDC00-\uDFFF]/},87782:(t,e,r)=>{"use strict";r(27495),r(90906),r(25440);var n=/\+-|\.\.|\?\?\?\?|!!!!|,,|--/,i=/\((c|tm|r|p)\)/i,o=/\((c|tm|r|p)\)/gi,a={c:"©",r:"®",p:"§",tm:"™"};function s(t,e){return a[e.toLowerCase()]}function u(t){var e,r,n=0;for(e=t.length-1;e>=0;e--)r=t[e],"text"!==r.type||n||(r.content=r.content.replace(o,s)),"link_open"===r.type&&"auto"===r.info&&n--,"link_close"===r.type&&"auto"===r.info&&n++}function c(t){var e,r,i=0;for(e=t.length-1;e>=0;e--)r=t[e],"text"!==r.type||i||n.test(r.content)&&(r.content=r.content.replace(/\+-/g,"±").replace(/\.{2,}/g,"…").replace(/([?!])…/g,"$1..").replace(/([?!]){4,}/g,"$1$1$1").replace(/,{2,}/g,",").replace(/(^|[^-])---(?=[^-]|$)/gm,"$1—").replace(/(^|\s)--(?=\s|$)/gm,"$1–").replace(/(^|[^-\s])--(?=[^-\s]|$)/gm,"$1–")),"link_open"===r.type&&"auto"===r.info&&i--,"link_close"===r.type&&"auto"===r.info&&i++}t.exports=function(t){var e;if(t.md.options.typographer)for(e=t.tokens.length-1;e>=0;e--)"inline"===t.tokens[e].type&&(i.test(t.tokens[e].content)&&u(t.tokens[e].children),n.test(t.tokens[e].content)&&c(t.tokens[e].children))}},88090:(t,e,r)=>{"use strict";r(48598),r(44114),r(34782);var n=r(31926),i=r(8700).isSpace;function o(t,e,r,n){var o,a,s,u,c,l,f,p;for(this.src=t,this.md=e,this.env=r,this.tokens=n,this.bMarks=[],this.eMarks=[],this.tShift=[],this.sCount=[],this.bsCount=[],this.blkIndent=0,this.line=0,this.lineMax=0,this.tight=!1,this.ddIndent=-1,this.listIndent=-1,this.parentType="root",this.level=0,this.result="",a=this.src,p=!1,s=u=l=f=0,c=a.length;u<c;u++){if(o=a.charCodeAt(u),!p){if(i(o)){l++,9===o?f+=4-f%4:f++;continue}p=!0}10!==o&&u!==c-1||(10!==o&&u++,this.bMarks.push(s),this.eMarks.push(u),this.tShift.push(l),this.sCount.push(f),this.bsCount.push(0),p=!1,l=0,f=0,s=u+1)}this.bMarks.push(a.length),this.eMarks.push(a.length),this.tShift.push(0),this.sCount.push(0),this.bsCount.push(0),this.lineMax=this.bMarks.length-1}o.prototype.push=function(t,e,r){var i=new n(t,e,r);return i.block=!0,r<0&&this.level--,i.level=this.level,r>0&&this.level++,this.tokens.push(i),i},o.prototype.isEmpty=function(t){return this.bMarks[t]+this.tShift[t]>=this.eMarks[t]},o.prototype.skipEmptyLines=function(t){for(var e=this.lineMax;t<e;t++)if(this.bMarks[t]+this.tShift[t]<this.eMarks[t])break;return t},o.prototype.skipSpaces=function(t){for(var e,r=this.src.length;t<r;t++)if(e=this.src.charCodeAt(t),!i(e))break;return t},o.prototype.skipSpacesBack=function(t,e){if(t<=e)return t;while(t>e)if(!i(this.src.charCodeAt(--t)))return t+1;return t},o.prototype.skipChars=function(t,e){for(var r=this.src.length;t<r;t++)if(this.src.charCodeAt(t)!==e)break;return t},o.prototype.skipCharsBack=function(t,e,r){if(t<=r)return t;while(t>r)if(e!==this.src.charCodeAt(--t))return t+1;return t},o.prototype.getLines=function(t,e,r,n){var o,a,s,u,c,l,f,p=t;if(t>=e)return"";for(l=new Array(e-t),o=0;p<e;p++,o++){a=0,f=u=this.bMarks[p],c=p+1<e||n?this.eMarks[p]+1:this.eMarks[p];while(u<c&&a<r){if(s=this.src.charCodeAt(u),i(s))9===s?a+=4-(a+this.bsCount[p])%4:a++;else{if(!(u-f<this.tShift[p]))break;a++}u++}l[o]=a>r?new Array(a-r+1).join(" ")+this.src.slice(u,c):this.src.slice(u,c)}return l.join("")},o.prototype.Token=n,t.exports=o},88267:(t,e,r)=>{"use strict";var n=r(46518),i=r(79504),o=r(655),a=String.fromCharCode,s=i("".charAt),u=i(/./.exec),c=i("".slice),l=/^[\da-f]{2}$/i,f=/^[\da-f]{4}$/i;n({global:!0},{unescape:function(t){var e,r,n=o(t),i="",p=n.length,h=0;while(h<p){if(e=s(n,h++),"%"===e)if("u"===s(n,h)){if(r=c(n,h+1,h+5),u(f,r)){i+=a(parseInt(r,16)),h+=5;continue}}else if(r=c(n,h,h+2),u(l,r)){i+=a(parseInt(r,16)),h+=2;continue}i+=e}return i}})},88431:(t,e,r)=>{"use strict";var n=r(46518),i=r(59213).every,o=r(34598),a=o("every");n({target:"Array",proto:!0,forced:!a},{every:function(t){return i(this,t,arguments.length>1?arguments[1]:void 0)}})},88490:t=>{"use strict";var e=Array,r=Math.abs,n=Math.pow,i=Math.floor,o=Math.log,a=Math.LN2,s=function(t,s,u){var c,l,f,p=e(u),h=8*u-s-1,d=(1<<h)-1,v=d>>1,g=23===s?n(2,-24)-n(2,-77):0,m=t<0||0===t&&1/t<0?1:0,y=0;t=r(t),t!==t||t===1/0?(l=t!==t?1:0,c=d):(c=i(o(t)/a),f=n(2,-c),t*f<1&&(c--,f*=2),t+=c+v>=1?g/f:g*n(2,1-v),t*f>=2&&(c++,f/=2),c+v>=d?(l=0,c=d):c+v>=1?(l=(t*f-1)*n(2,s),c+=v):(l=t*n(2,v-1)*n(2,s),c=0));while(s>=8)p[y++]=255&l,l/=256,s-=8;c=c<<s|l,h+=s;while(h>0)p[y++]=255&c,c/=256,h-=8;return p[y-1]|=128*m,p},u=function(t,e){var r,i=t.length,o=8*i-e-1,a=(1<<o)-1,s=a>>1,u=o-7,c=i-1,l=t[c--],f=127&l;l>>=7;while(u>0)f=256*f+t[c--],u-=8;r=f&(1<<-u)-1,f>>=-u,u+=e;while(u>0)r=256*r+t[c--],u-=8;if(0===f)f=1-s;else{if(f===a)return r?NaN:l?-1/0:1/0;r+=n(2,e),f-=s}return(l?-1:1)*r*n(2,f-e)};t.exports={pack:s,unpack:u}},88676:(t,e,r)=>{"use strict";r(25276),r(48598),r(44114),r(34782),r(27495),r(90744),r(42762);var n=r(8700).assign,i=r(8700).unescapeAll,o=r(8700).escapeHtml,a={};function s(){this.rules=n({},a)}a.code_inline=function(t,e,r,n,i){var a=t[e];return"<code"+i.renderAttrs(a)+">"+o(t[e].content)+"</code>"},a.code_block=function(t,e,r,n,i){var a=t[e];return"<pre"+i.renderAttrs(a)+"><code>"+o(t[e].content)+"</code></pre>\n"},a.fence=function(t,e,r,n,a){var s,u,c,l,f,p=t[e],h=p.info?i(p.info).trim():"",d="",v="";return h&&(c=h.split(/(\s+)/g),d=c[0],v=c.slice(2).join("")),s=r.highlight&&r.highlight(p.content,d,v)||o(p.content),0===s.indexOf("<pre")?s+"\n":h?(u=p.attrIndex("class"),l=p.attrs?p.attrs.slice():[],u<0?l.push(["class",r.langPrefix+d]):(l[u]=l[u].slice(),l[u][1]+=" "+r.langPrefix+d),f={attrs:l},"<pre><code"+a.renderAttrs(f)+">"+s+"</code></pre>\n"):"<pre><code"+a.renderAttrs(p)+">"+s+"</code></pre>\n"},a.image=function(t,e,r,n,i){var o=t[e];return o.attrs[o.attrIndex("alt")][1]=i.renderInlineAsText(o.children,r,n),i.renderToken(t,e,r)},a.hardbreak=function(t,e,r){return r.xhtmlOut?"<br />\n":"<br>\n"},a.softbreak=function(t,e,r){return r.breaks?r.xhtmlOut?"<br />\n":"<br>\n":"\n"},a.text=function(t,e){return o(t[e].content)},a.html_block=function(t,e){return t[e].content},a.html_inline=function(t,e){return t[e].content},s.prototype.renderAttrs=function(t){var e,r,n;if(!t.attrs)return"";for(n="",e=0,r=t.attrs.length;e<r;e++)n+=" "+o(t.attrs[e][0])+'="'+o(t.attrs[e][1])+'"';return n},s.prototype.renderToken=function(t,e,r){var n,i="",o=!1,a=t[e];return a.hidden?"":(a.block&&-1!==a.nesting&&e&&t[e-1].hidden&&(i+="\n"),i+=(-1===a.nesting?"</":"<")+a.tag,i+=this.renderAttrs(a),0===a.nesting&&r.xhtmlOut&&(i+=" /"),a.block&&(o=!0,1===a.nesting&&e+1<t.length&&(n=t[e+1],("inline"===n.type||n.hidden||-1===n.nesting&&n.tag===a.tag)&&(o=!1))),i+=o?">\n":">",i)},s.prototype.renderInline=function(t,e,r){for(var n,i="",o=this.rules,a=0,s=t.length;a<s;a++)n=t[a].type,"undefined"!==typeof o[n]?i+=o[n](t,a,e,r,this):i+=this.renderToken(t,a,e);return i},s.prototype.renderInlineAsText=function(t,e,r){for(var n="",i=0,o=t.length;i<o;i++)"text"===t[i].type?n+=t[i].content:"image"===t[i].type?n+=this.renderInlineAsText(t[i].children,e,r):"softbreak"===t[i].type&&(n+="\n");return n},s.prototype.render=function(t,e,r){var n,i,o,a="",s=this.rules;for(n=0,i=t.length;n<i;n++)o=t[n].type,"inline"===o?a+=this.renderInline(t[n].children,e,r):"undefined"!==typeof s[o]?a+=s[t[n].type](t,n,e,r,this):a+=this.renderToken(t,n,e,r);return a},t.exports=s},88727:t=>{"use strict";t.exports=["constructor","hasOwnProperty","isPrototypeOf","propertyIsEnumerable","toLocaleString","toString","valueOf"]},88747:(t,e,r)=>{"use strict";var n=r(94644),i=n.aTypedArray,o=n.exportTypedArrayMethod,a=Math.floor;o("reverse",(function(){var t,e=this,r=i(e).length,n=a(r/2),o=0;while(o<n)t=e[o],e[o++]=e[--r],e[r]=t;return e}))},89228:(t,e,r)=>{"use strict";r(27495);var n=r(69565),i=r(36840),o=r(57323),a=r(79039),s=r(78227),u=r(66699),c=s("species"),l=RegExp.prototype;t.exports=function(t,e,r,f){var p=s(t),h=!a((function(){var e={};return e[p]=function(){return 7},7!==""[t](e)})),d=h&&!a((function(){var e=!1,r=/a/;return"split"===t&&(r={},r.constructor={},r.constructor[c]=function(){return r},r.flags="",r[p]=/./[p]),r.exec=function(){return e=!0,null},r[p](""),!e}));if(!h||!d||r){var v=/./[p],g=e(p,""[t],(function(t,e,r,i,a){var s=e.exec;return s===o||s===l.exec?h&&!a?{done:!0,value:n(v,e,r,i)}:{done:!0,value:n(t,r,e,i)}:{done:!1}}));i(String.prototype,t,g[0]),i(l,p,g[1])}f&&u(l[p],"sham",!0)}},89286:(t,e,r)=>{"use strict";var n=r(94402),i=r(38469),o=n.Set,a=n.add;t.exports=function(t){var e=new o;return i(t,(function(t){a(e,t)})),e}},89429:(t,e,r)=>{"use strict";var n=r(22195),i=r(16193);t.exports=function(t){if(i){try{return n.process.getBuiltinModule(t)}catch(e){}try{return Function('return require("'+t+'")')()}catch(e){}}}},89463:(t,e,r)=>{"use strict";var n=r(46518),i=r(43724),o=r(22195),a=r(79504),s=r(39297),u=r(94901),c=r(1625),l=r(655),f=r(62106),p=r(77740),h=o.Symbol,d=h&&h.prototype;if(i&&u(h)&&(!("description"in d)||void 0!==h().description)){var v={},g=function(){var t=arguments.length<1||void 0===arguments[0]?void 0:l(arguments[0]),e=c(d,this)?new h(t):void 0===t?h():h(t);return""===t&&(v[e]=!0),e};p(g,h),g.prototype=d,d.constructor=g;var m="Symbol(description detection)"===String(h("description detection")),y=a(d.valueOf),b=a(d.toString),w=/^Symbol\((.*)\)[^)]+$/,_=a("".replace),x=a("".slice);f(d,"description",{configurable:!0,get:function(){var t=y(this);if(s(v,t))return"";var e=b(t),r=m?x(e,7,-1):_(e,w,"$1");return""===r?void 0:r}}),n({global:!0,constructor:!0,forced:!0},{Symbol:g})}},89544:(t,e,r)=>{"use strict";var n=r(82839);t.exports=/(?:ipad|iphone|ipod).*applewebkit/i.test(n)},89572:(t,e,r)=>{"use strict";var n=r(39297),i=r(36840),o=r(53640),a=r(78227),s=a("toPrimitive"),u=Date.prototype;n(u,s)||i(u,s,o)},89907:(t,e,r)=>{"use strict";var n=r(46518),i=r(77240),o=r(23061);n({target:"String",proto:!0,forced:o("anchor")},{anchor:function(t){return i(this,"a","name",t)}})},89955:(t,e,r)=>{"use strict";var n=r(94644),i=r(59213).findIndex,o=n.aTypedArray,a=n.exportTypedArrayMethod;a("findIndex",(function(t){return i(o(this),t,arguments.length>1?arguments[1]:void 0)}))},90144:(t,e,r)=>{"use strict";r.d(e,{C4:()=>L,EW:()=>Ht,Gc:()=>St,IG:()=>Pt,IJ:()=>qt,KR:()=>Nt,Kh:()=>Et,Pr:()=>$t,R1:()=>zt,X2:()=>w,bl:()=>P,fE:()=>Dt,g8:()=>Tt,hV:()=>Xt,hZ:()=>G,i9:()=>Mt,ju:()=>It,lJ:()=>jt,qA:()=>W,u4:()=>$,uY:()=>v,ux:()=>Lt,wB:()=>Jt,yC:()=>d});var n,i,o=r(3604),a=r(29990),s=r(19129),u=r(23772),c=r(74721),l=r(25026),f=r(69492),p=(r(52675),r(89463),r(2259),r(28706),r(33771),r(2008),r(51629),r(48598),r(62062),r(44114),r(15086),r(26910),r(9678),r(57145),r(71658),r(94170),r(18111),r(22489),r(7588),r(61701),r(13579),r(36033),r(2892),r(1480),r(40875),r(94052),r(26099),r(21211),r(40888),r(86565),r(32812),r(71137),r(34268),r(69479),r(31415),r(17642),r(58004),r(33853),r(45876),r(32475),r(15024),r(31698),r(47764),r(48718),r(73772),r(30958),r(23500),r(62953),r(24232));var h,d=function(){function t(){var e=arguments.length>0&&void 0!==arguments[0]&&arguments[0];(0,l.A)(this,t),this.detached=e,this._active=!0,this.effects=[],this.cleanups=[],this._isPaused=!1,this.parent=i,!e&&i&&(this.index=(i.scopes||(i.scopes=[])).push(this)-1)}return(0,f.A)(t,[{key:"active",get:function(){return this._active}},{key:"pause",value:function(){if(this._active){var t,e;if(this._isPaused=!0,this.scopes)for(t=0,e=this.scopes.length;t<e;t++)this.scopes[t].pause();for(t=0,e=this.effects.length;t<e;t++)this.effects[t].pause()}}},{key:"resume",value:function(){if(this._active&&this._isPaused){var t,e;if(this._isPaused=!1,this.scopes)for(t=0,e=this.scopes.length;t<e;t++)this.scopes[t].resume();for(t=0,e=this.effects.length;t<e;t++)this.effects[t].resume()}}},{key:"run",value:function(t){if(this._active){var e=i;try{return i=this,t()}finally{i=e}}else 0}},{key:"on",value:function(){i=this}},{key:"off",value:function(){i=this.parent}},{key:"stop",value:function(t){if(this._active){var e,r;for(this._active=!1,e=0,r=this.effects.length;e<r;e++)this.effects[e].stop();for(this.effects.length=0,e=0,r=this.cleanups.length;e<r;e++)this.cleanups[e]();if(this.cleanups.length=0,this.scopes){for(e=0,r=this.scopes.length;e<r;e++)this.scopes[e].stop(!0);this.scopes.length=0}if(!this.detached&&this.parent&&!t){var n=this.parent.scopes.pop();n&&n!==this&&(this.parent.scopes[this.index]=n,n.index=this.index)}this.parent=void 0}}}])}();function v(t){return new d(t)}function g(){return i}var m,y,b=new WeakSet,w=function(){function t(e){(0,l.A)(this,t),this.fn=e,this.deps=void 0,this.depsTail=void 0,this.flags=5,this.next=void 0,this.cleanup=void 0,this.scheduler=void 0,i&&i.active&&i.effects.push(this)}return(0,f.A)(t,[{key:"pause",value:function(){this.flags|=64}},{key:"resume",value:function(){64&this.flags&&(this.flags&=-65,b.has(this)&&(b["delete"](this),this.trigger()))}},{key:"notify",value:function(){2&this.flags&&!(32&this.flags)||8&this.flags||x(this)}},{key:"run",value:function(){if(!(1&this.flags))return this.fn();this.flags|=2,j(this),E(this);var t=h,e=D;h=this,D=!0;try{return this.fn()}finally{0,S(this),h=t,D=e,this.flags&=-3}}},{key:"stop",value:function(){if(1&this.flags){for(var t=this.deps;t;t=t.nextDep)T(t);this.deps=this.depsTail=void 0,j(this),this.onStop&&this.onStop(),this.flags&=-2}}},{key:"trigger",value:function(){64&this.flags?b.add(this):this.scheduler?this.scheduler():this.runIfDirty()}},{key:"runIfDirty",value:function(){C(this)&&this.run()}},{key:"dirty",get:function(){return C(this)}}])}(),_=0;function x(t){var e=arguments.length>1&&void 0!==arguments[1]&&arguments[1];if(t.flags|=8,e)return t.next=y,void(y=t);t.next=m,m=t}function A(){_++}function k(){if(!(--_>0)){if(y){var t=y;y=void 0;while(t){var e=t.next;t.next=void 0,t.flags&=-9,t=e}}var r;while(m){var n=m;m=void 0;while(n){var i=n.next;if(n.next=void 0,n.flags&=-9,1&n.flags)try{n.trigger()}catch(o){r||(r=o)}n=i}}if(r)throw r}}function E(t){for(var e=t.deps;e;e=e.nextDep)e.version=-1,e.prevActiveLink=e.dep.activeLink,e.dep.activeLink=e}function S(t){var e,r=t.depsTail,n=r;while(n){var i=n.prevDep;-1===n.version?(n===r&&(r=i),T(n),R(n)):e=n,n.dep.activeLink=n.prevActiveLink,n.prevActiveLink=void 0,n=i}t.deps=e,t.depsTail=r}function C(t){for(var e=t.deps;e;e=e.nextDep)if(e.dep.version!==e.version||e.dep.computed&&(O(e.dep.computed)||e.dep.version!==e.version))return!0;return!!t._dirty}function O(t){if((!(4&t.flags)||16&t.flags)&&(t.flags&=-17,t.globalVersion!==F)){t.globalVersion=F;var e=t.dep;if(t.flags|=2,e.version>0&&!t.isSSR&&t.deps&&!C(t))t.flags&=-3;else{var r=h,n=D;h=t,D=!0;try{E(t);var i=t.fn(t._value);(0===e.version||(0,p.$H)(i,t._value))&&(t._value=i,e.version++)}catch(o){throw e.version++,o}finally{h=r,D=n,S(t),t.flags&=-3}}}}function T(t){var e=arguments.length>1&&void 0!==arguments[1]&&arguments[1],r=t.dep,n=t.prevSub,i=t.nextSub;if(n&&(n.nextSub=i,t.prevSub=void 0),i&&(i.prevSub=n,t.nextSub=void 0),r.subs===t&&(r.subs=n,!n&&r.computed)){r.computed.flags&=-5;for(var o=r.computed.deps;o;o=o.nextDep)T(o,!0)}e||--r.sc||!r.map||r.map["delete"](r.key)}function R(t){var e=t.prevDep,r=t.nextDep;e&&(e.nextDep=r,t.prevDep=void 0),r&&(r.prevDep=e,t.nextDep=void 0)}var D=!0,I=[];function L(){I.push(D),D=!1}function P(){var t=I.pop();D=void 0===t||t}function j(t){var e=t.cleanup;if(t.cleanup=void 0,e){var r=h;h=void 0;try{e()}finally{h=r}}}var F=0,M=(0,f.A)((function t(e,r){(0,l.A)(this,t),this.sub=e,this.dep=r,this.version=r.version,this.nextDep=this.prevDep=this.nextSub=this.prevSub=this.prevActiveLink=void 0})),N=function(){function t(e){(0,l.A)(this,t),this.computed=e,this.version=0,this.activeLink=void 0,this.subs=void 0,this.map=void 0,this.key=void 0,this.sc=0}return(0,f.A)(t,[{key:"track",value:function(t){if(h&&D&&h!==this.computed){var e=this.activeLink;if(void 0===e||e.sub!==h)e=this.activeLink=new M(h,this),h.deps?(e.prevDep=h.depsTail,h.depsTail.nextDep=e,h.depsTail=e):h.deps=h.depsTail=e,q(e);else if(-1===e.version&&(e.version=this.version,e.nextDep)){var r=e.nextDep;r.prevDep=e.prevDep,e.prevDep&&(e.prevDep.nextDep=r),e.prevDep=h.depsTail,e.nextDep=void 0,h.depsTail.nextDep=e,h.depsTail=e,h.deps===e&&(h.deps=r)}return e}}},{key:"trigger",value:function(t){this.version++,F++,this.notify(t)}},{key:"notify",value:function(t){A();try{for(var e=this.subs;e;e=e.prevSub)e.sub.notify()&&e.sub.dep.notify()}finally{k()}}}])}();function q(t){if(t.dep.sc++,4&t.sub.flags){var e=t.dep.computed;if(e&&!t.dep.subs){e.flags|=20;for(var r=e.deps;r;r=r.nextDep)q(r)}var n=t.dep.subs;n!==t&&(t.prevSub=n,n&&(n.nextSub=t)),t.dep.subs=t}}var U=new WeakMap,B=Symbol(""),z=Symbol(""),V=Symbol("");function $(t,e,r){if(D&&h){var n=U.get(t);n||U.set(t,n=new Map);var i=n.get(r);i||(n.set(r,i=new N),i.map=n,i.key=r),i.track()}}function G(t,e,r,n,i,o){var a=U.get(t);if(a){var s=function(t){t&&t.trigger()};if(A(),"clear"===e)a.forEach(s);else{var u=(0,p.cy)(t),c=u&&(0,p.yI)(r);if(u&&"length"===r){var l=Number(n);a.forEach((function(t,e){("length"===e||e===V||!(0,p.Bm)(e)&&e>=l)&&s(t)}))}else switch((void 0!==r||a.has(void 0))&&s(a.get(r)),c&&s(a.get(V)),e){case"add":u?c&&s(a.get("length")):(s(a.get(B)),(0,p.CE)(t)&&s(a.get(z)));break;case"delete":u||(s(a.get(B)),(0,p.CE)(t)&&s(a.get(z)));break;case"set":(0,p.CE)(t)&&s(a.get(B));break}}k()}else F++}function H(t){var e=Lt(t);return e===t?e:($(e,"iterate",V),Dt(t)?e:e.map(jt))}function W(t){return $(t=Lt(t),"iterate",V),t}var Z=(n={__proto__:null},(0,u.A)((0,u.A)((0,u.A)((0,u.A)((0,u.A)((0,u.A)((0,u.A)((0,u.A)((0,u.A)((0,u.A)(n,Symbol.iterator,(function(){return K(this,Symbol.iterator,jt)})),"concat",(function(){for(var t,e=arguments.length,r=new Array(e),n=0;n<e;n++)r[n]=arguments[n];return(t=H(this)).concat.apply(t,(0,c.A)(r.map((function(t){return(0,p.cy)(t)?H(t):t}))))})),"entries",(function(){return K(this,"entries",(function(t){return t[1]=jt(t[1]),t}))})),"every",(function(t,e){return J(this,"every",t,e,void 0,arguments)})),"filter",(function(t,e){return J(this,"filter",t,e,(function(t){return t.map(jt)}),arguments)})),"find",(function(t,e){return J(this,"find",t,e,jt,arguments)})),"findIndex",(function(t,e){return J(this,"findIndex",t,e,void 0,arguments)})),"findLast",(function(t,e){return J(this,"findLast",t,e,jt,arguments)})),"findLastIndex",(function(t,e){return J(this,"findLastIndex",t,e,void 0,arguments)})),"forEach",(function(t,e){return J(this,"forEach",t,e,void 0,arguments)})),(0,u.A)((0,u.A)((0,u.A)((0,u.A)((0,u.A)((0,u.A)((0,u.A)((0,u.A)((0,u.A)((0,u.A)(n,"includes",(function(){for(var t=arguments.length,e=new Array(t),r=0;r<t;r++)e[r]=arguments[r];return Q(this,"includes",e)})),"indexOf",(function(){for(var t=arguments.length,e=new Array(t),r=0;r<t;r++)e[r]=arguments[r];return Q(this,"indexOf",e)})),"join",(function(t){return H(this).join(t)})),"lastIndexOf",(function(){for(var t=arguments.length,e=new Array(t),r=0;r<t;r++)e[r]=arguments[r];return Q(this,"lastIndexOf",e)})),"map",(function(t,e){return J(this,"map",t,e,void 0,arguments)})),"pop",(function(){return tt(this,"pop")})),"push",(function(){for(var t=arguments.length,e=new Array(t),r=0;r<t;r++)e[r]=arguments[r];return tt(this,"push",e)})),"reduce",(function(t){for(var e=arguments.length,r=new Array(e>1?e-1:0),n=1;n<e;n++)r[n-1]=arguments[n];return X(this,"reduce",t,r)})),"reduceRight",(function(t){for(var e=arguments.length,r=new Array(e>1?e-1:0),n=1;n<e;n++)r[n-1]=arguments[n];return X(this,"reduceRight",t,r)})),"shift",(function(){return tt(this,"shift")})),(0,u.A)((0,u.A)((0,u.A)((0,u.A)((0,u.A)((0,u.A)((0,u.A)(n,"some",(function(t,e){return J(this,"some",t,e,void 0,arguments)})),"splice",(function(){for(var t=arguments.length,e=new Array(t),r=0;r<t;r++)e[r]=arguments[r];return tt(this,"splice",e)})),"toReversed",(function(){return H(this).toReversed()})),"toSorted",(function(t){return H(this).toSorted(t)})),"toSpliced",(function(){var t;return(t=H(this)).toSpliced.apply(t,arguments)})),"unshift",(function(){for(var t=arguments.length,e=new Array(t),r=0;r<t;r++)e[r]=arguments[r];return tt(this,"unshift",e)})),"values",(function(){return K(this,"values",jt)})));function K(t,e,r){var n=W(t),i=n[e]();return n===t||Dt(t)||(i._next=i.next,i.next=function(){var t=i._next();return t.value&&(t.value=r(t.value)),t}),i}var Y=Array.prototype;function J(t,e,r,n,i,o){var a=W(t),s=a!==t&&!Dt(t),u=a[e];if(u!==Y[e]){var c=u.apply(t,o);return s?jt(c):c}var l=r;a!==t&&(s?l=function(e,n){return r.call(this,jt(e),n,t)}:r.length>2&&(l=function(e,n){return r.call(this,e,n,t)}));var f=u.call(a,l,n);return s&&i?i(f):f}function X(t,e,r,n){var i=W(t),o=r;return i!==t&&(Dt(t)?r.length>3&&(o=function(e,n,i){return r.call(this,e,n,i,t)}):o=function(e,n,i){return r.call(this,e,jt(n),i,t)}),i[e].apply(i,[o].concat((0,c.A)(n)))}function Q(t,e,r){var n=Lt(t);$(n,"iterate",V);var i=n[e].apply(n,(0,c.A)(r));return-1!==i&&!1!==i||!It(r[0])?i:(r[0]=Lt(r[0]),n[e].apply(n,(0,c.A)(r)))}function tt(t,e){var r=arguments.length>2&&void 0!==arguments[2]?arguments[2]:[];L(),A();var n=Lt(t)[e].apply(t,r);return k(),P(),n}var et=(0,p.pD)("__proto__,__v_isRef,__isVue"),rt=new Set(Object.getOwnPropertyNames(Symbol).filter((function(t){return"arguments"!==t&&"caller"!==t})).map((function(t){return Symbol[t]})).filter(p.Bm));function nt(t){(0,p.Bm)(t)||(t=String(t));var e=Lt(this);return $(e,"has",t),e.hasOwnProperty(t)}var it=function(){function t(){var e=arguments.length>0&&void 0!==arguments[0]&&arguments[0],r=arguments.length>1&&void 0!==arguments[1]&&arguments[1];(0,l.A)(this,t),this._isReadonly=e,this._isShallow=r}return(0,f.A)(t,[{key:"get",value:function(t,e,r){if("__v_skip"===e)return t["__v_skip"];var n=this._isReadonly,i=this._isShallow;if("__v_isReactive"===e)return!n;if("__v_isReadonly"===e)return n;if("__v_isShallow"===e)return i;if("__v_raw"===e)return r===(n?i?xt:_t:i?wt:bt).get(t)||Object.getPrototypeOf(t)===Object.getPrototypeOf(r)?t:void 0;var o=(0,p.cy)(t);if(!n){var a;if(o&&(a=Z[e]))return a;if("hasOwnProperty"===e)return nt}var s=Reflect.get(t,e,Mt(t)?t:r);return((0,p.Bm)(e)?rt.has(e):et(e))?s:(n||$(t,"get",e),i?s:Mt(s)?o&&(0,p.yI)(e)?s:s.value:(0,p.Gv)(s)?n?Ct(s):Et(s):s)}}])}(),ot=function(t){function e(){var t=arguments.length>0&&void 0!==arguments[0]&&arguments[0];return(0,l.A)(this,e),(0,a.A)(this,e,[!1,t])}return(0,s.A)(e,t),(0,f.A)(e,[{key:"set",value:function(t,e,r,n){var i=t[e];if(!this._isShallow){var o=Rt(i);if(Dt(r)||Rt(r)||(i=Lt(i),r=Lt(r)),!(0,p.cy)(t)&&Mt(i)&&!Mt(r))return!o&&(i.value=r,!0)}var a=(0,p.cy)(t)&&(0,p.yI)(e)?Number(e)<t.length:(0,p.$3)(t,e),s=Reflect.set(t,e,r,Mt(t)?t:n);return t===Lt(n)&&(a?(0,p.$H)(r,i)&&G(t,"set",e,r,i):G(t,"add",e,r)),s}},{key:"deleteProperty",value:function(t,e){var r=(0,p.$3)(t,e),n=t[e],i=Reflect.deleteProperty(t,e);return i&&r&&G(t,"delete",e,void 0,n),i}},{key:"has",value:function(t,e){var r=Reflect.has(t,e);return(0,p.Bm)(e)&&rt.has(e)||$(t,"has",e),r}},{key:"ownKeys",value:function(t){return $(t,"iterate",(0,p.cy)(t)?"length":B),Reflect.ownKeys(t)}}])}(it),at=function(t){function e(){var t=arguments.length>0&&void 0!==arguments[0]&&arguments[0];return(0,l.A)(this,e),(0,a.A)(this,e,[!0,t])}return(0,s.A)(e,t),(0,f.A)(e,[{key:"set",value:function(t,e){return!0}},{key:"deleteProperty",value:function(t,e){return!0}}])}(it),st=new ot,ut=new at,ct=new ot(!0),lt=function(t){return t},ft=function(t){return Reflect.getPrototypeOf(t)};function pt(t,e,r){return function(){var n=this["__v_raw"],i=Lt(n),o=(0,p.CE)(i),a="entries"===t||t===Symbol.iterator&&o,s="keys"===t&&o,c=n[t].apply(n,arguments),l=r?lt:e?Ft:jt;return!e&&$(i,"iterate",s?z:B),(0,u.A)({next:function(){var t=c.next(),e=t.value,r=t.done;return r?{value:e,done:r}:{value:a?[l(e[0]),l(e[1])]:l(e),done:r}}},Symbol.iterator,(function(){return this}))}}function ht(t){return function(){return"delete"!==t&&("clear"===t?void 0:this)}}function dt(t,e){var r={get:function(r){var n=this["__v_raw"],i=Lt(n),o=Lt(r);t||((0,p.$H)(r,o)&&$(i,"get",r),$(i,"get",o));var a=ft(i),s=a.has,u=e?lt:t?Ft:jt;return s.call(i,r)?u(n.get(r)):s.call(i,o)?u(n.get(o)):void(n!==i&&n.get(r))},get size(){var e=this["__v_raw"];return!t&&$(Lt(e),"iterate",B),Reflect.get(e,"size",e)},has:function(e){var r=this["__v_raw"],n=Lt(r),i=Lt(e);return t||((0,p.$H)(e,i)&&$(n,"has",e),$(n,"has",i)),e===i?r.has(e):r.has(e)||r.has(i)},forEach:function(r,n){var i=this,o=i["__v_raw"],a=Lt(o),s=e?lt:t?Ft:jt;return!t&&$(a,"iterate",B),o.forEach((function(t,e){return r.call(n,s(t),s(e),i)}))}};(0,p.X$)(r,t?{add:ht("add"),set:ht("set"),delete:ht("delete"),clear:ht("clear")}:{add:function(t){e||Dt(t)||Rt(t)||(t=Lt(t));var r=Lt(this),n=ft(r),i=n.has.call(r,t);return i||(r.add(t),G(r,"add",t,t)),this},set:function(t,r){e||Dt(r)||Rt(r)||(r=Lt(r));var n=Lt(this),i=ft(n),o=i.has,a=i.get,s=o.call(n,t);s||(t=Lt(t),s=o.call(n,t));var u=a.call(n,t);return n.set(t,r),s?(0,p.$H)(r,u)&&G(n,"set",t,r,u):G(n,"add",t,r),this},delete:function(t){var e=Lt(this),r=ft(e),n=r.has,i=r.get,o=n.call(e,t);o||(t=Lt(t),o=n.call(e,t));var a=i?i.call(e,t):void 0,s=e["delete"](t);return o&&G(e,"delete",t,void 0,a),s},clear:function(){var t=Lt(this),e=0!==t.size,r=void 0,n=t.clear();return e&&G(t,"clear",void 0,void 0,r),n}});var n=["keys","values","entries",Symbol.iterator];return n.forEach((function(n){r[n]=pt(n,t,e)})),r}function vt(t,e){var r=dt(t,e);return function(e,n,i){return"__v_isReactive"===n?!t:"__v_isReadonly"===n?t:"__v_raw"===n?e:Reflect.get((0,p.$3)(r,n)&&n in e?r:e,n,i)}}var gt={get:vt(!1,!1)},mt={get:vt(!1,!0)},yt={get:vt(!0,!1)};var bt=new WeakMap,wt=new WeakMap,_t=new WeakMap,xt=new WeakMap;function At(t){switch(t){case"Object":case"Array":return 1;case"Map":case"Set":case"WeakMap":case"WeakSet":return 2;default:return 0}}function kt(t){return t["__v_skip"]||!Object.isExtensible(t)?0:At((0,p.Zf)(t))}function Et(t){return Rt(t)?t:Ot(t,!1,st,gt,bt)}function St(t){return Ot(t,!1,ct,mt,wt)}function Ct(t){return Ot(t,!0,ut,yt,_t)}function Ot(t,e,r,n,i){if(!(0,p.Gv)(t))return t;if(t["__v_raw"]&&(!e||!t["__v_isReactive"]))return t;var o=i.get(t);if(o)return o;var a=kt(t);if(0===a)return t;var s=new Proxy(t,2===a?n:r);return i.set(t,s),s}function Tt(t){return Rt(t)?Tt(t["__v_raw"]):!(!t||!t["__v_isReactive"])}function Rt(t){return!(!t||!t["__v_isReadonly"])}function Dt(t){return!(!t||!t["__v_isShallow"])}function It(t){return!!t&&!!t["__v_raw"]}function Lt(t){var e=t&&t["__v_raw"];return e?Lt(e):t}function Pt(t){return!(0,p.$3)(t,"__v_skip")&&Object.isExtensible(t)&&(0,p.yQ)(t,"__v_skip",!0),t}var jt=function(t){return(0,p.Gv)(t)?Et(t):t},Ft=function(t){return(0,p.Gv)(t)?Ct(t):t};function Mt(t){return!!t&&!0===t["__v_isRef"]}function Nt(t){return Ut(t,!1)}function qt(t){return Ut(t,!0)}function Ut(t,e){return Mt(t)?t:new Bt(t,e)}var Bt=function(){function t(e,r){(0,l.A)(this,t),this.dep=new N,this["__v_isRef"]=!0,this["__v_isShallow"]=!1,this._rawValue=r?e:Lt(e),this._value=r?e:jt(e),this["__v_isShallow"]=r}return(0,f.A)(t,[{key:"value",get:function(){return this.dep.track(),this._value},set:function(t){var e=this._rawValue,r=this["__v_isShallow"]||Dt(t)||Rt(t);t=r?t:Lt(t),(0,p.$H)(t,e)&&(this._rawValue=t,this._value=r?t:jt(t),this.dep.trigger())}}])}();function zt(t){return Mt(t)?t.value:t}var Vt={get:function(t,e,r){return"__v_raw"===e?t:zt(Reflect.get(t,e,r))},set:function(t,e,r,n){var i=t[e];return Mt(i)&&!Mt(r)?(i.value=r,!0):Reflect.set(t,e,r,n)}};function $t(t){return Tt(t)?t:new Proxy(t,Vt)}var Gt=function(){function t(e,r,n){(0,l.A)(this,t),this.fn=e,this.setter=r,this._value=void 0,this.dep=new N(this),this.__v_isRef=!0,this.deps=void 0,this.depsTail=void 0,this.flags=16,this.globalVersion=F-1,this.next=void 0,this.effect=this,this["__v_isReadonly"]=!r,this.isSSR=n}return(0,f.A)(t,[{key:"notify",value:function(){if(this.flags|=16,!(8&this.flags||h===this))return x(this,!0),!0}},{key:"value",get:function(){var t=this.dep.track();return O(this),t&&(t.version=this.dep.version),this._value},set:function(t){this.setter&&this.setter(t)}}])}();function Ht(t,e){var r,n,i=arguments.length>2&&void 0!==arguments[2]&&arguments[2];(0,p.Tn)(t)?r=t:(r=t.get,n=t.set);var o=new Gt(r,n,i);return o}var Wt={},Zt=new WeakMap,Kt=void 0;function Yt(t){var e=arguments.length>2&&void 0!==arguments[2]?arguments[2]:Kt;if(e){var r=Zt.get(e);r||Zt.set(e,r=[]),r.push(t)}else 0}function Jt(t,e){var r,n,i,a,s=arguments.length>2&&void 0!==arguments[2]?arguments[2]:p.MZ,u=s.immediate,c=s.deep,l=s.once,f=s.scheduler,h=s.augmentJob,d=s.call,v=function(t){return c?t:Dt(t)||!1===c||0===c?Xt(t,1):Xt(t)},m=!1,y=!1;if(Mt(t)?(n=function(){return t.value},m=Dt(t)):Tt(t)?(n=function(){return v(t)},m=!0):(0,p.cy)(t)?(y=!0,m=t.some((function(t){return Tt(t)||Dt(t)})),n=function(){return t.map((function(t){return Mt(t)?t.value:Tt(t)?v(t):(0,p.Tn)(t)?d?d(t,2):t():void 0}))}):n=(0,p.Tn)(t)?e?d?function(){return d(t,2)}:t:function(){if(i){L();try{i()}finally{P()}}var e=Kt;Kt=r;try{return d?d(t,3,[a]):t(a)}finally{Kt=e}}:p.tE,e&&c){var b=n,_=!0===c?1/0:c;n=function(){return Xt(b(),_)}}var x=g(),A=function(){r.stop(),x&&x.active&&(0,p.TF)(x.effects,r)};if(l&&e){var k=e;e=function(){k.apply(void 0,arguments),A()}}var E=y?new Array(t.length).fill(Wt):Wt,S=function(t){if(1&r.flags&&(r.dirty||t))if(e){var n=r.run();if(c||m||(y?n.some((function(t,e){return(0,p.$H)(t,E[e])})):(0,p.$H)(n,E))){i&&i();var o=Kt;Kt=r;try{var s=[n,E===Wt?void 0:y&&E[0]===Wt?[]:E,a];d?d(e,3,s):e.apply(void 0,s),E=n}finally{Kt=o}}}else r.run()};return h&&h(S),r=new w(n),r.scheduler=f?function(){return f(S,!1)}:S,a=function(t){return Yt(t,!1,r)},i=r.onStop=function(){var t=Zt.get(r);if(t){if(d)d(t,4);else{var e,n=(0,o.A)(t);try{for(n.s();!(e=n.n()).done;){var i=e.value;i()}}catch(a){n.e(a)}finally{n.f()}}Zt["delete"](r)}},e?u?S(!0):E=r.run():f?f(S.bind(null,!0),!0):r.run(),A.pause=r.pause.bind(r),A.resume=r.resume.bind(r),A.stop=A,A}function Xt(t){var e=arguments.length>1&&void 0!==arguments[1]?arguments[1]:1/0,r=arguments.length>2?arguments[2]:void 0;if(e<=0||!(0,p.Gv)(t)||t["__v_skip"])return t;if(r=r||new Set,r.has(t))return t;if(r.add(t),e--,Mt(t))Xt(t.value,e,r);else if((0,p.cy)(t))for(var n=0;n<t.length;n++)Xt(t[n],e,r);else if((0,p.vM)(t)||(0,p.CE)(t))t.forEach((function(t){Xt(t,e,r)}));else if((0,p.Qd)(t)){for(var i in t)Xt(t[i],e,r);var a,s=(0,o.A)(Object.getOwnPropertySymbols(t));try{for(s.s();!(a=s.n()).done;){var u=a.value;Object.prototype.propertyIsEnumerable.call(t,u)&&Xt(t[u],e,r)}}catch(c){s.e(c)}finally{s.f()}}return t}},90235:(t,e,r)=>{"use strict";var n=r(59213).forEach,i=r(34598),o=i("forEach");t.exports=o?[].forEach:function(t){return n(this,t,arguments.length>1?arguments[1]:void 0)}},90537:(t,e,r)=>{"use strict";var n=r(80550),i=r(84428),o=r(10916).CONSTRUCTOR;t.exports=o||!i((function(t){n.all(t).then(void 0,(function(){}))}))},90679:(t,e,r)=>{"use strict";var n=r(1625),i=TypeError;t.exports=function(t,e){if(n(e,t))return t;throw new i("Incorrect invocation")}},90744:(t,e,r)=>{"use strict";var n=r(69565),i=r(79504),o=r(89228),a=r(28551),s=r(64117),u=r(67750),c=r(2293),l=r(57829),f=r(18014),p=r(655),h=r(55966),d=r(56682),v=r(58429),g=r(79039),m=v.UNSUPPORTED_Y,y=4294967295,b=Math.min,w=i([].push),_=i("".slice),x=!g((function(){var t=/(?:)/,e=t.exec;t.exec=function(){return e.apply(this,arguments)};var r="ab".split(t);return 2!==r.length||"a"!==r[0]||"b"!==r[1]})),A="c"==="abbc".split(/(b)*/)[1]||4!=="test".split(/(?:)/,-1).length||2!=="ab".split(/(?:ab)*/).length||4!==".".split(/(.?)(.?)/).length||".".split(/()()/).length>1||"".split(/.?/).length;o("split",(function(t,e,r){var i="0".split(void 0,0).length?function(t,r){return void 0===t&&0===r?[]:n(e,this,t,r)}:e;return[function(e,r){var o=u(this),a=s(e)?void 0:h(e,t);return a?n(a,e,o,r):n(i,p(o),e,r)},function(t,n){var o=a(this),s=p(t);if(!A){var u=r(i,o,s,n,i!==e);if(u.done)return u.value}var h=c(o,RegExp),v=o.unicode,g=(o.ignoreCase?"i":"")+(o.multiline?"m":"")+(o.unicode?"u":"")+(m?"g":"y"),x=new h(m?"^(?:"+o.source+")":o,g),k=void 0===n?y:n>>>0;if(0===k)return[];if(0===s.length)return null===d(x,s)?[s]:[];var E=0,S=0,C=[];while(S<s.length){x.lastIndex=m?0:S;var O,T=d(x,m?_(s,S):s);if(null===T||(O=b(f(x.lastIndex+(m?S:0)),s.length))===E)S=l(s,S,v);else{if(w(C,_(s,E,S)),C.length===k)return C;for(var R=1;R<=T.length-1;R++)if(w(C,T[R]),C.length===k)return C;S=E=O}}return w(C,_(s,E)),C}]}),A||!x,m)},90757:t=>{"use strict";t.exports=function(t,e){try{1===arguments.length?console.error(t):console.error(t,e)}catch(r){}}},90906:(t,e,r)=>{"use strict";r(27495);var n=r(46518),i=r(69565),o=r(94901),a=r(28551),s=r(655),u=function(){var t=!1,e=/[ac]/;return e.exec=function(){return t=!0,/./.exec.apply(this,arguments)},!0===e.test("abc")&&t}(),c=/./.test;n({target:"RegExp",proto:!0,forced:!u},{test:function(t){var e=a(this),r=s(t),n=e.exec;if(!o(n))return i(c,e,r);var u=i(n,e,r);return null!==u&&(a(u),!0)}})},91134:(t,e,r)=>{"use strict";var n=r(94644),i=r(43839).findLastIndex,o=n.aTypedArray,a=n.exportTypedArrayMethod;a("findLastIndex",(function(t){return i(o(this),t,arguments.length>1?arguments[1]:void 0)}))},91181:(t,e,r)=>{"use strict";var n,i,o,a=r(58622),s=r(22195),u=r(20034),c=r(66699),l=r(39297),f=r(77629),p=r(66119),h=r(30421),d="Object already initialized",v=s.TypeError,g=s.WeakMap,m=function(t){return o(t)?i(t):n(t,{})},y=function(t){return function(e){var r;if(!u(e)||(r=i(e)).type!==t)throw new v("Incompatible receiver, "+t+" required");return r}};if(a||f.state){var b=f.state||(f.state=new g);b.get=b.get,b.has=b.has,b.set=b.set,n=function(t,e){if(b.has(t))throw new v(d);return e.facade=t,b.set(t,e),e},i=function(t){return b.get(t)||{}},o=function(t){return b.has(t)}}else{var w=p("state");h[w]=!0,n=function(t,e){if(l(t,w))throw new v(d);return e.facade=t,c(t,w,e),e},i=function(t){return l(t,w)?t[w]:{}},o=function(t){return l(t,w)}}t.exports={set:n,get:i,has:o,enforce:m,getterFor:y}},91291:(t,e,r)=>{"use strict";var n=r(80741);t.exports=function(t){var e=+t;return e!==e||0===e?0:n(e)}},91296:(t,e,r)=>{"use strict";var n=r(4495);t.exports=n&&!!Symbol["for"]&&!!Symbol.keyFor},91625:(t,e,r)=>{"use strict";var n=r(79504),i=r(56279),o=r(3451).getWeakData,a=r(90679),s=r(28551),u=r(64117),c=r(20034),l=r(72652),f=r(59213),p=r(39297),h=r(91181),d=h.set,v=h.getterFor,g=f.find,m=f.findIndex,y=n([].splice),b=0,w=function(t){return t.frozen||(t.frozen=new _)},_=function(){this.entries=[]},x=function(t,e){return g(t.entries,(function(t){return t[0]===e}))};_.prototype={get:function(t){var e=x(this,t);if(e)return e[1]},has:function(t){return!!x(this,t)},set:function(t,e){var r=x(this,t);r?r[1]=e:this.entries.push([t,e])},delete:function(t){var e=m(this.entries,(function(e){return e[0]===t}));return~e&&y(this.entries,e,1),!!~e}},t.exports={getConstructor:function(t,e,r,n){var f=t((function(t,i){a(t,h),d(t,{type:e,id:b++,frozen:null}),u(i)||l(i,t[n],{that:t,AS_ENTRIES:r})})),h=f.prototype,g=v(e),m=function(t,e,r){var n=g(t),i=o(s(e),!0);return!0===i?w(n).set(e,r):i[n.id]=r,t};return i(h,{delete:function(t){var e=g(this);if(!c(t))return!1;var r=o(t);return!0===r?w(e)["delete"](t):r&&p(r,e.id)&&delete r[e.id]},has:function(t){var e=g(this);if(!c(t))return!1;var r=o(t);return!0===r?w(e).has(t):r&&p(r,e.id)}}),i(h,r?{get:function(t){var e=g(this);if(c(t)){var r=o(t);if(!0===r)return w(e).get(t);if(r)return r[e.id]}},set:function(t,e){return m(this,t,e)}}:{add:function(t){return m(this,t,!0)}}),f}}},91955:(t,e,r)=>{"use strict";var n,i,o,a,s,u=r(22195),c=r(93389),l=r(76080),f=r(59225).set,p=r(18265),h=r(89544),d=r(44265),v=r(7860),g=r(16193),m=u.MutationObserver||u.WebKitMutationObserver,y=u.document,b=u.process,w=u.Promise,_=c("queueMicrotask");if(!_){var x=new p,A=function(){var t,e;g&&(t=b.domain)&&t.exit();while(e=x.get())try{e()}catch(r){throw x.head&&n(),r}t&&t.enter()};h||g||v||!m||!y?!d&&w&&w.resolve?(a=w.resolve(void 0),a.constructor=w,s=l(a.then,a),n=function(){s(A)}):g?n=function(){b.nextTick(A)}:(f=l(f,u),n=function(){f(A)}):(i=!0,o=y.createTextNode(""),new m(A).observe(o,{characterData:!0}),n=function(){o.data=i=!i}),_=function(t){x.head||n(),x.add(t)}}t.exports=_},92140:(t,e,r)=>{"use strict";var n=r(78227),i=n("toStringTag"),o={};o[i]="z",t.exports="[object z]"===String(o)},92405:(t,e,r)=>{"use strict";var n=r(16468),i=r(86938);n("Set",(function(t){return function(){return t(this,arguments.length?arguments[0]:void 0)}}),i)},92744:(t,e,r)=>{"use strict";var n=r(79039);t.exports=!n((function(){return Object.isExtensible(Object.preventExtensions({}))}))},92796:(t,e,r)=>{"use strict";var n=r(79039),i=r(94901),o=/#|\.prototype\./,a=function(t,e){var r=u[s(t)];return r===l||r!==c&&(i(e)?n(e):!!e)},s=a.normalize=function(t){return String(t).replace(o,".").toLowerCase()},u=a.data={},c=a.NATIVE="N",l=a.POLYFILL="P";t.exports=a},92804:t=>{"use strict";var e="ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789",r=e+"+/",n=e+"-_",i=function(t){for(var e={},r=0;r<64;r++)e[t.charAt(r)]=r;return e};t.exports={i2c:r,c2i:i(r),i2cUrl:n,c2iUrl:i(n)}},93389:(t,e,r)=>{"use strict";var n=r(22195),i=r(43724),o=Object.getOwnPropertyDescriptor;t.exports=function(t){if(!i)return n[t];var e=o(n,t);return e&&e.value}},93438:(t,e,r)=>{"use strict";var n=r(28551),i=r(20034),o=r(36043);t.exports=function(t,e){if(n(t),i(e)&&e.constructor===t)return e;var r=o.f(t),a=r.resolve;return a(e),r.promise}},93515:(t,e,r)=>{"use strict";var n=r(46518);n({target:"Date",proto:!0},{toGMTString:Date.prototype.toUTCString})},93794:(t,e,r)=>{r(52675),r(89463),r(2259),r(16280),r(76918),r(64346),r(26099),r(47764),r(62953);var n=r(82229);function i(t,e){var r="undefined"!=typeof Symbol&&t[Symbol.iterator]||t["@@iterator"];if(!r){if(Array.isArray(t)||(r=n(t))||e&&t&&"number"==typeof t.length){r&&(t=r);var i=0,o=function(){};return{s:o,n:function(){return i>=t.length?{done:!0}:{done:!1,value:t[i++]}},e:function(t){throw t},f:o}}throw new TypeError("Invalid attempt to iterate non-iterable instance.\nIn order to be iterable, non-array objects must have a [Symbol.iterator]() method.")}var a,s=!0,u=!1;return{s:function(){r=r.call(t)},n:function(){var t=r.next();return s=t.done,t},e:function(t){u=!0,a=t},f:function(){try{s||null==r["return"]||r["return"]()}finally{if(u)throw a}}}}t.exports=i,t.exports.__esModule=!0,t.exports["default"]=t.exports},94052:(t,e,r)=>{"use strict";var n=r(46518),i=r(34124);n({target:"Object",stat:!0,forced:Object.isExtensible!==i},{isExtensible:i})},94170:(t,e,r)=>{"use strict";var n=r(46518),i=r(30566);n({target:"Function",proto:!0,forced:Function.bind!==i},{bind:i})},94402:(t,e,r)=>{"use strict";var n=r(79504),i=Set.prototype;t.exports={Set,add:n(i.add),has:n(i.has),remove:n(i["delete"]),proto:i}},94483:(t,e,r)=>{"use strict";var n,i,o,a,s=r(22195),u=r(89429),c=r(1548),l=s.structuredClone,f=s.ArrayBuffer,p=s.MessageChannel,h=!1;if(c)h=function(t){l(t,{transfer:[t]})};else if(f)try{p||(n=u("worker_threads"),n&&(p=n.MessageChannel)),p&&(i=new p,o=new f(2),a=function(t){i.port1.postMessage(null,[t])},2===o.byteLength&&(a(o),0===o.byteLength&&(h=a)))}catch(d){}t.exports=h},94490:(t,e,r)=>{"use strict";var n=r(46518),i=r(79504),o=r(34376),a=i([].reverse),s=[1,2];n({target:"Array",proto:!0,forced:String(s)===String(s.reverse())},{reverse:function(){return o(this)&&(this.length=this.length),a(this)}})},94644:(t,e,r)=>{"use strict";var n,i,o,a=r(77811),s=r(43724),u=r(22195),c=r(94901),l=r(20034),f=r(39297),p=r(36955),h=r(16823),d=r(66699),v=r(36840),g=r(62106),m=r(1625),y=r(42787),b=r(52967),w=r(78227),_=r(33392),x=r(91181),A=x.enforce,k=x.get,E=u.Int8Array,S=E&&E.prototype,C=u.Uint8ClampedArray,O=C&&C.prototype,T=E&&y(E),R=S&&y(S),D=Object.prototype,I=u.TypeError,L=w("toStringTag"),P=_("TYPED_ARRAY_TAG"),j="TypedArrayConstructor",F=a&&!!b&&"Opera"!==p(u.opera),M=!1,N={Int8Array:1,Uint8Array:1,Uint8ClampedArray:1,Int16Array:2,Uint16Array:2,Int32Array:4,Uint32Array:4,Float32Array:4,Float64Array:8},q={BigInt64Array:8,BigUint64Array:8},U=function(t){if(!l(t))return!1;var e=p(t);return"DataView"===e||f(N,e)||f(q,e)},B=function(t){var e=y(t);if(l(e)){var r=k(e);return r&&f(r,j)?r[j]:B(e)}},z=function(t){if(!l(t))return!1;var e=p(t);return f(N,e)||f(q,e)},V=function(t){if(z(t))return t;throw new I("Target is not a typed array")},$=function(t){if(c(t)&&(!b||m(T,t)))return t;throw new I(h(t)+" is not a typed array constructor")},G=function(t,e,r,n){if(s){if(r)for(var i in N){var o=u[i];if(o&&f(o.prototype,t))try{delete o.prototype[t]}catch(a){try{o.prototype[t]=e}catch(c){}}}R[t]&&!r||v(R,t,r?e:F&&S[t]||e,n)}},H=function(t,e,r){var n,i;if(s){if(b){if(r)for(n in N)if(i=u[n],i&&f(i,t))try{delete i[t]}catch(o){}if(T[t]&&!r)return;try{return v(T,t,r?e:F&&T[t]||e)}catch(o){}}for(n in N)i=u[n],!i||i[t]&&!r||v(i,t,e)}};for(n in N)i=u[n],o=i&&i.prototype,o?A(o)[j]=i:F=!1;for(n in q)i=u[n],o=i&&i.prototype,o&&(A(o)[j]=i);if((!F||!c(T)||T===Function.prototype)&&(T=function(){throw new I("Incorrect invocation")},F))for(n in N)u[n]&&b(u[n],T);if((!F||!R||R===D)&&(R=T.prototype,F))for(n in N)u[n]&&b(u[n].prototype,R);if(F&&y(O)!==R&&b(O,R),s&&!f(R,L))for(n in M=!0,g(R,L,{configurable:!0,get:function(){return l(this)?this[P]:void 0}}),N)u[n]&&d(u[n],P,n);t.exports={NATIVE_ARRAY_BUFFER_VIEWS:F,TYPED_ARRAY_TAG:M&&P,aTypedArray:V,aTypedArrayConstructor:$,exportTypedArrayMethod:G,exportTypedArrayStaticMethod:H,getTypedArrayConstructor:B,isView:U,isTypedArray:z,TypedArray:T,TypedArrayPrototype:R}},94901:t=>{"use strict";var e="object"==typeof document&&document.all;t.exports="undefined"==typeof e&&void 0!==e?function(t){return"function"==typeof t||t===e}:function(t){return"function"==typeof t}},95636:(t,e,r)=>{"use strict";var n=r(22195),i=r(79504),o=r(46706),a=r(57696),s=r(55169),u=r(67394),c=r(94483),l=r(1548),f=n.structuredClone,p=n.ArrayBuffer,h=n.DataView,d=Math.min,v=p.prototype,g=h.prototype,m=i(v.slice),y=o(v,"resizable","get"),b=o(v,"maxByteLength","get"),w=i(g.getInt8),_=i(g.setInt8);t.exports=(l||c)&&function(t,e,r){var n,i=u(t),o=void 0===e?i:a(e),v=!y||!y(t);if(s(t),l&&(t=f(t,{transfer:[t]}),i===o&&(r||v)))return t;if(i>=o&&(!r||v))n=m(t,0,o);else{var g=r&&!v&&b?{maxByteLength:b(t)}:void 0;n=new p(o,g);for(var x=new h(t),A=new h(n),k=d(o,i),E=0;E<k;E++)_(A,E,w(x,E))}return l||c(t),n}},96019:(t,e,r)=>{"use strict";function n(t,e){var r,n,i,o,a,s,u,c,l={},f=e.length;if(f){var p=0,h=-2,d=[];for(r=0;r<f;r++)if(i=e[r],d.push(0),e[p].marker===i.marker&&h===i.token-1||(p=r),h=i.token,i.length=i.length||0,i.close){for(l.hasOwnProperty(i.marker)||(l[i.marker]=[-1,-1,-1,-1,-1,-1]),a=l[i.marker][(i.open?3:0)+i.length%3],n=p-d[p]-1,s=n;n>a;n-=d[n]+1)if(o=e[n],o.marker===i.marker&&o.open&&o.end<0&&(u=!1,(o.close||i.open)&&(o.length+i.length)%3===0&&(o.length%3===0&&i.length%3===0||(u=!0)),!u)){c=n>0&&!e[n-1].open?d[n-1]+1:0,d[r]=r-n+c,d[n]=c,i.open=!1,o.end=r,o.close=!1,s=-1,h=-2;break}-1!==s&&(l[i.marker][(i.open?3:0)+(i.length||0)%3]=s)}}}r(44114),t.exports=function(t){var e,r=t.tokens_meta,i=t.tokens_meta.length;for(n(t,t.delimiters),e=0;e<i;e++)r[e]&&r[e].delimiters&&n(t,r[e].delimiters)}},96319:(t,e,r)=>{"use strict";var n=r(28551),i=r(9539);t.exports=function(t,e,r,o){try{return o?e(n(r)[0],r[1]):e(r)}catch(a){i(t,"throw",a)}}},96395:t=>{"use strict";t.exports=!1},96801:(t,e,r)=>{"use strict";var n=r(43724),i=r(48686),o=r(24913),a=r(28551),s=r(25397),u=r(71072);e.f=n&&!i?Object.defineProperties:function(t,e){a(t);var r,n=s(e),i=u(e),c=i.length,l=0;while(c>l)o.f(t,r=i[l++],n[r]);return t}},96837:t=>{"use strict";var e=TypeError,r=9007199254740991;t.exports=function(t){if(t>r)throw e("Maximum allowed index exceeded");return t}},97040:(t,e,r)=>{"use strict";var n=r(43724),i=r(24913),o=r(6980);t.exports=function(t,e,r){n?i.f(t,e,o(0,r)):t[e]=r}},97080:(t,e,r)=>{"use strict";var n=r(94402).has;t.exports=function(t){return n(t),t}},97726:(t,e,r)=>{"use strict";function n(t){switch(t){case 10:case 33:case 35:case 36:case 37:case 38:case 42:case 43:case 45:case 58:case 60:case 61:case 62:case 64:case 91:case 92:case 93:case 94:case 95:case 96:case 123:case 125:case 126:return!0;default:return!1}}r(34782),t.exports=function(t,e){var r=t.pos;while(r<t.posMax&&!n(t.src.charCodeAt(r)))r++;return r!==t.pos&&(e||(t.pending+=t.src.slice(t.pos,r)),t.pos=r,!0)}},97751:(t,e,r)=>{"use strict";var n=r(22195),i=r(94901),o=function(t){return i(t)?t:void 0};t.exports=function(t,e){return arguments.length<2?o(n[t]):n[t]&&n[t][e]}},97812:(t,e,r)=>{"use strict";var n=r(46518),i=r(39297),o=r(10757),a=r(16823),s=r(25745),u=r(91296),c=s("symbol-to-string-registry");n({target:"Symbol",stat:!0,forced:!u},{keyFor:function(t){if(!o(t))throw new TypeError(a(t)+" is not a symbol");if(i(c,t))return c[t]}})},97916:(t,e,r)=>{"use strict";var n=r(76080),i=r(69565),o=r(48981),a=r(96319),s=r(44209),u=r(33517),c=r(26198),l=r(97040),f=r(70081),p=r(50851),h=Array;t.exports=function(t){var e=o(t),r=u(this),d=arguments.length,v=d>1?arguments[1]:void 0,g=void 0!==v;g&&(v=n(v,d>2?arguments[2]:void 0));var m,y,b,w,_,x,A=p(e),k=0;if(!A||this===h&&s(A))for(m=c(e),y=r?new this(m):h(m);m>k;k++)x=g?v(e[k],k):e[k],l(y,k,x);else for(y=r?new this:[],w=f(e,A),_=w.next;!(b=i(_,w)).done;k++)x=g?a(w,v,[b.value,k],!0):b.value,l(y,k,x);return y.length=k,y}},98406:(t,e,r)=>{"use strict";r(23792),r(27337);var n=r(46518),i=r(22195),o=r(93389),a=r(97751),s=r(69565),u=r(79504),c=r(43724),l=r(67416),f=r(36840),p=r(62106),h=r(56279),d=r(10687),v=r(33994),g=r(91181),m=r(90679),y=r(94901),b=r(39297),w=r(76080),_=r(36955),x=r(28551),A=r(20034),k=r(655),E=r(2360),S=r(6980),C=r(70081),O=r(50851),T=r(62529),R=r(22812),D=r(78227),I=r(74488),L=D("iterator"),P="URLSearchParams",j=P+"Iterator",F=g.set,M=g.getterFor(P),N=g.getterFor(j),q=o("fetch"),U=o("Request"),B=o("Headers"),z=U&&U.prototype,V=B&&B.prototype,$=i.TypeError,G=i.encodeURIComponent,H=String.fromCharCode,W=a("String","fromCodePoint"),Z=parseInt,K=u("".charAt),Y=u([].join),J=u([].push),X=u("".replace),Q=u([].shift),tt=u([].splice),et=u("".split),rt=u("".slice),nt=u(/./.exec),it=/\+/g,ot="�",at=/^[0-9a-f]+$/i,st=function(t,e){var r=rt(t,e,e+2);return nt(at,r)?Z(r,16):NaN},ut=function(t){for(var e=0,r=128;r>0&&0!==(t&r);r>>=1)e++;return e},ct=function(t){var e=null;switch(t.length){case 1:e=t[0];break;case 2:e=(31&t[0])<<6|63&t[1];break;case 3:e=(15&t[0])<<12|(63&t[1])<<6|63&t[2];break;case 4:e=(7&t[0])<<18|(63&t[1])<<12|(63&t[2])<<6|63&t[3];break}return e>1114111?null:e},lt=function(t){t=X(t,it," ");var e=t.length,r="",n=0;while(n<e){var i=K(t,n);if("%"===i){if("%"===K(t,n+1)||n+3>e){r+="%",n++;continue}var o=st(t,n+1);if(o!==o){r+=i,n++;continue}n+=2;var a=ut(o);if(0===a)i=H(o);else{if(1===a||a>4){r+=ot,n++;continue}var s=[o],u=1;while(u<a){if(n++,n+3>e||"%"!==K(t,n))break;var c=st(t,n+1);if(c!==c){n+=3;break}if(c>191||c<128)break;J(s,c),n+=2,u++}if(s.length!==a){r+=ot;continue}var l=ct(s);null===l?r+=ot:i=W(l)}}r+=i,n++}return r},ft=/[!'()~]|%20/g,pt={"!":"%21","'":"%27","(":"%28",")":"%29","~":"%7E","%20":"+"},ht=function(t){return pt[t]},dt=function(t){return X(G(t),ft,ht)},vt=v((function(t,e){F(this,{type:j,target:M(t).entries,index:0,kind:e})}),P,(function(){var t=N(this),e=t.target,r=t.index++;if(!e||r>=e.length)return t.target=null,T(void 0,!0);var n=e[r];switch(t.kind){case"keys":return T(n.key,!1);case"values":return T(n.value,!1)}return T([n.key,n.value],!1)}),!0),gt=function(t){this.entries=[],this.url=null,void 0!==t&&(A(t)?this.parseObject(t):this.parseQuery("string"==typeof t?"?"===K(t,0)?rt(t,1):t:k(t)))};gt.prototype={type:P,bindURL:function(t){this.url=t,this.update()},parseObject:function(t){var e,r,n,i,o,a,u,c=this.entries,l=O(t);if(l){e=C(t,l),r=e.next;while(!(n=s(r,e)).done){if(i=C(x(n.value)),o=i.next,(a=s(o,i)).done||(u=s(o,i)).done||!s(o,i).done)throw new $("Expected sequence with length 2");J(c,{key:k(a.value),value:k(u.value)})}}else for(var f in t)b(t,f)&&J(c,{key:f,value:k(t[f])})},parseQuery:function(t){if(t){var e,r,n=this.entries,i=et(t,"&"),o=0;while(o<i.length)e=i[o++],e.length&&(r=et(e,"="),J(n,{key:lt(Q(r)),value:lt(Y(r,"="))}))}},serialize:function(){var t,e=this.entries,r=[],n=0;while(n<e.length)t=e[n++],J(r,dt(t.key)+"="+dt(t.value));return Y(r,"&")},update:function(){this.entries.length=0,this.parseQuery(this.url.query)},updateURL:function(){this.url&&this.url.update()}};var mt=function(){m(this,yt);var t=arguments.length>0?arguments[0]:void 0,e=F(this,new gt(t));c||(this.size=e.entries.length)},yt=mt.prototype;if(h(yt,{append:function(t,e){var r=M(this);R(arguments.length,2),J(r.entries,{key:k(t),value:k(e)}),c||this.length++,r.updateURL()},delete:function(t){var e=M(this),r=R(arguments.length,1),n=e.entries,i=k(t),o=r<2?void 0:arguments[1],a=void 0===o?o:k(o),s=0;while(s<n.length){var u=n[s];if(u.key!==i||void 0!==a&&u.value!==a)s++;else if(tt(n,s,1),void 0!==a)break}c||(this.size=n.length),e.updateURL()},get:function(t){var e=M(this).entries;R(arguments.length,1);for(var r=k(t),n=0;n<e.length;n++)if(e[n].key===r)return e[n].value;return null},getAll:function(t){var e=M(this).entries;R(arguments.length,1);for(var r=k(t),n=[],i=0;i<e.length;i++)e[i].key===r&&J(n,e[i].value);return n},has:function(t){var e=M(this).entries,r=R(arguments.length,1),n=k(t),i=r<2?void 0:arguments[1],o=void 0===i?i:k(i),a=0;while(a<e.length){var s=e[a++];if(s.key===n&&(void 0===o||s.value===o))return!0}return!1},set:function(t,e){var r=M(this);R(arguments.length,1);for(var n,i=r.entries,o=!1,a=k(t),s=k(e),u=0;u<i.length;u++)n=i[u],n.key===a&&(o?tt(i,u--,1):(o=!0,n.value=s));o||J(i,{key:a,value:s}),c||(this.size=i.length),r.updateURL()},sort:function(){var t=M(this);I(t.entries,(function(t,e){return t.key>e.key?1:-1})),t.updateURL()},forEach:function(t){var e,r=M(this).entries,n=w(t,arguments.length>1?arguments[1]:void 0),i=0;while(i<r.length)e=r[i++],n(e.value,e.key,this)},keys:function(){return new vt(this,"keys")},values:function(){return new vt(this,"values")},entries:function(){return new vt(this,"entries")}},{enumerable:!0}),f(yt,L,yt.entries,{name:"entries"}),f(yt,"toString",(function(){return M(this).serialize()}),{enumerable:!0}),c&&p(yt,"size",{get:function(){return M(this).entries.length},configurable:!0,enumerable:!0}),d(mt,P),n({global:!0,constructor:!0,forced:!l},{URLSearchParams:mt}),!l&&y(B)){var bt=u(V.has),wt=u(V.set),_t=function(t){if(A(t)){var e,r=t.body;if(_(r)===P)return e=t.headers?new B(t.headers):new B,bt(e,"content-type")||wt(e,"content-type","application/x-www-form-urlencoded;charset=UTF-8"),E(t,{body:S(0,k(r)),headers:S(0,e)})}return t};if(y(q)&&n({global:!0,enumerable:!0,dontCallGetSet:!0,forced:!0},{fetch:function(t){return q(t,arguments.length>1?_t(arguments[1]):{})}}),y(U)){var xt=function(t){return m(this,z),new U(t,arguments.length>1?_t(arguments[1]):{})};z.constructor=xt,xt.prototype=z,n({global:!0,constructor:!0,dontCallGetSet:!0,forced:!0},{Request:xt})}}t.exports={URLSearchParams:mt,getState:M}},98721:(t,e,r)=>{"use strict";var n=r(43724),i=r(79504),o=r(62106),a=URLSearchParams.prototype,s=i(a.forEach);n&&!("size"in a)&&o(a,"size",{get:function(){var t=0;return s(this,(function(){t++})),t},configurable:!0,enumerable:!0})},99136:t=>{"use strict";t.exports=function(t,e,r){var n,i,o,a,s=-1,u=t.posMax,c=t.pos;t.pos=e+1,n=1;while(t.pos<u){if(o=t.src.charCodeAt(t.pos),93===o&&(n--,0===n)){i=!0;break}if(a=t.pos,t.md.inline.skipToken(t),91===o)if(a===t.pos-1)n++;else if(r)return t.pos=c,-1}return i&&(s=t.pos),t.pos=c,s}},99449:(t,e,r)=>{"use strict";var n=r(46518),i=r(27476),o=r(77347).f,a=r(18014),s=r(655),u=r(60511),c=r(67750),l=r(41436),f=r(96395),p=i("".slice),h=Math.min,d=l("endsWith"),v=!f&&!d&&!!function(){var t=o(String.prototype,"endsWith");return t&&!t.writable}();n({target:"String",proto:!0,forced:!v&&!d},{endsWith:function(t){var e=s(c(this));u(t);var r=arguments.length>1?arguments[1]:void 0,n=e.length,i=void 0===r?n:h(a(r),n),o=s(t);return p(e,i-o.length,i)===o}})},99590:(t,e,r)=>{"use strict";var n=r(91291),i=RangeError;t.exports=function(t){var e=n(t);if(e<0)throw new i("The argument can't be less than 0");return e}}}]);
-//# sourceMappingURL=chunk-vendors.b27501e0.js.map
\ No newline at end of file